@@ -117,6 +117,36 @@ type IngressClassParamsSpec struct {
 	// LoadBalancerAttributes define the custom attributes to LoadBalancers for all Ingress that that belong to IngressClass with this IngressClassParams.
 	// +optional
 	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// LoadBalancerName defines the name of the LoadBalancer for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +kubebuilder:validation:MaxLength=32
+	// +optional
+	LoadBalancerName string `json:"loadBalancerName,omitempty"`
+
+	// Listeners defines the ports and protocols the LoadBalancer listens on for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// * only HTTP and HTTPS listeners are supported.
+	// +optional
+	Listeners []Listener `json:"listeners,omitempty"`
+
+	// CertificateARNs defines the ARNs of ACM certificates for HTTPS listeners of all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	CertificateARNs []string `json:"certificateARNs,omitempty"`
+
+	// InboundCIDRs defines the CIDRs that are allowed to access the LoadBalancer for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	InboundCIDRs []string `json:"inboundCIDRs,omitempty"`
+
+	// TargetType defines the target type of TargetGroups for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	TargetType *TargetType `json:"targetType,omitempty"`
+
+	// WAFv2ACLARN defines the ARN of the WAFv2 WebACL associated with the LoadBalancer for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	WAFv2ACLARN string `json:"wafv2ACLARN,omitempty"`
+
+	// ShieldAdvancedProtection defines whether AWS Shield Advanced protection is enabled on the LoadBalancer for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	ShieldAdvancedProtection *bool `json:"shieldAdvancedProtection,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,88 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IngressGroupNamespaceQuota limits the resources each namespace can claim on the shared LoadBalancer.
+type IngressGroupNamespaceQuota struct {
+	// maxRules is the maximum number of rules, counted as the paths of Ingress rules, across Ingresses within a namespace.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRules *int32 `json:"maxRules,omitempty"`
+
+	// maxHostnames is the maximum number of distinct hostnames across Ingresses within a namespace.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxHostnames *int32 `json:"maxHostnames,omitempty"`
+}
+
+// IngressGroupReservedHostname reserves a hostname to specific namespaces.
+type IngressGroupReservedHostname struct {
+	// hostname is the hostname that is reserved.
+	// +kubebuilder:validation:MinLength=1
+	Hostname string `json:"hostname"`
+
+	// namespaces are the namespaces that are allowed to use the hostname.
+	// +kubebuilder:validation:MinItems=1
+	Namespaces []string `json:"namespaces"`
+}
+
+// IngressGroupPolicySpec defines the desired state of IngressGroupPolicy
+type IngressGroupPolicySpec struct {
+	// namespaceSelector restricts the namespaces of Ingresses that are allowed to join the IngressGroup.
+	// * if absent or present but empty, it selects all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// namespaceQuota limits the rules and hostnames each namespace can claim on the IngressGroup.
+	// +optional
+	NamespaceQuota *IngressGroupNamespaceQuota `json:"namespaceQuota,omitempty"`
+
+	// reservedHostnames reserves hostnames to specific namespaces.
+	// Ingresses from other namespaces that use a reserved hostname are denied.
+	// +optional
+	ReservedHostnames []IngressGroupReservedHostname `json:"reservedHostnames,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=igp
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// IngressGroupPolicy is the Schema for the IngressGroupPolicy API.
+// It applies to the explicit IngressGroup with the same name as the IngressGroupPolicy.
+type IngressGroupPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IngressGroupPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IngressGroupPolicyList contains a list of IngressGroupPolicy
+type IngressGroupPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IngressGroupPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&IngressGroupPolicy{}, &IngressGroupPolicyList{})
+}
@@ -0,0 +1,292 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +kubebuilder:validation:Enum=HTTP;HTTPS;TLS
+// ListenerProtocol is the protocol of listener.
+//
+// * HTTP and HTTPS listeners are supported by Ingresses.
+// * TLS listeners are supported by Services, and denote the Service ports that terminate TLS on the load balancer.
+type ListenerProtocol string
+
+const (
+	ListenerProtocolHTTP  ListenerProtocol = "HTTP"
+	ListenerProtocolHTTPS ListenerProtocol = "HTTPS"
+	ListenerProtocolTLS   ListenerProtocol = "TLS"
+)
+
+// Listener defines a listener of load balancer.
+type Listener struct {
+	// Port is the port of listener.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Protocol is the protocol of listener.
+	Protocol ListenerProtocol `json:"protocol"`
+}
+
+// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP
+// HealthCheckProtocol is the protocol used for health checks.
+//
+// * TCP health checks are only supported by Services.
+type HealthCheckProtocol string
+
+const (
+	HealthCheckProtocolHTTP  HealthCheckProtocol = "HTTP"
+	HealthCheckProtocolHTTPS HealthCheckProtocol = "HTTPS"
+	HealthCheckProtocolTCP   HealthCheckProtocol = "TCP"
+)
+
+// HealthCheckConfiguration defines the health check configuration of target groups.
+type HealthCheckConfiguration struct {
+	// Port is the port used for health checks, either a port number or "traffic-port".
+	// +optional
+	Port *intstr.IntOrString `json:"port,omitempty"`
+
+	// Protocol is the protocol used for health checks.
+	// +optional
+	Protocol *HealthCheckProtocol `json:"protocol,omitempty"`
+
+	// Path is the destination path for HTTP and HTTPS health checks.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// IntervalSeconds is the approximate amount of time, in seconds, between health checks of an individual target.
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty"`
+
+	// TimeoutSeconds is the amount of time, in seconds, during which no response means a failed health check.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=120
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// HealthyThresholdCount is the number of consecutive successful health checks required before considering an unhealthy target healthy.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	HealthyThresholdCount *int64 `json:"healthyThresholdCount,omitempty"`
+
+	// UnhealthyThresholdCount is the number of consecutive failed health checks required before considering a target unhealthy.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	UnhealthyThresholdCount *int64 `json:"unhealthyThresholdCount,omitempty"`
+
+	// SuccessCodes are the HTTP or gRPC codes to use when checking for a successful response from a target.
+	// +optional
+	SuccessCodes *string `json:"successCodes,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=none;cognito;oidc
+// AuthType is the type of authentication.
+type AuthType string
+
+const (
+	AuthTypeNone    AuthType = "none"
+	AuthTypeCognito AuthType = "cognito"
+	AuthTypeOIDC    AuthType = "oidc"
+)
+
+// +kubebuilder:validation:Enum=authenticate;allow;deny
+// AuthOnUnauthenticatedRequest is the behavior if the user is not authenticated.
+type AuthOnUnauthenticatedRequest string
+
+const (
+	AuthOnUnauthenticatedRequestAuthenticate AuthOnUnauthenticatedRequest = "authenticate"
+	AuthOnUnauthenticatedRequestAllow        AuthOnUnauthenticatedRequest = "allow"
+	AuthOnUnauthenticatedRequestDeny         AuthOnUnauthenticatedRequest = "deny"
+)
+
+// AuthIDPCognito defines the configuration for IDP of Amazon Cognito.
+type AuthIDPCognito struct {
+	// UserPoolARN is the Amazon Resource Name (ARN) of the Amazon Cognito user pool.
+	UserPoolARN string `json:"userPoolARN"`
+
+	// UserPoolClientID is the ID of the Amazon Cognito user pool client.
+	UserPoolClientID string `json:"userPoolClientID"`
+
+	// UserPoolDomain is the domain prefix or fully-qualified domain name of the Amazon Cognito user pool.
+	UserPoolDomain string `json:"userPoolDomain"`
+
+	// AuthenticationRequestExtraParams are the query parameters (up to 10) to include in the redirect request to the authorization endpoint.
+	// +optional
+	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
+}
+
+// AuthIDPOIDC defines the configuration for IDP of OIDC.
+type AuthIDPOIDC struct {
+	// Issuer is the OIDC issuer identifier of the IdP.
+	Issuer string `json:"issuer"`
+
+	// AuthorizationEndpoint is the authorization endpoint of the IdP.
+	AuthorizationEndpoint string `json:"authorizationEndpoint"`
+
+	// TokenEndpoint is the token endpoint of the IdP.
+	TokenEndpoint string `json:"tokenEndpoint"`
+
+	// UserInfoEndpoint is the user info endpoint of the IdP.
+	UserInfoEndpoint string `json:"userInfoEndpoint"`
+
+	// SecretName is the name of the Secret that contains the clientID and clientSecret.
+	SecretName string `json:"secretName"`
+
+	// AuthenticationRequestExtraParams are the query parameters (up to 10) to include in the redirect request to the authorization endpoint.
+	// +optional
+	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
+}
+
+// AuthConfiguration defines the authentication configuration of Ingresses.
+type AuthConfiguration struct {
+	// Type is the type of authentication.
+	Type AuthType `json:"type"`
+
+	// IDPCognito is the IDP configuration when type is cognito.
+	// +optional
+	IDPCognito *AuthIDPCognito `json:"idpCognito,omitempty"`
+
+	// IDPOIDC is the IDP configuration when type is oidc.
+	// +optional
+	IDPOIDC *AuthIDPOIDC `json:"idpOIDC,omitempty"`
+
+	// OnUnauthenticatedRequest is the behavior if the user is not authenticated.
+	// +optional
+	OnUnauthenticatedRequest *AuthOnUnauthenticatedRequest `json:"onUnauthenticatedRequest,omitempty"`
+
+	// Scope is the set of user claims to be requested from the IdP.
+	// +optional
+	Scope *string `json:"scope,omitempty"`
+
+	// SessionCookieName is the name of the cookie used to maintain session information.
+	// +optional
+	SessionCookieName *string `json:"sessionCookieName,omitempty"`
+
+	// SessionTimeout is the maximum duration of the authentication session, in seconds.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=604800
+	// +optional
+	SessionTimeout *int64 `json:"sessionTimeout,omitempty"`
+}
+
+// WAFConfiguration defines the web application firewall configuration of Ingresses.
+type WAFConfiguration struct {
+	// WAFv2ACLARN is the ARN of the WAFv2 web ACL to associate with the load balancer.
+	// +optional
+	WAFv2ACLARN *string `json:"wafv2ACLARN,omitempty"`
+
+	// WAFACLID is the ID of the WAF Classic web ACL to associate with the load balancer.
+	// +optional
+	WAFACLID *string `json:"wafACLID,omitempty"`
+
+	// ShieldAdvancedProtection specifies whether AWS Shield Advanced protection is enabled on the load balancer.
+	// +optional
+	ShieldAdvancedProtection *bool `json:"shieldAdvancedProtection,omitempty"`
+}
+
+// LoadBalancerConfigurationSpec defines the desired state of LoadBalancerConfiguration
+type LoadBalancerConfigurationSpec struct {
+	// LoadBalancerName is the name of the load balancer.
+	// +kubebuilder:validation:MaxLength=32
+	// +optional
+	LoadBalancerName *string `json:"loadBalancerName,omitempty"`
+
+	// Scheme is the scheme of the load balancer.
+	// +optional
+	Scheme *LoadBalancerScheme `json:"scheme,omitempty"`
+
+	// IPAddressType is the ip address type of the load balancer.
+	// +optional
+	IPAddressType *IPAddressType `json:"ipAddressType,omitempty"`
+
+	// Subnets are the subnet IDs or subnet Name tags of the load balancer.
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// Tags are the AWS Tags on AWS resources provisioned for the load balancer.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// LoadBalancerAttributes are the custom attributes of the load balancer.
+	// +optional
+	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// Listeners are the listeners of the load balancer.
+	// +optional
+	Listeners []Listener `json:"listeners,omitempty"`
+
+	// CertificateARNs are the ARNs of the certificates for HTTPS and TLS listeners.
+	// +optional
+	CertificateARNs []string `json:"certificateARNs,omitempty"`
+
+	// SSLPolicy is the security policy for HTTPS and TLS listeners.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+
+	// HealthCheck is the health check configuration of target groups.
+	// +optional
+	HealthCheck *HealthCheckConfiguration `json:"healthCheck,omitempty"`
+
+	// TargetGroupAttributes are the custom attributes of target groups.
+	// +optional
+	TargetGroupAttributes []Attribute `json:"targetGroupAttributes,omitempty"`
+
+	// Authentication is the authentication configuration.
+	// Only supported by Ingresses.
+	// +optional
+	Authentication *AuthConfiguration `json:"authentication,omitempty"`
+
+	// WAF is the web application firewall configuration.
+	// Only supported by Ingresses.
+	// +optional
+	WAF *WAFConfiguration `json:"waf,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=lbconfig
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SCHEME",type="string",JSONPath=".spec.scheme",description="The AWS Load Balancer scheme"
+// +kubebuilder:printcolumn:name="IP-ADDRESS-TYPE",type="string",JSONPath=".spec.ipAddressType",description="The AWS Load Balancer ipAddressType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// LoadBalancerConfiguration is the Schema for the LoadBalancerConfiguration API
+type LoadBalancerConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LoadBalancerConfigurationSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerConfigurationList contains a list of LoadBalancerConfiguration
+type LoadBalancerConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancerConfiguration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancerConfiguration{}, &LoadBalancerConfigurationList{})
+}
@@ -121,6 +121,18 @@ type TargetGroupBindingNetworking struct {
 	Ingress []NetworkingIngressRule `json:"ingress,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=Replace;BlueGreen
+// ServiceRefUpdateStrategy defines how targets are replaced when serviceRef of TargetGroupBinding is updated.
+type ServiceRefUpdateStrategy string
+
+const (
+	// ServiceRefUpdateStrategyReplace deregisters the targets of previous Service while registering the targets of new Service.
+	ServiceRefUpdateStrategyReplace ServiceRefUpdateStrategy = "Replace"
+	// ServiceRefUpdateStrategyBlueGreen registers the targets of new Service,
+	// and only deregisters the targets of previous Service once the targets of new Service are healthy.
+	ServiceRefUpdateStrategyBlueGreen ServiceRefUpdateStrategy = "BlueGreen"
+)
+
 // TargetGroupBindingSpec defines the desired state of TargetGroupBinding
 type TargetGroupBindingSpec struct {
 	// targetGroupARN is the Amazon Resource Name (ARN) for the TargetGroup.
@@ -145,6 +157,49 @@ type TargetGroupBindingSpec struct {
 	// ipAddressType specifies whether the target group is of type IPv4 or IPv6. If unspecified, it will be automatically inferred.
 	// +optional
 	IPAddressType *TargetGroupIPAddressType `json:"ipAddressType,omitempty"`
+
+	// multiClusterTargetGroup denotes whether the TargetGroup is shared with TargetGroupBindings from other clusters.
+	// When enabled, the TargetGroupBinding only deregisters the targets that it has registered,
+	// and the registered targets are tracked in a ConfigMap within the TargetGroupBinding's namespace.
+	// +optional
+	MultiClusterTargetGroup bool `json:"multiClusterTargetGroup,omitempty"`
+
+	// serviceRefUpdateStrategy defines how targets are replaced when serviceRef is updated. Defaults to Replace.
+	// +optional
+	ServiceRefUpdateStrategy *ServiceRefUpdateStrategy `json:"serviceRefUpdateStrategy,omitempty"`
+}
+
+const (
+	// TargetGroupBindingConditionReady indicates whether the TargetGroupBinding is successfully reconciled.
+	TargetGroupBindingConditionReady = "Ready"
+	// TargetGroupBindingConditionTargetGroupNotFound indicates whether the TargetGroup of TargetGroupBinding doesn't exist.
+	TargetGroupBindingConditionTargetGroupNotFound = "TargetGroupNotFound"
+	// TargetGroupBindingConditionNetworkingReconcileFailed indicates whether the networking rules of TargetGroupBinding failed to reconcile.
+	TargetGroupBindingConditionNetworkingReconcileFailed = "NetworkingReconcileFailed"
+)
+
+// TargetGroupBindingTargetsStatus summarizes the targets in TargetGroup.
+type TargetGroupBindingTargetsStatus struct {
+	// registered is the number of targets registered in TargetGroup.
+	Registered int32 `json:"registered"`
+
+	// healthy is the number of targets in healthy state.
+	Healthy int32 `json:"healthy"`
+
+	// unhealthy is the number of targets in unhealthy or unavailable state.
+	Unhealthy int32 `json:"unhealthy"`
+
+	// draining is the number of targets in draining state.
+	Draining int32 `json:"draining"`
+}
+
+// TargetGroupBindingSwapStatus is the progress of swapping targets to a new serviceRef.
+type TargetGroupBindingSwapStatus struct {
+	// startTime is the time when the swap started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// pendingTargets is the number of endpoints of new serviceRef that are not yet registered as healthy targets.
+	PendingTargets int32 `json:"pendingTargets"`
 }
 
 // TargetGroupBindingStatus defines the observed state of TargetGroupBinding
@@ -152,6 +207,29 @@ type TargetGroupBindingStatus struct {
 	// The generation observed by the TargetGroupBinding controller.
 	// +optional
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// conditions represent the latest available observations of TargetGroupBinding's state.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// targets summarizes the targets in TargetGroup, as observed during the last reconcile.
+	// +optional
+	Targets *TargetGroupBindingTargetsStatus `json:"targets,omitempty"`
+
+	// lastSyncTime is the last time the TargetGroupBinding was successfully reconciled.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// serviceRef is the serviceRef whose endpoints are registered as targets, as of the last successful reconcile.
+	// It differs from spec.serviceRef while a BlueGreen swap is in progress.
+	// +optional
+	ServiceRef *ServiceReference `json:"serviceRef,omitempty"`
+
+	// swap is the progress of swapping targets to spec.serviceRef, which is only set while a BlueGreen swap is in progress.
+	// +optional
+	Swap *TargetGroupBindingSwapStatus `json:"swap,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -161,6 +239,12 @@ type TargetGroupBindingStatus struct {
 // +kubebuilder:printcolumn:name="SERVICE-PORT",type="string",JSONPath=".spec.serviceRef.port",description="The Kubernetes Service's port"
 // +kubebuilder:printcolumn:name="TARGET-TYPE",type="string",JSONPath=".spec.targetType",description="The AWS TargetGroup's TargetType"
 // +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".spec.targetGroupARN",description="The AWS TargetGroup's Amazon Resource Name",priority=1
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the TargetGroupBinding is successfully reconciled"
+// +kubebuilder:printcolumn:name="REGISTERED",type="integer",JSONPath=".status.targets.registered",description="The number of targets registered in AWS TargetGroup"
+// +kubebuilder:printcolumn:name="HEALTHY",type="integer",JSONPath=".status.targets.healthy",description="The number of healthy targets in AWS TargetGroup"
+// +kubebuilder:printcolumn:name="UNHEALTHY",type="integer",JSONPath=".status.targets.unhealthy",description="The number of unhealthy targets in AWS TargetGroup",priority=1
+// +kubebuilder:printcolumn:name="DRAINING",type="integer",JSONPath=".status.targets.draining",description="The number of draining targets in AWS TargetGroup",priority=1
+// +kubebuilder:printcolumn:name="LAST-SYNC",type="date",JSONPath=".status.lastSyncTime",description="The last time the TargetGroupBinding was successfully reconciled",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // TargetGroupBinding is the Schema for the TargetGroupBinding API
 type TargetGroupBinding struct {
@@ -0,0 +1,182 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TrafficSplitIngressBackend references the backend of Ingress whose traffic is split.
+type TrafficSplitIngressBackend struct {
+	// ingressName is the name of Ingress within the TrafficSplit's namespace.
+	IngressName string `json:"ingressName"`
+
+	// serviceName is the name of backend Service referenced by the Ingress's rules.
+	// Only the rules that forward to this Service are split.
+	ServiceName string `json:"serviceName"`
+}
+
+// TrafficSplitStep is a step of progressive traffic shifting.
+type TrafficSplitStep struct {
+	// weight is the percentage of traffic forwarded to the canary Service during this step.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// duration is how long to hold the weight before moving to the next step.
+	// The last step is held until the TrafficSplit is updated or deleted.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Pause;Rollback
+// TrafficSplitFailurePolicy defines what happens when the canary target group becomes unhealthy.
+type TrafficSplitFailurePolicy string
+
+const (
+	// TrafficSplitFailurePolicyPause keeps the current weight and stops shifting traffic.
+	TrafficSplitFailurePolicyPause TrafficSplitFailurePolicy = "Pause"
+	// TrafficSplitFailurePolicyRollback shifts all traffic back to the stable Service.
+	// The TrafficSplit stays rolled back until its spec is updated, even if the canary recovers.
+	TrafficSplitFailurePolicyRollback TrafficSplitFailurePolicy = "Rollback"
+)
+
+// TrafficSplitAnalysis defines how the health of canary target group is analysed.
+type TrafficSplitAnalysis struct {
+	// maxUnhealthyTargetsPercent is the maximum percentage of unhealthy targets in the canary target group.
+	// The canary is considered degraded once the percentage is exceeded.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxUnhealthyTargetsPercent *int32 `json:"maxUnhealthyTargetsPercent,omitempty"`
+
+	// failurePolicy defines what happens when the canary is degraded. Defaults to Rollback.
+	// Rollback sets the weight to 0 and stops shifting traffic until the spec is updated,
+	// while Pause keeps the current weight and resumes from the current step once the canary recovers.
+	// +optional
+	FailurePolicy *TrafficSplitFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// TrafficSplitSpec defines the desired state of TrafficSplit
+type TrafficSplitSpec struct {
+	// backend is the Ingress backend whose traffic is split.
+	Backend TrafficSplitIngressBackend `json:"backend"`
+
+	// stableServiceName is the name of Service that serves the stable version.
+	// The Service must expose the port referenced by the Ingress backend.
+	StableServiceName string `json:"stableServiceName"`
+
+	// canaryServiceName is the name of Service that serves the canary version.
+	// The Service must expose the port referenced by the Ingress backend.
+	CanaryServiceName string `json:"canaryServiceName"`
+
+	// steps are the steps of progressive traffic shifting, which are executed in order.
+	// +kubebuilder:validation:MinItems=1
+	Steps []TrafficSplitStep `json:"steps"`
+
+	// analysis defines how the health of canary target group is analysed.
+	// +optional
+	Analysis *TrafficSplitAnalysis `json:"analysis,omitempty"`
+
+	// paused stops shifting traffic while keeping the current weight.
+	// The current step is restarted once it's set back to false.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Progressing;Paused;Succeeded;RolledBack
+// TrafficSplitPhase is the phase of TrafficSplit.
+type TrafficSplitPhase string
+
+const (
+	// TrafficSplitPhaseProgressing means traffic is being shifted according to steps.
+	// A new TrafficSplit, or one whose spec is updated after it succeeded or rolled back, starts from the first step.
+	TrafficSplitPhaseProgressing TrafficSplitPhase = "Progressing"
+	// TrafficSplitPhasePaused means traffic shifting is paused, either by spec.paused or by a degraded canary.
+	TrafficSplitPhasePaused TrafficSplitPhase = "Paused"
+	// TrafficSplitPhaseSucceeded means the last step is reached, and its weight is kept.
+	TrafficSplitPhaseSucceeded TrafficSplitPhase = "Succeeded"
+	// TrafficSplitPhaseRolledBack means all traffic is shifted back to stable Service due to a degraded canary.
+	// The canary target group is kept with a weight of 0 until the spec is updated.
+	TrafficSplitPhaseRolledBack TrafficSplitPhase = "RolledBack"
+)
+
+// TrafficSplitStatus defines the observed state of TrafficSplit
+type TrafficSplitStatus struct {
+	// The generation observed by the TrafficSplit controller.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// phase is the phase of traffic shifting, which is one of Progressing, Paused, Succeeded or RolledBack.
+	// +optional
+	Phase TrafficSplitPhase `json:"phase,omitempty"`
+
+	// currentStep is the index of current step.
+	// +optional
+	CurrentStep int32 `json:"currentStep,omitempty"`
+
+	// weight is the percentage of traffic currently forwarded to the canary Service.
+	// It's reset to 0 when rolled back.
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+
+	// stepStartTime is the time when current step started.
+	// +optional
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+
+	// canaryTargetGroupARN is the ARN of target group for the canary Service.
+	// +optional
+	CanaryTargetGroupARN string `json:"canaryTargetGroupARN,omitempty"`
+
+	// canaryTargets summarizes the targets in canary target group, as observed during the last reconcile.
+	// +optional
+	CanaryTargets *TargetGroupBindingTargetsStatus `json:"canaryTargets,omitempty"`
+
+	// message is a human-readable explanation of current phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=tsplit
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="INGRESS",type="string",JSONPath=".spec.backend.ingressName",description="The Ingress whose backend is split"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".status.phase",description="The phase of traffic shifting"
+// +kubebuilder:printcolumn:name="WEIGHT",type="integer",JSONPath=".status.weight",description="The percentage of traffic forwarded to canary Service"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// TrafficSplit is the Schema for the TrafficSplit API
+type TrafficSplit struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrafficSplitSpec   `json:"spec,omitempty"`
+	Status TrafficSplitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrafficSplitList contains a list of TrafficSplit
+type TrafficSplitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrafficSplit `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TrafficSplit{}, &TrafficSplitList{})
+}
@@ -0,0 +1,277 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// render-stack renders the resource stacks that controller would build for Ingresses and Services,
+// without a live cluster or AWS account. AWS lookups are served from an environment file describing the VPC.
+//
+//	render-stack --cluster-name=my-cluster --environment=env.yaml --manifests=ingress.yaml --manifests=service.yaml
+//
+// With --plan, the rendered stacks are planned against the AWS account instead, which prints the changes that controller would make.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	zapraw "go.uber.org/zap"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/render"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	flagEnvironment = "environment"
+	flagManifests   = "manifests"
+	flagOutput      = "output"
+	flagPlan        = "plan"
+
+	outputJSON = "json"
+	outputYAML = "yaml"
+	outputDiff = "diff"
+)
+
+var scheme = k8sruntime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = elbv2api.AddToScheme(scheme)
+}
+
+// renderConfig contains the configuration for render-stack.
+type renderConfig struct {
+	ControllerConfig config.ControllerConfig
+
+	// EnvironmentFile is the path to environment file.
+	EnvironmentFile string
+	// ManifestFiles are the paths to manifest files, "-" reads from stdin.
+	ManifestFiles []string
+	// Output is the output format.
+	Output string
+	// Plan specifies whether to plan the stacks against AWS instead of rendering them.
+	Plan bool
+}
+
+func main() {
+	renderCFG, err := loadRenderConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to load config: %v\n", err)
+		os.Exit(1)
+	}
+	logger := getLoggerWithLogLevel(renderCFG.ControllerConfig.LogLevel)
+	if err := run(context.Background(), renderCFG, os.Stdout, logger); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to render stacks: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, renderCFG renderConfig, out io.Writer, logger logr.Logger) error {
+	envPayload, err := os.ReadFile(renderCFG.EnvironmentFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to read environment")
+	}
+	env, err := render.LoadEnvironment(envPayload)
+	if err != nil {
+		return err
+	}
+	var objects []client.Object
+	for _, manifestFile := range renderCFG.ManifestFiles {
+		manifestPayload, err := readManifest(manifestFile)
+		if err != nil {
+			return err
+		}
+		manifestObjects, err := render.LoadObjects(scheme, manifestPayload)
+		if err != nil {
+			return errors.Wrapf(err, "manifest: %v", manifestFile)
+		}
+		objects = append(objects, manifestObjects...)
+	}
+
+	renderer := render.NewDefaultRenderer(scheme, env, renderCFG.ControllerConfig, logger)
+	if renderCFG.Plan {
+		return runPlan(ctx, renderCFG, env, renderer, objects, out, logger)
+	}
+	stackSchemas, err := renderer.Render(ctx, objects)
+	if err != nil {
+		return err
+	}
+	return writeOutput(out, renderCFG.Output, stackSchemas)
+}
+
+// runPlan plans the rendered stacks against AWS, in the same way as controller would deploy them.
+// Only read-only calls are made to AWS.
+func runPlan(ctx context.Context, renderCFG renderConfig, env render.Environment, renderer render.Renderer,
+	objects []client.Object, out io.Writer, logger logr.Logger) error {
+	stacks, err := renderer.BuildStacks(ctx, objects)
+	if err != nil {
+		return err
+	}
+	awsCFG := renderCFG.ControllerConfig.AWSConfig
+	if awsCFG.VpcID == "" {
+		awsCFG.VpcID = env.VPCID
+	}
+	cloud, err := aws.NewCloud(awsCFG, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize AWS cloud")
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	sgManager := networking.NewDefaultSecurityGroupManager(cloud.EC2(), logger)
+
+	plans := make([]*plan.Plan, 0, len(stacks))
+	for _, stack := range stacks {
+		planner := deploy.NewDefaultStackPlanner(cloud, k8sClient, sgManager, renderCFG.ControllerConfig, stack.TagPrefix, logger)
+		stackPlan, err := planner.Plan(ctx, stack.Stack)
+		if err != nil {
+			return errors.Wrapf(err, "failed to plan stack: %v", stack.Stack.StackID())
+		}
+		plans = append(plans, stackPlan)
+	}
+	if renderCFG.Output == outputDiff {
+		return writePlanDiff(out, plans)
+	}
+	return writeOutput(out, renderCFG.Output, plans)
+}
+
+// writeOutput writes obj into out with the json or yaml output format.
+func writeOutput(out io.Writer, output string, obj interface{}) error {
+	payload, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+	if output == outputYAML {
+		if payload, err = yaml.JSONToYAML(payload); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintln(out, string(payload))
+	return err
+}
+
+// writePlanDiff writes plans into out with the diff output format, one change per line under the stackID of each plan.
+func writePlanDiff(out io.Writer, plans []*plan.Plan) error {
+	for _, stackPlan := range plans {
+		if _, err := fmt.Fprintf(out, "stack %v:\n", stackPlan.StackID); err != nil {
+			return err
+		}
+		if stackPlan.Empty() {
+			if _, err := fmt.Fprintln(out, "  no changes"); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, change := range stackPlan.Changes {
+			if _, err := fmt.Fprintf(out, "  %v\n", change.String()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readManifest(manifestFile string) ([]byte, error) {
+	var payload []byte
+	var err error
+	if manifestFile == "-" {
+		payload, err = io.ReadAll(os.Stdin)
+	} else {
+		payload, err = os.ReadFile(manifestFile)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read manifest: %v", manifestFile)
+	}
+	return payload, nil
+}
+
+// loadRenderConfig loads the config from command line flags.
+// the controller flags are accepted as is, so that stacks are rendered with the same settings as controller.
+func loadRenderConfig() (renderConfig, error) {
+	renderCFG := renderConfig{
+		ControllerConfig: config.ControllerConfig{
+			AWSConfig: aws.CloudConfig{
+				ThrottleConfig: throttle.NewDefaultServiceOperationsThrottleConfig(),
+			},
+			FeatureGates: config.NewFeatureGates(),
+		},
+	}
+
+	fs := pflag.NewFlagSet("", pflag.ExitOnError)
+	renderCFG.ControllerConfig.BindFlags(fs)
+	fs.StringVar(&renderCFG.EnvironmentFile, flagEnvironment, "",
+		"Path to the file describing the VPC, subnets, securityGroups and certificates")
+	fs.StringSliceVar(&renderCFG.ManifestFiles, flagManifests, nil,
+		"Paths to the manifests of Ingresses, Services and the objects they reference, - reads from stdin")
+	fs.StringVar(&renderCFG.Output, flagOutput, outputYAML,
+		"Output format - yaml(default), json, diff(only with --plan)")
+	fs.BoolVar(&renderCFG.Plan, flagPlan, false,
+		"Plan the rendered stacks against the AWS account and print the changes controller would make, instead of the stacks")
+
+	if err := fs.Parse(os.Args); err != nil {
+		return renderConfig{}, err
+	}
+	if err := renderCFG.ControllerConfig.Validate(); err != nil {
+		return renderConfig{}, err
+	}
+	if renderCFG.EnvironmentFile == "" {
+		return renderConfig{}, errors.Errorf("--%v must be specified", flagEnvironment)
+	}
+	if len(renderCFG.ManifestFiles) == 0 {
+		return renderConfig{}, errors.Errorf("--%v must be specified", flagManifests)
+	}
+	switch renderCFG.Output {
+	case outputJSON, outputYAML:
+	case outputDiff:
+		if !renderCFG.Plan {
+			return renderConfig{}, errors.Errorf("output format %v requires --%v", outputDiff, flagPlan)
+		}
+	default:
+		return renderConfig{}, errors.Errorf("unsupported output format: %v", renderCFG.Output)
+	}
+	return renderCFG, nil
+}
+
+// getLoggerWithLogLevel returns logger with specific log level, which writes to stderr.
+func getLoggerWithLogLevel(logLevel string) logr.Logger {
+	var zapLevel zapraw.AtomicLevel
+	switch logLevel {
+	case "debug":
+		zapLevel = zapraw.NewAtomicLevelAt(zapraw.DebugLevel)
+	default:
+		zapLevel = zapraw.NewAtomicLevelAt(zapraw.InfoLevel)
+	}
+
+	logger := zap.New(zap.UseDevMode(false),
+		zap.WriteTo(os.Stderr),
+		zap.Level(zapLevel),
+		zap.StacktraceLevel(zapraw.NewAtomicLevelAt(zapraw.FatalLevel)))
+	return runtime.NewConciseLogger(logger)
+}
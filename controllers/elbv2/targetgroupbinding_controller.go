@@ -21,18 +21,23 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	discv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shard"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/go-logr/logr"
@@ -50,13 +55,14 @@ const (
 // NewTargetGroupBindingReconciler constructs new targetGroupBindingReconciler
 func NewTargetGroupBindingReconciler(k8sClient client.Client, eventRecorder record.EventRecorder, finalizerManager k8s.FinalizerManager,
 	tgbResourceManager targetgroupbinding.ResourceManager, config config.ControllerConfig,
-	logger logr.Logger) *targetGroupBindingReconciler {
+	shardManager shard.Manager, logger logr.Logger) *targetGroupBindingReconciler {
 
 	return &targetGroupBindingReconciler{
 		k8sClient:          k8sClient,
 		eventRecorder:      eventRecorder,
 		finalizerManager:   finalizerManager,
 		tgbResourceManager: tgbResourceManager,
+		shardManager:       shardManager,
 		logger:             logger,
 
 		maxConcurrentReconciles:    config.TargetGroupBindingMaxConcurrentReconciles,
@@ -71,6 +77,7 @@ type targetGroupBindingReconciler struct {
 	eventRecorder      record.EventRecorder
 	finalizerManager   k8s.FinalizerManager
 	tgbResourceManager targetgroupbinding.ResourceManager
+	shardManager       shard.Manager
 	logger             logr.Logger
 
 	maxConcurrentReconciles    int
@@ -86,6 +93,7 @@ type targetGroupBindingReconciler struct {
 // +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;update;delete
 // +kubebuilder:rbac:groups="discovery.k8s.io",resources=endpointslices,verbs=get;list;watch
 
 func (r *targetGroupBindingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -111,14 +119,14 @@ func (r *targetGroupBindingReconciler) reconcileTargetGroupBinding(ctx context.C
 		return err
 	}
 
-	if err := r.tgbResourceManager.Reconcile(ctx, tgb); err != nil {
-		return err
-	}
-
-	if err := r.updateTargetGroupBindingStatus(ctx, tgb); err != nil {
+	targetsStatus, reconcileErr := r.tgbResourceManager.Reconcile(ctx, tgb)
+	if err := r.updateTargetGroupBindingStatus(ctx, tgb, targetsStatus, reconcileErr); err != nil {
 		r.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 		return err
 	}
+	if reconcileErr != nil {
+		return reconcileErr
+	}
 
 	r.eventRecorder.Event(tgb, corev1.EventTypeNormal, k8s.TargetGroupBindingEventReasonSuccessfullyReconciled, "Successfully reconciled")
 	return nil
@@ -138,55 +146,105 @@ func (r *targetGroupBindingReconciler) cleanupTargetGroupBinding(ctx context.Con
 	return nil
 }
 
-func (r *targetGroupBindingReconciler) updateTargetGroupBindingStatus(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	if aws.Int64Value(tgb.Status.ObservedGeneration) == tgb.Generation {
+func (r *targetGroupBindingReconciler) updateTargetGroupBindingStatus(ctx context.Context, tgb *elbv2api.TargetGroupBinding,
+	targetsStatus *elbv2api.TargetGroupBindingTargetsStatus, reconcileErr error) error {
+	newStatus := targetgroupbinding.BuildTargetGroupBindingStatus(tgb, targetsStatus, reconcileErr, metav1.Now())
+	if equality.Semantic.DeepEqual(tgb.Status, newStatus) {
 		return nil
 	}
 	tgbOld := tgb.DeepCopy()
-	tgb.Status.ObservedGeneration = aws.Int64(tgb.Generation)
+	tgb.Status = newStatus
 	if err := r.k8sClient.Status().Patch(ctx, tgb, client.MergeFrom(tgbOld)); err != nil {
 		return errors.Wrapf(err, "failed to update targetGroupBinding status: %v", k8s.NamespacedName(tgb))
 	}
+	r.recordServiceRefSwapEvents(tgb, tgbOld.Status)
 	return nil
 }
 
+// recordServiceRefSwapEvents records events for the start and completion of serviceRef swap.
+func (r *targetGroupBindingReconciler) recordServiceRefSwapEvents(tgb *elbv2api.TargetGroupBinding, oldStatus elbv2api.TargetGroupBindingStatus) {
+	switch {
+	case oldStatus.Swap == nil && tgb.Status.Swap != nil:
+		r.eventRecorder.Event(tgb, corev1.EventTypeNormal, k8s.TargetGroupBindingEventReasonSwapStarted,
+			fmt.Sprintf("Started swapping serviceRef from %v to %v", tgb.Status.ServiceRef.Name, tgb.Spec.ServiceRef.Name))
+	case oldStatus.Swap != nil && tgb.Status.Swap == nil:
+		r.eventRecorder.Event(tgb, corev1.EventTypeNormal, k8s.TargetGroupBindingEventReasonSwapCompleted,
+			fmt.Sprintf("Swapped serviceRef to %v after %v", tgb.Spec.ServiceRef.Name, time.Since(oldStatus.Swap.StartTime.Time).Round(time.Second)))
+	}
+}
+
 func (r *targetGroupBindingReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	c, err := shard.NewController(controllerName, mgr, r.shardManager, controller.Options{
+		MaxConcurrentReconciles: r.maxConcurrentReconciles,
+		RateLimiter:             workqueue.NewItemExponentialFailureRateLimiter(5*time.Millisecond, r.maxExponentialBackoffDelay),
+		Reconciler:              shard.NewShardedReconciler(r, r.shardManager, r.logger),
+	})
+	if err != nil {
+		return err
+	}
 	if err := r.setupIndexes(ctx, mgr.GetFieldIndexer()); err != nil {
 		return err
 	}
+	if err := r.setupWatches(ctx, c); err != nil {
+		return err
+	}
+	return nil
+}
 
+func (r *targetGroupBindingReconciler) setupWatches(_ context.Context, c controller.Controller) error {
+	if err := c.Watch(&source.Kind{Type: &elbv2api.TargetGroupBinding{}}, &handler.EnqueueRequestForObject{},
+		ignoreStatusOnlyUpdatePredicate()); err != nil {
+		return err
+	}
+	tgbEventChan := make(chan event.GenericEvent)
+	if err := c.Watch(&source.Channel{Source: tgbEventChan}, &handler.EnqueueRequestForObject{}); err != nil {
+		return err
+	}
 	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(r.k8sClient,
 		r.logger.WithName("eventHandlers").WithName("service"))
-	nodeEventsHandler := eventhandlers.NewEnqueueRequestsForNodeEvent(r.k8sClient,
-		r.logger.WithName("eventHandlers").WithName("node"))
-
+	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
+		return err
+	}
 	// Use the config flag to decide whether to use and watch an Endpoints event handler or an EndpointSlices event handler
 	if r.enableEndpointSlices {
 		epSliceEventsHandler := eventhandlers.NewEnqueueRequestsForEndpointSlicesEvent(r.k8sClient,
 			r.logger.WithName("eventHandlers").WithName("endpointslices"))
-		return ctrl.NewControllerManagedBy(mgr).
-			For(&elbv2api.TargetGroupBinding{}).
-			Named(controllerName).
-			Watches(&source.Kind{Type: &corev1.Service{}}, svcEventHandler).
-			Watches(&source.Kind{Type: &discv1.EndpointSlice{}}, epSliceEventsHandler).
-			Watches(&source.Kind{Type: &corev1.Node{}}, nodeEventsHandler).
-			WithOptions(controller.Options{
-				MaxConcurrentReconciles: r.maxConcurrentReconciles,
-				RateLimiter:             workqueue.NewItemExponentialFailureRateLimiter(5*time.Millisecond, r.maxExponentialBackoffDelay)}).
-			Complete(r)
+		if err := c.Watch(&source.Kind{Type: &discv1.EndpointSlice{}}, epSliceEventsHandler); err != nil {
+			return err
+		}
 	} else {
 		epsEventsHandler := eventhandlers.NewEnqueueRequestsForEndpointsEvent(r.k8sClient,
 			r.logger.WithName("eventHandlers").WithName("endpoints"))
-		return ctrl.NewControllerManagedBy(mgr).
-			For(&elbv2api.TargetGroupBinding{}).
-			Named(controllerName).
-			Watches(&source.Kind{Type: &corev1.Service{}}, svcEventHandler).
-			Watches(&source.Kind{Type: &corev1.Endpoints{}}, epsEventsHandler).
-			Watches(&source.Kind{Type: &corev1.Node{}}, nodeEventsHandler).
-			WithOptions(controller.Options{
-				MaxConcurrentReconciles: r.maxConcurrentReconciles,
-				RateLimiter:             workqueue.NewItemExponentialFailureRateLimiter(5*time.Millisecond, r.maxExponentialBackoffDelay)}).
-			Complete(r)
+		if err := c.Watch(&source.Kind{Type: &corev1.Endpoints{}}, epsEventsHandler); err != nil {
+			return err
+		}
+	}
+	nodeEventsHandler := eventhandlers.NewEnqueueRequestsForNodeEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("node"))
+	if err := c.Watch(&source.Kind{Type: &corev1.Node{}}, nodeEventsHandler); err != nil {
+		return err
+	}
+	r.shardManager.AddChangeHandler(shard.NewResyncHandler(r.k8sClient, func() client.ObjectList { return &elbv2api.TargetGroupBindingList{} },
+		tgbEventChan, r.logger.WithName("shardResync")))
+	return nil
+}
+
+// ignoreStatusOnlyUpdatePredicate ignores updates that only changed the status of TargetGroupBinding,
+// so that status updates from our own reconcile won't trigger reconcile again.
+func ignoreStatusOnlyUpdatePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			tgbOld, okOld := e.ObjectOld.(*elbv2api.TargetGroupBinding)
+			tgbNew, okNew := e.ObjectNew.(*elbv2api.TargetGroupBinding)
+			if !okOld || !okNew {
+				return true
+			}
+			// resync events have identical resourceVersion, which should always be reconciled.
+			if tgbOld.ResourceVersion == tgbNew.ResourceVersion || tgbOld.Generation != tgbNew.Generation {
+				return true
+			}
+			return equality.Semantic.DeepEqual(tgbOld.Status, tgbNew.Status)
+		},
 	}
 }
 
@@ -0,0 +1,143 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/trafficsplit"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	trafficSplitControllerName = "trafficSplit"
+)
+
+// NewTrafficSplitReconciler constructs new trafficSplitReconciler
+func NewTrafficSplitReconciler(k8sClient client.Client, eventRecorder record.EventRecorder,
+	targetsManager targetgroupbinding.TargetsManager, logger logr.Logger) *trafficSplitReconciler {
+	return &trafficSplitReconciler{
+		k8sClient:      k8sClient,
+		eventRecorder:  eventRecorder,
+		targetsManager: targetsManager,
+		logger:         logger,
+	}
+}
+
+// trafficSplitReconciler reconciles a TrafficSplit object.
+// It shifts the weight in TrafficSplit's status according to steps and the health of canary target group,
+// while the weight is applied to the Ingress by Ingress controller.
+type trafficSplitReconciler struct {
+	k8sClient      client.Client
+	eventRecorder  record.EventRecorder
+	targetsManager targetgroupbinding.TargetsManager
+	logger         logr.Logger
+}
+
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=trafficsplits,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=trafficsplits/status,verbs=update;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *trafficSplitReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.logger.V(1).Info("Reconcile request", "name", req.Name)
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *trafficSplitReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	ts := &elbv2api.TrafficSplit{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, ts); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !ts.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	canaryTGARN, err := trafficsplit.FindCanaryTargetGroupARN(ctx, r.k8sClient, ts)
+	if err != nil {
+		return err
+	}
+	var canaryTargets *elbv2api.TargetGroupBindingTargetsStatus
+	if canaryTGARN != "" {
+		targets, err := r.targetsManager.ListTargets(ctx, canaryTGARN)
+		if err != nil {
+			return err
+		}
+		canaryTargets = targetgroupbinding.BuildTargetsStatus(targets)
+	}
+
+	newStatus, requeueAfter := trafficsplit.BuildTrafficSplitStatus(ts, canaryTGARN, canaryTargets, metav1.Now())
+	r.recordTrafficSplitEvents(ts, newStatus)
+	if err := r.updateTrafficSplitStatus(ctx, ts, newStatus); err != nil {
+		r.eventRecorder.Event(ts, corev1.EventTypeWarning, k8s.TrafficSplitEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	if requeueAfter > 0 {
+		return runtime.NewRequeueNeededAfter("monitor canary", requeueAfter)
+	}
+	return nil
+}
+
+// recordTrafficSplitEvents records events for the phase and step transitions of TrafficSplit.
+func (r *trafficSplitReconciler) recordTrafficSplitEvents(ts *elbv2api.TrafficSplit, newStatus elbv2api.TrafficSplitStatus) {
+	oldStatus := ts.Status
+	switch {
+	case newStatus.Phase == elbv2api.TrafficSplitPhaseSucceeded && oldStatus.Phase != elbv2api.TrafficSplitPhaseSucceeded:
+		r.eventRecorder.Event(ts, corev1.EventTypeNormal, k8s.TrafficSplitEventReasonSucceeded,
+			fmt.Sprintf("Successfully shifted %v%% traffic to canary", newStatus.Weight))
+	case newStatus.Phase == elbv2api.TrafficSplitPhaseRolledBack && oldStatus.Phase != elbv2api.TrafficSplitPhaseRolledBack,
+		newStatus.Phase == elbv2api.TrafficSplitPhasePaused && oldStatus.Phase != elbv2api.TrafficSplitPhasePaused && !ts.Spec.Paused:
+		r.eventRecorder.Event(ts, corev1.EventTypeWarning, k8s.TrafficSplitEventReasonCanaryDegraded, newStatus.Message)
+	case newStatus.Phase == elbv2api.TrafficSplitPhaseProgressing && newStatus.Weight != oldStatus.Weight:
+		r.eventRecorder.Event(ts, corev1.EventTypeNormal, k8s.TrafficSplitEventReasonStepAdvanced,
+			fmt.Sprintf("Shifted %v%% traffic to canary at step %v", newStatus.Weight, newStatus.CurrentStep))
+	}
+}
+
+func (r *trafficSplitReconciler) updateTrafficSplitStatus(ctx context.Context, ts *elbv2api.TrafficSplit, newStatus elbv2api.TrafficSplitStatus) error {
+	if equality.Semantic.DeepEqual(ts.Status, newStatus) {
+		return nil
+	}
+	tsOld := ts.DeepCopy()
+	ts.Status = newStatus
+	if err := r.k8sClient.Status().Patch(ctx, ts, client.MergeFrom(tsOld)); err != nil {
+		return errors.Wrapf(err, "failed to update trafficSplit status: %v", k8s.NamespacedName(ts))
+	}
+	return nil
+}
+
+func (r *trafficSplitReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&elbv2api.TrafficSplit{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named(trafficSplitControllerName).
+		Complete(r)
+}
@@ -0,0 +1,77 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// NewEnqueueRequestsForGatewayClassEvent constructs new enqueueRequestsForGatewayClassEvent.
+func NewEnqueueRequestsForGatewayClassEvent(k8sClient client.Client, logger logr.Logger) *enqueueRequestsForGatewayClassEvent {
+	return &enqueueRequestsForGatewayClassEvent{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForGatewayClassEvent)(nil)
+
+type enqueueRequestsForGatewayClassEvent struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*gwv1beta1.GatewayClass))
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	gwClassOld := e.ObjectOld.(*gwv1beta1.GatewayClass)
+	gwClassNew := e.ObjectNew.(*gwv1beta1.GatewayClass)
+
+	// we only care below update event:
+	//	1. GatewayClass spec updates
+	//	2. GatewayClass deletion
+	if equality.Semantic.DeepEqual(gwClassOld.Spec, gwClassNew.Spec) &&
+		equality.Semantic.DeepEqual(gwClassOld.DeletionTimestamp.IsZero(), gwClassNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedGateways(queue, gwClassNew)
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*gwv1beta1.GatewayClass))
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*gwv1beta1.GatewayClass))
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) enqueueImpactedGateways(queue workqueue.RateLimitingInterface, gwClass *gwv1beta1.GatewayClass) {
+	gwList := &gwv1beta1.GatewayList{}
+	if err := h.k8sClient.List(context.Background(), gwList); err != nil {
+		h.logger.Error(err, "failed to fetch gateways")
+		return
+	}
+	for i := range gwList.Items {
+		gw := &gwList.Items[i]
+		if string(gw.Spec.GatewayClassName) != gwClass.Name {
+			continue
+		}
+		gwKey := k8s.NamespacedName(gw)
+		h.logger.V(1).Info("enqueue gateway for gatewayClass event",
+			"gatewayClass", gwClass.Name,
+			"gateway", gwKey.String(),
+		)
+		queue.Add(reconcile.Request{NamespacedName: gwKey})
+	}
+}
@@ -0,0 +1,86 @@
+package eventhandlers
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// NewEnqueueRequestsForHTTPRouteEvent constructs new enqueueRequestsForHTTPRouteEvent.
+func NewEnqueueRequestsForHTTPRouteEvent(logger logr.Logger) *enqueueRequestsForHTTPRouteEvent {
+	return &enqueueRequestsForHTTPRouteEvent{
+		logger: logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForHTTPRouteEvent)(nil)
+
+type enqueueRequestsForHTTPRouteEvent struct {
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForHTTPRouteEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueReferencedGateways(queue, e.Object.(*gwv1beta1.HTTPRoute))
+}
+
+func (h *enqueueRequestsForHTTPRouteEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	routeOld := e.ObjectOld.(*gwv1beta1.HTTPRoute)
+	routeNew := e.ObjectNew.(*gwv1beta1.HTTPRoute)
+
+	// we only care below update event:
+	//	1. HTTPRoute annotation updates
+	//	2. HTTPRoute spec updates
+	//	3. HTTPRoute deletion
+	if equality.Semantic.DeepEqual(routeOld.Annotations, routeNew.Annotations) &&
+		equality.Semantic.DeepEqual(routeOld.Spec, routeNew.Spec) &&
+		equality.Semantic.DeepEqual(routeOld.DeletionTimestamp.IsZero(), routeNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	// both old and new parents should be reconciled, so that the route can be detached from old parents.
+	h.enqueueReferencedGateways(queue, routeOld)
+	h.enqueueReferencedGateways(queue, routeNew)
+}
+
+func (h *enqueueRequestsForHTTPRouteEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueReferencedGateways(queue, e.Object.(*gwv1beta1.HTTPRoute))
+}
+
+func (h *enqueueRequestsForHTTPRouteEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueReferencedGateways(queue, e.Object.(*gwv1beta1.HTTPRoute))
+}
+
+func (h *enqueueRequestsForHTTPRouteEvent) enqueueReferencedGateways(queue workqueue.RateLimitingInterface, route *gwv1beta1.HTTPRoute) {
+	for _, gwKey := range ReferencedGatewayKeys(route.Namespace, route.Spec.ParentRefs) {
+		h.logger.V(1).Info("enqueue gateway for httpRoute event",
+			"httpRoute", k8s.NamespacedName(route).String(),
+			"gateway", gwKey.String(),
+		)
+		queue.Add(reconcile.Request{NamespacedName: gwKey})
+	}
+}
+
+// ReferencedGatewayKeys returns the keys of Gateways referenced by parentRefs on route within routeNamespace.
+func ReferencedGatewayKeys(routeNamespace string, parentRefs []gwv1beta1.ParentReference) []types.NamespacedName {
+	var gwKeys []types.NamespacedName
+	for _, parentRef := range parentRefs {
+		if parentRef.Group != nil && *parentRef.Group != gwv1beta1.GroupName {
+			continue
+		}
+		if parentRef.Kind != nil && *parentRef.Kind != "Gateway" {
+			continue
+		}
+		gwNamespace := routeNamespace
+		if parentRef.Namespace != nil {
+			gwNamespace = string(*parentRef.Namespace)
+		}
+		gwKeys = append(gwKeys, types.NamespacedName{Namespace: gwNamespace, Name: string(parentRef.Name)})
+	}
+	return gwKeys
+}
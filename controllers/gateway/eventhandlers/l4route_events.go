@@ -0,0 +1,84 @@
+package eventhandlers
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	gatewaypkg "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForL4RouteEvent constructs new enqueueRequestsForL4RouteEvent.
+// it handles events for TCPRoute, UDPRoute and TLSRoute.
+func NewEnqueueRequestsForL4RouteEvent(logger logr.Logger) *enqueueRequestsForL4RouteEvent {
+	return &enqueueRequestsForL4RouteEvent{
+		logger: logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForL4RouteEvent)(nil)
+
+type enqueueRequestsForL4RouteEvent struct {
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForL4RouteEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueReferencedGateways(queue, e.Object)
+}
+
+func (h *enqueueRequestsForL4RouteEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	routeOld, err := gatewaypkg.NewL4Route(e.ObjectOld)
+	if err != nil {
+		h.logger.Error(err, "failed to handle route event")
+		return
+	}
+	routeNew, err := gatewaypkg.NewL4Route(e.ObjectNew)
+	if err != nil {
+		h.logger.Error(err, "failed to handle route event")
+		return
+	}
+
+	// we only care below update event:
+	//	1. route annotation updates
+	//	2. route spec updates
+	//	3. route deletion
+	if equality.Semantic.DeepEqual(e.ObjectOld.GetAnnotations(), e.ObjectNew.GetAnnotations()) &&
+		equality.Semantic.DeepEqual(routeOld.ParentRefs, routeNew.ParentRefs) &&
+		equality.Semantic.DeepEqual(routeOld.Hostnames, routeNew.Hostnames) &&
+		equality.Semantic.DeepEqual(routeOld.RuleBackendRefs, routeNew.RuleBackendRefs) &&
+		equality.Semantic.DeepEqual(e.ObjectOld.GetDeletionTimestamp().IsZero(), e.ObjectNew.GetDeletionTimestamp().IsZero()) {
+		return
+	}
+
+	// both old and new parents should be reconciled, so that the route can be detached from old parents.
+	h.enqueueReferencedGateways(queue, e.ObjectOld)
+	h.enqueueReferencedGateways(queue, e.ObjectNew)
+}
+
+func (h *enqueueRequestsForL4RouteEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueReferencedGateways(queue, e.Object)
+}
+
+func (h *enqueueRequestsForL4RouteEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueReferencedGateways(queue, e.Object)
+}
+
+func (h *enqueueRequestsForL4RouteEvent) enqueueReferencedGateways(queue workqueue.RateLimitingInterface, obj client.Object) {
+	route, err := gatewaypkg.NewL4Route(obj)
+	if err != nil {
+		h.logger.Error(err, "failed to handle route event")
+		return
+	}
+	for _, gwKey := range ReferencedGatewayKeys(obj.GetNamespace(), route.ParentRefs) {
+		h.logger.V(1).Info("enqueue gateway for route event",
+			"kind", route.Kind,
+			"route", k8s.NamespacedName(obj).String(),
+			"gateway", gwKey.String(),
+		)
+		queue.Add(reconcile.Request{NamespacedName: gwKey})
+	}
+}
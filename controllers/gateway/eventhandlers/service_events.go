@@ -0,0 +1,140 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	gatewaypkg "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// NewEnqueueRequestsForServiceEvent constructs new enqueueRequestsForServiceEvent.
+// l4RoutesAvailable denotes whether TCPRoute, UDPRoute and TLSRoute resources are available in cluster.
+func NewEnqueueRequestsForServiceEvent(k8sClient client.Client, l4RoutesAvailable bool, logger logr.Logger) *enqueueRequestsForServiceEvent {
+	return &enqueueRequestsForServiceEvent{
+		k8sClient:         k8sClient,
+		l4RoutesAvailable: l4RoutesAvailable,
+		logger:            logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForServiceEvent)(nil)
+
+type enqueueRequestsForServiceEvent struct {
+	k8sClient         client.Client
+	l4RoutesAvailable bool
+	logger            logr.Logger
+}
+
+func (h *enqueueRequestsForServiceEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	svcOld := e.ObjectOld.(*corev1.Service)
+	svcNew := e.ObjectNew.(*corev1.Service)
+
+	// we only care below update event:
+	//	1. Service annotation updates
+	//	2. Service spec updates
+	if equality.Semantic.DeepEqual(svcOld.Annotations, svcNew.Annotations) &&
+		equality.Semantic.DeepEqual(svcOld.Spec, svcNew.Spec) {
+		return
+	}
+
+	h.enqueueImpactedGateways(queue, svcNew)
+}
+
+func (h *enqueueRequestsForServiceEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+func (h *enqueueRequestsForServiceEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+// enqueueImpactedGateways will enqueue Gateways whose routes references the service as backend.
+func (h *enqueueRequestsForServiceEvent) enqueueImpactedGateways(queue workqueue.RateLimitingInterface, svc *corev1.Service) {
+	routeList := &gwv1beta1.HTTPRouteList{}
+	if err := h.k8sClient.List(context.Background(), routeList, client.InNamespace(svc.Namespace)); err != nil {
+		h.logger.Error(err, "failed to fetch httpRoutes")
+		return
+	}
+
+	svcKey := k8s.NamespacedName(svc)
+	gwKeys := make(map[types.NamespacedName]struct{})
+	for _, route := range routeList.Items {
+		if !isServiceReferencedByHTTPRoute(route, svc.Name) {
+			continue
+		}
+		for _, gwKey := range ReferencedGatewayKeys(route.Namespace, route.Spec.ParentRefs) {
+			gwKeys[gwKey] = struct{}{}
+		}
+	}
+	if h.l4RoutesAvailable {
+		l4Routes, err := gatewaypkg.ListL4Routes(context.Background(), h.k8sClient, client.InNamespace(svc.Namespace))
+		if err != nil {
+			h.logger.Error(err, "failed to fetch L4 routes")
+			return
+		}
+		for _, route := range l4Routes {
+			if !isServiceReferencedByL4Route(route, svc.Name) {
+				continue
+			}
+			for _, gwKey := range ReferencedGatewayKeys(route.Object.GetNamespace(), route.ParentRefs) {
+				gwKeys[gwKey] = struct{}{}
+			}
+		}
+	}
+	for gwKey := range gwKeys {
+		h.logger.V(1).Info("enqueue gateway for service event",
+			"service", svcKey.String(),
+			"gateway", gwKey.String(),
+		)
+		queue.Add(reconcile.Request{NamespacedName: gwKey})
+	}
+}
+
+// isServiceReferencedByHTTPRoute checks whether the service with svcName is referenced as backend by HTTPRoute in same namespace.
+func isServiceReferencedByHTTPRoute(route gwv1beta1.HTTPRoute, svcName string) bool {
+	for _, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			if isServiceBackendRef(backendRef.BackendRef, route.Namespace, svcName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isServiceReferencedByL4Route checks whether the service with svcName is referenced as backend by L4 route in same namespace.
+func isServiceReferencedByL4Route(route gatewaypkg.L4Route, svcName string) bool {
+	for _, backendRefs := range route.RuleBackendRefs {
+		for _, backendRef := range backendRefs {
+			if isServiceBackendRef(backendRef, route.Object.GetNamespace(), svcName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isServiceBackendRef checks whether the backendRef on route within routeNamespace references the service with svcName.
+func isServiceBackendRef(backendRef gwv1beta1.BackendRef, routeNamespace string, svcName string) bool {
+	if backendRef.Kind != nil && *backendRef.Kind != "Service" {
+		return false
+	}
+	if backendRef.Namespace != nil && string(*backendRef.Namespace) != routeNamespace {
+		return false
+	}
+	return string(backendRef.Name) == svcName
+}
@@ -0,0 +1,380 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/gateway/eventhandlers"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	gatewaypkg "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	gatewayFinalizer        = "gateway.k8s.aws/resources"
+	gatewayTagPrefix        = "gateway.k8s.aws"
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+	controllerName          = "gateway"
+)
+
+// NewGatewayReconciler constructs new gatewayReconciler
+func NewGatewayReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+	finalizerManager k8s.FinalizerManager, networkingSGManager networkingpkg.SecurityGroupManager,
+	networkingSGReconciler networkingpkg.SecurityGroupReconciler, subnetsResolver networkingpkg.SubnetsResolver,
+	vpcInfoProvider networkingpkg.VPCInfoProvider, controllerConfig config.ControllerConfig, backendSGProvider networkingpkg.BackendSGProvider,
+	logger logr.Logger) *gatewayReconciler {
+
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
+	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, false)
+	trackingProvider := tracking.NewDefaultProvider(gatewayTagPrefix, controllerConfig.ClusterName)
+	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.RGT(), cloud.VpcID(), controllerConfig.FeatureGates, logger)
+	modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
+		cloud.EC2(), cloud.ACM(),
+		annotationParser, subnetsResolver,
+		authConfigBuilder, enhancedBackendBuilder, trackingProvider, elbv2TaggingManager, controllerConfig.FeatureGates,
+		cloud.VpcID(), controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
+		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, backendSGProvider,
+		controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType),
+		ingress.NewLoadBalancerQuotas(controllerConfig.IngressConfig), config.RuleConflictPolicy(controllerConfig.IngressConfig.RuleConflictPolicy), logger)
+	svcAnnotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
+	svcModelBuilder := service.NewDefaultModelBuilder(svcAnnotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
+		elbv2TaggingManager, controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
+		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType),
+		gatewaypkg.NewFrontendServiceUtils(), networkingpkg.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID()), backendSGProvider,
+		controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules)
+	stackMarshaller := deploy.NewDefaultStackMarshaller()
+	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler,
+		controllerConfig, gatewayTagPrefix, logger)
+	classLoader := gatewaypkg.NewDefaultClassLoader(k8sClient)
+	routeAttacher := gatewaypkg.NewDefaultRouteAttacher(k8sClient)
+	ingressTranslator := gatewaypkg.NewDefaultIngressTranslator(k8sClient, routeAttacher)
+	serviceTranslator := gatewaypkg.NewDefaultServiceTranslator(k8sClient, routeAttacher)
+
+	return &gatewayReconciler{
+		k8sClient:        k8sClient,
+		eventRecorder:    eventRecorder,
+		finalizerManager: finalizerManager,
+
+		classLoader:       classLoader,
+		ingressTranslator: ingressTranslator,
+		serviceTranslator: serviceTranslator,
+		modelBuilder:      modelBuilder,
+		svcModelBuilder:   svcModelBuilder,
+		stackMarshaller:   stackMarshaller,
+		stackDeployer:     stackDeployer,
+		logger:            logger,
+
+		maxConcurrentReconciles: controllerConfig.GatewayMaxConcurrentReconciles,
+	}
+}
+
+// gatewayReconciler reconciles a Gateway along with its attached routes.
+// Gateways of ALB GatewayClass are fulfilled with HTTPRoutes, while Gateways of NLB GatewayClass are fulfilled with TCPRoutes, UDPRoutes and TLSRoutes.
+type gatewayReconciler struct {
+	k8sClient        client.Client
+	eventRecorder    record.EventRecorder
+	finalizerManager k8s.FinalizerManager
+
+	classLoader       gatewaypkg.ClassLoader
+	ingressTranslator gatewaypkg.IngressTranslator
+	serviceTranslator gatewaypkg.ServiceTranslator
+	modelBuilder      ingress.ModelBuilder
+	svcModelBuilder   service.ModelBuilder
+	stackMarshaller   deploy.StackMarshaller
+	stackDeployer     deploy.StackDeployer
+	logger            logr.Logger
+
+	maxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes;udproutes;tlsroutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes/status;udproutes/status;tlsroutes/status,verbs=update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *gatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *gatewayReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	gw := &gwv1beta1.Gateway{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, gw); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	gwClass, err := r.classLoader.Load(ctx, gw, gatewaypkg.GatewayClassControllerALB, gatewaypkg.GatewayClassControllerNLB)
+	if err != nil {
+		return err
+	}
+	if gwClass == nil || !gw.DeletionTimestamp.IsZero() {
+		return r.cleanupGatewayResources(ctx, gw)
+	}
+	if err := r.updateGatewayClassStatus(ctx, gwClass); err != nil {
+		return err
+	}
+	if err := r.finalizerManager.AddFinalizers(ctx, gw, gatewayFinalizer); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		return err
+	}
+	var listenerTranslations []gatewaypkg.ListenerTranslation
+	var lb *elbv2model.LoadBalancer
+	if gwClass.Spec.ControllerName == gatewaypkg.GatewayClassControllerNLB {
+		listenerTranslations, lb, err = r.reconcileNLBGatewayResources(ctx, gw)
+	} else {
+		listenerTranslations, lb, err = r.reconcileALBGatewayResources(ctx, gw)
+	}
+	if err != nil {
+		if listenerTranslations != nil {
+			if statusErr := r.updateGatewayStatus(ctx, gw, listenerTranslations, "", err.Error()); statusErr != nil {
+				r.logger.Error(statusErr, "failed to update gateway status", "gateway", k8s.NamespacedName(gw))
+			}
+		}
+		return err
+	}
+	lbDNS := ""
+	if lb != nil {
+		lbDNS, err = lb.DNSName().Resolve(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if err := r.updateGatewayStatus(ctx, gw, listenerTranslations, lbDNS, ""); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	r.eventRecorder.Event(gw, corev1.EventTypeNormal, k8s.GatewayEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	return nil
+}
+
+// reconcileALBGatewayResources fulfills the Gateway and its attached HTTPRoutes with ALB.
+// listener translations will be returned as long as the routes are translated, so that the Gateway status can be updated.
+func (r *gatewayReconciler) reconcileALBGatewayResources(ctx context.Context, gw *gwv1beta1.Gateway) ([]gatewaypkg.ListenerTranslation, *elbv2model.LoadBalancer, error) {
+	translation, err := r.ingressTranslator.Translate(ctx, gw)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedTranslateRoutes, fmt.Sprintf("Failed translate routes due to %v", err))
+		return nil, nil, err
+	}
+	if err := r.updateHTTPRouteStatuses(ctx, gw, translation); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return nil, nil, err
+	}
+	stack, lb, _, _, err := r.modelBuilder.Build(ctx, translation.IngGroup)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return translation.Listeners, nil, err
+	}
+	if err := r.deployModel(ctx, gw, stack); err != nil {
+		return translation.Listeners, nil, err
+	}
+	return translation.Listeners, lb, nil
+}
+
+// reconcileNLBGatewayResources fulfills the Gateway and its attached TCPRoutes, UDPRoutes and TLSRoutes with NLB.
+// listener translations will be returned as long as the routes are translated, so that the Gateway status can be updated.
+func (r *gatewayReconciler) reconcileNLBGatewayResources(ctx context.Context, gw *gwv1beta1.Gateway) ([]gatewaypkg.ListenerTranslation, *elbv2model.LoadBalancer, error) {
+	translation, err := r.serviceTranslator.Translate(ctx, gw)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedTranslateRoutes, fmt.Sprintf("Failed translate routes due to %v", err))
+		return nil, nil, err
+	}
+	if err := r.updateL4RouteStatuses(ctx, gw, translation); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return nil, nil, err
+	}
+	stack, lb, err := r.svcModelBuilder.Build(ctx, translation.Service, service.WithBackendByPort(translation.BackendByPort))
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return translation.Listeners, nil, err
+	}
+	if err := r.deployModel(ctx, gw, stack); err != nil {
+		return translation.Listeners, nil, err
+	}
+	return translation.Listeners, lb, nil
+}
+
+func (r *gatewayReconciler) cleanupGatewayResources(ctx context.Context, gw *gwv1beta1.Gateway) error {
+	if k8s.HasFinalizer(gw, gatewayFinalizer) {
+		// resources for both ALB and NLB GatewayClass are tracked with same stackID, so an empty stack will clean up both.
+		stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(gw)))
+		if err := r.deployModel(ctx, gw, stack); err != nil {
+			return err
+		}
+		if err := r.finalizerManager.RemoveFinalizers(ctx, gw, gatewayFinalizer); err != nil {
+			r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) deployModel(ctx context.Context, gw *gwv1beta1.Gateway, stack core.Stack) error {
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully built model", "model", stackJSON)
+
+	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully deployed model", "gateway", k8s.NamespacedName(gw))
+	return nil
+}
+
+func (r *gatewayReconciler) updateGatewayClassStatus(ctx context.Context, gwClass *gwv1beta1.GatewayClass) error {
+	status := gatewaypkg.BuildGatewayClassStatus(gwClass)
+	if equality.Semantic.DeepEqual(gwClass.Status, status) {
+		return nil
+	}
+	gwClassOld := gwClass.DeepCopy()
+	gwClass.Status = status
+	if err := r.k8sClient.Status().Patch(ctx, gwClass, client.MergeFrom(gwClassOld)); err != nil {
+		return errors.Wrapf(err, "failed to update gatewayClass status: %v", gwClass.Name)
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) updateGatewayStatus(ctx context.Context, gw *gwv1beta1.Gateway, listenerTranslations []gatewaypkg.ListenerTranslation,
+	lbDNS string, programmedErrMessage string) error {
+	status := gatewaypkg.BuildGatewayStatus(gw, listenerTranslations, lbDNS, programmedErrMessage)
+	if equality.Semantic.DeepEqual(gw.Status, status) {
+		return nil
+	}
+	gwOld := gw.DeepCopy()
+	gw.Status = status
+	if err := r.k8sClient.Status().Patch(ctx, gw, client.MergeFrom(gwOld)); err != nil {
+		return errors.Wrapf(err, "failed to update gateway status: %v", k8s.NamespacedName(gw))
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) updateHTTPRouteStatuses(ctx context.Context, gw *gwv1beta1.Gateway, translation gatewaypkg.GatewayTranslation) error {
+	for _, routeTranslation := range translation.HTTPRoutes {
+		route := routeTranslation.Route
+		status := gatewaypkg.BuildHTTPRouteStatus(gw, routeTranslation, gatewaypkg.GatewayClassControllerALB)
+		if equality.Semantic.DeepEqual(route.Status, status) {
+			continue
+		}
+		routeOld := route.DeepCopy()
+		route.Status = status
+		if err := r.k8sClient.Status().Patch(ctx, route, client.MergeFrom(routeOld)); err != nil {
+			return errors.Wrapf(err, "failed to update httpRoute status: %v", k8s.NamespacedName(route))
+		}
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) updateL4RouteStatuses(ctx context.Context, gw *gwv1beta1.Gateway, translation gatewaypkg.ServiceGatewayTranslation) error {
+	for _, routeTranslation := range translation.L4Routes {
+		route := routeTranslation.Route
+		status := gatewaypkg.BuildL4RouteStatus(gw, routeTranslation, gatewaypkg.GatewayClassControllerNLB)
+		if equality.Semantic.DeepEqual(*route.Status, status) {
+			continue
+		}
+		routeOld := route.Object.DeepCopyObject().(client.Object)
+		*route.Status = status
+		if err := r.k8sClient.Status().Patch(ctx, route.Object, client.MergeFrom(routeOld)); err != nil {
+			return errors.Wrapf(err, "failed to update %v status: %v", route.Kind, k8s.NamespacedName(route.Object))
+		}
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, clientSet *kubernetes.Clientset) error {
+	c, err := controller.New(controllerName, mgr, controller.Options{
+		MaxConcurrentReconciles: r.maxConcurrentReconciles,
+		Reconciler:              r,
+	})
+	if err != nil {
+		return err
+	}
+	l4RoutesAvailable, err := isL4RouteResourcesAvailable(clientSet)
+	if err != nil {
+		return err
+	}
+	if err := r.setupWatches(ctx, c, l4RoutesAvailable); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) setupWatches(_ context.Context, c controller.Controller, l4RoutesAvailable bool) error {
+	gwEventHandler := eventhandlers.NewEnqueueRequestsForGatewayEvent(r.logger.WithName("eventHandlers").WithName("gateway"))
+	gwClassEventHandler := eventhandlers.NewEnqueueRequestsForGatewayClassEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("gatewayClass"))
+	httpRouteEventHandler := eventhandlers.NewEnqueueRequestsForHTTPRouteEvent(r.logger.WithName("eventHandlers").WithName("httpRoute"))
+	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(r.k8sClient, l4RoutesAvailable,
+		r.logger.WithName("eventHandlers").WithName("service"))
+	if err := c.Watch(&source.Kind{Type: &gwv1beta1.Gateway{}}, gwEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &gwv1beta1.GatewayClass{}}, gwClassEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &gwv1beta1.HTTPRoute{}}, httpRouteEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
+		return err
+	}
+	if l4RoutesAvailable {
+		l4RouteEventHandler := eventhandlers.NewEnqueueRequestsForL4RouteEvent(r.logger.WithName("eventHandlers").WithName("l4Route"))
+		for _, routeObj := range []client.Object{&gwv1alpha2.TCPRoute{}, &gwv1alpha2.UDPRoute{}, &gwv1alpha2.TLSRoute{}} {
+			if err := c.Watch(&source.Kind{Type: routeObj}, l4RouteEventHandler); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// isL4RouteResourcesAvailable checks whether TCPRoute, UDPRoute and TLSRoute resources are available in cluster.
+// these resources are only available when experimental Gateway API CRDs are installed.
+func isL4RouteResourcesAvailable(clientSet *kubernetes.Clientset) (bool, error) {
+	resList, err := clientSet.ServerResourcesForGroupVersion(gwv1alpha2.GroupVersion.String())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	availableKinds := sets.NewString()
+	for _, res := range resList.APIResources {
+		availableKinds.Insert(res.Kind)
+	}
+	return availableKinds.HasAll(string(gatewaypkg.RouteKindTCPRoute), string(gatewaypkg.RouteKindUDPRoute), string(gatewaypkg.RouteKindTLSRoute)), nil
+}
@@ -41,10 +41,10 @@ func (h *enqueueRequestsForIngressEvent) Update(e event.UpdateEvent, queue workq
 	ingNew := e.ObjectNew.(*networking.Ingress)
 
 	// we only care below update event:
-	//	1. Ingress annotation updates
+	//	1. Ingress annotation updates, except for the reconcile status annotation maintained by ourselves
 	//	2. Ingress spec updates
 	//	3. Ingress deletion
-	if equality.Semantic.DeepEqual(ingOld.Annotations, ingNew.Annotations) &&
+	if equality.Semantic.DeepEqual(annotationsWithoutReconcileStatus(ingOld), annotationsWithoutReconcileStatus(ingNew)) &&
 		equality.Semantic.DeepEqual(ingOld.Spec, ingNew.Spec) &&
 		equality.Semantic.DeepEqual(ingOld.DeletionTimestamp.IsZero(), ingNew.DeletionTimestamp.IsZero()) {
 		return
@@ -88,3 +88,17 @@ func (h *enqueueRequestsForIngressEvent) enqueueIfBelongsToGroup(queue workqueue
 		queue.Add(ingress.EncodeGroupIDToReconcileRequest(groupID))
 	}
 }
+
+// annotationsWithoutReconcileStatus returns the annotations of Ingress, excluding the reconcile status annotation.
+func annotationsWithoutReconcileStatus(ing *networking.Ingress) map[string]string {
+	if _, exists := ing.Annotations[ingress.StatusAnnotation]; !exists {
+		return ing.Annotations
+	}
+	ingAnnotations := make(map[string]string, len(ing.Annotations))
+	for key, value := range ing.Annotations {
+		if key != ingress.StatusAnnotation {
+			ingAnnotations[key] = value
+		}
+	}
+	return ingAnnotations
+}
@@ -0,0 +1,62 @@
+package eventhandlers
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForIngressGroupPolicyEvent constructs new enqueueRequestsForIngressGroupPolicyEvent.
+func NewEnqueueRequestsForIngressGroupPolicyEvent(logger logr.Logger) *enqueueRequestsForIngressGroupPolicyEvent {
+	return &enqueueRequestsForIngressGroupPolicyEvent{
+		logger: logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForIngressGroupPolicyEvent)(nil)
+
+type enqueueRequestsForIngressGroupPolicyEvent struct {
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForIngressGroupPolicyEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	policyNew := e.Object.(*elbv2api.IngressGroupPolicy)
+	h.enqueueImpactedIngressGroup(queue, policyNew)
+}
+
+func (h *enqueueRequestsForIngressGroupPolicyEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	policyOld := e.ObjectOld.(*elbv2api.IngressGroupPolicy)
+	policyNew := e.ObjectNew.(*elbv2api.IngressGroupPolicy)
+
+	// we only care below update event:
+	//	1. IngressGroupPolicy spec updates
+	//	2. IngressGroupPolicy deletion
+	if equality.Semantic.DeepEqual(policyOld.Spec, policyNew.Spec) &&
+		equality.Semantic.DeepEqual(policyOld.DeletionTimestamp.IsZero(), policyNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedIngressGroup(queue, policyNew)
+}
+
+func (h *enqueueRequestsForIngressGroupPolicyEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	policyOld := e.Object.(*elbv2api.IngressGroupPolicy)
+	h.enqueueImpactedIngressGroup(queue, policyOld)
+}
+
+func (h *enqueueRequestsForIngressGroupPolicyEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for IngressGroupPolicies.
+}
+
+// enqueueImpactedIngressGroup enqueues the explicit IngressGroup with the same name as IngressGroupPolicy.
+func (h *enqueueRequestsForIngressGroupPolicyEvent) enqueueImpactedIngressGroup(queue workqueue.RateLimitingInterface, policy *elbv2api.IngressGroupPolicy) {
+	groupID := ingress.NewGroupIDForExplicitGroup(policy.GetName())
+	h.logger.V(1).Info("enqueue ingressGroup for ingressGroupPolicy event",
+		"ingressGroupPolicy", policy.GetName(),
+		"ingressGroup", groupID)
+	queue.Add(ingress.EncodeGroupIDToReconcileRequest(groupID))
+}
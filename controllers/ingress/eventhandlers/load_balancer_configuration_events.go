@@ -0,0 +1,86 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/lbconfig"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForLoadBalancerConfigurationEvent constructs new enqueueRequestsForLoadBalancerConfigurationEvent.
+func NewEnqueueRequestsForLoadBalancerConfigurationEvent(ingEventChan chan<- event.GenericEvent,
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForLoadBalancerConfigurationEvent {
+	return &enqueueRequestsForLoadBalancerConfigurationEvent{
+		ingEventChan:  ingEventChan,
+		k8sClient:     k8sClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForLoadBalancerConfigurationEvent)(nil)
+
+type enqueueRequestsForLoadBalancerConfigurationEvent struct {
+	ingEventChan  chan<- event.GenericEvent
+	k8sClient     client.Client
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	lbConfigNew := e.Object.(*elbv2api.LoadBalancerConfiguration)
+	h.enqueueImpactedIngresses(lbConfigNew)
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	lbConfigOld := e.ObjectOld.(*elbv2api.LoadBalancerConfiguration)
+	lbConfigNew := e.ObjectNew.(*elbv2api.LoadBalancerConfiguration)
+
+	// we only care below update event:
+	//	1. LoadBalancerConfiguration spec updates
+	//	2. LoadBalancerConfiguration deletion
+	if equality.Semantic.DeepEqual(lbConfigOld.Spec, lbConfigNew.Spec) &&
+		equality.Semantic.DeepEqual(lbConfigOld.DeletionTimestamp.IsZero(), lbConfigNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedIngresses(lbConfigNew)
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	lbConfigOld := e.Object.(*elbv2api.LoadBalancerConfiguration)
+	h.enqueueImpactedIngresses(lbConfigOld)
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for LoadBalancerConfigurations.
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) enqueueImpactedIngresses(lbConfig *elbv2api.LoadBalancerConfiguration) {
+	ingList := &networking.IngressList{}
+	if err := h.k8sClient.List(context.Background(), ingList,
+		client.InNamespace(lbConfig.GetNamespace()),
+		client.MatchingFields{lbconfig.IndexKeyLoadBalancerConfigurationRefName: lbConfig.GetName()}); err != nil {
+		h.logger.Error(err, "failed to fetch ingresses")
+		return
+	}
+	for index := range ingList.Items {
+		ing := &ingList.Items[index]
+
+		h.logger.V(1).Info("enqueue ingress for loadBalancerConfiguration event",
+			"loadBalancerConfiguration", k8s.NamespacedName(lbConfig),
+			"ingress", k8s.NamespacedName(ing))
+		h.ingEventChan <- event.GenericEvent{
+			Object: ing,
+		}
+	}
+}
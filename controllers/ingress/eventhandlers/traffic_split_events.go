@@ -0,0 +1,87 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForTrafficSplitEvent constructs new enqueueRequestsForTrafficSplitEvent.
+func NewEnqueueRequestsForTrafficSplitEvent(ingEventChan chan<- event.GenericEvent,
+	k8sClient client.Client, logger logr.Logger) *enqueueRequestsForTrafficSplitEvent {
+	return &enqueueRequestsForTrafficSplitEvent{
+		ingEventChan: ingEventChan,
+		k8sClient:    k8sClient,
+		logger:       logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForTrafficSplitEvent)(nil)
+
+type enqueueRequestsForTrafficSplitEvent struct {
+	ingEventChan chan<- event.GenericEvent
+	k8sClient    client.Client
+	logger       logr.Logger
+}
+
+func (h *enqueueRequestsForTrafficSplitEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	tsNew := e.Object.(*elbv2api.TrafficSplit)
+	h.enqueueImpactedIngress(tsNew)
+}
+
+func (h *enqueueRequestsForTrafficSplitEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	tsOld := e.ObjectOld.(*elbv2api.TrafficSplit)
+	tsNew := e.ObjectNew.(*elbv2api.TrafficSplit)
+
+	// we only care below update event:
+	//	1. TrafficSplit spec updates
+	//	2. TrafficSplit weight updates
+	//	3. TrafficSplit deletion
+	if equality.Semantic.DeepEqual(tsOld.Spec, tsNew.Spec) &&
+		tsOld.Status.Weight == tsNew.Status.Weight &&
+		equality.Semantic.DeepEqual(tsOld.DeletionTimestamp.IsZero(), tsNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedIngress(tsNew)
+	if tsOld.Spec.Backend.IngressName != tsNew.Spec.Backend.IngressName {
+		h.enqueueImpactedIngress(tsOld)
+	}
+}
+
+func (h *enqueueRequestsForTrafficSplitEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	tsOld := e.Object.(*elbv2api.TrafficSplit)
+	h.enqueueImpactedIngress(tsOld)
+}
+
+func (h *enqueueRequestsForTrafficSplitEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for TrafficSplits.
+}
+
+func (h *enqueueRequestsForTrafficSplitEvent) enqueueImpactedIngress(ts *elbv2api.TrafficSplit) {
+	ing := &networking.Ingress{}
+	ingKey := types.NamespacedName{Namespace: ts.Namespace, Name: ts.Spec.Backend.IngressName}
+	if err := h.k8sClient.Get(context.Background(), ingKey, ing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			h.logger.Error(err, "failed to fetch ingress", "ingress", ingKey)
+		}
+		return
+	}
+
+	h.logger.V(1).Info("enqueue ingress for trafficSplit event",
+		"trafficSplit", k8s.NamespacedName(ts),
+		"ingress", k8s.NamespacedName(ing))
+	h.ingEventChan <- event.GenericEvent{
+		Object: ing,
+	}
+}
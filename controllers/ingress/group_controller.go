@@ -8,7 +8,9 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
@@ -21,10 +23,11 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
-	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/lbconfig"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shard"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -45,44 +48,51 @@ const (
 func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
 	finalizerManager k8s.FinalizerManager, networkingSGManager networkingpkg.SecurityGroupManager,
 	networkingSGReconciler networkingpkg.SecurityGroupReconciler, subnetsResolver networkingpkg.SubnetsResolver,
-	controllerConfig config.ControllerConfig, backendSGProvider networkingpkg.BackendSGProvider, logger logr.Logger) *groupReconciler {
+	controllerConfig config.ControllerConfig, backendSGProvider networkingpkg.BackendSGProvider, shardManager shard.Manager, logger logr.Logger) *groupReconciler {
 
 	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
 	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
-	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder)
+	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder,
+		controllerConfig.FeatureGates.Enabled(config.EnableTrafficSplitController))
 	referenceIndexer := ingress.NewDefaultReferenceIndexer(enhancedBackendBuilder, authConfigBuilder, logger)
 	trackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, controllerConfig.ClusterName)
-	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerConfig.FeatureGates, logger)
+	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.RGT(), cloud.VpcID(), controllerConfig.FeatureGates, logger)
 	modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
 		cloud.EC2(), cloud.ACM(),
 		annotationParser, subnetsResolver,
 		authConfigBuilder, enhancedBackendBuilder, trackingProvider, elbv2TaggingManager, controllerConfig.FeatureGates,
 		cloud.VpcID(), controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
 		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, backendSGProvider,
-		controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), logger)
+		controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType),
+		ingress.NewLoadBalancerQuotas(controllerConfig.IngressConfig), config.RuleConflictPolicy(controllerConfig.IngressConfig.RuleConflictPolicy), logger)
+	shardedModelBuilder := ingress.NewDefaultShardedModelBuilder(modelBuilder, controllerConfig.FeatureGates.Enabled(config.IngressGroupSharding), logger)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
 	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler,
 		controllerConfig, ingressTagPrefix, logger)
 	classLoader := ingress.NewDefaultClassLoader(k8sClient)
 	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(controllerConfig.IngressConfig.IngressClass)
 	manageIngressesWithoutIngressClass := controllerConfig.IngressConfig.IngressClass == ""
-	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass)
+	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass,
+		controllerConfig.FeatureGates.Enabled(config.IngressGroupPolicy))
 	groupFinalizerManager := ingress.NewDefaultFinalizerManager(finalizerManager)
 
 	return &groupReconciler{
 		k8sClient:         k8sClient,
 		eventRecorder:     eventRecorder,
 		referenceIndexer:  referenceIndexer,
-		modelBuilder:      modelBuilder,
+		modelBuilder:      shardedModelBuilder,
 		stackMarshaller:   stackMarshaller,
 		stackDeployer:     stackDeployer,
 		backendSGProvider: backendSGProvider,
 
 		groupLoader:           groupLoader,
 		groupFinalizerManager: groupFinalizerManager,
+		shardManager:          shardManager,
 		logger:                logger,
 
 		maxConcurrentReconciles: controllerConfig.IngressConfig.MaxConcurrentReconciles,
+		enableTrafficSplit:      controllerConfig.FeatureGates.Enabled(config.EnableTrafficSplitController),
+		enableGroupPolicy:       controllerConfig.FeatureGates.Enabled(config.IngressGroupPolicy),
 	}
 }
 
@@ -91,7 +101,7 @@ type groupReconciler struct {
 	k8sClient         client.Client
 	eventRecorder     record.EventRecorder
 	referenceIndexer  ingress.ReferenceIndexer
-	modelBuilder      ingress.ModelBuilder
+	modelBuilder      ingress.ShardedModelBuilder
 	stackMarshaller   deploy.StackMarshaller
 	stackDeployer     deploy.StackDeployer
 	backendSGProvider networkingpkg.BackendSGProvider
@@ -99,12 +109,18 @@ type groupReconciler struct {
 
 	groupLoader           ingress.GroupLoader
 	groupFinalizerManager ingress.FinalizerManager
+	shardManager          shard.Manager
 	logger                logr.Logger
 
 	maxConcurrentReconciles int
+	enableTrafficSplit      bool
+	enableGroupPolicy       bool
 }
 
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerconfigurations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=trafficsplits,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=ingressgrouppolicies,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses/status,verbs=update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch
@@ -128,17 +144,26 @@ func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
 		return err
 	}
-	_, lb, err := r.buildAndDeployModel(ctx, ingGroup)
+	shardModels, err := r.buildAndDeployModels(ctx, ingGroup)
 	if err != nil {
+		if statusErr := r.updateIngressGroupReconcileStatus(ctx, ingGroup.ID, ingGroup, nil, nil, err); statusErr != nil {
+			r.logger.Error(statusErr, "failed to update reconcile status", "ingressGroup", ingGroup.ID)
+		}
 		return err
 	}
 
-	if len(ingGroup.Members) > 0 && lb != nil {
-		lbDNS, err := lb.DNSName().Resolve(ctx)
-		if err != nil {
-			return err
+	for _, shardModel := range shardModels {
+		if len(shardModel.Group.Members) > 0 && shardModel.LoadBalancer != nil {
+			lbDNS, err := shardModel.LoadBalancer.DNSName().Resolve(ctx)
+			if err != nil {
+				return err
+			}
+			if err := r.updateIngressGroupStatus(ctx, shardModel.Group, lbDNS); err != nil {
+				r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+				return err
+			}
 		}
-		if err := r.updateIngressGroupStatus(ctx, ingGroup, lbDNS); err != nil {
+		if err := r.updateIngressGroupReconcileStatus(ctx, ingGroup.ID, shardModel.Group, shardModel.LoadBalancer, shardModel.IngressResources, nil); err != nil {
 			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 			return err
 		}
@@ -161,26 +186,64 @@ func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error
 	return nil
 }
 
-func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingress.Group) (core.Stack, *elbv2model.LoadBalancer, error) {
-	stack, lb, secrets, err := r.modelBuilder.Build(ctx, ingGroup)
-	if err != nil {
-		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
-		return nil, nil, err
-	}
-	stackJSON, err := r.stackMarshaller.Marshal(stack)
+func (r *groupReconciler) buildAndDeployModels(ctx context.Context, ingGroup ingress.Group) ([]ingress.ShardModel, error) {
+	shardModels, err := r.modelBuilder.Build(ctx, ingGroup)
 	if err != nil {
+		var quotaExceededErr *ingress.QuotaExceededError
+		if errors.As(err, &quotaExceededErr) {
+			r.recordQuotaExceededEvent(ctx, ingGroup, quotaExceededErr)
+		}
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
-		return nil, nil, err
+		return nil, err
 	}
-	r.logger.Info("successfully built model", "model", stackJSON)
+	r.recordRuleConflictEvents(ctx, ingGroup, shardModels)
+	var secrets []types.NamespacedName
+	for _, shardModel := range shardModels {
+		stackJSON, err := r.stackMarshaller.Marshal(shardModel.Stack)
+		if err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+			return nil, err
+		}
+		r.logger.Info("successfully built model", "model", stackJSON)
 
-	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
-		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
-		return nil, nil, err
+		if err := r.stackDeployer.Deploy(ctx, shardModel.Stack); err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+			return nil, err
+		}
+		r.logger.Info("successfully deployed model", "ingressGroup", shardModel.Group.ID)
+		secrets = append(secrets, shardModel.SecretKeys...)
 	}
-	r.logger.Info("successfully deployed model", "ingressGroup", ingGroup.ID)
 	r.secretsManager.MonitorSecrets(ingGroup.ID.String(), secrets)
-	return stack, lb, err
+	return shardModels, nil
+}
+
+// recordQuotaExceededEvent records the QuotaExceeded event on the Ingresses that don't fit in the load balancer quotas.
+func (r *groupReconciler) recordQuotaExceededEvent(_ context.Context, ingGroup ingress.Group, quotaExceededErr *ingress.QuotaExceededError) {
+	offendingIngKeys := make(map[types.NamespacedName]bool, len(quotaExceededErr.Ingresses))
+	for _, ingKey := range quotaExceededErr.Ingresses {
+		offendingIngKeys[ingKey] = true
+	}
+	message := fmt.Sprintf("Ingress doesn't fit in the %v quota of load balancer: %v > %v", quotaExceededErr.Quota, quotaExceededErr.Usage, quotaExceededErr.Limit)
+	for _, member := range ingGroup.Members {
+		if offendingIngKeys[k8s.NamespacedName(member.Ing)] {
+			r.eventRecorder.Event(member.Ing, corev1.EventTypeWarning, k8s.IngressEventReasonQuotaExceeded, message)
+		}
+	}
+}
+
+// recordRuleConflictEvents records the ConflictingRule event on the Ingresses whose rules are dropped or shadowed due to conflicts with other Ingresses.
+func (r *groupReconciler) recordRuleConflictEvents(_ context.Context, ingGroup ingress.Group, shardModels []ingress.ShardModel) {
+	for _, member := range ingGroup.Members {
+		for _, shardModel := range shardModels {
+			ingResources, exists := shardModel.IngressResources[k8s.NamespacedName(member.Ing)]
+			if !exists {
+				continue
+			}
+			for _, conflict := range ingResources.RuleConflicts {
+				r.eventRecorder.Event(member.Ing, corev1.EventTypeWarning, k8s.IngressEventReasonConflictingRule, conflict)
+			}
+		}
+	}
 }
 
 func (r *groupReconciler) recordIngressGroupEvent(_ context.Context, ingGroup ingress.Group, eventType string, reason string, message string) {
@@ -215,10 +278,63 @@ func (r *groupReconciler) updateIngressStatus(ctx context.Context, lbDNS string,
 	return nil
 }
 
+// updateIngressGroupReconcileStatus updates the reconcile status annotation for member Ingresses,
+// and removes it from Ingresses that no longer belong to the IngressGroup.
+// ingGroup is either the IngressGroup identified by groupID, or a shard of it.
+func (r *groupReconciler) updateIngressGroupReconcileStatus(ctx context.Context, groupID ingress.GroupID, ingGroup ingress.Group, lb *elbv2model.LoadBalancer,
+	ingResources map[types.NamespacedName]*ingress.IngressResources, reconcileErr error) error {
+	for _, member := range ingGroup.Members {
+		status, err := ingress.BuildReconcileStatus(ctx, member.Ing, groupID, ingGroup.Shard, lb, ingResources[k8s.NamespacedName(member.Ing)], reconcileErr)
+		if err != nil {
+			return err
+		}
+		rawStatus, err := ingress.EncodeReconcileStatus(status)
+		if err != nil {
+			return err
+		}
+		if err := r.updateIngressStatusAnnotation(ctx, member.Ing, &rawStatus); err != nil {
+			return err
+		}
+	}
+	for _, inactiveMember := range ingGroup.InactiveMembers {
+		if err := r.updateIngressStatusAnnotation(ctx, inactiveMember, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateIngressStatusAnnotation sets the reconcile status annotation on Ingress, or removes it if rawStatus is nil.
+func (r *groupReconciler) updateIngressStatusAnnotation(ctx context.Context, ing *networking.Ingress, rawStatus *string) error {
+	existingRawStatus, exists := ing.Annotations[ingress.StatusAnnotation]
+	if rawStatus == nil && !exists {
+		return nil
+	}
+	if rawStatus != nil && exists && existingRawStatus == *rawStatus {
+		return nil
+	}
+	ingOld := ing.DeepCopy()
+	if rawStatus == nil {
+		delete(ing.Annotations, ingress.StatusAnnotation)
+	} else {
+		if ing.Annotations == nil {
+			ing.Annotations = make(map[string]string)
+		}
+		ing.Annotations[ingress.StatusAnnotation] = *rawStatus
+	}
+	if err := r.k8sClient.Patch(ctx, ing, client.MergeFrom(ingOld)); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to update ingress reconcile status: %v", k8s.NamespacedName(ing))
+	}
+	return nil
+}
+
 func (r *groupReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, clientSet *kubernetes.Clientset) error {
-	c, err := controller.New(controllerName, mgr, controller.Options{
+	c, err := shard.NewController(controllerName, mgr, r.shardManager, controller.Options{
 		MaxConcurrentReconciles: r.maxConcurrentReconciles,
-		Reconciler:              r,
+		Reconciler:              shard.NewShardedReconciler(r, r.shardManager, r.logger),
 	})
 	if err != nil {
 		return err
@@ -260,6 +376,13 @@ func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.
 	); err != nil {
 		return err
 	}
+	if err := fieldIndexer.IndexField(ctx, &networking.Ingress{}, lbconfig.IndexKeyLoadBalancerConfigurationRefName,
+		func(obj client.Object) []string {
+			return lbconfig.BuildIngressRefIndexes(obj.(*networking.Ingress))
+		},
+	); err != nil {
+		return err
+	}
 	if ingressClassResourceAvailable {
 		if err := fieldIndexer.IndexField(ctx, &networking.IngressClass{}, ingress.IndexKeyIngressClassParamsRefName,
 			func(obj client.Object) []string {
@@ -289,6 +412,8 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 		r.logger.WithName("eventHandlers").WithName("service"))
 	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(ingEventChan, svcEventChan, r.k8sClient, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("secret"))
+	lbConfigEventHandler := eventhandlers.NewEnqueueRequestsForLoadBalancerConfigurationEvent(ingEventChan, r.k8sClient, r.eventRecorder,
+		r.logger.WithName("eventHandlers").WithName("loadBalancerConfiguration"))
 	if err := c.Watch(&source.Channel{Source: ingEventChan}, ingEventHandler); err != nil {
 		return err
 	}
@@ -304,6 +429,23 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 	if err := c.Watch(&source.Channel{Source: secretEventsChan}, secretEventHandler); err != nil {
 		return err
 	}
+	if err := c.Watch(&source.Kind{Type: &elbv2api.LoadBalancerConfiguration{}}, lbConfigEventHandler); err != nil {
+		return err
+	}
+	if r.enableTrafficSplit {
+		trafficSplitEventHandler := eventhandlers.NewEnqueueRequestsForTrafficSplitEvent(ingEventChan, r.k8sClient,
+			r.logger.WithName("eventHandlers").WithName("trafficSplit"))
+		if err := c.Watch(&source.Kind{Type: &elbv2api.TrafficSplit{}}, trafficSplitEventHandler); err != nil {
+			return err
+		}
+	}
+	if r.enableGroupPolicy {
+		groupPolicyEventHandler := eventhandlers.NewEnqueueRequestsForIngressGroupPolicyEvent(
+			r.logger.WithName("eventHandlers").WithName("ingressGroupPolicy"))
+		if err := c.Watch(&source.Kind{Type: &elbv2api.IngressGroupPolicy{}}, groupPolicyEventHandler); err != nil {
+			return err
+		}
+	}
 	if ingressClassResourceAvailable {
 		ingClassEventChan := make(chan event.GenericEvent)
 		ingClassParamsEventHandler := eventhandlers.NewEnqueueRequestsForIngressClassParamsEvent(ingClassEventChan, r.k8sClient, r.eventRecorder,
@@ -320,6 +462,8 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 			return err
 		}
 	}
+	r.shardManager.AddChangeHandler(shard.NewResyncHandler(r.k8sClient, func() client.ObjectList { return &networking.IngressList{} },
+		ingEventChan, r.logger.WithName("shardResync")))
 	r.secretsManager = k8s.NewSecretsManager(clientSet, secretEventsChan, ctrl.Log.WithName("secrets-manager"))
 	return nil
 }
@@ -1,12 +1,15 @@
 package eventhandlers
 
 import (
+	"context"
+
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -15,10 +18,11 @@ import (
 
 // NewEnqueueRequestForServiceEvent constructs new enqueueRequestsForServiceEvent.
 func NewEnqueueRequestForServiceEvent(eventRecorder record.EventRecorder,
-	serviceUtils svcpkg.ServiceUtils, logger logr.Logger) *enqueueRequestsForServiceEvent {
+	serviceUtils svcpkg.ServiceUtils, groupLoader svcpkg.GroupLoader, logger logr.Logger) *enqueueRequestsForServiceEvent {
 	return &enqueueRequestsForServiceEvent{
 		eventRecorder: eventRecorder,
 		serviceUtils:  serviceUtils,
+		groupLoader:   groupLoader,
 		logger:        logger,
 	}
 }
@@ -28,11 +32,14 @@ var _ handler.EventHandler = (*enqueueRequestsForServiceEvent)(nil)
 type enqueueRequestsForServiceEvent struct {
 	eventRecorder record.EventRecorder
 	serviceUtils  svcpkg.ServiceUtils
+	groupLoader   svcpkg.GroupLoader
 	logger        logr.Logger
 }
 
 func (h *enqueueRequestsForServiceEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
-	h.enqueueManagedService(queue, e.Object.(*corev1.Service))
+	svc := e.Object.(*corev1.Service)
+	h.enqueueServiceGroups(queue, svc)
+	h.enqueueManagedService(queue, svc)
 }
 
 func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
@@ -45,6 +52,7 @@ func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, queue workq
 		return
 	}
 
+	h.enqueueServiceGroups(queue, oldSvc, newSvc)
 	h.enqueueManagedService(queue, newSvc)
 }
 
@@ -55,6 +63,29 @@ func (h *enqueueRequestsForServiceEvent) Delete(e event.DeleteEvent, queue workq
 }
 
 func (h *enqueueRequestsForServiceEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	svc := e.Object.(*corev1.Service)
+	h.enqueueServiceGroups(queue, svc)
+	h.enqueueManagedService(queue, svc)
+}
+
+// enqueueServiceGroups enqueues the service groups that Services belong to or pending finalization.
+func (h *enqueueRequestsForServiceEvent) enqueueServiceGroups(queue workqueue.RateLimitingInterface, services ...*corev1.Service) {
+	groupIDs := make(map[svcpkg.GroupID]struct{})
+	for _, svc := range services {
+		groupID, err := h.groupLoader.LoadGroupIDIfAny(context.Background(), svc)
+		if err != nil {
+			h.logger.Error(err, "failed to load service group", "service", k8s.NamespacedName(svc))
+		} else if groupID != nil {
+			groupIDs[*groupID] = struct{}{}
+		}
+		for _, pendingGroupID := range h.groupLoader.LoadGroupIDsPendingFinalization(context.Background(), svc) {
+			groupIDs[pendingGroupID] = struct{}{}
+		}
+	}
+	for groupID := range groupIDs {
+		h.logger.V(1).Info("enqueue serviceGroup for service event", "serviceGroup", groupID)
+		queue.Add(svcpkg.EncodeGroupIDToReconcileRequest(groupID))
+	}
 }
 
 func (h *enqueueRequestsForServiceEvent) enqueueManagedService(queue workqueue.RateLimitingInterface, service *corev1.Service) {
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
@@ -16,19 +17,21 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/lbconfig"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shard"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 const (
-	serviceFinalizer        = "service.k8s.aws/resources"
 	serviceTagPrefix        = "service.k8s.aws"
 	serviceAnnotationPrefix = "service.beta.kubernetes.io"
 	controllerName          = "service"
@@ -37,14 +40,20 @@ const (
 func NewServiceReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
 	finalizerManager k8s.FinalizerManager, networkingSGManager networking.SecurityGroupManager,
 	networkingSGReconciler networking.SecurityGroupReconciler, subnetsResolver networking.SubnetsResolver,
-	vpcInfoProvider networking.VPCInfoProvider, controllerConfig config.ControllerConfig, logger logr.Logger) *serviceReconciler {
+	vpcInfoProvider networking.VPCInfoProvider, controllerConfig config.ControllerConfig, backendSGProvider networking.BackendSGProvider,
+	shardManager shard.Manager, logger logr.Logger) *serviceReconciler {
 
 	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
 	trackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, controllerConfig.ClusterName)
-	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerConfig.FeatureGates, logger)
-	serviceUtils := service.NewServiceUtils(annotationParser, serviceFinalizer, controllerConfig.ServiceConfig.LoadBalancerClass, controllerConfig.FeatureGates)
+	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.RGT(), cloud.VpcID(), controllerConfig.FeatureGates, logger)
+	serviceUtils := service.NewServiceUtils(annotationParser, k8s.ServiceFinalizer, controllerConfig.ServiceConfig.LoadBalancerClass, controllerConfig.FeatureGates)
+	groupLoader := service.NewDefaultGroupLoader(k8sClient, annotationParser, serviceUtils)
+	groupFinalizerManager := service.NewDefaultFinalizerManager(finalizerManager)
+	lbConfigResolver := lbconfig.NewDefaultResolver(k8sClient)
+	sgResolver := networking.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID())
 	modelBuilder := service.NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
-		elbv2TaggingManager, controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags, controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils)
+		elbv2TaggingManager, controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags, controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
+		sgResolver, backendSGProvider, controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
 	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, controllerConfig, serviceTagPrefix, logger)
 	return &serviceReconciler{
@@ -55,10 +64,15 @@ func NewServiceReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorde
 		loadBalancerClass: controllerConfig.ServiceConfig.LoadBalancerClass,
 		serviceUtils:      serviceUtils,
 
-		modelBuilder:    modelBuilder,
-		stackMarshaller: stackMarshaller,
-		stackDeployer:   stackDeployer,
-		logger:          logger,
+		groupLoader:           groupLoader,
+		groupFinalizerManager: groupFinalizerManager,
+		lbConfigResolver:      lbConfigResolver,
+		modelBuilder:          modelBuilder,
+		stackMarshaller:       stackMarshaller,
+		stackDeployer:         stackDeployer,
+		backendSGProvider:     backendSGProvider,
+		shardManager:          shardManager,
+		logger:                logger,
 
 		maxConcurrentReconciles: controllerConfig.ServiceMaxConcurrentReconciles,
 	}
@@ -72,10 +86,15 @@ type serviceReconciler struct {
 	loadBalancerClass string
 	serviceUtils      service.ServiceUtils
 
-	modelBuilder    service.ModelBuilder
-	stackMarshaller deploy.StackMarshaller
-	stackDeployer   deploy.StackDeployer
-	logger          logr.Logger
+	groupLoader           service.GroupLoader
+	groupFinalizerManager service.FinalizerManager
+	lbConfigResolver      lbconfig.Resolver
+	modelBuilder          service.ModelBuilder
+	stackMarshaller       deploy.StackMarshaller
+	stackDeployer         deploy.StackDeployer
+	backendSGProvider     networking.BackendSGProvider
+	shardManager          shard.Manager
+	logger                logr.Logger
 
 	maxConcurrentReconciles int
 }
@@ -83,16 +102,28 @@ type serviceReconciler struct {
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=services/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerconfigurations,verbs=get;list;watch
 
 func (r *serviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
 }
 
 func (r *serviceReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	if groupID, isGroup := service.DecodeGroupIDFromReconcileRequest(req); isGroup {
+		return r.reconcileServiceGroup(ctx, groupID)
+	}
 	svc := &corev1.Service{}
 	if err := r.k8sClient.Get(ctx, req.NamespacedName, svc); err != nil {
 		return client.IgnoreNotFound(err)
 	}
+	groupID, err := r.groupLoader.LoadGroupIDIfAny(ctx, svc)
+	if err != nil {
+		return err
+	}
+	if groupID != nil {
+		// the load balancer for service group members are reconciled with the group.
+		return r.cleanupStandaloneLoadBalancerResources(ctx, svc)
+	}
 	stack, lb, err := r.buildModel(ctx, svc)
 	if err != nil {
 		return err
@@ -104,7 +135,12 @@ func (r *serviceReconciler) reconcile(ctx context.Context, req ctrl.Request) err
 }
 
 func (r *serviceReconciler) buildModel(ctx context.Context, svc *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, error) {
-	stack, lb, err := r.modelBuilder.Build(ctx, svc)
+	resolvedSvc, err := r.resolveLoadBalancerConfiguration(ctx, svc)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return nil, nil, err
+	}
+	stack, lb, err := r.modelBuilder.Build(ctx, resolvedSvc)
 	if err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
 		return nil, nil, err
@@ -118,6 +154,21 @@ func (r *serviceReconciler) buildModel(ctx context.Context, svc *corev1.Service)
 	return stack, lb, nil
 }
 
+// resolveLoadBalancerConfiguration returns a copy of Service with the referenced LoadBalancerConfiguration merged into its annotations.
+// Services that are not supported(e.g. in deletion state) are returned as is, so that their resources can always be cleaned up.
+func (r *serviceReconciler) resolveLoadBalancerConfiguration(ctx context.Context, svc *corev1.Service) (*corev1.Service, error) {
+	if !r.serviceUtils.IsServiceSupported(svc) {
+		return svc, nil
+	}
+	svcAnnotations, err := r.lbConfigResolver.ResolveServiceAnnotations(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	resolvedSvc := svc.DeepCopy()
+	resolvedSvc.Annotations = svcAnnotations
+	return resolvedSvc, nil
+}
+
 func (r *serviceReconciler) deployModel(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
 	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
@@ -129,7 +180,7 @@ func (r *serviceReconciler) deployModel(ctx context.Context, svc *corev1.Service
 }
 
 func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context, svc *corev1.Service, stack core.Stack, lb *elbv2model.LoadBalancer) error {
-	if err := r.finalizerManager.AddFinalizers(ctx, svc, serviceFinalizer); err != nil {
+	if err := r.finalizerManager.AddFinalizers(ctx, svc, k8s.ServiceFinalizer); err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
 		return err
 	}
@@ -151,7 +202,7 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 }
 
 func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
-	if k8s.HasFinalizer(svc, serviceFinalizer) {
+	if k8s.HasFinalizer(svc, k8s.ServiceFinalizer) {
 		err := r.deployModel(ctx, svc, stack)
 		if err != nil {
 			return err
@@ -160,14 +211,124 @@ func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, sv
 			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
 			return err
 		}
-		if err := r.finalizerManager.RemoveFinalizers(ctx, svc, serviceFinalizer); err != nil {
+		if err := r.finalizerManager.RemoveFinalizers(ctx, svc, k8s.ServiceFinalizer); err != nil {
 			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
 			return err
 		}
+		if err := r.backendSGProvider.Release(ctx); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// cleanupStandaloneLoadBalancerResources cleans up the load balancer provisioned for Service before it joins a service group.
+// the status of Service is left untouched since it's managed by the service group.
+func (r *serviceReconciler) cleanupStandaloneLoadBalancerResources(ctx context.Context, svc *corev1.Service) error {
+	if !k8s.HasFinalizer(svc, k8s.ServiceFinalizer) {
+		return nil
+	}
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(svc)))
+	if err := r.deployModel(ctx, svc, stack); err != nil {
+		return err
+	}
+	if err := r.finalizerManager.RemoveFinalizers(ctx, svc, k8s.ServiceFinalizer); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		return err
+	}
+	return nil
+}
+
+// reconcileServiceGroup reconciles the shared load balancer for member Services of service group.
+func (r *serviceReconciler) reconcileServiceGroup(ctx context.Context, groupID service.GroupID) error {
+	group, err := r.groupLoader.Load(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if err := r.groupFinalizerManager.AddGroupFinalizer(ctx, groupID, group.Members); err != nil {
+		r.recordServiceGroupEvent(group, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		return err
+	}
+	frontend, err := r.buildGroupFrontend(ctx, group)
+	if err != nil {
+		r.recordServiceGroupEvent(group, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	for _, conflict := range frontend.PortConflicts {
+		r.eventRecorder.Event(conflict.Service, corev1.EventTypeWarning, k8s.ServiceEventReasonPortConflict,
+			fmt.Sprintf("Port not exposed by service group %v: %v", groupID, conflict.String()))
+	}
+
+	stack, lb, err := r.modelBuilder.Build(ctx, frontend.Service, service.WithBackendByPort(frontend.BackendByPort))
+	if err != nil {
+		r.recordServiceGroupEvent(group, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		r.recordServiceGroupEvent(group, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully built model", "model", stackJSON)
+	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+		r.recordServiceGroupEvent(group, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully deployed model", "serviceGroup", groupID)
+
+	if lb != nil {
+		lbDNS, err := lb.DNSName().Resolve(ctx)
+		if err != nil {
+			return err
+		}
+		for _, member := range group.Members {
+			if err := r.updateServiceStatus(ctx, lbDNS, member); err != nil {
+				r.eventRecorder.Event(member, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+				return err
+			}
+		}
+	}
+	for _, inactiveMember := range group.InactiveMembers {
+		if err := r.cleanupServiceStatus(ctx, inactiveMember); err != nil {
+			r.eventRecorder.Event(inactiveMember, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
+			return err
+		}
+	}
+	if err := r.groupFinalizerManager.RemoveGroupFinalizer(ctx, groupID, group.InactiveMembers); err != nil {
+		r.recordServiceGroupEvent(group, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		return err
+	}
+	if len(group.Members) == 0 {
+		if err := r.backendSGProvider.Release(ctx); err != nil {
+			return err
+		}
+	}
+	r.recordServiceGroupEvent(group, corev1.EventTypeNormal, k8s.ServiceEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	return nil
+}
+
+// buildGroupFrontend builds the frontend Service for service group,
+// where the LoadBalancerConfiguration referenced by the first member Service is merged into frontend annotations.
+func (r *serviceReconciler) buildGroupFrontend(ctx context.Context, group service.Group) (service.GroupFrontend, error) {
+	frontend := service.BuildGroupFrontend(group)
+	if len(group.Members) == 0 {
+		return frontend, nil
+	}
+	frontendAnnotations, err := r.lbConfigResolver.ResolveServiceAnnotations(ctx, group.Members[0])
+	if err != nil {
+		return service.GroupFrontend{}, err
+	}
+	frontend.Service.Annotations = frontendAnnotations
+	return frontend, nil
+}
+
+// recordServiceGroupEvent records event on all active member Services of service group.
+func (r *serviceReconciler) recordServiceGroupEvent(group service.Group, eventType string, reason string, message string) {
+	for _, member := range group.Members {
+		r.eventRecorder.Event(member, eventType, reason, message)
+	}
+}
+
 func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS string, svc *corev1.Service) error {
 	if len(svc.Status.LoadBalancer.Ingress) != 1 ||
 		svc.Status.LoadBalancer.Ingress[0].IP != "" ||
@@ -195,24 +356,49 @@ func (r *serviceReconciler) cleanupServiceStatus(ctx context.Context, svc *corev
 }
 
 func (r *serviceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
-	c, err := controller.New(controllerName, mgr, controller.Options{
+	c, err := shard.NewController(controllerName, mgr, r.shardManager, controller.Options{
 		MaxConcurrentReconciles: r.maxConcurrentReconciles,
-		Reconciler:              r,
+		Reconciler:              shard.NewShardedReconciler(r, r.shardManager, r.logger),
 	})
 	if err != nil {
 		return err
 	}
+	if err := r.setupIndexes(ctx, mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
 	if err := r.setupWatches(ctx, c); err != nil {
 		return err
 	}
 	return nil
 }
 
+func (r *serviceReconciler) setupIndexes(ctx context.Context, fieldIndexer client.FieldIndexer) error {
+	if err := fieldIndexer.IndexField(ctx, &corev1.Service{}, lbconfig.IndexKeyLoadBalancerConfigurationRefName,
+		func(obj client.Object) []string {
+			return lbconfig.BuildServiceRefIndexes(obj.(*corev1.Service))
+		},
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (r *serviceReconciler) setupWatches(_ context.Context, c controller.Controller) error {
 	svcEventHandler := eventhandlers.NewEnqueueRequestForServiceEvent(r.eventRecorder,
-		r.serviceUtils, r.logger.WithName("eventHandlers").WithName("service"))
+		r.serviceUtils, r.groupLoader, r.logger.WithName("eventHandlers").WithName("service"))
 	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
 		return err
 	}
+	svcEventChan := make(chan event.GenericEvent)
+	lbConfigEventHandler := eventhandlers.NewEnqueueRequestsForLoadBalancerConfigurationEvent(svcEventChan, r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("loadBalancerConfiguration"))
+	if err := c.Watch(&source.Channel{Source: svcEventChan}, svcEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &elbv2api.LoadBalancerConfiguration{}}, lbConfigEventHandler); err != nil {
+		return err
+	}
+	r.shardManager.AddChangeHandler(shard.NewResyncHandler(r.k8sClient, func() client.ObjectList { return &corev1.ServiceList{} },
+		svcEventChan, r.logger.WithName("shardResync")))
 	return nil
 }
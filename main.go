@@ -28,6 +28,7 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	elbv2controller "sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/gateway"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
@@ -37,6 +38,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shard"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/version"
 	corewebhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/core"
@@ -46,6 +48,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -58,6 +62,8 @@ func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 
 	_ = elbv2api.AddToScheme(scheme)
+	_ = gwv1beta1.AddToScheme(scheme)
+	_ = gwv1alpha2.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -98,6 +104,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	shardManager, err := buildShardManager(controllerCFG, mgr)
+	if err != nil {
+		setupLog.Error(err, "unable to setup shard manager")
+		os.Exit(1)
+	}
+
 	podInfoRepo := k8s.NewDefaultPodInfoRepo(clientSet.CoreV1().RESTClient(), rtOpts.Namespace, ctrl.Log)
 	finalizerManager := k8s.NewDefaultFinalizerManager(mgr.GetClient(), ctrl.Log)
 	sgManager := networking.NewDefaultSecurityGroupManager(cloud.EC2(), ctrl.Log)
@@ -105,21 +117,28 @@ func main() {
 	azInfoProvider := networking.NewDefaultAZInfoProvider(cloud.EC2(), ctrl.Log.WithName("az-info-provider"))
 	vpcInfoProvider := networking.NewDefaultVPCInfoProvider(cloud.EC2(), ctrl.Log.WithName("vpc-info-provider"))
 	subnetResolver := networking.NewDefaultSubnetsResolver(azInfoProvider, cloud.EC2(), cloud.VpcID(), controllerCFG.ClusterName, ctrl.Log.WithName("subnets-resolver"))
+	multiClusterManager := targetgroupbinding.NewDefaultMultiClusterManager(mgr.GetClient(), mgr.GetAPIReader(), ctrl.Log.WithName("multi-cluster-manager"))
 	tgbResManager := targetgroupbinding.NewDefaultResourceManager(mgr.GetClient(), cloud.ELBV2(), cloud.EC2(),
-		podInfoRepo, sgManager, sgReconciler, vpcInfoProvider,
+		podInfoRepo, sgManager, sgReconciler, vpcInfoProvider, multiClusterManager,
 		cloud.VpcID(), controllerCFG.ClusterName, controllerCFG.FeatureGates.Enabled(config.EndpointsFailOpen), controllerCFG.EnableEndpointSlices, controllerCFG.DisableRestrictedSGRules,
 		mgr.GetEventRecorderFor("targetGroupBinding"), ctrl.Log)
-	backendSGProvider := networking.NewBackendSGProvider(controllerCFG.ClusterName, controllerCFG.BackendSecurityGroup,
-		cloud.VpcID(), cloud.EC2(), mgr.GetClient(), controllerCFG.DefaultTags, ctrl.Log.WithName("backend-sg-provider"))
+	backendSGProvider, err := buildBackendSGProvider(controllerCFG, cloud, mgr)
+	if err != nil {
+		setupLog.Error(err, "unable to setup backend SG provider")
+		os.Exit(1)
+	}
 	ingGroupReconciler := ingress.NewGroupReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("ingress"),
 		finalizerManager, sgManager, sgReconciler, subnetResolver,
-		controllerCFG, backendSGProvider, ctrl.Log.WithName("controllers").WithName("ingress"))
+		controllerCFG, backendSGProvider, shardManager, ctrl.Log.WithName("controllers").WithName("ingress"))
 	svcReconciler := service.NewServiceReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("service"),
 		finalizerManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider,
-		controllerCFG, ctrl.Log.WithName("controllers").WithName("service"))
+		controllerCFG, backendSGProvider, shardManager, ctrl.Log.WithName("controllers").WithName("service"))
 	tgbReconciler := elbv2controller.NewTargetGroupBindingReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("targetGroupBinding"),
 		finalizerManager, tgbResManager,
-		controllerCFG, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"))
+		controllerCFG, shardManager, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"))
+	gwReconciler := gateway.NewGatewayReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("gateway"),
+		finalizerManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider,
+		controllerCFG, backendSGProvider, ctrl.Log.WithName("controllers").WithName("gateway"))
 
 	ctx := ctrl.SetupSignalHandler()
 	if err = ingGroupReconciler.SetupWithManager(ctx, mgr, clientSet); err != nil {
@@ -135,11 +154,30 @@ func main() {
 		}
 	}
 
+	// Setup gateway reconciler only if Gateway API CRDs are expected to be installed.
+	if controllerCFG.FeatureGates.Enabled(config.EnableGatewayController) {
+		if err = gwReconciler.SetupWithManager(ctx, mgr, clientSet); err != nil {
+			setupLog.Error(err, "Unable to create controller", "controller", "Gateway")
+			os.Exit(1)
+		}
+	}
+
 	if err := tgbReconciler.SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TargetGroupBinding")
 		os.Exit(1)
 	}
 
+	// Setup trafficSplit reconciler only if TrafficSplit CRDs are expected to be installed.
+	if controllerCFG.FeatureGates.Enabled(config.EnableTrafficSplitController) {
+		trafficSplitTargetsManager := targetgroupbinding.NewCachedTargetsManager(cloud.ELBV2(), ctrl.Log.WithName("targets-manager"))
+		trafficSplitReconciler := elbv2controller.NewTrafficSplitReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("trafficSplit"),
+			trafficSplitTargetsManager, ctrl.Log.WithName("controllers").WithName("trafficSplit"))
+		if err := trafficSplitReconciler.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "TrafficSplit")
+			os.Exit(1)
+		}
+	}
+
 	// Add liveness probe
 	err = mgr.AddHealthzCheck("health-ping", healthz.Ping)
 	setupLog.Info("adding health check for controller")
@@ -155,6 +193,7 @@ func main() {
 	elbv2webhook.NewTargetGroupBindingMutator(cloud.ELBV2(), ctrl.Log).SetupWithManager(mgr)
 	elbv2webhook.NewTargetGroupBindingValidator(mgr.GetClient(), cloud.ELBV2(), ctrl.Log).SetupWithManager(mgr)
 	networkingwebhook.NewIngressValidator(mgr.GetClient(), controllerCFG.IngressConfig, ctrl.Log).SetupWithManager(mgr)
+	corewebhook.NewServiceValidator().SetupWithManager(mgr)
 	//+kubebuilder:scaffold:builder
 
 	go func() {
@@ -197,6 +236,44 @@ func loadControllerConfig() (config.ControllerConfig, error) {
 	return controllerCFG, nil
 }
 
+// buildShardManager builds the shard manager, which owns every object unless replica sharding is enabled.
+func buildShardManager(controllerCFG config.ControllerConfig, mgr ctrl.Manager) (shard.Manager, error) {
+	if !controllerCFG.ReplicaShardingConfig.EnableReplicaSharding {
+		return shard.NewUnshardedManager(), nil
+	}
+	identity, err := shard.LoadIdentity()
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := shard.LoadNamespace(controllerCFG.RuntimeConfig.LeaderElectionNamespace)
+	if err != nil {
+		return nil, err
+	}
+	shardManager := shard.NewDefaultManager(mgr.GetClient(), mgr.GetAPIReader(), namespace, controllerCFG.RuntimeConfig.LeaderElectionID, identity,
+		controllerCFG.ReplicaShardingConfig.LeaseDuration, controllerCFG.ReplicaShardingConfig.RenewInterval, ctrl.Log.WithName("shard-manager"))
+	if err := mgr.Add(shardManager); err != nil {
+		return nil, err
+	}
+	return shardManager, nil
+}
+
+// buildBackendSGProvider builds the backend SG provider.
+// when replica sharding is enabled, Ingresses and Services are reconciled by every replica, but only the leader replica releases
+// the auto-generated backend SG.
+func buildBackendSGProvider(controllerCFG config.ControllerConfig, cloud aws.Cloud, mgr ctrl.Manager) (networking.BackendSGProvider, error) {
+	backendSGProvider := networking.NewBackendSGProvider(controllerCFG.ClusterName, controllerCFG.BackendSecurityGroup,
+		cloud.VpcID(), cloud.EC2(), mgr.GetClient(), controllerCFG.DefaultTags, ctrl.Log.WithName("backend-sg-provider"))
+	if !controllerCFG.ReplicaShardingConfig.EnableReplicaSharding {
+		return backendSGProvider, nil
+	}
+	leaderElectedBackendSGProvider := networking.NewLeaderElectedBackendSGProvider(backendSGProvider, mgr.Elected(),
+		ctrl.Log.WithName("backend-sg-provider"))
+	if err := mgr.Add(leaderElectedBackendSGProvider); err != nil {
+		return nil, err
+	}
+	return leaderElectedBackendSGProvider, nil
+}
+
 // getLoggerWithLogLevel returns logger with specific log level.
 func getLoggerWithLogLevel(logLevel string) logr.Logger {
 	var zapLevel zapraw.AtomicLevel
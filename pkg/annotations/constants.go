@@ -25,6 +25,8 @@ const (
 	IngressSuffixInboundCIDRs                 = "inbound-cidrs"
 	IngressSuffixCertificateARN               = "certificate-arn"
 	IngressSuffixSSLPolicy                    = "ssl-policy"
+	IngressSuffixMutualAuthentication         = "mutual-authentication"
+	IngressSuffixListenerAttributes           = "listener-attributes"
 	IngressSuffixTargetType                   = "target-type"
 	IngressSuffixBackendProtocol              = "backend-protocol"
 	IngressSuffixBackendProtocolVersion       = "backend-protocol-version"
@@ -46,6 +48,8 @@ const (
 	IngressSuffixAuthSessionTimeout           = "auth-session-timeout"
 	IngressSuffixTargetNodeLabels             = "target-node-labels"
 	IngressSuffixManageSecurityGroupRules     = "manage-backend-security-group-rules"
+	IngressSuffixLoadBalancerConfiguration    = "load-balancer-configuration"
+	IngressSuffixStatus                       = "status"
 
 	// NLB annotation suffixes
 	// prefixes service.beta.kubernetes.io, service.kubernetes.io
@@ -82,5 +86,10 @@ const (
 	SvcLBSuffixALPNPolicy                    = "aws-load-balancer-alpn-policy"
 	SvcLBSuffixTargetNodeLabels              = "aws-load-balancer-target-node-labels"
 	SvcLBSuffixLoadBalancerAttributes        = "aws-load-balancer-attributes"
+	SvcLBSuffixListenerAttributes            = "aws-load-balancer-listener-attributes"
 	SvcLBSuffixManageSGRules                 = "aws-load-balancer-manage-backend-security-group-rules"
+	SvcLBSuffixSecurityGroups                = "aws-load-balancer-security-groups"
+	SvcLBSuffixGroupName                     = "aws-load-balancer-group-name"
+	SvcLBSuffixGroupOrder                    = "aws-load-balancer-group-order"
+	SvcLBSuffixLoadBalancerConfiguration     = "aws-load-balancer-configuration"
 )
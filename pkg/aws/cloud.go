@@ -41,6 +41,9 @@ type Cloud interface {
 	// RGT provides API to AWS RGT
 	RGT() services.RGT
 
+	// S3 provides API to AWS S3
+	S3() services.S3
+
 	// Region for the kubernetes cluster
 	Region() string
 
@@ -102,6 +105,15 @@ func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud,
 		throttler := throttle.NewThrottler(cfg.ThrottleConfig)
 		throttler.InjectHandlers(&sess.Handlers)
 	}
+	if cfg.AdaptiveThrottleConfig != nil {
+		adaptiveThrottler := throttle.NewAdaptiveThrottler(cfg.AdaptiveThrottleConfig)
+		adaptiveThrottler.InjectHandlers(&sess.Handlers)
+		if metricsRegisterer != nil {
+			if err := metricsRegisterer.Register(adaptiveThrottler); err != nil {
+				return nil, errors.Wrap(err, "failed to register adaptive throttle metrics")
+			}
+		}
+	}
 	if metricsRegisterer != nil {
 		metricsCollector, err := metrics.NewCollector(metricsRegisterer)
 		if err != nil {
@@ -111,6 +123,17 @@ func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud,
 	}
 
 	ec2Service := services.NewEC2(sess)
+	elbv2Service := services.NewELBV2(sess)
+	if cfg.DescribeCacheTTL > 0 {
+		describeCache := services.NewDescribeCache(cfg.DescribeCacheTTL)
+		if metricsRegisterer != nil {
+			if err := metricsRegisterer.Register(describeCache); err != nil {
+				return nil, errors.Wrap(err, "failed to register describe cache metrics")
+			}
+		}
+		ec2Service = services.NewCachedEC2(ec2Service, describeCache)
+		elbv2Service = services.NewCachedELBV2(elbv2Service, describeCache)
+	}
 
 	if len(cfg.VpcID) == 0 {
 		vpcID, err := inferVPCID(metadata, ec2Service)
@@ -123,12 +146,13 @@ func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud,
 	return &defaultCloud{
 		cfg:         cfg,
 		ec2:         ec2Service,
-		elbv2:       services.NewELBV2(sess),
+		elbv2:       elbv2Service,
 		acm:         services.NewACM(sess),
 		wafv2:       services.NewWAFv2(sess),
 		wafRegional: services.NewWAFRegional(sess, cfg.Region),
 		shield:      services.NewShield(sess),
 		rgt:         services.NewRGT(sess),
+		s3:          services.NewS3(sess),
 	}, nil
 }
 
@@ -181,6 +205,7 @@ type defaultCloud struct {
 	wafRegional services.WAFRegional
 	shield      services.Shield
 	rgt         services.RGT
+	s3          services.S3
 }
 
 func (c *defaultCloud) EC2() services.EC2 {
@@ -211,6 +236,10 @@ func (c *defaultCloud) RGT() services.RGT {
 	return c.rgt
 }
 
+func (c *defaultCloud) S3() services.S3 {
+	return c.s3
+}
+
 func (c *defaultCloud) Region() string {
 	return c.cfg.Region
 }
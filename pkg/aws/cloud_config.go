@@ -8,15 +8,18 @@ import (
 )
 
 const (
-	flagAWSRegion        = "aws-region"
-	flagAWSAPIEndpoints  = "aws-api-endpoints"
-	flagAWSAPIThrottle   = "aws-api-throttle"
-	flagAWSVpcID         = "aws-vpc-id"
-	flagAWSVpcCacheTTL   = "aws-vpc-cache-ttl"
-	flagAWSMaxRetries    = "aws-max-retries"
-	defaultVpcID         = ""
-	defaultRegion        = ""
-	defaultAPIMaxRetries = 10
+	flagAWSRegion              = "aws-region"
+	flagAWSAPIEndpoints        = "aws-api-endpoints"
+	flagAWSAPIThrottle         = "aws-api-throttle"
+	flagAWSAPIAdaptiveThrottle = "aws-api-adaptive-throttle"
+	flagAWSVpcID               = "aws-vpc-id"
+	flagAWSVpcCacheTTL         = "aws-vpc-cache-ttl"
+	flagAWSMaxRetries          = "aws-max-retries"
+	flagAWSDescribeCacheTTL    = "aws-describe-cache-ttl"
+	defaultVpcID               = ""
+	defaultRegion              = ""
+	defaultAPIMaxRetries       = 10
+	defaultDescribeCacheTTL    = 0
 )
 
 type CloudConfig struct {
@@ -26,6 +29,10 @@ type CloudConfig struct {
 	// Throttle settings for AWS APIs
 	ThrottleConfig *throttle.ServiceOperationsThrottleConfig
 
+	// Adaptive throttle settings for AWS APIs, the rates are lowered when AWS throttles requests.
+	// Adaptive throttling is disabled when nil.
+	AdaptiveThrottleConfig *throttle.ServiceOperationsThrottleConfig
+
 	// VpcID for the LoadBalancer resources.
 	VpcID string
 
@@ -37,12 +44,42 @@ type CloudConfig struct {
 
 	// AWS endpoints configuration
 	AWSEndpoints map[string]string
+
+	// TTL for cached results of ELBV2 and EC2 describe APIs, caching is disabled when zero.
+	DescribeCacheTTL time.Duration
 }
 
 func (cfg *CloudConfig) BindFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&cfg.Region, flagAWSRegion, defaultRegion, "AWS Region for the kubernetes cluster")
 	fs.Var(cfg.ThrottleConfig, flagAWSAPIThrottle, "throttle settings for AWS APIs, format: serviceID1:operationRegex1=rate:burst,serviceID2:operationRegex2=rate:burst")
+	fs.Var(&optionalThrottleConfig{cfg: &cfg.AdaptiveThrottleConfig}, flagAWSAPIAdaptiveThrottle, "adaptive throttle settings for AWS APIs, the rates are lowered when AWS throttles requests and recover gradually, format: serviceID1:operationRegex1=maxRate:burst,serviceID2:operationRegex2=maxRate:burst")
 	fs.StringVar(&cfg.VpcID, flagAWSVpcID, defaultVpcID, "AWS VpcID for the LoadBalancer resources")
 	fs.IntVar(&cfg.MaxRetries, flagAWSMaxRetries, defaultAPIMaxRetries, "Maximum retries for AWS APIs")
 	fs.StringToStringVar(&cfg.AWSEndpoints, flagAWSAPIEndpoints, nil, "Custom AWS endpoint configuration, format: serviceID1=URL1,serviceID2=URL2")
+	fs.DurationVar(&cfg.DescribeCacheTTL, flagAWSDescribeCacheTTL, defaultDescribeCacheTTL, "TTL for cached results of ELBV2 and EC2 describe APIs, caching is disabled when zero")
+}
+
+var _ pflag.Value = &optionalThrottleConfig{}
+
+// optionalThrottleConfig is a flag value that only allocates the throttle config once the flag is specified.
+type optionalThrottleConfig struct {
+	cfg **throttle.ServiceOperationsThrottleConfig
+}
+
+func (v *optionalThrottleConfig) String() string {
+	if v.cfg == nil {
+		return ""
+	}
+	return (*v.cfg).String()
+}
+
+func (v *optionalThrottleConfig) Set(val string) error {
+	if *v.cfg == nil {
+		*v.cfg = &throttle.ServiceOperationsThrottleConfig{}
+	}
+	return (*v.cfg).Set(val)
+}
+
+func (v *optionalThrottleConfig) Type() string {
+	return (*v.cfg).Type()
 }
@@ -0,0 +1,55 @@
+package fake
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	acmsdk "github.com/aws/aws-sdk-go/service/acm"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+var _ services.ACM = &fakeACM{}
+
+// fakeACM serves the ACM APIs from store.
+type fakeACM struct {
+	services.ACM
+
+	store *store
+}
+
+func (c *fakeACM) ListCertificatesAsList(_ context.Context, input *acmsdk.ListCertificatesInput) ([]*acmsdk.CertificateSummary, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	statuses := sets.NewString(awssdk.StringValueSlice(input.CertificateStatuses)...)
+	var certSummaries []*acmsdk.CertificateSummary
+	for _, certARN := range sets.StringKeySet(c.store.certificates).List() {
+		cert := c.store.certificates[certARN]
+		if statuses.Len() != 0 && !statuses.Has(awssdk.StringValue(cert.Status)) {
+			continue
+		}
+		certSummaries = append(certSummaries, &acmsdk.CertificateSummary{
+			CertificateArn: awssdk.String(certARN),
+			DomainName:     cert.DomainName,
+			Status:         cert.Status,
+			Type:           cert.Type,
+		})
+	}
+	return certSummaries, nil
+}
+
+func (c *fakeACM) DescribeCertificateWithContext(_ context.Context, input *acmsdk.DescribeCertificateInput, _ ...request.Option) (*acmsdk.DescribeCertificateOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	certARN := awssdk.StringValue(input.CertificateArn)
+	cert, exists := c.store.certificates[certARN]
+	if !exists {
+		return nil, c.store.newAPIError(acmsdk.ErrCodeResourceNotFoundException, 400, "Could not find certificate %v.", certARN)
+	}
+	return &acmsdk.DescribeCertificateOutput{
+		Certificate: copyOf(cert).(*acmsdk.CertificateDetail),
+	}, nil
+}
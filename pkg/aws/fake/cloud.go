@@ -0,0 +1,378 @@
+package fake
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	acmsdk "github.com/aws/aws-sdk-go/service/acm"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	shieldsdk "github.com/aws/aws-sdk-go/service/shield"
+	wafv2sdk "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	defaultRegion       = "us-west-2"
+	defaultAccountID    = "123456789012"
+	defaultVpcID        = "vpc-0123456789abcdef0"
+	defaultVpcCIDRBlock = "192.168.0.0/16"
+)
+
+// Quotas are the service quotas enforced by the fake Cloud.
+// Zero fields default to the default quotas of AWS.
+type Quotas struct {
+	// LoadBalancers is the maximum number of load balancers per region.
+	LoadBalancers int
+	// TargetGroups is the maximum number of target groups per region.
+	TargetGroups int
+	// ListenersPerLoadBalancer is the maximum number of listeners per load balancer.
+	ListenersPerLoadBalancer int
+	// RulesPerLoadBalancer is the maximum number of listener rules per load balancer, excluding the default rules.
+	RulesPerLoadBalancer int
+	// CertificatesPerLoadBalancer is the maximum number of certificates per load balancer, excluding the default certificates.
+	CertificatesPerLoadBalancer int
+	// TargetsPerTargetGroup is the maximum number of targets per target group.
+	TargetsPerTargetGroup int
+	// TagsPerResource is the maximum number of tags per resource.
+	TagsPerResource int
+	// SecurityGroups is the maximum number of security groups per region.
+	SecurityGroups int
+	// RulesPerSecurityGroup is the maximum number of inbound rules per security group.
+	RulesPerSecurityGroup int
+}
+
+// withDefaults returns the quotas with zero fields defaulted.
+func (q Quotas) withDefaults() Quotas {
+	defaultInt := func(value int, defaultValue int) int {
+		if value == 0 {
+			return defaultValue
+		}
+		return value
+	}
+	return Quotas{
+		LoadBalancers:               defaultInt(q.LoadBalancers, 50),
+		TargetGroups:                defaultInt(q.TargetGroups, 3000),
+		ListenersPerLoadBalancer:    defaultInt(q.ListenersPerLoadBalancer, 50),
+		RulesPerLoadBalancer:        defaultInt(q.RulesPerLoadBalancer, 100),
+		CertificatesPerLoadBalancer: defaultInt(q.CertificatesPerLoadBalancer, 25),
+		TargetsPerTargetGroup:       defaultInt(q.TargetsPerTargetGroup, 1000),
+		TagsPerResource:             defaultInt(q.TagsPerResource, 50),
+		SecurityGroups:              defaultInt(q.SecurityGroups, 2500),
+		RulesPerSecurityGroup:       defaultInt(q.RulesPerSecurityGroup, 60),
+	}
+}
+
+// Config is the configuration for fake Cloud.
+type Config struct {
+	// Region of the fake Cloud, defaults to us-west-2.
+	Region string
+	// AccountID that owns the resources, defaults to 123456789012.
+	AccountID string
+	// VpcID of the VPC for the LoadBalancer resources, which is created along with the fake Cloud.
+	VpcID string
+	// VpcCIDRBlocks of the VPC, defaults to 192.168.0.0/16.
+	VpcCIDRBlocks []string
+	// Quotas enforced by the fake Cloud.
+	Quotas Quotas
+}
+
+// NewCloud constructs new fake Cloud.
+// The fake Cloud keeps the AWS resources in memory, and serves the ELBV2, EC2, ACM, WAFv2, Shield, S3 and Resource Groups Tagging APIs invoked by the controller
+// with the behavior of AWS, including the validations, quotas and error codes.
+// Only the APIs invoked by the controller are implemented, other calls fail with NotImplemented error.
+func NewCloud(cfg Config) *Cloud {
+	if cfg.Region == "" {
+		cfg.Region = defaultRegion
+	}
+	if cfg.AccountID == "" {
+		cfg.AccountID = defaultAccountID
+	}
+	if cfg.VpcID == "" {
+		cfg.VpcID = defaultVpcID
+	}
+	if len(cfg.VpcCIDRBlocks) == 0 {
+		cfg.VpcCIDRBlocks = []string{defaultVpcCIDRBlock}
+	}
+	cfg.Quotas = cfg.Quotas.withDefaults()
+
+	s := newStore(cfg)
+	sess := newNotImplementedSession(cfg.Region)
+	return &Cloud{
+		cfg:         cfg,
+		store:       s,
+		ec2:         &fakeEC2{EC2: services.NewEC2(sess), store: s},
+		elbv2:       &fakeELBV2{ELBV2: services.NewELBV2(sess), store: s},
+		acm:         &fakeACM{ACM: services.NewACM(sess), store: s},
+		wafv2:       &fakeWAFv2{WAFv2: services.NewWAFv2(sess), store: s},
+		wafRegional: &fakeWAFRegional{WAFRegional: services.NewWAFRegional(sess, cfg.Region)},
+		shield:      &fakeShield{Shield: services.NewShield(sess), store: s},
+		rgt:         &fakeRGT{RGT: services.NewRGT(sess), store: s},
+		s3:          &fakeS3{S3: services.NewS3(sess), store: s},
+	}
+}
+
+var _ aws.Cloud = &Cloud{}
+
+// Cloud is an in-process fake of aws.Cloud.
+type Cloud struct {
+	cfg   Config
+	store *store
+
+	ec2         *fakeEC2
+	elbv2       *fakeELBV2
+	acm         *fakeACM
+	wafv2       *fakeWAFv2
+	wafRegional *fakeWAFRegional
+	shield      *fakeShield
+	rgt         *fakeRGT
+	s3          *fakeS3
+}
+
+func (c *Cloud) EC2() services.EC2 {
+	return c.ec2
+}
+
+func (c *Cloud) ELBV2() services.ELBV2 {
+	return c.elbv2
+}
+
+func (c *Cloud) ACM() services.ACM {
+	return c.acm
+}
+
+func (c *Cloud) WAFv2() services.WAFv2 {
+	return c.wafv2
+}
+
+func (c *Cloud) WAFRegional() services.WAFRegional {
+	return c.wafRegional
+}
+
+func (c *Cloud) Shield() services.Shield {
+	return c.shield
+}
+
+func (c *Cloud) RGT() services.RGT {
+	return c.rgt
+}
+
+func (c *Cloud) S3() services.S3 {
+	return c.s3
+}
+
+func (c *Cloud) Region() string {
+	return c.cfg.Region
+}
+
+func (c *Cloud) VpcID() string {
+	return c.cfg.VpcID
+}
+
+// AddSubnet adds a subnet into the VPC of fake Cloud.
+// The SubnetId, CidrBlock, AvailabilityZone and AvailabilityZoneId of subnet must be specified.
+func (c *Cloud) AddSubnet(subnet *ec2sdk.Subnet) error {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+	return c.store.addSubnet(subnet)
+}
+
+// AddSecurityGroup adds a security group that isn't managed by the controller, e.g. the security group of worker nodes.
+// The GroupId and GroupName of securityGroup must be specified.
+func (c *Cloud) AddSecurityGroup(securityGroup *ec2sdk.SecurityGroup) error {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+	return c.store.addSecurityGroup(securityGroup)
+}
+
+// AddInstance adds an EC2 instance, along with its network interfaces.
+// The InstanceId and SubnetId of instance must be specified.
+func (c *Cloud) AddInstance(instance *ec2sdk.Instance) error {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+	return c.store.addInstance(instance)
+}
+
+// AddNetworkInterface adds a network interface that isn't attached to instances, e.g. the branch ENI of pods.
+// The NetworkInterfaceId and SubnetId of networkInterface must be specified.
+func (c *Cloud) AddNetworkInterface(networkInterface *ec2sdk.NetworkInterface) error {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+	return c.store.addNetworkInterface(networkInterface)
+}
+
+// AddCertificate adds an ACM certificate.
+// The CertificateArn of certificate must be specified, the certificate defaults to an issued certificate issued by Amazon.
+func (c *Cloud) AddCertificate(certificate *acmsdk.CertificateDetail) error {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+	return c.store.addCertificate(certificate)
+}
+
+// AddWebACL adds a WAFv2 webACL. The ARN of webACL must be specified.
+func (c *Cloud) AddWebACL(webACL *wafv2sdk.WebACL) error {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+	return c.store.addWebACL(webACL)
+}
+
+// SetShieldSubscriptionState sets the subscription state of AWS Shield Advanced, which defaults to INACTIVE.
+func (c *Cloud) SetShieldSubscriptionState(subscriptionState string) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+	c.store.shieldSubscriptionState = subscriptionState
+}
+
+// SetTargetHealth sets the health of a registered target, which overrides the health the fake Cloud reports.
+func (c *Cloud) SetTargetHealth(tgARN string, target *elbv2sdk.TargetDescription, targetHealth *elbv2sdk.TargetHealth) error {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+	return c.store.setTargetHealth(tgARN, target, targetHealth)
+}
+
+// S3Object returns the content of an S3 object uploaded by the controller.
+func (c *Cloud) S3Object(bucket string, key string) ([]byte, bool) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+	body, exists := c.store.s3Objects[s3ObjectKey(bucket, key)]
+	return body, exists
+}
+
+// store holds the AWS resources of fake Cloud.
+type store struct {
+	mutex sync.Mutex
+	cfg   Config
+	// lastID is the last ID allocated to resources.
+	lastID int64
+
+	vpc                *ec2sdk.Vpc
+	subnets            map[string]*ec2sdk.Subnet
+	securityGroups     map[string]*ec2sdk.SecurityGroup
+	networkInterfaces  map[string]*ec2sdk.NetworkInterface
+	instances          map[string]*ec2sdk.Instance
+	allocatedAddresses map[string]int
+
+	loadBalancers map[string]*loadBalancer
+	targetGroups  map[string]*targetGroup
+	listeners     map[string]*listener
+	rules         map[string]*rule
+	trustStores   map[string]*trustStore
+
+	certificates map[string]*acmsdk.CertificateDetail
+
+	webACLs              map[string]*wafv2sdk.WebACL
+	webACLARNByResources map[string]string
+
+	shieldSubscriptionState string
+	protections             map[string]*shieldsdk.Protection
+
+	s3Objects map[string][]byte
+}
+
+func newStore(cfg Config) *store {
+	vpc := &ec2sdk.Vpc{
+		VpcId:     awssdk.String(cfg.VpcID),
+		OwnerId:   awssdk.String(cfg.AccountID),
+		State:     awssdk.String(ec2sdk.VpcStateAvailable),
+		CidrBlock: awssdk.String(cfg.VpcCIDRBlocks[0]),
+	}
+	for _, cidrBlock := range cfg.VpcCIDRBlocks {
+		vpc.CidrBlockAssociationSet = append(vpc.CidrBlockAssociationSet, &ec2sdk.VpcCidrBlockAssociation{
+			CidrBlock: awssdk.String(cidrBlock),
+			CidrBlockState: &ec2sdk.VpcCidrBlockState{
+				State: awssdk.String(ec2sdk.VpcCidrBlockStateCodeAssociated),
+			},
+		})
+	}
+	return &store{
+		cfg:                     cfg,
+		vpc:                     vpc,
+		subnets:                 make(map[string]*ec2sdk.Subnet),
+		securityGroups:          make(map[string]*ec2sdk.SecurityGroup),
+		networkInterfaces:       make(map[string]*ec2sdk.NetworkInterface),
+		instances:               make(map[string]*ec2sdk.Instance),
+		allocatedAddresses:      make(map[string]int),
+		loadBalancers:           make(map[string]*loadBalancer),
+		targetGroups:            make(map[string]*targetGroup),
+		listeners:               make(map[string]*listener),
+		rules:                   make(map[string]*rule),
+		trustStores:             make(map[string]*trustStore),
+		certificates:            make(map[string]*acmsdk.CertificateDetail),
+		webACLs:                 make(map[string]*wafv2sdk.WebACL),
+		webACLARNByResources:    make(map[string]string),
+		shieldSubscriptionState: shieldsdk.SubscriptionStateInactive,
+		protections:             make(map[string]*shieldsdk.Protection),
+		s3Objects:               make(map[string][]byte),
+	}
+}
+
+// nextID allocates an ID for resources, which is formatted as a hexadecimal string with specified width.
+// IDs are allocated in ascending order, so that resources sorted by ID are in creation order.
+func (s *store) nextID(width int) string {
+	s.lastID++
+	return fmt.Sprintf("%0*x", width, s.lastID)
+}
+
+// newAPIError constructs an API error as returned by AWS.
+func (s *store) newAPIError(code string, statusCode int, format string, args ...interface{}) error {
+	s.lastID++
+	requestID := fmt.Sprintf("00000000-0000-0000-0000-%012x", s.lastID)
+	return awserr.NewRequestFailure(awserr.New(code, fmt.Sprintf(format, args...), nil), statusCode, requestID)
+}
+
+func (s *store) addCertificate(certificate *acmsdk.CertificateDetail) error {
+	certARN := awssdk.StringValue(certificate.CertificateArn)
+	if certARN == "" {
+		return errors.New("CertificateArn must be specified")
+	}
+	certificate = copyOf(certificate).(*acmsdk.CertificateDetail)
+	if certificate.Status == nil {
+		certificate.Status = awssdk.String(acmsdk.CertificateStatusIssued)
+	}
+	if certificate.Type == nil {
+		certificate.Type = awssdk.String(acmsdk.CertificateTypeAmazonIssued)
+	}
+	if certificate.DomainName == nil && len(certificate.SubjectAlternativeNames) != 0 {
+		certificate.DomainName = certificate.SubjectAlternativeNames[0]
+	}
+	s.certificates[certARN] = certificate
+	return nil
+}
+
+func (s *store) addWebACL(webACL *wafv2sdk.WebACL) error {
+	webACLARN := awssdk.StringValue(webACL.ARN)
+	if webACLARN == "" {
+		return errors.New("ARN must be specified")
+	}
+	s.webACLs[webACLARN] = copyOf(webACL).(*wafv2sdk.WebACL)
+	return nil
+}
+
+// copyOf deep copies the AWS SDK object or slice of objects, so that the objects held by store are never shared with callers.
+// nil pointers and slices are copied as nil.
+func copyOf(obj interface{}) interface{} {
+	v := reflect.ValueOf(obj)
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return obj
+		}
+		return awsutil.CopyOf(obj)
+	case reflect.Slice:
+		if v.IsNil() {
+			return obj
+		}
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			copied.Index(i).Set(reflect.ValueOf(copyOf(v.Index(i).Interface())))
+		}
+		return copied.Interface()
+	}
+	return obj
+}
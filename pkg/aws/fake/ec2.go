@@ -0,0 +1,908 @@
+package fake
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	// the number of addresses AWS reserves at the start of each subnet CIDR block.
+	reservedAddressesPerSubnet = 4
+)
+
+var _ services.EC2 = &fakeEC2{}
+
+// fakeEC2 serves the EC2 APIs from store.
+type fakeEC2 struct {
+	services.EC2
+
+	store *store
+}
+
+func (c *fakeEC2) DescribeVpcsWithContext(_ context.Context, input *ec2sdk.DescribeVpcsInput, _ ...request.Option) (*ec2sdk.DescribeVpcsOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	for _, vpcID := range awssdk.StringValueSlice(input.VpcIds) {
+		if vpcID != awssdk.StringValue(c.store.vpc.VpcId) {
+			return nil, c.store.newAPIError("InvalidVpcID.NotFound", 400, "The vpc ID '%v' does not exist", vpcID)
+		}
+	}
+	matches, err := c.store.matchesEC2Filters(input.Filters, c.store.vpc.Tags, func(filterName string) ([]string, bool) {
+		switch filterName {
+		case "vpc-id":
+			return []string{awssdk.StringValue(c.store.vpc.VpcId)}, true
+		}
+		return nil, false
+	})
+	if err != nil {
+		return nil, err
+	}
+	output := &ec2sdk.DescribeVpcsOutput{}
+	if matches {
+		output.Vpcs = []*ec2sdk.Vpc{copyOf(c.store.vpc).(*ec2sdk.Vpc)}
+	}
+	return output, nil
+}
+
+func (c *fakeEC2) DescribeAvailabilityZonesWithContext(_ context.Context, input *ec2sdk.DescribeAvailabilityZonesInput, _ ...request.Option) (*ec2sdk.DescribeAvailabilityZonesOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	zoneIDs := sets.NewString(awssdk.StringValueSlice(input.ZoneIds)...)
+	zoneNames := sets.NewString(awssdk.StringValueSlice(input.ZoneNames)...)
+	seenZoneIDs := sets.NewString()
+	output := &ec2sdk.DescribeAvailabilityZonesOutput{}
+	for _, subnetID := range sets.StringKeySet(c.store.subnets).List() {
+		subnet := c.store.subnets[subnetID]
+		zoneID := awssdk.StringValue(subnet.AvailabilityZoneId)
+		zoneName := awssdk.StringValue(subnet.AvailabilityZone)
+		if seenZoneIDs.Has(zoneID) {
+			continue
+		}
+		seenZoneIDs.Insert(zoneID)
+		if zoneIDs.Len() != 0 && !zoneIDs.Has(zoneID) {
+			continue
+		}
+		if zoneNames.Len() != 0 && !zoneNames.Has(zoneName) {
+			continue
+		}
+		output.AvailabilityZones = append(output.AvailabilityZones, &ec2sdk.AvailabilityZone{
+			RegionName: awssdk.String(c.store.cfg.Region),
+			ZoneId:     awssdk.String(zoneID),
+			ZoneName:   awssdk.String(zoneName),
+			ZoneType:   awssdk.String("availability-zone"),
+			State:      awssdk.String(ec2sdk.AvailabilityZoneStateAvailable),
+		})
+	}
+	for _, zoneID := range zoneIDs.Difference(seenZoneIDs).List() {
+		return nil, c.store.newAPIError("InvalidParameterValue", 400, "Invalid availability zone: [%v]", zoneID)
+	}
+	return output, nil
+}
+
+func (c *fakeEC2) DescribeSubnetsAsList(_ context.Context, input *ec2sdk.DescribeSubnetsInput) ([]*ec2sdk.Subnet, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	for _, subnetID := range awssdk.StringValueSlice(input.SubnetIds) {
+		if _, exists := c.store.subnets[subnetID]; !exists {
+			return nil, c.store.newAPIError("InvalidSubnetID.NotFound", 400, "The subnet ID '%v' does not exist", subnetID)
+		}
+	}
+	subnetIDs := sets.NewString(awssdk.StringValueSlice(input.SubnetIds)...)
+	var subnets []*ec2sdk.Subnet
+	for _, subnetID := range sets.StringKeySet(c.store.subnets).List() {
+		if subnetIDs.Len() != 0 && !subnetIDs.Has(subnetID) {
+			continue
+		}
+		subnet := c.store.subnets[subnetID]
+		matches, err := c.store.matchesEC2Filters(input.Filters, subnet.Tags, func(filterName string) ([]string, bool) {
+			switch filterName {
+			case "vpc-id":
+				return []string{awssdk.StringValue(subnet.VpcId)}, true
+			case "subnet-id":
+				return []string{subnetID}, true
+			case "availability-zone":
+				return []string{awssdk.StringValue(subnet.AvailabilityZone)}, true
+			case "availability-zone-id":
+				return []string{awssdk.StringValue(subnet.AvailabilityZoneId)}, true
+			case "cidr-block":
+				return []string{awssdk.StringValue(subnet.CidrBlock)}, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			subnets = append(subnets, copyOf(subnet).(*ec2sdk.Subnet))
+		}
+	}
+	return subnets, nil
+}
+
+func (c *fakeEC2) DescribeSecurityGroupsAsList(_ context.Context, input *ec2sdk.DescribeSecurityGroupsInput) ([]*ec2sdk.SecurityGroup, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	for _, groupID := range awssdk.StringValueSlice(input.GroupIds) {
+		if _, exists := c.store.securityGroups[groupID]; !exists {
+			return nil, c.store.newAPIError("InvalidGroup.NotFound", 400, "The security group '%v' does not exist", groupID)
+		}
+	}
+	groupIDs := sets.NewString(awssdk.StringValueSlice(input.GroupIds)...)
+	var securityGroups []*ec2sdk.SecurityGroup
+	for _, groupID := range sets.StringKeySet(c.store.securityGroups).List() {
+		if groupIDs.Len() != 0 && !groupIDs.Has(groupID) {
+			continue
+		}
+		sg := c.store.securityGroups[groupID]
+		matches, err := c.store.matchesEC2Filters(input.Filters, sg.Tags, func(filterName string) ([]string, bool) {
+			switch filterName {
+			case "vpc-id":
+				return []string{awssdk.StringValue(sg.VpcId)}, true
+			case "group-id":
+				return []string{groupID}, true
+			case "group-name":
+				return []string{awssdk.StringValue(sg.GroupName)}, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			securityGroups = append(securityGroups, copyOf(sg).(*ec2sdk.SecurityGroup))
+		}
+	}
+	return securityGroups, nil
+}
+
+func (c *fakeEC2) DescribeNetworkInterfacesAsList(_ context.Context, input *ec2sdk.DescribeNetworkInterfacesInput) ([]*ec2sdk.NetworkInterface, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	for _, eniID := range awssdk.StringValueSlice(input.NetworkInterfaceIds) {
+		if _, exists := c.store.networkInterfaces[eniID]; !exists {
+			return nil, c.store.newAPIError("InvalidNetworkInterfaceID.NotFound", 400, "The networkInterface ID '%v' does not exist", eniID)
+		}
+	}
+	eniIDs := sets.NewString(awssdk.StringValueSlice(input.NetworkInterfaceIds)...)
+	var enis []*ec2sdk.NetworkInterface
+	for _, eniID := range sets.StringKeySet(c.store.networkInterfaces).List() {
+		if eniIDs.Len() != 0 && !eniIDs.Has(eniID) {
+			continue
+		}
+		eni := c.store.networkInterfaces[eniID]
+		matches, err := c.store.matchesEC2Filters(input.Filters, eni.TagSet, func(filterName string) ([]string, bool) {
+			switch filterName {
+			case "vpc-id":
+				return []string{awssdk.StringValue(eni.VpcId)}, true
+			case "subnet-id":
+				return []string{awssdk.StringValue(eni.SubnetId)}, true
+			case "network-interface-id":
+				return []string{eniID}, true
+			case "description":
+				return []string{awssdk.StringValue(eni.Description)}, true
+			case "group-id":
+				var groupIDs []string
+				for _, group := range eni.Groups {
+					groupIDs = append(groupIDs, awssdk.StringValue(group.GroupId))
+				}
+				return groupIDs, true
+			case "addresses.private-ip-address":
+				var addresses []string
+				for _, address := range eni.PrivateIpAddresses {
+					addresses = append(addresses, awssdk.StringValue(address.PrivateIpAddress))
+				}
+				return addresses, true
+			case "ipv6-addresses.ipv6-address":
+				var addresses []string
+				for _, address := range eni.Ipv6Addresses {
+					addresses = append(addresses, awssdk.StringValue(address.Ipv6Address))
+				}
+				return addresses, true
+			case "attachment.instance-id":
+				if eni.Attachment == nil {
+					return nil, true
+				}
+				return []string{awssdk.StringValue(eni.Attachment.InstanceId)}, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			enis = append(enis, copyOf(eni).(*ec2sdk.NetworkInterface))
+		}
+	}
+	return enis, nil
+}
+
+func (c *fakeEC2) DescribeInstancesAsList(_ context.Context, input *ec2sdk.DescribeInstancesInput) ([]*ec2sdk.Instance, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	for _, instanceID := range awssdk.StringValueSlice(input.InstanceIds) {
+		if _, exists := c.store.instances[instanceID]; !exists {
+			return nil, c.store.newAPIError("InvalidInstanceID.NotFound", 400, "The instance ID '%v' does not exist", instanceID)
+		}
+	}
+	instanceIDs := sets.NewString(awssdk.StringValueSlice(input.InstanceIds)...)
+	var instances []*ec2sdk.Instance
+	for _, instanceID := range sets.StringKeySet(c.store.instances).List() {
+		if instanceIDs.Len() != 0 && !instanceIDs.Has(instanceID) {
+			continue
+		}
+		instance := c.store.instances[instanceID]
+		matches, err := c.store.matchesEC2Filters(input.Filters, instance.Tags, func(filterName string) ([]string, bool) {
+			switch filterName {
+			case "vpc-id":
+				return []string{awssdk.StringValue(instance.VpcId)}, true
+			case "subnet-id":
+				return []string{awssdk.StringValue(instance.SubnetId)}, true
+			case "instance-id":
+				return []string{instanceID}, true
+			case "instance-state-name":
+				return []string{awssdk.StringValue(instance.State.Name)}, true
+			}
+			return nil, false
+		})
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			instances = append(instances, copyOf(instance).(*ec2sdk.Instance))
+		}
+	}
+	return instances, nil
+}
+
+func (c *fakeEC2) CreateSecurityGroupWithContext(_ context.Context, input *ec2sdk.CreateSecurityGroupInput, _ ...request.Option) (*ec2sdk.CreateSecurityGroupOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	groupName := awssdk.StringValue(input.GroupName)
+	vpcID := awssdk.StringValue(input.VpcId)
+	if vpcID == "" {
+		vpcID = awssdk.StringValue(c.store.vpc.VpcId)
+	}
+	if groupName == "" || awssdk.StringValue(input.Description) == "" {
+		return nil, c.store.newAPIError("MissingParameter", 400, "The request must contain the parameters GroupName and GroupDescription")
+	}
+	if strings.HasPrefix(groupName, "sg-") {
+		return nil, c.store.newAPIError("InvalidParameterValue", 400, "Group names may not be in the format sg-*")
+	}
+	if vpcID != awssdk.StringValue(c.store.vpc.VpcId) {
+		return nil, c.store.newAPIError("InvalidVpcID.NotFound", 400, "The vpc ID '%v' does not exist", vpcID)
+	}
+	for _, sg := range c.store.securityGroups {
+		if awssdk.StringValue(sg.GroupName) == groupName && awssdk.StringValue(sg.VpcId) == vpcID {
+			return nil, c.store.newAPIError("InvalidGroup.Duplicate", 400, "The security group '%v' already exists for VPC '%v'", groupName, vpcID)
+		}
+	}
+	if len(c.store.securityGroups) >= c.store.cfg.Quotas.SecurityGroups {
+		return nil, c.store.newAPIError("SecurityGroupLimitExceeded", 400, "The maximum number of security groups has been reached.")
+	}
+	var tags []*ec2sdk.Tag
+	for _, tagSpec := range input.TagSpecifications {
+		if awssdk.StringValue(tagSpec.ResourceType) != ec2sdk.ResourceTypeSecurityGroup {
+			return nil, c.store.newAPIError("InvalidParameterValue", 400, "'%v' is not a valid taggable resource type for this operation.", awssdk.StringValue(tagSpec.ResourceType))
+		}
+		tags = append(tags, tagSpec.Tags...)
+	}
+	if len(tags) > c.store.cfg.Quotas.TagsPerResource {
+		return nil, c.store.newAPIError("TagLimitExceeded", 400, "The maximum number of Tags for a resource has been reached.")
+	}
+
+	groupID := "sg-" + c.store.nextID(17)
+	c.store.securityGroups[groupID] = &ec2sdk.SecurityGroup{
+		GroupId:     awssdk.String(groupID),
+		GroupName:   awssdk.String(groupName),
+		Description: input.Description,
+		VpcId:       awssdk.String(vpcID),
+		OwnerId:     awssdk.String(c.store.cfg.AccountID),
+		Tags:        copyOf(tags).([]*ec2sdk.Tag),
+		IpPermissionsEgress: []*ec2sdk.IpPermission{
+			{
+				IpProtocol: awssdk.String("-1"),
+				IpRanges: []*ec2sdk.IpRange{
+					{
+						CidrIp: awssdk.String("0.0.0.0/0"),
+					},
+				},
+			},
+		},
+	}
+	return &ec2sdk.CreateSecurityGroupOutput{
+		GroupId: awssdk.String(groupID),
+		Tags:    copyOf(tags).([]*ec2sdk.Tag),
+	}, nil
+}
+
+func (c *fakeEC2) DeleteSecurityGroupWithContext(_ context.Context, input *ec2sdk.DeleteSecurityGroupInput, _ ...request.Option) (*ec2sdk.DeleteSecurityGroupOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	groupID := awssdk.StringValue(input.GroupId)
+	if _, exists := c.store.securityGroups[groupID]; !exists {
+		return nil, c.store.newAPIError("InvalidGroup.NotFound", 400, "The security group '%v' does not exist", groupID)
+	}
+	if c.store.isSecurityGroupInUse(groupID) {
+		return nil, c.store.newAPIError("DependencyViolation", 400, "resource %v has a dependent object", groupID)
+	}
+	delete(c.store.securityGroups, groupID)
+	return &ec2sdk.DeleteSecurityGroupOutput{}, nil
+}
+
+func (c *fakeEC2) AuthorizeSecurityGroupIngressWithContext(_ context.Context, input *ec2sdk.AuthorizeSecurityGroupIngressInput, _ ...request.Option) (*ec2sdk.AuthorizeSecurityGroupIngressOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	groupID := awssdk.StringValue(input.GroupId)
+	sg, exists := c.store.securityGroups[groupID]
+	if !exists {
+		return nil, c.store.newAPIError("InvalidGroup.NotFound", 400, "The security group '%v' does not exist", groupID)
+	}
+	currentPermissions := expandIPPermissions(sg.IpPermissions)
+	currentPermissionKeys := sets.NewString()
+	for _, permission := range currentPermissions {
+		currentPermissionKeys.Insert(buildIPPermissionKey(permission))
+	}
+	newPermissions := expandIPPermissions(input.IpPermissions)
+	for _, permission := range newPermissions {
+		for _, pair := range permission.UserIdGroupPairs {
+			if _, exists := c.store.securityGroups[awssdk.StringValue(pair.GroupId)]; !exists {
+				return nil, c.store.newAPIError("InvalidGroup.NotFound", 400, "The security group '%v' does not exist", awssdk.StringValue(pair.GroupId))
+			}
+			pair.UserId = awssdk.String(c.store.cfg.AccountID)
+		}
+		permissionKey := buildIPPermissionKey(permission)
+		if currentPermissionKeys.Has(permissionKey) {
+			return nil, c.store.newAPIError("InvalidPermission.Duplicate", 400, "the specified rule %q already exists", permissionKey)
+		}
+		currentPermissionKeys.Insert(permissionKey)
+	}
+	if len(currentPermissions)+len(newPermissions) > c.store.cfg.Quotas.RulesPerSecurityGroup {
+		return nil, c.store.newAPIError("RulesPerSecurityGroupLimitExceeded", 400, "The maximum number of rules per security group has been reached.")
+	}
+	sg.IpPermissions = compactIPPermissions(append(currentPermissions, newPermissions...))
+	return &ec2sdk.AuthorizeSecurityGroupIngressOutput{
+		Return: awssdk.Bool(true),
+	}, nil
+}
+
+func (c *fakeEC2) RevokeSecurityGroupIngressWithContext(_ context.Context, input *ec2sdk.RevokeSecurityGroupIngressInput, _ ...request.Option) (*ec2sdk.RevokeSecurityGroupIngressOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	groupID := awssdk.StringValue(input.GroupId)
+	sg, exists := c.store.securityGroups[groupID]
+	if !exists {
+		return nil, c.store.newAPIError("InvalidGroup.NotFound", 400, "The security group '%v' does not exist", groupID)
+	}
+	revokedPermissionKeys := sets.NewString()
+	for _, permission := range expandIPPermissions(input.IpPermissions) {
+		revokedPermissionKeys.Insert(buildIPPermissionKey(permission))
+	}
+	var remainingPermissions []*ec2sdk.IpPermission
+	for _, permission := range expandIPPermissions(sg.IpPermissions) {
+		permissionKey := buildIPPermissionKey(permission)
+		if revokedPermissionKeys.Has(permissionKey) {
+			revokedPermissionKeys.Delete(permissionKey)
+			continue
+		}
+		remainingPermissions = append(remainingPermissions, permission)
+	}
+	if revokedPermissionKeys.Len() != 0 {
+		return nil, c.store.newAPIError("InvalidPermission.NotFound", 400, "The specified rule does not exist in this security group.")
+	}
+	sg.IpPermissions = compactIPPermissions(remainingPermissions)
+	return &ec2sdk.RevokeSecurityGroupIngressOutput{
+		Return: awssdk.Bool(true),
+	}, nil
+}
+
+func (c *fakeEC2) CreateTagsWithContext(_ context.Context, input *ec2sdk.CreateTagsInput, _ ...request.Option) (*ec2sdk.CreateTagsOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	for _, resourceID := range awssdk.StringValueSlice(input.Resources) {
+		tags, err := c.store.findEC2ResourceTags(resourceID)
+		if err != nil {
+			return nil, err
+		}
+		newTags := mergeEC2Tags(*tags, input.Tags)
+		if len(newTags) > c.store.cfg.Quotas.TagsPerResource {
+			return nil, c.store.newAPIError("TagLimitExceeded", 400, "The maximum number of Tags for a resource has been reached.")
+		}
+		*tags = newTags
+	}
+	return &ec2sdk.CreateTagsOutput{}, nil
+}
+
+func (c *fakeEC2) DeleteTagsWithContext(_ context.Context, input *ec2sdk.DeleteTagsInput, _ ...request.Option) (*ec2sdk.DeleteTagsOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	for _, resourceID := range awssdk.StringValueSlice(input.Resources) {
+		tags, err := c.store.findEC2ResourceTags(resourceID)
+		if err != nil {
+			return nil, err
+		}
+		var remainingTags []*ec2sdk.Tag
+		for _, tag := range *tags {
+			deleted := false
+			for _, deletedTag := range input.Tags {
+				if awssdk.StringValue(deletedTag.Key) == awssdk.StringValue(tag.Key) &&
+					(deletedTag.Value == nil || awssdk.StringValue(deletedTag.Value) == awssdk.StringValue(tag.Value)) {
+					deleted = true
+					break
+				}
+			}
+			if !deleted {
+				remainingTags = append(remainingTags, tag)
+			}
+		}
+		*tags = remainingTags
+	}
+	return &ec2sdk.DeleteTagsOutput{}, nil
+}
+
+func (s *store) addSubnet(subnet *ec2sdk.Subnet) error {
+	subnetID := awssdk.StringValue(subnet.SubnetId)
+	if subnetID == "" || subnet.CidrBlock == nil || subnet.AvailabilityZone == nil || subnet.AvailabilityZoneId == nil {
+		return errors.New("SubnetId, CidrBlock, AvailabilityZone and AvailabilityZoneId must be specified")
+	}
+	_, ipNet, err := net.ParseCIDR(awssdk.StringValue(subnet.CidrBlock))
+	if err != nil {
+		return err
+	}
+	subnet = copyOf(subnet).(*ec2sdk.Subnet)
+	if subnet.VpcId == nil {
+		subnet.VpcId = s.vpc.VpcId
+	}
+	if subnet.OwnerId == nil {
+		subnet.OwnerId = awssdk.String(s.cfg.AccountID)
+	}
+	if subnet.State == nil {
+		subnet.State = awssdk.String(ec2sdk.SubnetStateAvailable)
+	}
+	if subnet.SubnetArn == nil {
+		subnet.SubnetArn = awssdk.String(fmt.Sprintf("arn:aws:ec2:%v:%v:subnet/%v", s.cfg.Region, s.cfg.AccountID, subnetID))
+	}
+	if subnet.AvailableIpAddressCount == nil {
+		ones, bits := ipNet.Mask.Size()
+		// AWS reserves the first four and the last address of each subnet.
+		subnet.AvailableIpAddressCount = awssdk.Int64(int64(1)<<(bits-ones) - reservedAddressesPerSubnet - 1)
+	}
+	s.subnets[subnetID] = subnet
+	return nil
+}
+
+func (s *store) addSecurityGroup(securityGroup *ec2sdk.SecurityGroup) error {
+	groupID := awssdk.StringValue(securityGroup.GroupId)
+	if groupID == "" || securityGroup.GroupName == nil {
+		return errors.New("GroupId and GroupName must be specified")
+	}
+	securityGroup = copyOf(securityGroup).(*ec2sdk.SecurityGroup)
+	if securityGroup.VpcId == nil {
+		securityGroup.VpcId = s.vpc.VpcId
+	}
+	if securityGroup.OwnerId == nil {
+		securityGroup.OwnerId = awssdk.String(s.cfg.AccountID)
+	}
+	s.securityGroups[groupID] = securityGroup
+	return nil
+}
+
+func (s *store) addInstance(instance *ec2sdk.Instance) error {
+	instanceID := awssdk.StringValue(instance.InstanceId)
+	if instanceID == "" || instance.SubnetId == nil {
+		return errors.New("InstanceId and SubnetId must be specified")
+	}
+	subnet, exists := s.subnets[awssdk.StringValue(instance.SubnetId)]
+	if !exists {
+		return errors.Errorf("subnet %v not found", awssdk.StringValue(instance.SubnetId))
+	}
+	instance = copyOf(instance).(*ec2sdk.Instance)
+	if instance.VpcId == nil {
+		instance.VpcId = subnet.VpcId
+	}
+	if instance.State == nil {
+		instance.State = &ec2sdk.InstanceState{
+			Code: awssdk.Int64(16),
+			Name: awssdk.String(ec2sdk.InstanceStateNameRunning),
+		}
+	}
+	if instance.Placement == nil {
+		instance.Placement = &ec2sdk.Placement{
+			AvailabilityZone: subnet.AvailabilityZone,
+		}
+	}
+	if len(instance.NetworkInterfaces) == 0 {
+		privateIPAddress := awssdk.StringValue(instance.PrivateIpAddress)
+		if privateIPAddress == "" {
+			address, err := s.allocateAddress(subnet)
+			if err != nil {
+				return err
+			}
+			privateIPAddress = address
+		}
+		instance.PrivateIpAddress = awssdk.String(privateIPAddress)
+		instance.NetworkInterfaces = []*ec2sdk.InstanceNetworkInterface{
+			{
+				NetworkInterfaceId: awssdk.String("eni-" + s.nextID(17)),
+				SubnetId:           instance.SubnetId,
+				VpcId:              instance.VpcId,
+				PrivateIpAddress:   awssdk.String(privateIPAddress),
+				PrivateIpAddresses: []*ec2sdk.InstancePrivateIpAddress{
+					{
+						Primary:          awssdk.Bool(true),
+						PrivateIpAddress: awssdk.String(privateIPAddress),
+					},
+				},
+				Groups: instance.SecurityGroups,
+				Attachment: &ec2sdk.InstanceNetworkInterfaceAttachment{
+					DeviceIndex: awssdk.Int64(0),
+					Status:      awssdk.String(ec2sdk.AttachmentStatusAttached),
+				},
+			},
+		}
+	}
+	for _, instanceENI := range instance.NetworkInterfaces {
+		eni := &ec2sdk.NetworkInterface{
+			NetworkInterfaceId: instanceENI.NetworkInterfaceId,
+			SubnetId:           instanceENI.SubnetId,
+			VpcId:              instanceENI.VpcId,
+			AvailabilityZone:   subnet.AvailabilityZone,
+			InterfaceType:      awssdk.String(ec2sdk.NetworkInterfaceTypeInterface),
+			PrivateIpAddress:   instanceENI.PrivateIpAddress,
+			Status:             awssdk.String(ec2sdk.NetworkInterfaceStatusInUse),
+			Attachment: &ec2sdk.NetworkInterfaceAttachment{
+				InstanceId:  awssdk.String(instanceID),
+				DeviceIndex: instanceENI.Attachment.DeviceIndex,
+				Status:      awssdk.String(ec2sdk.AttachmentStatusAttached),
+			},
+		}
+		for _, address := range instanceENI.PrivateIpAddresses {
+			eni.PrivateIpAddresses = append(eni.PrivateIpAddresses, &ec2sdk.NetworkInterfacePrivateIpAddress{
+				Primary:          address.Primary,
+				PrivateIpAddress: address.PrivateIpAddress,
+			})
+		}
+		for _, address := range instanceENI.Ipv6Addresses {
+			eni.Ipv6Addresses = append(eni.Ipv6Addresses, &ec2sdk.NetworkInterfaceIpv6Address{
+				Ipv6Address: address.Ipv6Address,
+			})
+		}
+		for _, group := range instanceENI.Groups {
+			eni.Groups = append(eni.Groups, &ec2sdk.GroupIdentifier{
+				GroupId:   group.GroupId,
+				GroupName: group.GroupName,
+			})
+		}
+		if err := s.addNetworkInterface(eni); err != nil {
+			return err
+		}
+	}
+	s.instances[instanceID] = instance
+	return nil
+}
+
+func (s *store) addNetworkInterface(networkInterface *ec2sdk.NetworkInterface) error {
+	eniID := awssdk.StringValue(networkInterface.NetworkInterfaceId)
+	if eniID == "" || networkInterface.SubnetId == nil {
+		return errors.New("NetworkInterfaceId and SubnetId must be specified")
+	}
+	subnet, exists := s.subnets[awssdk.StringValue(networkInterface.SubnetId)]
+	if !exists {
+		return errors.Errorf("subnet %v not found", awssdk.StringValue(networkInterface.SubnetId))
+	}
+	networkInterface = copyOf(networkInterface).(*ec2sdk.NetworkInterface)
+	if networkInterface.VpcId == nil {
+		networkInterface.VpcId = subnet.VpcId
+	}
+	if networkInterface.AvailabilityZone == nil {
+		networkInterface.AvailabilityZone = subnet.AvailabilityZone
+	}
+	if networkInterface.OwnerId == nil {
+		networkInterface.OwnerId = awssdk.String(s.cfg.AccountID)
+	}
+	if networkInterface.Status == nil {
+		networkInterface.Status = awssdk.String(ec2sdk.NetworkInterfaceStatusInUse)
+	}
+	if networkInterface.PrivateIpAddress == nil {
+		address, err := s.allocateAddress(subnet)
+		if err != nil {
+			return err
+		}
+		networkInterface.PrivateIpAddress = awssdk.String(address)
+	}
+	if len(networkInterface.PrivateIpAddresses) == 0 {
+		networkInterface.PrivateIpAddresses = []*ec2sdk.NetworkInterfacePrivateIpAddress{
+			{
+				Primary:          awssdk.Bool(true),
+				PrivateIpAddress: networkInterface.PrivateIpAddress,
+			},
+		}
+	}
+	s.networkInterfaces[eniID] = networkInterface
+	return nil
+}
+
+// allocateAddress allocates a private IPv4 address from subnet.
+func (s *store) allocateAddress(subnet *ec2sdk.Subnet) (string, error) {
+	subnetID := awssdk.StringValue(subnet.SubnetId)
+	if awssdk.Int64Value(subnet.AvailableIpAddressCount) <= 0 {
+		return "", s.newAPIError("InsufficientFreeAddressesInSubnet", 400, "There are not enough free addresses in subnet '%v' to satisfy the requested number of instances.", subnetID)
+	}
+	_, ipNet, err := net.ParseCIDR(awssdk.StringValue(subnet.CidrBlock))
+	if err != nil {
+		return "", err
+	}
+	ipv4 := ipNet.IP.To4()
+	if ipv4 == nil {
+		return "", errors.Errorf("subnet %v doesn't have IPv4 CIDR block", subnetID)
+	}
+	offset := reservedAddressesPerSubnet + s.allocatedAddresses[subnetID]
+	s.allocatedAddresses[subnetID]++
+	subnet.AvailableIpAddressCount = awssdk.Int64(awssdk.Int64Value(subnet.AvailableIpAddressCount) - 1)
+	address := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(address, binary.BigEndian.Uint32(ipv4)+uint32(offset))
+	return address.String(), nil
+}
+
+// releaseAddress releases a private IPv4 address allocated from subnet.
+func (s *store) releaseAddress(subnetID string) {
+	if subnet, exists := s.subnets[subnetID]; exists {
+		subnet.AvailableIpAddressCount = awssdk.Int64(awssdk.Int64Value(subnet.AvailableIpAddressCount) + 1)
+	}
+}
+
+// cidrContains checks whether address is within cidrBlock.
+func cidrContains(cidrBlock string, address string) bool {
+	_, ipNet, err := net.ParseCIDR(cidrBlock)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(address)
+	return ip != nil && ipNet.Contains(ip)
+}
+
+// isSecurityGroupInUse checks whether security group is used by network interfaces or referenced by other security groups.
+func (s *store) isSecurityGroupInUse(groupID string) bool {
+	for _, eni := range s.networkInterfaces {
+		for _, group := range eni.Groups {
+			if awssdk.StringValue(group.GroupId) == groupID {
+				return true
+			}
+		}
+	}
+	for _, lb := range s.loadBalancers {
+		for _, lbGroupID := range awssdk.StringValueSlice(lb.SecurityGroups) {
+			if lbGroupID == groupID {
+				return true
+			}
+		}
+	}
+	for otherGroupID, sg := range s.securityGroups {
+		if otherGroupID == groupID {
+			continue
+		}
+		for _, permission := range sg.IpPermissions {
+			for _, pair := range permission.UserIdGroupPairs {
+				if awssdk.StringValue(pair.GroupId) == groupID {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// findEC2ResourceTags finds the tags of EC2 resource by resourceID.
+func (s *store) findEC2ResourceTags(resourceID string) (*[]*ec2sdk.Tag, error) {
+	switch {
+	case strings.HasPrefix(resourceID, "sg-"):
+		if sg, exists := s.securityGroups[resourceID]; exists {
+			return &sg.Tags, nil
+		}
+		return nil, s.newAPIError("InvalidGroup.NotFound", 400, "The security group '%v' does not exist", resourceID)
+	case strings.HasPrefix(resourceID, "subnet-"):
+		if subnet, exists := s.subnets[resourceID]; exists {
+			return &subnet.Tags, nil
+		}
+		return nil, s.newAPIError("InvalidSubnetID.NotFound", 400, "The subnet ID '%v' does not exist", resourceID)
+	case strings.HasPrefix(resourceID, "eni-"):
+		if eni, exists := s.networkInterfaces[resourceID]; exists {
+			return &eni.TagSet, nil
+		}
+		return nil, s.newAPIError("InvalidNetworkInterfaceID.NotFound", 400, "The networkInterface ID '%v' does not exist", resourceID)
+	case strings.HasPrefix(resourceID, "i-"):
+		if instance, exists := s.instances[resourceID]; exists {
+			return &instance.Tags, nil
+		}
+		return nil, s.newAPIError("InvalidInstanceID.NotFound", 400, "The instance ID '%v' does not exist", resourceID)
+	case resourceID == awssdk.StringValue(s.vpc.VpcId):
+		return &s.vpc.Tags, nil
+	}
+	return nil, s.newAPIError("InvalidID", 400, "The ID '%v' is not valid", resourceID)
+}
+
+// matchesEC2Filters checks whether resource matches all filters.
+// The tag:<key> and tag-key filters are matched against tags, other filters are matched against the values from filterValues.
+func (s *store) matchesEC2Filters(filters []*ec2sdk.Filter, tags []*ec2sdk.Tag, filterValues func(filterName string) ([]string, bool)) (bool, error) {
+	for _, filter := range filters {
+		filterName := awssdk.StringValue(filter.Name)
+		var values []string
+		switch {
+		case strings.HasPrefix(filterName, "tag:"):
+			tagKey := strings.TrimPrefix(filterName, "tag:")
+			for _, tag := range tags {
+				if awssdk.StringValue(tag.Key) == tagKey {
+					values = append(values, awssdk.StringValue(tag.Value))
+				}
+			}
+		case filterName == "tag-key":
+			for _, tag := range tags {
+				values = append(values, awssdk.StringValue(tag.Key))
+			}
+		default:
+			var supported bool
+			values, supported = filterValues(filterName)
+			if !supported {
+				return false, s.newAPIError("InvalidParameterValue", 400, "The filter '%v' is invalid", filterName)
+			}
+		}
+		if !matchesAnyFilterValue(awssdk.StringValueSlice(filter.Values), values) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// matchesAnyFilterValue checks whether any of values matches any of the filterValues, which can contain the * and ? wildcards.
+func matchesAnyFilterValue(filterValues []string, values []string) bool {
+	for _, filterValue := range filterValues {
+		pattern := regexp.QuoteMeta(filterValue)
+		pattern = strings.ReplaceAll(pattern, `\*`, ".*")
+		pattern = strings.ReplaceAll(pattern, `\?`, ".")
+		matcher := regexp.MustCompile("^" + pattern + "$")
+		for _, value := range values {
+			if matcher.MatchString(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mergeEC2Tags merges newTags into tags, the tags with same key are overwritten.
+func mergeEC2Tags(tags []*ec2sdk.Tag, newTags []*ec2sdk.Tag) []*ec2sdk.Tag {
+	merged := copyOf(tags).([]*ec2sdk.Tag)
+	for _, newTag := range newTags {
+		overwritten := false
+		for _, tag := range merged {
+			if awssdk.StringValue(tag.Key) == awssdk.StringValue(newTag.Key) {
+				tag.Value = awssdk.String(awssdk.StringValue(newTag.Value))
+				overwritten = true
+				break
+			}
+		}
+		if !overwritten {
+			merged = append(merged, &ec2sdk.Tag{
+				Key:   awssdk.String(awssdk.StringValue(newTag.Key)),
+				Value: awssdk.String(awssdk.StringValue(newTag.Value)),
+			})
+		}
+	}
+	return merged
+}
+
+// expandIPPermissions expands permissions so that each permission has exactly one source.
+func expandIPPermissions(permissions []*ec2sdk.IpPermission) []*ec2sdk.IpPermission {
+	var expanded []*ec2sdk.IpPermission
+	for _, permission := range permissions {
+		base := ec2sdk.IpPermission{
+			IpProtocol: awssdk.String(normalizeIPProtocol(awssdk.StringValue(permission.IpProtocol))),
+		}
+		if awssdk.StringValue(base.IpProtocol) != "-1" {
+			base.FromPort = permission.FromPort
+			base.ToPort = permission.ToPort
+		}
+		for _, ipRange := range permission.IpRanges {
+			perm := base
+			perm.IpRanges = []*ec2sdk.IpRange{copyOf(ipRange).(*ec2sdk.IpRange)}
+			expanded = append(expanded, &perm)
+		}
+		for _, ipv6Range := range permission.Ipv6Ranges {
+			perm := base
+			perm.Ipv6Ranges = []*ec2sdk.Ipv6Range{copyOf(ipv6Range).(*ec2sdk.Ipv6Range)}
+			expanded = append(expanded, &perm)
+		}
+		for _, prefixListID := range permission.PrefixListIds {
+			perm := base
+			perm.PrefixListIds = []*ec2sdk.PrefixListId{copyOf(prefixListID).(*ec2sdk.PrefixListId)}
+			expanded = append(expanded, &perm)
+		}
+		for _, pair := range permission.UserIdGroupPairs {
+			perm := base
+			perm.UserIdGroupPairs = []*ec2sdk.UserIdGroupPair{copyOf(pair).(*ec2sdk.UserIdGroupPair)}
+			expanded = append(expanded, &perm)
+		}
+	}
+	return expanded
+}
+
+// compactIPPermissions groups the expanded permissions by protocol and port range, as AWS reports them.
+func compactIPPermissions(expanded []*ec2sdk.IpPermission) []*ec2sdk.IpPermission {
+	var compacted []*ec2sdk.IpPermission
+	compactedByKey := make(map[string]*ec2sdk.IpPermission)
+	for _, permission := range expanded {
+		key := fmt.Sprintf("%v:%v-%v", awssdk.StringValue(permission.IpProtocol),
+			awssdk.Int64Value(permission.FromPort), awssdk.Int64Value(permission.ToPort))
+		compactedPermission, exists := compactedByKey[key]
+		if !exists {
+			compactedPermission = &ec2sdk.IpPermission{
+				IpProtocol: permission.IpProtocol,
+				FromPort:   permission.FromPort,
+				ToPort:     permission.ToPort,
+			}
+			compactedByKey[key] = compactedPermission
+			compacted = append(compacted, compactedPermission)
+		}
+		compactedPermission.IpRanges = append(compactedPermission.IpRanges, permission.IpRanges...)
+		compactedPermission.Ipv6Ranges = append(compactedPermission.Ipv6Ranges, permission.Ipv6Ranges...)
+		compactedPermission.PrefixListIds = append(compactedPermission.PrefixListIds, permission.PrefixListIds...)
+		compactedPermission.UserIdGroupPairs = append(compactedPermission.UserIdGroupPairs, permission.UserIdGroupPairs...)
+	}
+	return compacted
+}
+
+// buildIPPermissionKey builds the key that identifies an expanded permission, regardless of its description.
+func buildIPPermissionKey(permission *ec2sdk.IpPermission) string {
+	var source string
+	switch {
+	case len(permission.IpRanges) == 1:
+		source = awssdk.StringValue(permission.IpRanges[0].CidrIp)
+	case len(permission.Ipv6Ranges) == 1:
+		source = awssdk.StringValue(permission.Ipv6Ranges[0].CidrIpv6)
+	case len(permission.PrefixListIds) == 1:
+		source = awssdk.StringValue(permission.PrefixListIds[0].PrefixListId)
+	case len(permission.UserIdGroupPairs) == 1:
+		source = awssdk.StringValue(permission.UserIdGroupPairs[0].GroupId)
+	}
+	return fmt.Sprintf("%v:%v-%v from %v", awssdk.StringValue(permission.IpProtocol),
+		awssdk.Int64Value(permission.FromPort), awssdk.Int64Value(permission.ToPort), source)
+}
+
+// normalizeIPProtocol normalizes the IP protocol numbers to names, as AWS reports them.
+func normalizeIPProtocol(ipProtocol string) string {
+	switch strings.ToLower(ipProtocol) {
+	case "6":
+		return "tcp"
+	case "17":
+		return "udp"
+	case "1":
+		return "icmp"
+	case "58":
+		return "icmpv6"
+	case "all":
+		return "-1"
+	}
+	return strings.ToLower(ipProtocol)
+}
@@ -0,0 +1,211 @@
+package fake
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fakeEC2_CreateSecurityGroupWithContext(t *testing.T) {
+	tests := []struct {
+		name            string
+		quotas          Quotas
+		existingGroups  []*ec2sdk.SecurityGroup
+		input           *ec2sdk.CreateSecurityGroupInput
+		wantErrCode     string
+		wantGroupsInVPC int
+	}{
+		{
+			name: "security group created",
+			input: &ec2sdk.CreateSecurityGroupInput{
+				GroupName:   awssdk.String("awesome-sg"),
+				Description: awssdk.String("awesome sg"),
+				VpcId:       awssdk.String(defaultVpcID),
+			},
+			wantGroupsInVPC: 1,
+		},
+		{
+			name: "security group name is duplicated",
+			existingGroups: []*ec2sdk.SecurityGroup{
+				{GroupId: awssdk.String("sg-existing"), GroupName: awssdk.String("awesome-sg")},
+			},
+			input: &ec2sdk.CreateSecurityGroupInput{
+				GroupName:   awssdk.String("awesome-sg"),
+				Description: awssdk.String("awesome sg"),
+				VpcId:       awssdk.String(defaultVpcID),
+			},
+			wantErrCode: "InvalidGroup.Duplicate",
+		},
+		{
+			name:   "security group quota reached",
+			quotas: Quotas{SecurityGroups: 1},
+			existingGroups: []*ec2sdk.SecurityGroup{
+				{GroupId: awssdk.String("sg-existing"), GroupName: awssdk.String("other-sg")},
+			},
+			input: &ec2sdk.CreateSecurityGroupInput{
+				GroupName:   awssdk.String("awesome-sg"),
+				Description: awssdk.String("awesome sg"),
+				VpcId:       awssdk.String(defaultVpcID),
+			},
+			wantErrCode: "SecurityGroupLimitExceeded",
+		},
+		{
+			name: "VPC not found",
+			input: &ec2sdk.CreateSecurityGroupInput{
+				GroupName:   awssdk.String("awesome-sg"),
+				Description: awssdk.String("awesome sg"),
+				VpcId:       awssdk.String("vpc-unknown"),
+			},
+			wantErrCode: "InvalidVpcID.NotFound",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cloud := NewCloud(Config{Quotas: tt.quotas})
+			for _, sg := range tt.existingGroups {
+				require.NoError(t, cloud.AddSecurityGroup(sg))
+			}
+			resp, err := cloud.EC2().CreateSecurityGroupWithContext(context.Background(), tt.input)
+			if tt.wantErrCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErrCode, err.(awserr.Error).Code())
+				return
+			}
+			require.NoError(t, err)
+			sgs, err := cloud.EC2().DescribeSecurityGroupsAsList(context.Background(), &ec2sdk.DescribeSecurityGroupsInput{
+				GroupIds: []*string{resp.GroupId},
+			})
+			require.NoError(t, err)
+			require.Len(t, sgs, tt.wantGroupsInVPC)
+			assert.Equal(t, "awesome-sg", awssdk.StringValue(sgs[0].GroupName))
+			assert.Len(t, sgs[0].IpPermissionsEgress, 1)
+		})
+	}
+}
+
+func Test_fakeEC2_securityGroupIngress(t *testing.T) {
+	ctx := context.Background()
+	cloud := NewCloud(Config{Quotas: Quotas{RulesPerSecurityGroup: 2}})
+	require.NoError(t, cloud.AddSecurityGroup(&ec2sdk.SecurityGroup{GroupId: awssdk.String("sg-a"), GroupName: awssdk.String("sg-a")}))
+	permission := func(port int64, cidr string) *ec2sdk.IpPermission {
+		return &ec2sdk.IpPermission{
+			IpProtocol: awssdk.String("tcp"),
+			FromPort:   awssdk.Int64(port),
+			ToPort:     awssdk.Int64(port),
+			IpRanges:   []*ec2sdk.IpRange{{CidrIp: awssdk.String(cidr)}},
+		}
+	}
+
+	_, err := cloud.EC2().AuthorizeSecurityGroupIngressWithContext(ctx, &ec2sdk.AuthorizeSecurityGroupIngressInput{
+		GroupId:       awssdk.String("sg-a"),
+		IpPermissions: []*ec2sdk.IpPermission{permission(80, "10.0.0.0/8"), permission(443, "10.0.0.0/8")},
+	})
+	require.NoError(t, err)
+
+	_, err = cloud.EC2().AuthorizeSecurityGroupIngressWithContext(ctx, &ec2sdk.AuthorizeSecurityGroupIngressInput{
+		GroupId:       awssdk.String("sg-a"),
+		IpPermissions: []*ec2sdk.IpPermission{permission(80, "10.0.0.0/8")},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "InvalidPermission.Duplicate", err.(awserr.Error).Code())
+
+	_, err = cloud.EC2().AuthorizeSecurityGroupIngressWithContext(ctx, &ec2sdk.AuthorizeSecurityGroupIngressInput{
+		GroupId:       awssdk.String("sg-a"),
+		IpPermissions: []*ec2sdk.IpPermission{permission(8080, "10.0.0.0/8")},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "RulesPerSecurityGroupLimitExceeded", err.(awserr.Error).Code())
+
+	_, err = cloud.EC2().RevokeSecurityGroupIngressWithContext(ctx, &ec2sdk.RevokeSecurityGroupIngressInput{
+		GroupId:       awssdk.String("sg-a"),
+		IpPermissions: []*ec2sdk.IpPermission{permission(443, "10.0.0.0/8")},
+	})
+	require.NoError(t, err)
+
+	_, err = cloud.EC2().RevokeSecurityGroupIngressWithContext(ctx, &ec2sdk.RevokeSecurityGroupIngressInput{
+		GroupId:       awssdk.String("sg-a"),
+		IpPermissions: []*ec2sdk.IpPermission{permission(443, "10.0.0.0/8")},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "InvalidPermission.NotFound", err.(awserr.Error).Code())
+
+	sgs, err := cloud.EC2().DescribeSecurityGroupsAsList(ctx, &ec2sdk.DescribeSecurityGroupsInput{GroupIds: awssdk.StringSlice([]string{"sg-a"})})
+	require.NoError(t, err)
+	require.Len(t, sgs, 1)
+	assert.Equal(t, []*ec2sdk.IpPermission{permission(80, "10.0.0.0/8")}, sgs[0].IpPermissions)
+}
+
+func Test_fakeEC2_DescribeSubnetsAsList(t *testing.T) {
+	cloud := NewCloud(Config{})
+	for _, subnet := range []*ec2sdk.Subnet{
+		{
+			SubnetId:           awssdk.String("subnet-a"),
+			CidrBlock:          awssdk.String("192.168.0.0/24"),
+			AvailabilityZone:   awssdk.String("us-west-2a"),
+			AvailabilityZoneId: awssdk.String("usw2-az1"),
+			Tags:               []*ec2sdk.Tag{{Key: awssdk.String("kubernetes.io/role/elb"), Value: awssdk.String("1")}},
+		},
+		{
+			SubnetId:           awssdk.String("subnet-b"),
+			CidrBlock:          awssdk.String("192.168.1.0/24"),
+			AvailabilityZone:   awssdk.String("us-west-2b"),
+			AvailabilityZoneId: awssdk.String("usw2-az2"),
+		},
+	} {
+		require.NoError(t, cloud.AddSubnet(subnet))
+	}
+	tests := []struct {
+		name          string
+		input         *ec2sdk.DescribeSubnetsInput
+		wantSubnetIDs []string
+		wantErrCode   string
+	}{
+		{
+			name: "filter by tag key",
+			input: &ec2sdk.DescribeSubnetsInput{
+				Filters: []*ec2sdk.Filter{{Name: awssdk.String("tag-key"), Values: awssdk.StringSlice([]string{"kubernetes.io/role/elb"})}},
+			},
+			wantSubnetIDs: []string{"subnet-a"},
+		},
+		{
+			name: "filter by vpc-id",
+			input: &ec2sdk.DescribeSubnetsInput{
+				Filters: []*ec2sdk.Filter{{Name: awssdk.String("vpc-id"), Values: awssdk.StringSlice([]string{defaultVpcID})}},
+			},
+			wantSubnetIDs: []string{"subnet-a", "subnet-b"},
+		},
+		{
+			name:        "subnet not found",
+			input:       &ec2sdk.DescribeSubnetsInput{SubnetIds: awssdk.StringSlice([]string{"subnet-c"})},
+			wantErrCode: "InvalidSubnetID.NotFound",
+		},
+		{
+			name: "filter is invalid",
+			input: &ec2sdk.DescribeSubnetsInput{
+				Filters: []*ec2sdk.Filter{{Name: awssdk.String("unknown"), Values: awssdk.StringSlice([]string{"value"})}},
+			},
+			wantErrCode: "InvalidParameterValue",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subnets, err := cloud.EC2().DescribeSubnetsAsList(context.Background(), tt.input)
+			if tt.wantErrCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErrCode, err.(awserr.Error).Code())
+				return
+			}
+			require.NoError(t, err)
+			var gotSubnetIDs []string
+			for _, subnet := range subnets {
+				gotSubnetIDs = append(gotSubnetIDs, awssdk.StringValue(subnet.SubnetId))
+			}
+			assert.Equal(t, tt.wantSubnetIDs, gotSubnetIDs)
+		})
+	}
+}
@@ -0,0 +1,1109 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	// hosted zone IDs of load balancers in us-west-2.
+	applicationLoadBalancerHostedZoneID = "Z1H1FL5HABSF5"
+	networkLoadBalancerHostedZoneID     = "Z18D5FSROUN65G"
+
+	// the maximum number of resources DescribeTags accepts.
+	describeTagsResourcesLimit = 20
+
+	elbv2ErrCodeValidationError = "ValidationError"
+)
+
+var (
+	elbv2NamePattern = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,30}[a-zA-Z0-9])?$`)
+)
+
+// loadBalancer is a load balancer held by store.
+type loadBalancer struct {
+	*elbv2sdk.LoadBalancer
+
+	id         string
+	tags       []*elbv2sdk.Tag
+	attributes map[string]string
+	// networkInterfaceIDs are the IDs of network interfaces created for load balancer.
+	networkInterfaceIDs []string
+}
+
+// targetGroup is a target group held by store.
+type targetGroup struct {
+	*elbv2sdk.TargetGroup
+
+	tags       []*elbv2sdk.Tag
+	attributes map[string]string
+	targets    []*registeredTarget
+}
+
+// registeredTarget is a target registered into target group.
+type registeredTarget struct {
+	target *elbv2sdk.TargetDescription
+	// health overrides the health the fake Cloud reports for target.
+	health *elbv2sdk.TargetHealth
+}
+
+// trustStore is a trust store held by store.
+type trustStore struct {
+	*elbv2sdk.TrustStore
+
+	tags []*elbv2sdk.Tag
+}
+
+var _ services.ELBV2 = &fakeELBV2{}
+
+// fakeELBV2 serves the ELBV2 APIs from store.
+type fakeELBV2 struct {
+	services.ELBV2
+
+	store *store
+}
+
+func (c *fakeELBV2) CreateLoadBalancerWithContext(_ context.Context, input *elbv2sdk.CreateLoadBalancerInput, _ ...request.Option) (*elbv2sdk.CreateLoadBalancerOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lbName := awssdk.StringValue(input.Name)
+	lbType := awssdk.StringValue(input.Type)
+	if lbType == "" {
+		lbType = elbv2sdk.LoadBalancerTypeEnumApplication
+	}
+	scheme := awssdk.StringValue(input.Scheme)
+	if scheme == "" {
+		scheme = elbv2sdk.LoadBalancerSchemeEnumInternetFacing
+	}
+	ipAddressType := awssdk.StringValue(input.IpAddressType)
+	if ipAddressType == "" {
+		ipAddressType = elbv2sdk.IpAddressTypeIpv4
+	}
+	if !elbv2NamePattern.MatchString(lbName) || strings.HasPrefix(lbName, "internal-") {
+		return nil, c.store.newAPIError(elbv2ErrCodeValidationError, 400, "The load balancer name '%v' isn't valid", lbName)
+	}
+	if lbType != elbv2sdk.LoadBalancerTypeEnumApplication && lbType != elbv2sdk.LoadBalancerTypeEnumNetwork {
+		return nil, c.store.newAPIError(elbv2ErrCodeValidationError, 400, "Load balancer type '%v' is not supported", lbType)
+	}
+	for _, lb := range c.store.loadBalancers {
+		if awssdk.StringValue(lb.LoadBalancerName) == lbName {
+			return nil, c.store.newAPIError(elbv2sdk.ErrCodeDuplicateLoadBalancerNameException, 400, "A load balancer with the same name '%v' exists, but with different settings", lbName)
+		}
+	}
+	if len(c.store.loadBalancers) >= c.store.cfg.Quotas.LoadBalancers {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTooManyLoadBalancersException, 400, "The maximum number of load balancers has been reached")
+	}
+	if len(input.Tags) > c.store.cfg.Quotas.TagsPerResource {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTooManyTagsException, 400, "The quota for the number of tags that can be assigned to a load balancer has been reached")
+	}
+	subnetMappings := input.SubnetMappings
+	for _, subnetID := range input.Subnets {
+		subnetMappings = append(subnetMappings, &elbv2sdk.SubnetMapping{SubnetId: subnetID})
+	}
+	if err := c.store.validateLoadBalancerSubnetMappings(lbType, subnetMappings); err != nil {
+		return nil, err
+	}
+	if err := c.store.validateLoadBalancerSecurityGroups(input.SecurityGroups); err != nil {
+		return nil, err
+	}
+
+	lbID := c.store.nextID(16)
+	lbTypeShortName := "app"
+	hostedZoneID := applicationLoadBalancerHostedZoneID
+	if lbType == elbv2sdk.LoadBalancerTypeEnumNetwork {
+		lbTypeShortName = "net"
+		hostedZoneID = networkLoadBalancerHostedZoneID
+	}
+	dnsName := fmt.Sprintf("%v-%v.elb.%v.amazonaws.com", lbName, lbID, c.store.cfg.Region)
+	if scheme == elbv2sdk.LoadBalancerSchemeEnumInternal && lbType == elbv2sdk.LoadBalancerTypeEnumApplication {
+		dnsName = "internal-" + dnsName
+	}
+	lb := &loadBalancer{
+		LoadBalancer: &elbv2sdk.LoadBalancer{
+			LoadBalancerArn:       awssdk.String(fmt.Sprintf("arn:aws:elasticloadbalancing:%v:%v:loadbalancer/%v/%v/%v", c.store.cfg.Region, c.store.cfg.AccountID, lbTypeShortName, lbName, lbID)),
+			LoadBalancerName:      awssdk.String(lbName),
+			Type:                  awssdk.String(lbType),
+			Scheme:                awssdk.String(scheme),
+			IpAddressType:         awssdk.String(ipAddressType),
+			VpcId:                 c.store.vpc.VpcId,
+			DNSName:               awssdk.String(dnsName),
+			CanonicalHostedZoneId: awssdk.String(hostedZoneID),
+			CustomerOwnedIpv4Pool: input.CustomerOwnedIpv4Pool,
+			SecurityGroups:        awssdk.StringSlice(awssdk.StringValueSlice(input.SecurityGroups)),
+			CreatedTime:           awssdk.Time(time.Now()),
+			State: &elbv2sdk.LoadBalancerState{
+				Code: awssdk.String(elbv2sdk.LoadBalancerStateEnumActive),
+			},
+		},
+		id:         lbID,
+		tags:       mergeELBV2Tags(nil, input.Tags),
+		attributes: defaultLoadBalancerAttributes(lbType),
+	}
+	if err := c.store.attachLoadBalancerSubnets(lb, subnetMappings); err != nil {
+		return nil, err
+	}
+	c.store.loadBalancers[awssdk.StringValue(lb.LoadBalancerArn)] = lb
+	return &elbv2sdk.CreateLoadBalancerOutput{
+		LoadBalancers: []*elbv2sdk.LoadBalancer{copyOf(lb.LoadBalancer).(*elbv2sdk.LoadBalancer)},
+	}, nil
+}
+
+func (c *fakeELBV2) DeleteLoadBalancerWithContext(_ context.Context, input *elbv2sdk.DeleteLoadBalancerInput, _ ...request.Option) (*elbv2sdk.DeleteLoadBalancerOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lbARN := awssdk.StringValue(input.LoadBalancerArn)
+	lb, exists := c.store.loadBalancers[lbARN]
+	if !exists {
+		// deleting a load balancer that doesn't exist succeeds.
+		return &elbv2sdk.DeleteLoadBalancerOutput{}, nil
+	}
+	if lb.attributes["deletion_protection.enabled"] == "true" {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeOperationNotPermittedException, 400, "Load balancer '%v' cannot be deleted because deletion protection is enabled", lbARN)
+	}
+	for lsARN, ls := range c.store.listeners {
+		if awssdk.StringValue(ls.LoadBalancerArn) == lbARN {
+			c.store.deleteListener(lsARN)
+		}
+	}
+	c.store.detachLoadBalancerSubnets(lb)
+	delete(c.store.webACLARNByResources, lbARN)
+	delete(c.store.loadBalancers, lbARN)
+	return &elbv2sdk.DeleteLoadBalancerOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeLoadBalancersAsList(_ context.Context, input *elbv2sdk.DescribeLoadBalancersInput) ([]*elbv2sdk.LoadBalancer, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lbARNs := sets.NewString(awssdk.StringValueSlice(input.LoadBalancerArns)...)
+	lbNames := sets.NewString(awssdk.StringValueSlice(input.Names)...)
+	foundLBCount := 0
+	var lbs []*elbv2sdk.LoadBalancer
+	for _, lbARN := range sets.StringKeySet(c.store.loadBalancers).List() {
+		lb := c.store.loadBalancers[lbARN]
+		if lbARNs.Len() != 0 && !lbARNs.Has(lbARN) {
+			continue
+		}
+		if lbNames.Len() != 0 && !lbNames.Has(awssdk.StringValue(lb.LoadBalancerName)) {
+			continue
+		}
+		foundLBCount++
+		lbs = append(lbs, copyOf(lb.LoadBalancer).(*elbv2sdk.LoadBalancer))
+	}
+	if foundLBCount < lbARNs.Len()+lbNames.Len() {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeLoadBalancerNotFoundException, 400, "One or more load balancers not found")
+	}
+	return lbs, nil
+}
+
+func (c *fakeELBV2) SetSecurityGroupsWithContext(_ context.Context, input *elbv2sdk.SetSecurityGroupsInput, _ ...request.Option) (*elbv2sdk.SetSecurityGroupsOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lb, err := c.store.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	if awssdk.StringValue(lb.Type) == elbv2sdk.LoadBalancerTypeEnumNetwork && len(lb.SecurityGroups) == 0 {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeInvalidConfigurationRequestException, 400, "You cannot set security groups on a Network Load Balancer which was created without any security groups.")
+	}
+	if err := c.store.validateLoadBalancerSecurityGroups(input.SecurityGroups); err != nil {
+		return nil, err
+	}
+	lb.SecurityGroups = awssdk.StringSlice(awssdk.StringValueSlice(input.SecurityGroups))
+	for _, eniID := range lb.networkInterfaceIDs {
+		c.store.networkInterfaces[eniID].Groups = buildGroupIdentifiers(lb.SecurityGroups)
+	}
+	return &elbv2sdk.SetSecurityGroupsOutput{
+		SecurityGroupIds: awssdk.StringSlice(awssdk.StringValueSlice(lb.SecurityGroups)),
+	}, nil
+}
+
+func (c *fakeELBV2) SetSubnetsWithContext(_ context.Context, input *elbv2sdk.SetSubnetsInput, _ ...request.Option) (*elbv2sdk.SetSubnetsOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lb, err := c.store.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	subnetMappings := input.SubnetMappings
+	for _, subnetID := range input.Subnets {
+		subnetMappings = append(subnetMappings, &elbv2sdk.SubnetMapping{SubnetId: subnetID})
+	}
+	if err := c.store.validateLoadBalancerSubnetMappings(awssdk.StringValue(lb.Type), subnetMappings); err != nil {
+		return nil, err
+	}
+	if input.IpAddressType != nil {
+		lb.IpAddressType = input.IpAddressType
+	}
+	c.store.detachLoadBalancerSubnets(lb)
+	if err := c.store.attachLoadBalancerSubnets(lb, subnetMappings); err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.SetSubnetsOutput{
+		AvailabilityZones: copyOf(lb.AvailabilityZones).([]*elbv2sdk.AvailabilityZone),
+		IpAddressType:     lb.IpAddressType,
+	}, nil
+}
+
+func (c *fakeELBV2) SetIpAddressTypeWithContext(_ context.Context, input *elbv2sdk.SetIpAddressTypeInput, _ ...request.Option) (*elbv2sdk.SetIpAddressTypeOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lb, err := c.store.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	ipAddressType := awssdk.StringValue(input.IpAddressType)
+	if ipAddressType != elbv2sdk.IpAddressTypeIpv4 && ipAddressType != elbv2sdk.IpAddressTypeDualstack {
+		return nil, c.store.newAPIError(elbv2ErrCodeValidationError, 400, "IP address type '%v' is not valid", ipAddressType)
+	}
+	lb.IpAddressType = awssdk.String(ipAddressType)
+	return &elbv2sdk.SetIpAddressTypeOutput{
+		IpAddressType: awssdk.String(ipAddressType),
+	}, nil
+}
+
+func (c *fakeELBV2) DescribeLoadBalancerAttributesWithContext(_ context.Context, input *elbv2sdk.DescribeLoadBalancerAttributesInput, _ ...request.Option) (*elbv2sdk.DescribeLoadBalancerAttributesOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lb, err := c.store.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.DescribeLoadBalancerAttributesOutput{
+		Attributes: buildLoadBalancerAttributes(lb.attributes),
+	}, nil
+}
+
+func (c *fakeELBV2) ModifyLoadBalancerAttributesWithContext(_ context.Context, input *elbv2sdk.ModifyLoadBalancerAttributesInput, _ ...request.Option) (*elbv2sdk.ModifyLoadBalancerAttributesOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lb, err := c.store.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range input.Attributes {
+		lb.attributes[awssdk.StringValue(attr.Key)] = awssdk.StringValue(attr.Value)
+	}
+	return &elbv2sdk.ModifyLoadBalancerAttributesOutput{
+		Attributes: buildLoadBalancerAttributes(lb.attributes),
+	}, nil
+}
+
+func (c *fakeELBV2) ModifyLoadBalancerAttributes(input *elbv2sdk.ModifyLoadBalancerAttributesInput) (*elbv2sdk.ModifyLoadBalancerAttributesOutput, error) {
+	return c.ModifyLoadBalancerAttributesWithContext(context.Background(), input)
+}
+
+func (c *fakeELBV2) CreateTargetGroupWithContext(_ context.Context, input *elbv2sdk.CreateTargetGroupInput, _ ...request.Option) (*elbv2sdk.CreateTargetGroupOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tgName := awssdk.StringValue(input.Name)
+	if !elbv2NamePattern.MatchString(tgName) {
+		return nil, c.store.newAPIError(elbv2ErrCodeValidationError, 400, "Target group name '%v' isn't valid", tgName)
+	}
+	for _, tg := range c.store.targetGroups {
+		if awssdk.StringValue(tg.TargetGroupName) == tgName {
+			return nil, c.store.newAPIError(elbv2sdk.ErrCodeDuplicateTargetGroupNameException, 400, "A target group with the same name '%v' exists, but with different settings", tgName)
+		}
+	}
+	if len(c.store.targetGroups) >= c.store.cfg.Quotas.TargetGroups {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTooManyTargetGroupsException, 400, "The maximum number of target groups has been reached")
+	}
+	if len(input.Tags) > c.store.cfg.Quotas.TagsPerResource {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTooManyTagsException, 400, "The quota for the number of tags that can be assigned to a target group has been reached")
+	}
+	targetType := awssdk.StringValue(input.TargetType)
+	if targetType == "" {
+		targetType = elbv2sdk.TargetTypeEnumInstance
+	}
+	protocol := awssdk.StringValue(input.Protocol)
+	if targetType != elbv2sdk.TargetTypeEnumLambda {
+		if protocol == "" || input.Port == nil {
+			return nil, c.store.newAPIError(elbv2ErrCodeValidationError, 400, "A protocol and port must be specified")
+		}
+		if awssdk.StringValue(input.VpcId) != awssdk.StringValue(c.store.vpc.VpcId) {
+			return nil, c.store.newAPIError(elbv2ErrCodeValidationError, 400, "The VPC ID '%v' is not found", awssdk.StringValue(input.VpcId))
+		}
+	}
+
+	tgID := c.store.nextID(16)
+	sdkTG := &elbv2sdk.TargetGroup{
+		TargetGroupArn:             awssdk.String(fmt.Sprintf("arn:aws:elasticloadbalancing:%v:%v:targetgroup/%v/%v", c.store.cfg.Region, c.store.cfg.AccountID, tgName, tgID)),
+		TargetGroupName:            awssdk.String(tgName),
+		TargetType:                 awssdk.String(targetType),
+		Protocol:                   input.Protocol,
+		ProtocolVersion:            input.ProtocolVersion,
+		Port:                       input.Port,
+		VpcId:                      input.VpcId,
+		IpAddressType:              input.IpAddressType,
+		HealthCheckEnabled:         awssdk.Bool(true),
+		HealthCheckProtocol:        input.HealthCheckProtocol,
+		HealthCheckPort:            input.HealthCheckPort,
+		HealthCheckPath:            input.HealthCheckPath,
+		HealthCheckIntervalSeconds: input.HealthCheckIntervalSeconds,
+		HealthCheckTimeoutSeconds:  input.HealthCheckTimeoutSeconds,
+		HealthyThresholdCount:      input.HealthyThresholdCount,
+		UnhealthyThresholdCount:    input.UnhealthyThresholdCount,
+		Matcher:                    copyOf(input.Matcher).(*elbv2sdk.Matcher),
+	}
+	applyTargetGroupDefaults(sdkTG)
+	c.store.targetGroups[awssdk.StringValue(sdkTG.TargetGroupArn)] = &targetGroup{
+		TargetGroup: sdkTG,
+		tags:        mergeELBV2Tags(nil, input.Tags),
+		attributes:  defaultTargetGroupAttributes(sdkTG),
+	}
+	return &elbv2sdk.CreateTargetGroupOutput{
+		TargetGroups: []*elbv2sdk.TargetGroup{copyOf(sdkTG).(*elbv2sdk.TargetGroup)},
+	}, nil
+}
+
+func (c *fakeELBV2) ModifyTargetGroupWithContext(_ context.Context, input *elbv2sdk.ModifyTargetGroupInput, _ ...request.Option) (*elbv2sdk.ModifyTargetGroupOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tg, err := c.store.findTargetGroup(awssdk.StringValue(input.TargetGroupArn))
+	if err != nil {
+		return nil, err
+	}
+	if input.HealthCheckEnabled != nil {
+		tg.HealthCheckEnabled = input.HealthCheckEnabled
+	}
+	if input.HealthCheckProtocol != nil {
+		tg.HealthCheckProtocol = input.HealthCheckProtocol
+	}
+	if input.HealthCheckPort != nil {
+		tg.HealthCheckPort = input.HealthCheckPort
+	}
+	if input.HealthCheckPath != nil {
+		tg.HealthCheckPath = input.HealthCheckPath
+	}
+	if input.HealthCheckIntervalSeconds != nil {
+		tg.HealthCheckIntervalSeconds = input.HealthCheckIntervalSeconds
+	}
+	if input.HealthCheckTimeoutSeconds != nil {
+		tg.HealthCheckTimeoutSeconds = input.HealthCheckTimeoutSeconds
+	}
+	if input.HealthyThresholdCount != nil {
+		tg.HealthyThresholdCount = input.HealthyThresholdCount
+	}
+	if input.UnhealthyThresholdCount != nil {
+		tg.UnhealthyThresholdCount = input.UnhealthyThresholdCount
+	}
+	if input.Matcher != nil {
+		tg.Matcher = copyOf(input.Matcher).(*elbv2sdk.Matcher)
+	}
+	return &elbv2sdk.ModifyTargetGroupOutput{
+		TargetGroups: []*elbv2sdk.TargetGroup{c.store.buildSDKTargetGroup(tg)},
+	}, nil
+}
+
+func (c *fakeELBV2) DeleteTargetGroupWithContext(_ context.Context, input *elbv2sdk.DeleteTargetGroupInput, _ ...request.Option) (*elbv2sdk.DeleteTargetGroupOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tgARN := awssdk.StringValue(input.TargetGroupArn)
+	if _, err := c.store.findTargetGroup(tgARN); err != nil {
+		return nil, err
+	}
+	if len(c.store.findTargetGroupLoadBalancerARNs(tgARN)) != 0 {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeResourceInUseException, 400, "Target group '%v' is currently in use by a listener or a rule", tgARN)
+	}
+	delete(c.store.targetGroups, tgARN)
+	return &elbv2sdk.DeleteTargetGroupOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeTargetGroupsAsList(_ context.Context, input *elbv2sdk.DescribeTargetGroupsInput) ([]*elbv2sdk.TargetGroup, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	if input.LoadBalancerArn != nil {
+		if _, err := c.store.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn)); err != nil {
+			return nil, err
+		}
+	}
+	tgARNs := sets.NewString(awssdk.StringValueSlice(input.TargetGroupArns)...)
+	tgNames := sets.NewString(awssdk.StringValueSlice(input.Names)...)
+	foundTGCount := 0
+	var tgs []*elbv2sdk.TargetGroup
+	for _, tgARN := range sets.StringKeySet(c.store.targetGroups).List() {
+		tg := c.store.targetGroups[tgARN]
+		if tgARNs.Len() != 0 && !tgARNs.Has(tgARN) {
+			continue
+		}
+		if tgNames.Len() != 0 && !tgNames.Has(awssdk.StringValue(tg.TargetGroupName)) {
+			continue
+		}
+		sdkTG := c.store.buildSDKTargetGroup(tg)
+		if input.LoadBalancerArn != nil && !sets.NewString(awssdk.StringValueSlice(sdkTG.LoadBalancerArns)...).Has(awssdk.StringValue(input.LoadBalancerArn)) {
+			continue
+		}
+		foundTGCount++
+		tgs = append(tgs, sdkTG)
+	}
+	if foundTGCount < tgARNs.Len()+tgNames.Len() {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTargetGroupNotFoundException, 400, "One or more target groups not found")
+	}
+	return tgs, nil
+}
+
+func (c *fakeELBV2) DescribeTargetGroupAttributesWithContext(_ context.Context, input *elbv2sdk.DescribeTargetGroupAttributesInput, _ ...request.Option) (*elbv2sdk.DescribeTargetGroupAttributesOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tg, err := c.store.findTargetGroup(awssdk.StringValue(input.TargetGroupArn))
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.DescribeTargetGroupAttributesOutput{
+		Attributes: buildTargetGroupAttributes(tg.attributes),
+	}, nil
+}
+
+func (c *fakeELBV2) ModifyTargetGroupAttributesWithContext(_ context.Context, input *elbv2sdk.ModifyTargetGroupAttributesInput, _ ...request.Option) (*elbv2sdk.ModifyTargetGroupAttributesOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tg, err := c.store.findTargetGroup(awssdk.StringValue(input.TargetGroupArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range input.Attributes {
+		tg.attributes[awssdk.StringValue(attr.Key)] = awssdk.StringValue(attr.Value)
+	}
+	return &elbv2sdk.ModifyTargetGroupAttributesOutput{
+		Attributes: buildTargetGroupAttributes(tg.attributes),
+	}, nil
+}
+
+func (c *fakeELBV2) RegisterTargetsWithContext(_ context.Context, input *elbv2sdk.RegisterTargetsInput, _ ...request.Option) (*elbv2sdk.RegisterTargetsOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tg, err := c.store.findTargetGroup(awssdk.StringValue(input.TargetGroupArn))
+	if err != nil {
+		return nil, err
+	}
+	var newTargets []*registeredTarget
+	for _, target := range input.Targets {
+		target = copyOf(target).(*elbv2sdk.TargetDescription)
+		if target.Port == nil {
+			target.Port = tg.Port
+		}
+		if err := c.store.validateTarget(tg, target); err != nil {
+			return nil, err
+		}
+		if findRegisteredTarget(tg.targets, target) != nil || findRegisteredTarget(newTargets, target) != nil {
+			continue
+		}
+		newTargets = append(newTargets, &registeredTarget{target: target})
+	}
+	if len(tg.targets)+len(newTargets) > c.store.cfg.Quotas.TargetsPerTargetGroup {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTooManyTargetsException, 400, "You've reached the limit on the number of targets")
+	}
+	tg.targets = append(tg.targets, newTargets...)
+	return &elbv2sdk.RegisterTargetsOutput{}, nil
+}
+
+func (c *fakeELBV2) DeregisterTargetsWithContext(_ context.Context, input *elbv2sdk.DeregisterTargetsInput, _ ...request.Option) (*elbv2sdk.DeregisterTargetsOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tg, err := c.store.findTargetGroup(awssdk.StringValue(input.TargetGroupArn))
+	if err != nil {
+		return nil, err
+	}
+	deregisteredTargets := make(map[*registeredTarget]bool)
+	for _, target := range input.Targets {
+		target = copyOf(target).(*elbv2sdk.TargetDescription)
+		if target.Port == nil {
+			target.Port = tg.Port
+		}
+		registered := findRegisteredTarget(tg.targets, target)
+		if registered == nil {
+			return nil, c.store.newAPIError(elbv2sdk.ErrCodeInvalidTargetException, 400, "The following targets are not registered: '%v'", awssdk.StringValue(target.Id))
+		}
+		deregisteredTargets[registered] = true
+	}
+	var remainingTargets []*registeredTarget
+	for _, registered := range tg.targets {
+		if !deregisteredTargets[registered] {
+			remainingTargets = append(remainingTargets, registered)
+		}
+	}
+	tg.targets = remainingTargets
+	return &elbv2sdk.DeregisterTargetsOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeTargetHealthWithContext(_ context.Context, input *elbv2sdk.DescribeTargetHealthInput, _ ...request.Option) (*elbv2sdk.DescribeTargetHealthOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tgARN := awssdk.StringValue(input.TargetGroupArn)
+	tg, err := c.store.findTargetGroup(tgARN)
+	if err != nil {
+		return nil, err
+	}
+	inUse := len(c.store.findTargetGroupLoadBalancerARNs(tgARN)) != 0
+	output := &elbv2sdk.DescribeTargetHealthOutput{}
+	if len(input.Targets) == 0 {
+		for _, registered := range tg.targets {
+			output.TargetHealthDescriptions = append(output.TargetHealthDescriptions, c.store.buildTargetHealthDescription(tg, registered, inUse))
+		}
+		return output, nil
+	}
+	for _, target := range input.Targets {
+		target = copyOf(target).(*elbv2sdk.TargetDescription)
+		if target.Port == nil {
+			target.Port = tg.Port
+		}
+		registered := findRegisteredTarget(tg.targets, target)
+		if registered == nil {
+			registered = &registeredTarget{
+				target: target,
+				health: &elbv2sdk.TargetHealth{
+					State:       awssdk.String(elbv2sdk.TargetHealthStateEnumUnused),
+					Reason:      awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetNotRegistered),
+					Description: awssdk.String("Target is not registered to the target group"),
+				},
+			}
+		}
+		output.TargetHealthDescriptions = append(output.TargetHealthDescriptions, c.store.buildTargetHealthDescription(tg, registered, inUse))
+	}
+	return output, nil
+}
+
+func (c *fakeELBV2) AddTagsWithContext(_ context.Context, input *elbv2sdk.AddTagsInput, _ ...request.Option) (*elbv2sdk.AddTagsOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tagKeys := sets.NewString()
+	for _, tag := range input.Tags {
+		tagKey := awssdk.StringValue(tag.Key)
+		if tagKeys.Has(tagKey) {
+			return nil, c.store.newAPIError(elbv2sdk.ErrCodeDuplicateTagKeysException, 400, "A tag key '%v' was specified more than once", tagKey)
+		}
+		tagKeys.Insert(tagKey)
+	}
+	for _, resourceARN := range awssdk.StringValueSlice(input.ResourceArns) {
+		tags, err := c.store.findELBV2ResourceTags(resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		newTags := mergeELBV2Tags(*tags, input.Tags)
+		if len(newTags) > c.store.cfg.Quotas.TagsPerResource {
+			return nil, c.store.newAPIError(elbv2sdk.ErrCodeTooManyTagsException, 400, "The quota for the number of tags that can be assigned to '%v' has been reached", resourceARN)
+		}
+		*tags = newTags
+	}
+	return &elbv2sdk.AddTagsOutput{}, nil
+}
+
+func (c *fakeELBV2) RemoveTagsWithContext(_ context.Context, input *elbv2sdk.RemoveTagsInput, _ ...request.Option) (*elbv2sdk.RemoveTagsOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	removedTagKeys := sets.NewString(awssdk.StringValueSlice(input.TagKeys)...)
+	for _, resourceARN := range awssdk.StringValueSlice(input.ResourceArns) {
+		tags, err := c.store.findELBV2ResourceTags(resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		var remainingTags []*elbv2sdk.Tag
+		for _, tag := range *tags {
+			if !removedTagKeys.Has(awssdk.StringValue(tag.Key)) {
+				remainingTags = append(remainingTags, tag)
+			}
+		}
+		*tags = remainingTags
+	}
+	return &elbv2sdk.RemoveTagsOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeTagsWithContext(_ context.Context, input *elbv2sdk.DescribeTagsInput, _ ...request.Option) (*elbv2sdk.DescribeTagsOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	if len(input.ResourceArns) > describeTagsResourcesLimit {
+		return nil, c.store.newAPIError(elbv2ErrCodeValidationError, 400, "1 validation error detected: Value at 'resourceArns' failed to satisfy constraint: Member must have length less than or equal to %v", describeTagsResourcesLimit)
+	}
+	output := &elbv2sdk.DescribeTagsOutput{}
+	for _, resourceARN := range awssdk.StringValueSlice(input.ResourceArns) {
+		tags, err := c.store.findELBV2ResourceTags(resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		output.TagDescriptions = append(output.TagDescriptions, &elbv2sdk.TagDescription{
+			ResourceArn: awssdk.String(resourceARN),
+			Tags:        copyOf(*tags).([]*elbv2sdk.Tag),
+		})
+	}
+	return output, nil
+}
+
+func (c *fakeELBV2) CreateTrustStoreWithContext(_ context.Context, input *elbv2sdk.CreateTrustStoreInput, _ ...request.Option) (*elbv2sdk.CreateTrustStoreOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tsName := awssdk.StringValue(input.Name)
+	if !elbv2NamePattern.MatchString(tsName) {
+		return nil, c.store.newAPIError(elbv2ErrCodeValidationError, 400, "Trust store name '%v' isn't valid", tsName)
+	}
+	for _, ts := range c.store.trustStores {
+		if awssdk.StringValue(ts.Name) == tsName {
+			return nil, c.store.newAPIError(elbv2sdk.ErrCodeDuplicateTrustStoreNameException, 400, "A trust store with the name '%v' already exists", tsName)
+		}
+	}
+	if awssdk.StringValue(input.CaCertificatesBundleS3Bucket) == "" || awssdk.StringValue(input.CaCertificatesBundleS3Key) == "" {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeCaCertificatesBundleNotFoundException, 400, "The specified CA certificates bundle doesn't exist")
+	}
+	sdkTS := &elbv2sdk.TrustStore{
+		TrustStoreArn:          awssdk.String(fmt.Sprintf("arn:aws:elasticloadbalancing:%v:%v:truststore/%v/%v", c.store.cfg.Region, c.store.cfg.AccountID, tsName, c.store.nextID(16))),
+		Name:                   awssdk.String(tsName),
+		Status:                 awssdk.String(elbv2sdk.TrustStoreStatusActive),
+		NumberOfCaCertificates: awssdk.Int64(1),
+		TotalRevokedEntries:    awssdk.Int64(0),
+	}
+	c.store.trustStores[awssdk.StringValue(sdkTS.TrustStoreArn)] = &trustStore{
+		TrustStore: sdkTS,
+		tags:       mergeELBV2Tags(nil, input.Tags),
+	}
+	return &elbv2sdk.CreateTrustStoreOutput{
+		TrustStores: []*elbv2sdk.TrustStore{copyOf(sdkTS).(*elbv2sdk.TrustStore)},
+	}, nil
+}
+
+func (c *fakeELBV2) DeleteTrustStoreWithContext(_ context.Context, input *elbv2sdk.DeleteTrustStoreInput, _ ...request.Option) (*elbv2sdk.DeleteTrustStoreOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tsARN := awssdk.StringValue(input.TrustStoreArn)
+	if _, exists := c.store.trustStores[tsARN]; !exists {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTrustStoreNotFoundException, 400, "The specified trust store '%v' doesn't exist", tsARN)
+	}
+	for _, ls := range c.store.listeners {
+		if ls.MutualAuthentication != nil && awssdk.StringValue(ls.MutualAuthentication.TrustStoreArn) == tsARN {
+			return nil, c.store.newAPIError(elbv2sdk.ErrCodeTrustStoreInUseException, 400, "Trust store '%v' is in use by listener '%v'", tsARN, awssdk.StringValue(ls.ListenerArn))
+		}
+	}
+	delete(c.store.trustStores, tsARN)
+	return &elbv2sdk.DeleteTrustStoreOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeTrustStoresAsList(_ context.Context, input *elbv2sdk.DescribeTrustStoresInput) ([]*elbv2sdk.TrustStore, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	tsARNs := sets.NewString(awssdk.StringValueSlice(input.TrustStoreArns)...)
+	tsNames := sets.NewString(awssdk.StringValueSlice(input.Names)...)
+	foundTSCount := 0
+	var trustStores []*elbv2sdk.TrustStore
+	for _, tsARN := range sets.StringKeySet(c.store.trustStores).List() {
+		ts := c.store.trustStores[tsARN]
+		if tsARNs.Len() != 0 && !tsARNs.Has(tsARN) {
+			continue
+		}
+		if tsNames.Len() != 0 && !tsNames.Has(awssdk.StringValue(ts.Name)) {
+			continue
+		}
+		foundTSCount++
+		trustStores = append(trustStores, copyOf(ts.TrustStore).(*elbv2sdk.TrustStore))
+	}
+	if foundTSCount < tsARNs.Len()+tsNames.Len() {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTrustStoreNotFoundException, 400, "One or more trust stores not found")
+	}
+	return trustStores, nil
+}
+
+func (s *store) findLoadBalancer(lbARN string) (*loadBalancer, error) {
+	lb, exists := s.loadBalancers[lbARN]
+	if !exists {
+		return nil, s.newAPIError(elbv2sdk.ErrCodeLoadBalancerNotFoundException, 400, "Load balancer '%v' not found", lbARN)
+	}
+	return lb, nil
+}
+
+func (s *store) findTargetGroup(tgARN string) (*targetGroup, error) {
+	tg, exists := s.targetGroups[tgARN]
+	if !exists {
+		return nil, s.newAPIError(elbv2sdk.ErrCodeTargetGroupNotFoundException, 400, "Target groups '%v' not found", tgARN)
+	}
+	return tg, nil
+}
+
+// findELBV2ResourceTags finds the tags of ELBV2 resource by resourceARN.
+func (s *store) findELBV2ResourceTags(resourceARN string) (*[]*elbv2sdk.Tag, error) {
+	switch {
+	case strings.Contains(resourceARN, ":loadbalancer/"):
+		lb, err := s.findLoadBalancer(resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		return &lb.tags, nil
+	case strings.Contains(resourceARN, ":targetgroup/"):
+		tg, err := s.findTargetGroup(resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		return &tg.tags, nil
+	case strings.Contains(resourceARN, ":listener/"):
+		ls, err := s.findListener(resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		return &ls.tags, nil
+	case strings.Contains(resourceARN, ":listener-rule/"):
+		lr, err := s.findRule(resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		return &lr.tags, nil
+	case strings.Contains(resourceARN, ":truststore/"):
+		ts, exists := s.trustStores[resourceARN]
+		if !exists {
+			return nil, s.newAPIError(elbv2sdk.ErrCodeTrustStoreNotFoundException, 400, "The specified trust store '%v' doesn't exist", resourceARN)
+		}
+		return &ts.tags, nil
+	}
+	return nil, s.newAPIError(elbv2ErrCodeValidationError, 400, "'%v' is not a valid load balancer resource ARN", resourceARN)
+}
+
+// findTargetGroupLoadBalancerARNs finds the ARNs of load balancers that forward to target group.
+func (s *store) findTargetGroupLoadBalancerARNs(tgARN string) []string {
+	lbARNs := sets.NewString()
+	for _, lr := range s.rules {
+		if sets.NewString(findActionTargetGroupARNs(lr.Actions)...).Has(tgARN) {
+			lbARNs.Insert(awssdk.StringValue(s.listeners[lr.listenerARN].LoadBalancerArn))
+		}
+	}
+	return lbARNs.List()
+}
+
+// buildSDKTargetGroup builds the target group as AWS reports it.
+func (s *store) buildSDKTargetGroup(tg *targetGroup) *elbv2sdk.TargetGroup {
+	sdkTG := copyOf(tg.TargetGroup).(*elbv2sdk.TargetGroup)
+	sdkTG.LoadBalancerArns = awssdk.StringSlice(s.findTargetGroupLoadBalancerARNs(awssdk.StringValue(tg.TargetGroupArn)))
+	return sdkTG
+}
+
+// validateLoadBalancerSubnetMappings validates that subnets exist, and are in different availability zones.
+// Application load balancers require subnets from at least two availability zones.
+func (s *store) validateLoadBalancerSubnetMappings(lbType string, subnetMappings []*elbv2sdk.SubnetMapping) error {
+	zoneNames := sets.NewString()
+	for _, subnetMapping := range subnetMappings {
+		subnetID := awssdk.StringValue(subnetMapping.SubnetId)
+		subnet, exists := s.subnets[subnetID]
+		if !exists {
+			return s.newAPIError(elbv2sdk.ErrCodeSubnetNotFoundException, 400, "The subnet ID '%v' is not valid", subnetID)
+		}
+		zoneName := awssdk.StringValue(subnet.AvailabilityZone)
+		if zoneNames.Has(zoneName) {
+			return s.newAPIError(elbv2sdk.ErrCodeInvalidConfigurationRequestException, 400, "A load balancer cannot be attached to multiple subnets in the same Availability Zone")
+		}
+		zoneNames.Insert(zoneName)
+	}
+	if lbType == elbv2sdk.LoadBalancerTypeEnumApplication && zoneNames.Len() < 2 {
+		return s.newAPIError(elbv2ErrCodeValidationError, 400, "At least two subnets in two different Availability Zones must be specified")
+	}
+	if zoneNames.Len() == 0 {
+		return s.newAPIError(elbv2ErrCodeValidationError, 400, "At least one subnet must be specified")
+	}
+	return nil
+}
+
+func (s *store) validateLoadBalancerSecurityGroups(groupIDs []*string) error {
+	for _, groupID := range awssdk.StringValueSlice(groupIDs) {
+		if _, exists := s.securityGroups[groupID]; !exists {
+			return s.newAPIError(elbv2sdk.ErrCodeInvalidSecurityGroupException, 400, "Security group '%v' does not exist", groupID)
+		}
+	}
+	return nil
+}
+
+// attachLoadBalancerSubnets attaches load balancer to subnets, by creating a network interface in each subnet.
+func (s *store) attachLoadBalancerSubnets(lb *loadBalancer, subnetMappings []*elbv2sdk.SubnetMapping) error {
+	lbType := awssdk.StringValue(lb.Type)
+	interfaceType := ec2sdk.NetworkInterfaceTypeInterface
+	if lbType == elbv2sdk.LoadBalancerTypeEnumNetwork {
+		interfaceType = ec2sdk.NetworkInterfaceTypeNetworkLoadBalancer
+	}
+	lbARNParts := strings.SplitN(awssdk.StringValue(lb.LoadBalancerArn), ":loadbalancer/", 2)
+	lb.AvailabilityZones = nil
+	for _, subnetMapping := range subnetMappings {
+		subnet := s.subnets[awssdk.StringValue(subnetMapping.SubnetId)]
+		eni := &ec2sdk.NetworkInterface{
+			NetworkInterfaceId: awssdk.String("eni-" + s.nextID(17)),
+			SubnetId:           subnet.SubnetId,
+			Description:        awssdk.String("ELB " + lbARNParts[1]),
+			InterfaceType:      awssdk.String(interfaceType),
+			RequesterId:        awssdk.String("amazon-elb"),
+			RequesterManaged:   awssdk.Bool(true),
+			PrivateIpAddress:   subnetMapping.PrivateIPv4Address,
+			Groups:             buildGroupIdentifiers(lb.SecurityGroups),
+		}
+		if err := s.addNetworkInterface(eni); err != nil {
+			return err
+		}
+		lb.networkInterfaceIDs = append(lb.networkInterfaceIDs, awssdk.StringValue(eni.NetworkInterfaceId))
+		az := &elbv2sdk.AvailabilityZone{
+			SubnetId: subnet.SubnetId,
+			ZoneName: subnet.AvailabilityZone,
+		}
+		if lbType == elbv2sdk.LoadBalancerTypeEnumNetwork {
+			az.LoadBalancerAddresses = []*elbv2sdk.LoadBalancerAddress{
+				{
+					PrivateIPv4Address: s.networkInterfaces[awssdk.StringValue(eni.NetworkInterfaceId)].PrivateIpAddress,
+					AllocationId:       subnetMapping.AllocationId,
+					IPv6Address:        subnetMapping.IPv6Address,
+				},
+			}
+		}
+		lb.AvailabilityZones = append(lb.AvailabilityZones, az)
+	}
+	return nil
+}
+
+// detachLoadBalancerSubnets detaches load balancer from subnets, by deleting the network interfaces created for it.
+func (s *store) detachLoadBalancerSubnets(lb *loadBalancer) {
+	for _, eniID := range lb.networkInterfaceIDs {
+		if eni, exists := s.networkInterfaces[eniID]; exists {
+			s.releaseAddress(awssdk.StringValue(eni.SubnetId))
+			delete(s.networkInterfaces, eniID)
+		}
+	}
+	lb.networkInterfaceIDs = nil
+	lb.AvailabilityZones = nil
+}
+
+// validateTarget validates that target can be registered into target group.
+func (s *store) validateTarget(tg *targetGroup, target *elbv2sdk.TargetDescription) error {
+	targetID := awssdk.StringValue(target.Id)
+	switch awssdk.StringValue(tg.TargetType) {
+	case elbv2sdk.TargetTypeEnumInstance:
+		instance, exists := s.instances[targetID]
+		if !exists || awssdk.StringValue(instance.State.Name) != ec2sdk.InstanceStateNameRunning {
+			return s.newAPIError(elbv2sdk.ErrCodeInvalidTargetException, 400, "The following targets are not in a running state and can't be registered: '%v'", targetID)
+		}
+	case elbv2sdk.TargetTypeEnumIp:
+		if awssdk.StringValue(target.AvailabilityZone) == "all" {
+			return nil
+		}
+		for _, cidrBlock := range s.cfg.VpcCIDRBlocks {
+			if cidrContains(cidrBlock, targetID) {
+				return nil
+			}
+		}
+		return s.newAPIError(elbv2sdk.ErrCodeInvalidTargetException, 400, "The IP address '%v' is not within a CIDR block of the VPC", targetID)
+	}
+	return nil
+}
+
+// buildTargetHealthDescription builds the health of registered target.
+// Unless overridden, targets are healthy when target group receives traffic from a load balancer.
+func (s *store) buildTargetHealthDescription(tg *targetGroup, registered *registeredTarget, inUse bool) *elbv2sdk.TargetHealthDescription {
+	healthCheckPort := awssdk.StringValue(tg.HealthCheckPort)
+	if healthCheckPort == "traffic-port" {
+		healthCheckPort = fmt.Sprintf("%v", awssdk.Int64Value(registered.target.Port))
+	}
+	health := registered.health
+	switch {
+	case health != nil:
+	case !inUse:
+		health = &elbv2sdk.TargetHealth{
+			State:       awssdk.String(elbv2sdk.TargetHealthStateEnumUnused),
+			Reason:      awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetNotInUse),
+			Description: awssdk.String("Target group is not configured to receive traffic from the load balancer"),
+		}
+	default:
+		health = &elbv2sdk.TargetHealth{
+			State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+		}
+	}
+	return &elbv2sdk.TargetHealthDescription{
+		Target:          copyOf(registered.target).(*elbv2sdk.TargetDescription),
+		TargetHealth:    copyOf(health).(*elbv2sdk.TargetHealth),
+		HealthCheckPort: awssdk.String(healthCheckPort),
+	}
+}
+
+func (s *store) setTargetHealth(tgARN string, target *elbv2sdk.TargetDescription, targetHealth *elbv2sdk.TargetHealth) error {
+	tg, err := s.findTargetGroup(tgARN)
+	if err != nil {
+		return err
+	}
+	target = copyOf(target).(*elbv2sdk.TargetDescription)
+	if target.Port == nil {
+		target.Port = tg.Port
+	}
+	registered := findRegisteredTarget(tg.targets, target)
+	if registered == nil {
+		return s.newAPIError(elbv2sdk.ErrCodeInvalidTargetException, 400, "The following targets are not registered: '%v'", awssdk.StringValue(target.Id))
+	}
+	registered.health = copyOf(targetHealth).(*elbv2sdk.TargetHealth)
+	return nil
+}
+
+// findRegisteredTarget finds the registered target with same ID and port as target.
+func findRegisteredTarget(registeredTargets []*registeredTarget, target *elbv2sdk.TargetDescription) *registeredTarget {
+	for _, registered := range registeredTargets {
+		if awssdk.StringValue(registered.target.Id) == awssdk.StringValue(target.Id) &&
+			awssdk.Int64Value(registered.target.Port) == awssdk.Int64Value(target.Port) {
+			return registered
+		}
+	}
+	return nil
+}
+
+// applyTargetGroupDefaults applies the default settings AWS applies to target groups.
+func applyTargetGroupDefaults(tg *elbv2sdk.TargetGroup) {
+	protocol := awssdk.StringValue(tg.Protocol)
+	isHTTP := protocol == elbv2sdk.ProtocolEnumHttp || protocol == elbv2sdk.ProtocolEnumHttps
+	if tg.IpAddressType == nil && awssdk.StringValue(tg.TargetType) != elbv2sdk.TargetTypeEnumLambda {
+		tg.IpAddressType = awssdk.String(elbv2sdk.TargetGroupIpAddressTypeEnumIpv4)
+	}
+	if tg.ProtocolVersion == nil && isHTTP {
+		tg.ProtocolVersion = awssdk.String("HTTP1")
+	}
+	if tg.HealthCheckProtocol == nil {
+		if isHTTP {
+			tg.HealthCheckProtocol = tg.Protocol
+		} else {
+			tg.HealthCheckProtocol = awssdk.String(elbv2sdk.ProtocolEnumTcp)
+		}
+	}
+	if tg.HealthCheckPort == nil {
+		tg.HealthCheckPort = awssdk.String("traffic-port")
+	}
+	healthCheckProtocol := awssdk.StringValue(tg.HealthCheckProtocol)
+	isHTTPHealthCheck := healthCheckProtocol == elbv2sdk.ProtocolEnumHttp || healthCheckProtocol == elbv2sdk.ProtocolEnumHttps
+	if tg.HealthCheckPath == nil && isHTTPHealthCheck {
+		tg.HealthCheckPath = awssdk.String("/")
+	}
+	if tg.Matcher == nil && isHTTPHealthCheck {
+		tg.Matcher = &elbv2sdk.Matcher{HttpCode: awssdk.String("200")}
+	}
+	if tg.HealthCheckIntervalSeconds == nil {
+		tg.HealthCheckIntervalSeconds = awssdk.Int64(30)
+	}
+	if tg.HealthCheckTimeoutSeconds == nil {
+		if isHTTP {
+			tg.HealthCheckTimeoutSeconds = awssdk.Int64(5)
+		} else {
+			tg.HealthCheckTimeoutSeconds = awssdk.Int64(10)
+		}
+	}
+	if tg.HealthyThresholdCount == nil {
+		tg.HealthyThresholdCount = awssdk.Int64(5)
+	}
+	if tg.UnhealthyThresholdCount == nil {
+		tg.UnhealthyThresholdCount = awssdk.Int64(2)
+	}
+}
+
+// defaultLoadBalancerAttributes returns the default attributes of load balancers.
+func defaultLoadBalancerAttributes(lbType string) map[string]string {
+	attributes := map[string]string{
+		"access_logs.s3.enabled":      "false",
+		"deletion_protection.enabled": "false",
+	}
+	if lbType == elbv2sdk.LoadBalancerTypeEnumApplication {
+		attributes["idle_timeout.timeout_seconds"] = "60"
+		attributes["routing.http2.enabled"] = "true"
+		attributes["routing.http.drop_invalid_header_fields.enabled"] = "false"
+	} else {
+		attributes["load_balancing.cross_zone.enabled"] = "false"
+	}
+	return attributes
+}
+
+// defaultTargetGroupAttributes returns the default attributes of target groups.
+func defaultTargetGroupAttributes(tg *elbv2sdk.TargetGroup) map[string]string {
+	attributes := map[string]string{
+		"deregistration_delay.timeout_seconds": "300",
+		"stickiness.enabled":                   "false",
+	}
+	switch awssdk.StringValue(tg.Protocol) {
+	case elbv2sdk.ProtocolEnumHttp, elbv2sdk.ProtocolEnumHttps:
+		attributes["load_balancing.algorithm.type"] = "round_robin"
+		attributes["slow_start.duration_seconds"] = "0"
+		attributes["stickiness.type"] = "lb_cookie"
+	default:
+		attributes["proxy_protocol_v2.enabled"] = "false"
+		attributes["preserve_client_ip.enabled"] = "false"
+		attributes["stickiness.type"] = "source_ip"
+	}
+	if awssdk.StringValue(tg.TargetType) == elbv2sdk.TargetTypeEnumInstance {
+		attributes["preserve_client_ip.enabled"] = "true"
+	}
+	return attributes
+}
+
+func buildLoadBalancerAttributes(attributes map[string]string) []*elbv2sdk.LoadBalancerAttribute {
+	var sdkAttributes []*elbv2sdk.LoadBalancerAttribute
+	for _, key := range sets.StringKeySet(attributes).List() {
+		sdkAttributes = append(sdkAttributes, &elbv2sdk.LoadBalancerAttribute{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(attributes[key]),
+		})
+	}
+	return sdkAttributes
+}
+
+func buildTargetGroupAttributes(attributes map[string]string) []*elbv2sdk.TargetGroupAttribute {
+	var sdkAttributes []*elbv2sdk.TargetGroupAttribute
+	for _, key := range sets.StringKeySet(attributes).List() {
+		sdkAttributes = append(sdkAttributes, &elbv2sdk.TargetGroupAttribute{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(attributes[key]),
+		})
+	}
+	return sdkAttributes
+}
+
+func buildGroupIdentifiers(groupIDs []*string) []*ec2sdk.GroupIdentifier {
+	var groups []*ec2sdk.GroupIdentifier
+	for _, groupID := range awssdk.StringValueSlice(groupIDs) {
+		groups = append(groups, &ec2sdk.GroupIdentifier{
+			GroupId: awssdk.String(groupID),
+		})
+	}
+	return groups
+}
+
+// mergeELBV2Tags merges newTags into tags, the tags with same key are overwritten.
+func mergeELBV2Tags(tags []*elbv2sdk.Tag, newTags []*elbv2sdk.Tag) []*elbv2sdk.Tag {
+	merged := copyOf(tags).([]*elbv2sdk.Tag)
+	for _, newTag := range newTags {
+		overwritten := false
+		for _, tag := range merged {
+			if awssdk.StringValue(tag.Key) == awssdk.StringValue(newTag.Key) {
+				tag.Value = awssdk.String(awssdk.StringValue(newTag.Value))
+				overwritten = true
+				break
+			}
+		}
+		if !overwritten {
+			merged = append(merged, &elbv2sdk.Tag{
+				Key:   awssdk.String(awssdk.StringValue(newTag.Key)),
+				Value: awssdk.String(awssdk.StringValue(newTag.Value)),
+			})
+		}
+	}
+	return merged
+}
@@ -0,0 +1,741 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	defaultSSLPolicy = "ELBSecurityPolicy-2016-08"
+
+	defaultRulePriority = "default"
+	minRulePriority     = 1
+	maxRulePriority     = 50000
+)
+
+// listener is a listener held by store.
+type listener struct {
+	*elbv2sdk.Listener
+
+	tags       []*elbv2sdk.Tag
+	attributes map[string]string
+	// extraCertificateARNs are the ARNs of certificates added besides the default certificate.
+	extraCertificateARNs []string
+}
+
+// rule is a listener rule held by store.
+type rule struct {
+	*elbv2sdk.Rule
+
+	listenerARN string
+	tags        []*elbv2sdk.Tag
+}
+
+func (c *fakeELBV2) CreateListenerWithContext(_ context.Context, input *elbv2sdk.CreateListenerInput, _ ...request.Option) (*elbv2sdk.CreateListenerOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lbARN := awssdk.StringValue(input.LoadBalancerArn)
+	lb, err := c.store.findLoadBalancer(lbARN)
+	if err != nil {
+		return nil, err
+	}
+	port := awssdk.Int64Value(input.Port)
+	lbListenerCount := 0
+	for _, ls := range c.store.listeners {
+		if awssdk.StringValue(ls.LoadBalancerArn) != lbARN {
+			continue
+		}
+		if awssdk.Int64Value(ls.Port) == port {
+			return nil, c.store.newAPIError(elbv2sdk.ErrCodeDuplicateListenerException, 400, "A listener already exists on this port for this load balancer '%v'", lbARN)
+		}
+		lbListenerCount++
+	}
+	if lbListenerCount >= c.store.cfg.Quotas.ListenersPerLoadBalancer {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTooManyListenersException, 400, "You've reached the limit on the number of listeners per load balancer")
+	}
+	if len(input.Tags) > c.store.cfg.Quotas.TagsPerResource {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTooManyTagsException, 400, "The quota for the number of tags that can be assigned to a listener has been reached")
+	}
+	sdkLS := &elbv2sdk.Listener{
+		LoadBalancerArn:      lb.LoadBalancerArn,
+		Port:                 input.Port,
+		Protocol:             input.Protocol,
+		SslPolicy:            input.SslPolicy,
+		AlpnPolicy:           awssdk.StringSlice(awssdk.StringValueSlice(input.AlpnPolicy)),
+		MutualAuthentication: copyOf(input.MutualAuthentication).(*elbv2sdk.MutualAuthenticationAttributes),
+	}
+	if err := c.store.applyListenerSettings(lb, sdkLS, input.Certificates); err != nil {
+		return nil, err
+	}
+	defaultActions, err := c.store.buildActions(lb, input.DefaultActions)
+	if err != nil {
+		return nil, err
+	}
+	sdkLS.DefaultActions = defaultActions
+
+	lbARNParts := strings.SplitN(lbARN, ":loadbalancer/", 2)
+	lsARN := fmt.Sprintf("%v:listener/%v/%v", lbARNParts[0], lbARNParts[1], c.store.nextID(16))
+	sdkLS.ListenerArn = awssdk.String(lsARN)
+	c.store.listeners[lsARN] = &listener{
+		Listener:   sdkLS,
+		tags:       mergeELBV2Tags(nil, input.Tags),
+		attributes: defaultListenerAttributes(awssdk.StringValue(sdkLS.Protocol)),
+	}
+	defaultLRARN := c.store.buildRuleARN(lsARN)
+	c.store.rules[defaultLRARN] = &rule{
+		Rule: &elbv2sdk.Rule{
+			RuleArn:   awssdk.String(defaultLRARN),
+			Priority:  awssdk.String(defaultRulePriority),
+			IsDefault: awssdk.Bool(true),
+			Actions:   copyOf(defaultActions).([]*elbv2sdk.Action),
+		},
+		listenerARN: lsARN,
+	}
+	return &elbv2sdk.CreateListenerOutput{
+		Listeners: []*elbv2sdk.Listener{copyOf(sdkLS).(*elbv2sdk.Listener)},
+	}, nil
+}
+
+func (c *fakeELBV2) ModifyListenerWithContext(_ context.Context, input *elbv2sdk.ModifyListenerInput, _ ...request.Option) (*elbv2sdk.ModifyListenerOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lsARN := awssdk.StringValue(input.ListenerArn)
+	ls, err := c.store.findListener(lsARN)
+	if err != nil {
+		return nil, err
+	}
+	lbARN := awssdk.StringValue(ls.LoadBalancerArn)
+	lb := c.store.loadBalancers[lbARN]
+	if input.Port != nil && awssdk.Int64Value(input.Port) != awssdk.Int64Value(ls.Port) {
+		for _, otherLS := range c.store.listeners {
+			if awssdk.StringValue(otherLS.LoadBalancerArn) == lbARN && awssdk.Int64Value(otherLS.Port) == awssdk.Int64Value(input.Port) {
+				return nil, c.store.newAPIError(elbv2sdk.ErrCodeDuplicateListenerException, 400, "A listener already exists on this port for this load balancer '%v'", lbARN)
+			}
+		}
+	}
+
+	sdkLS := copyOf(ls.Listener).(*elbv2sdk.Listener)
+	if input.Port != nil {
+		sdkLS.Port = input.Port
+	}
+	if input.Protocol != nil {
+		sdkLS.Protocol = input.Protocol
+	}
+	if input.SslPolicy != nil {
+		sdkLS.SslPolicy = input.SslPolicy
+	}
+	if input.AlpnPolicy != nil {
+		sdkLS.AlpnPolicy = awssdk.StringSlice(awssdk.StringValueSlice(input.AlpnPolicy))
+	}
+	if input.MutualAuthentication != nil {
+		sdkLS.MutualAuthentication = copyOf(input.MutualAuthentication).(*elbv2sdk.MutualAuthenticationAttributes)
+	}
+	certificates := input.Certificates
+	if certificates == nil {
+		certificates = sdkLS.Certificates
+	}
+	if err := c.store.applyListenerSettings(lb, sdkLS, certificates); err != nil {
+		return nil, err
+	}
+	if input.DefaultActions != nil {
+		defaultActions, err := c.store.buildActions(lb, input.DefaultActions)
+		if err != nil {
+			return nil, err
+		}
+		sdkLS.DefaultActions = defaultActions
+		c.store.findDefaultRule(lsARN).Actions = copyOf(defaultActions).([]*elbv2sdk.Action)
+	}
+	ls.Listener = sdkLS
+	return &elbv2sdk.ModifyListenerOutput{
+		Listeners: []*elbv2sdk.Listener{copyOf(sdkLS).(*elbv2sdk.Listener)},
+	}, nil
+}
+
+func (c *fakeELBV2) DeleteListenerWithContext(_ context.Context, input *elbv2sdk.DeleteListenerInput, _ ...request.Option) (*elbv2sdk.DeleteListenerOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lsARN := awssdk.StringValue(input.ListenerArn)
+	if _, err := c.store.findListener(lsARN); err != nil {
+		return nil, err
+	}
+	c.store.deleteListener(lsARN)
+	return &elbv2sdk.DeleteListenerOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeListenersAsList(_ context.Context, input *elbv2sdk.DescribeListenersInput) ([]*elbv2sdk.Listener, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	if input.LoadBalancerArn != nil {
+		if _, err := c.store.findLoadBalancer(awssdk.StringValue(input.LoadBalancerArn)); err != nil {
+			return nil, err
+		}
+	}
+	lsARNs := sets.NewString(awssdk.StringValueSlice(input.ListenerArns)...)
+	foundLSCount := 0
+	var listeners []*elbv2sdk.Listener
+	for _, lsARN := range sets.StringKeySet(c.store.listeners).List() {
+		ls := c.store.listeners[lsARN]
+		if input.LoadBalancerArn != nil && awssdk.StringValue(ls.LoadBalancerArn) != awssdk.StringValue(input.LoadBalancerArn) {
+			continue
+		}
+		if lsARNs.Len() != 0 && !lsARNs.Has(lsARN) {
+			continue
+		}
+		foundLSCount++
+		listeners = append(listeners, copyOf(ls.Listener).(*elbv2sdk.Listener))
+	}
+	if foundLSCount < lsARNs.Len() {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeListenerNotFoundException, 400, "One or more listeners not found")
+	}
+	return listeners, nil
+}
+
+func (c *fakeELBV2) DescribeListenerAttributesWithContext(_ context.Context, input *services.DescribeListenerAttributesInput, _ ...request.Option) (*services.DescribeListenerAttributesOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	ls, err := c.store.findListener(awssdk.StringValue(input.ListenerArn))
+	if err != nil {
+		return nil, err
+	}
+	return &services.DescribeListenerAttributesOutput{
+		Attributes: buildListenerAttributes(ls.attributes),
+	}, nil
+}
+
+func (c *fakeELBV2) ModifyListenerAttributesWithContext(_ context.Context, input *services.ModifyListenerAttributesInput, _ ...request.Option) (*services.ModifyListenerAttributesOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	ls, err := c.store.findListener(awssdk.StringValue(input.ListenerArn))
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range input.Attributes {
+		ls.attributes[awssdk.StringValue(attr.Key)] = awssdk.StringValue(attr.Value)
+	}
+	return &services.ModifyListenerAttributesOutput{
+		Attributes: buildListenerAttributes(ls.attributes),
+	}, nil
+}
+
+func (c *fakeELBV2) AddListenerCertificatesWithContext(_ context.Context, input *elbv2sdk.AddListenerCertificatesInput, _ ...request.Option) (*elbv2sdk.AddListenerCertificatesOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	ls, err := c.store.findListener(awssdk.StringValue(input.ListenerArn))
+	if err != nil {
+		return nil, err
+	}
+	certARNs := sets.NewString(ls.extraCertificateARNs...)
+	for _, cert := range input.Certificates {
+		certARN := awssdk.StringValue(cert.CertificateArn)
+		if err := c.store.validateCertificate(certARN); err != nil {
+			return nil, err
+		}
+		certARNs.Insert(certARN)
+	}
+	if c.store.countLoadBalancerCertificates(awssdk.StringValue(ls.LoadBalancerArn))-len(ls.extraCertificateARNs)+certARNs.Len() > c.store.cfg.Quotas.CertificatesPerLoadBalancer {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTooManyCertificatesException, 400, "You've reached the limit on the number of certificates per load balancer")
+	}
+	ls.extraCertificateARNs = certARNs.List()
+	return &elbv2sdk.AddListenerCertificatesOutput{
+		Certificates: buildListenerCertificates(nil, ls.extraCertificateARNs),
+	}, nil
+}
+
+func (c *fakeELBV2) RemoveListenerCertificatesWithContext(_ context.Context, input *elbv2sdk.RemoveListenerCertificatesInput, _ ...request.Option) (*elbv2sdk.RemoveListenerCertificatesOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	ls, err := c.store.findListener(awssdk.StringValue(input.ListenerArn))
+	if err != nil {
+		return nil, err
+	}
+	certARNs := sets.NewString(ls.extraCertificateARNs...)
+	for _, cert := range input.Certificates {
+		certARN := awssdk.StringValue(cert.CertificateArn)
+		if len(ls.Certificates) != 0 && awssdk.StringValue(ls.Certificates[0].CertificateArn) == certARN {
+			return nil, c.store.newAPIError(elbv2sdk.ErrCodeOperationNotPermittedException, 400, "The default certificate cannot be removed from listener '%v'", awssdk.StringValue(ls.ListenerArn))
+		}
+		certARNs.Delete(certARN)
+	}
+	ls.extraCertificateARNs = certARNs.List()
+	return &elbv2sdk.RemoveListenerCertificatesOutput{}, nil
+}
+
+func (c *fakeELBV2) DescribeListenerCertificatesAsList(_ context.Context, input *elbv2sdk.DescribeListenerCertificatesInput) ([]*elbv2sdk.Certificate, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	ls, err := c.store.findListener(awssdk.StringValue(input.ListenerArn))
+	if err != nil {
+		return nil, err
+	}
+	return buildListenerCertificates(ls.Certificates, ls.extraCertificateARNs), nil
+}
+
+func (c *fakeELBV2) CreateRuleWithContext(_ context.Context, input *elbv2sdk.CreateRuleInput, _ ...request.Option) (*elbv2sdk.CreateRuleOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lsARN := awssdk.StringValue(input.ListenerArn)
+	ls, err := c.store.findListener(lsARN)
+	if err != nil {
+		return nil, err
+	}
+	lbARN := awssdk.StringValue(ls.LoadBalancerArn)
+	priority := awssdk.Int64Value(input.Priority)
+	if err := c.store.validateRulePriority(lsARN, priority, ""); err != nil {
+		return nil, err
+	}
+	if c.store.countLoadBalancerRules(lbARN) >= c.store.cfg.Quotas.RulesPerLoadBalancer {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTooManyRulesException, 400, "You've reached the limit on the number of rules per load balancer")
+	}
+	if len(input.Tags) > c.store.cfg.Quotas.TagsPerResource {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeTooManyTagsException, 400, "The quota for the number of tags that can be assigned to a rule has been reached")
+	}
+	if len(input.Conditions) == 0 {
+		return nil, c.store.newAPIError(elbv2ErrCodeValidationError, 400, "A rule must have at least one condition")
+	}
+	actions, err := c.store.buildActions(c.store.loadBalancers[lbARN], input.Actions)
+	if err != nil {
+		return nil, err
+	}
+	lrARN := c.store.buildRuleARN(lsARN)
+	sdkLR := &elbv2sdk.Rule{
+		RuleArn:    awssdk.String(lrARN),
+		Priority:   awssdk.String(strconv.FormatInt(priority, 10)),
+		IsDefault:  awssdk.Bool(false),
+		Conditions: copyOf(input.Conditions).([]*elbv2sdk.RuleCondition),
+		Actions:    actions,
+	}
+	c.store.rules[lrARN] = &rule{
+		Rule:        sdkLR,
+		listenerARN: lsARN,
+		tags:        mergeELBV2Tags(nil, input.Tags),
+	}
+	return &elbv2sdk.CreateRuleOutput{
+		Rules: []*elbv2sdk.Rule{copyOf(sdkLR).(*elbv2sdk.Rule)},
+	}, nil
+}
+
+func (c *fakeELBV2) ModifyRuleWithContext(_ context.Context, input *elbv2sdk.ModifyRuleInput, _ ...request.Option) (*elbv2sdk.ModifyRuleOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lr, err := c.store.findRule(awssdk.StringValue(input.RuleArn))
+	if err != nil {
+		return nil, err
+	}
+	if awssdk.BoolValue(lr.IsDefault) {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeOperationNotPermittedException, 400, "Default rule '%v' cannot be modified, modify the listener default actions instead", awssdk.StringValue(lr.RuleArn))
+	}
+	if input.Actions != nil {
+		lbARN := awssdk.StringValue(c.store.listeners[lr.listenerARN].LoadBalancerArn)
+		actions, err := c.store.buildActions(c.store.loadBalancers[lbARN], input.Actions)
+		if err != nil {
+			return nil, err
+		}
+		lr.Actions = actions
+	}
+	if input.Conditions != nil {
+		lr.Conditions = copyOf(input.Conditions).([]*elbv2sdk.RuleCondition)
+	}
+	return &elbv2sdk.ModifyRuleOutput{
+		Rules: []*elbv2sdk.Rule{copyOf(lr.Rule).(*elbv2sdk.Rule)},
+	}, nil
+}
+
+func (c *fakeELBV2) DeleteRuleWithContext(_ context.Context, input *elbv2sdk.DeleteRuleInput, _ ...request.Option) (*elbv2sdk.DeleteRuleOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	lr, err := c.store.findRule(awssdk.StringValue(input.RuleArn))
+	if err != nil {
+		return nil, err
+	}
+	if awssdk.BoolValue(lr.IsDefault) {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeOperationNotPermittedException, 400, "Default rule '%v' cannot be deleted", awssdk.StringValue(lr.RuleArn))
+	}
+	delete(c.store.rules, awssdk.StringValue(lr.RuleArn))
+	return &elbv2sdk.DeleteRuleOutput{}, nil
+}
+
+func (c *fakeELBV2) SetRulePrioritiesWithContext(_ context.Context, input *elbv2sdk.SetRulePrioritiesInput, _ ...request.Option) (*elbv2sdk.SetRulePrioritiesOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	// priorities are validated against the rules after all priorities have been applied.
+	newPriorities := make(map[string]int64, len(input.RulePriorities))
+	for _, rulePriority := range input.RulePriorities {
+		lr, err := c.store.findRule(awssdk.StringValue(rulePriority.RuleArn))
+		if err != nil {
+			return nil, err
+		}
+		if awssdk.BoolValue(lr.IsDefault) {
+			return nil, c.store.newAPIError(elbv2sdk.ErrCodeOperationNotPermittedException, 400, "The priority of default rule '%v' cannot be changed", awssdk.StringValue(lr.RuleArn))
+		}
+		newPriorities[awssdk.StringValue(lr.RuleArn)] = awssdk.Int64Value(rulePriority.Priority)
+	}
+	rulesByPriority := make(map[string]string)
+	for lrARN, lr := range c.store.rules {
+		if awssdk.BoolValue(lr.IsDefault) {
+			continue
+		}
+		priority := awssdk.StringValue(lr.Priority)
+		if newPriority, exists := newPriorities[lrARN]; exists {
+			if newPriority < minRulePriority || newPriority > maxRulePriority {
+				return nil, c.store.newAPIError(elbv2ErrCodeValidationError, 400, "Priority '%v' must be between %v and %v", newPriority, minRulePriority, maxRulePriority)
+			}
+			priority = strconv.FormatInt(newPriority, 10)
+		}
+		key := lr.listenerARN + "/" + priority
+		if _, exists := rulesByPriority[key]; exists {
+			return nil, c.store.newAPIError(elbv2sdk.ErrCodePriorityInUseException, 400, "Priority '%v' is currently in use", priority)
+		}
+		rulesByPriority[key] = lrARN
+	}
+	var rules []*elbv2sdk.Rule
+	for _, lrARN := range sets.StringKeySet(newPriorities).List() {
+		lr := c.store.rules[lrARN]
+		lr.Priority = awssdk.String(strconv.FormatInt(newPriorities[lrARN], 10))
+		rules = append(rules, copyOf(lr.Rule).(*elbv2sdk.Rule))
+	}
+	return &elbv2sdk.SetRulePrioritiesOutput{
+		Rules: rules,
+	}, nil
+}
+
+func (c *fakeELBV2) DescribeRulesAsList(_ context.Context, input *elbv2sdk.DescribeRulesInput) ([]*elbv2sdk.Rule, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	if input.ListenerArn != nil {
+		if _, err := c.store.findListener(awssdk.StringValue(input.ListenerArn)); err != nil {
+			return nil, err
+		}
+	}
+	lrARNs := sets.NewString(awssdk.StringValueSlice(input.RuleArns)...)
+	var rules []*rule
+	for lrARN, lr := range c.store.rules {
+		if input.ListenerArn != nil && lr.listenerARN != awssdk.StringValue(input.ListenerArn) {
+			continue
+		}
+		if lrARNs.Len() != 0 && !lrARNs.Has(lrARN) {
+			continue
+		}
+		rules = append(rules, lr)
+	}
+	if len(rules) < lrARNs.Len() {
+		return nil, c.store.newAPIError(elbv2sdk.ErrCodeRuleNotFoundException, 400, "One or more rules not found")
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].listenerARN != rules[j].listenerARN {
+			return rules[i].listenerARN < rules[j].listenerARN
+		}
+		return compareRulePriority(rules[i], rules[j])
+	})
+	sdkLRs := make([]*elbv2sdk.Rule, 0, len(rules))
+	for _, lr := range rules {
+		sdkLRs = append(sdkLRs, copyOf(lr.Rule).(*elbv2sdk.Rule))
+	}
+	return sdkLRs, nil
+}
+
+func (s *store) findListener(lsARN string) (*listener, error) {
+	ls, exists := s.listeners[lsARN]
+	if !exists {
+		return nil, s.newAPIError(elbv2sdk.ErrCodeListenerNotFoundException, 400, "Listener '%v' not found", lsARN)
+	}
+	return ls, nil
+}
+
+func (s *store) findRule(lrARN string) (*rule, error) {
+	lr, exists := s.rules[lrARN]
+	if !exists {
+		return nil, s.newAPIError(elbv2sdk.ErrCodeRuleNotFoundException, 400, "Rule '%v' not found", lrARN)
+	}
+	return lr, nil
+}
+
+func (s *store) findDefaultRule(lsARN string) *rule {
+	for _, lr := range s.rules {
+		if lr.listenerARN == lsARN && awssdk.BoolValue(lr.IsDefault) {
+			return lr
+		}
+	}
+	return nil
+}
+
+// deleteListener deletes listener along with its rules.
+func (s *store) deleteListener(lsARN string) {
+	for lrARN, lr := range s.rules {
+		if lr.listenerARN == lsARN {
+			delete(s.rules, lrARN)
+		}
+	}
+	delete(s.listeners, lsARN)
+}
+
+func (s *store) buildRuleARN(lsARN string) string {
+	lsARNParts := strings.SplitN(lsARN, ":listener/", 2)
+	return fmt.Sprintf("%v:listener-rule/%v/%v", lsARNParts[0], lsARNParts[1], s.nextID(16))
+}
+
+// applyListenerSettings validates the protocol, certificates and mutual authentication settings of listener,
+// and applies the defaults AWS applies to them.
+func (s *store) applyListenerSettings(lb *loadBalancer, ls *elbv2sdk.Listener, certificates []*elbv2sdk.Certificate) error {
+	protocol := awssdk.StringValue(ls.Protocol)
+	var isSecure bool
+	switch awssdk.StringValue(lb.Type) {
+	case elbv2sdk.LoadBalancerTypeEnumApplication:
+		if protocol != elbv2sdk.ProtocolEnumHttp && protocol != elbv2sdk.ProtocolEnumHttps {
+			return s.newAPIError(elbv2ErrCodeValidationError, 400, "Protocol '%v' is not supported by application load balancers", protocol)
+		}
+		isSecure = protocol == elbv2sdk.ProtocolEnumHttps
+	default:
+		if protocol != elbv2sdk.ProtocolEnumTcp && protocol != elbv2sdk.ProtocolEnumUdp &&
+			protocol != elbv2sdk.ProtocolEnumTcpUdp && protocol != elbv2sdk.ProtocolEnumTls {
+			return s.newAPIError(elbv2ErrCodeValidationError, 400, "Protocol '%v' is not supported by network load balancers", protocol)
+		}
+		isSecure = protocol == elbv2sdk.ProtocolEnumTls
+	}
+	if !isSecure {
+		ls.Certificates = nil
+		ls.SslPolicy = nil
+		ls.MutualAuthentication = nil
+		return nil
+	}
+	if len(certificates) == 0 {
+		return s.newAPIError(elbv2sdk.ErrCodeCertificateNotFoundException, 400, "A certificate must be specified for %v listeners", protocol)
+	}
+	certARN := awssdk.StringValue(certificates[0].CertificateArn)
+	if err := s.validateCertificate(certARN); err != nil {
+		return err
+	}
+	ls.Certificates = []*elbv2sdk.Certificate{{CertificateArn: awssdk.String(certARN)}}
+	if ls.SslPolicy == nil {
+		ls.SslPolicy = awssdk.String(defaultSSLPolicy)
+	}
+	if ls.MutualAuthentication != nil {
+		tsARN := awssdk.StringValue(ls.MutualAuthentication.TrustStoreArn)
+		if awssdk.StringValue(ls.MutualAuthentication.Mode) == "verify" {
+			if _, exists := s.trustStores[tsARN]; !exists {
+				return s.newAPIError(elbv2sdk.ErrCodeTrustStoreNotFoundException, 400, "The specified trust store '%v' doesn't exist", tsARN)
+			}
+			if ls.MutualAuthentication.IgnoreClientCertificateExpiry == nil {
+				ls.MutualAuthentication.IgnoreClientCertificateExpiry = awssdk.Bool(false)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCertificate validates that certARN refers to an existing ACM certificate.
+// Certificates from IAM are not emulated and always considered valid.
+func (s *store) validateCertificate(certARN string) error {
+	if !strings.HasPrefix(certARN, "arn:aws:acm:") {
+		return nil
+	}
+	if _, exists := s.certificates[certARN]; !exists {
+		return s.newAPIError(elbv2sdk.ErrCodeCertificateNotFoundException, 400, "Certificate '%v' not found", certARN)
+	}
+	return nil
+}
+
+// countLoadBalancerCertificates counts the certificates used by listeners of load balancer.
+func (s *store) countLoadBalancerCertificates(lbARN string) int {
+	count := 0
+	for _, ls := range s.listeners {
+		if awssdk.StringValue(ls.LoadBalancerArn) == lbARN {
+			count += len(ls.Certificates) + len(ls.extraCertificateARNs)
+		}
+	}
+	return count
+}
+
+// countLoadBalancerRules counts the rules of load balancer, excluding the default rules.
+func (s *store) countLoadBalancerRules(lbARN string) int {
+	count := 0
+	for _, lr := range s.rules {
+		if awssdk.BoolValue(lr.IsDefault) {
+			continue
+		}
+		if awssdk.StringValue(s.listeners[lr.listenerARN].LoadBalancerArn) == lbARN {
+			count++
+		}
+	}
+	return count
+}
+
+// validateRulePriority validates that priority is valid and not used by other rules of listener than excludedRuleARN.
+func (s *store) validateRulePriority(lsARN string, priority int64, excludedRuleARN string) error {
+	if priority < minRulePriority || priority > maxRulePriority {
+		return s.newAPIError(elbv2ErrCodeValidationError, 400, "Priority '%v' must be between %v and %v", priority, minRulePriority, maxRulePriority)
+	}
+	for lrARN, lr := range s.rules {
+		if lrARN == excludedRuleARN || lr.listenerARN != lsARN {
+			continue
+		}
+		if awssdk.StringValue(lr.Priority) == strconv.FormatInt(priority, 10) {
+			return s.newAPIError(elbv2sdk.ErrCodePriorityInUseException, 400, "Priority '%v' is currently in use", priority)
+		}
+	}
+	return nil
+}
+
+// buildActions validates actions, and normalizes them the way AWS reports them.
+func (s *store) buildActions(lb *loadBalancer, actions []*elbv2sdk.Action) ([]*elbv2sdk.Action, error) {
+	if len(actions) == 0 {
+		return nil, s.newAPIError(elbv2ErrCodeValidationError, 400, "At least one action must be specified")
+	}
+	lbARN := awssdk.StringValue(lb.LoadBalancerArn)
+	normalizedActions := make([]*elbv2sdk.Action, 0, len(actions))
+	for i, action := range actions {
+		action = copyOf(action).(*elbv2sdk.Action)
+		if action.Order == nil {
+			action.Order = awssdk.Int64(int64(i + 1))
+		}
+		switch awssdk.StringValue(action.Type) {
+		case elbv2sdk.ActionTypeEnumForward:
+			if action.ForwardConfig == nil {
+				action.ForwardConfig = &elbv2sdk.ForwardActionConfig{
+					TargetGroups: []*elbv2sdk.TargetGroupTuple{{TargetGroupArn: action.TargetGroupArn}},
+				}
+			}
+			for _, tgTuple := range action.ForwardConfig.TargetGroups {
+				if tgTuple.Weight == nil {
+					tgTuple.Weight = awssdk.Int64(1)
+				}
+			}
+			if action.ForwardConfig.TargetGroupStickinessConfig == nil {
+				action.ForwardConfig.TargetGroupStickinessConfig = &elbv2sdk.TargetGroupStickinessConfig{Enabled: awssdk.Bool(false)}
+			}
+			if len(action.ForwardConfig.TargetGroups) == 1 {
+				action.TargetGroupArn = action.ForwardConfig.TargetGroups[0].TargetGroupArn
+			} else {
+				action.TargetGroupArn = nil
+			}
+		case elbv2sdk.ActionTypeEnumRedirect:
+			if action.RedirectConfig == nil {
+				return nil, s.newAPIError(elbv2ErrCodeValidationError, 400, "A redirect configuration must be specified for redirect actions")
+			}
+			applyRedirectActionDefaults(action.RedirectConfig)
+		case elbv2sdk.ActionTypeEnumFixedResponse:
+			if action.FixedResponseConfig == nil {
+				return nil, s.newAPIError(elbv2ErrCodeValidationError, 400, "A fixed response configuration must be specified for fixed-response actions")
+			}
+		}
+		for _, tgARN := range findActionTargetGroupARNs([]*elbv2sdk.Action{action}) {
+			if _, err := s.findTargetGroup(tgARN); err != nil {
+				return nil, err
+			}
+			for _, otherLBARN := range s.findTargetGroupLoadBalancerARNs(tgARN) {
+				if otherLBARN != lbARN {
+					return nil, s.newAPIError(elbv2sdk.ErrCodeTargetGroupAssociationLimitException, 400, "The following target groups cannot be associated with more than one load balancer: '%v'", tgARN)
+				}
+			}
+		}
+		normalizedActions = append(normalizedActions, action)
+	}
+	return normalizedActions, nil
+}
+
+// findActionTargetGroupARNs finds the ARNs of target groups actions forward to.
+func findActionTargetGroupARNs(actions []*elbv2sdk.Action) []string {
+	tgARNs := sets.NewString()
+	for _, action := range actions {
+		if action.TargetGroupArn != nil {
+			tgARNs.Insert(awssdk.StringValue(action.TargetGroupArn))
+		}
+		if action.ForwardConfig != nil {
+			for _, tgTuple := range action.ForwardConfig.TargetGroups {
+				tgARNs.Insert(awssdk.StringValue(tgTuple.TargetGroupArn))
+			}
+		}
+	}
+	return tgARNs.List()
+}
+
+// applyRedirectActionDefaults applies the defaults AWS applies to redirect actions, which keep the original request components.
+func applyRedirectActionDefaults(redirectConfig *elbv2sdk.RedirectActionConfig) {
+	if redirectConfig.Host == nil {
+		redirectConfig.Host = awssdk.String("#{host}")
+	}
+	if redirectConfig.Path == nil {
+		redirectConfig.Path = awssdk.String("/#{path}")
+	}
+	if redirectConfig.Port == nil {
+		redirectConfig.Port = awssdk.String("#{port}")
+	}
+	if redirectConfig.Protocol == nil {
+		redirectConfig.Protocol = awssdk.String("#{protocol}")
+	}
+	if redirectConfig.Query == nil {
+		redirectConfig.Query = awssdk.String("#{query}")
+	}
+}
+
+// compareRulePriority compares rules by priority, the default rule is evaluated last.
+func compareRulePriority(lr *rule, otherLR *rule) bool {
+	if awssdk.BoolValue(lr.IsDefault) || awssdk.BoolValue(otherLR.IsDefault) {
+		return !awssdk.BoolValue(lr.IsDefault)
+	}
+	priority, _ := strconv.ParseInt(awssdk.StringValue(lr.Priority), 10, 64)
+	otherPriority, _ := strconv.ParseInt(awssdk.StringValue(otherLR.Priority), 10, 64)
+	return priority < otherPriority
+}
+
+// defaultListenerAttributes returns the default attributes of listeners with protocol.
+func defaultListenerAttributes(protocol string) map[string]string {
+	switch protocol {
+	case elbv2sdk.ProtocolEnumTcp, elbv2sdk.ProtocolEnumTcpUdp, elbv2sdk.ProtocolEnumTls:
+		return map[string]string{
+			"tcp.idle_timeout.seconds": "350",
+		}
+	}
+	return make(map[string]string)
+}
+
+func buildListenerAttributes(attributes map[string]string) []*services.ListenerAttribute {
+	var sdkAttributes []*services.ListenerAttribute
+	for _, key := range sets.StringKeySet(attributes).List() {
+		sdkAttributes = append(sdkAttributes, &services.ListenerAttribute{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(attributes[key]),
+		})
+	}
+	return sdkAttributes
+}
+
+// buildListenerCertificates builds the certificates of listener, the default certificate comes first.
+func buildListenerCertificates(defaultCertificates []*elbv2sdk.Certificate, extraCertificateARNs []string) []*elbv2sdk.Certificate {
+	var certificates []*elbv2sdk.Certificate
+	for _, cert := range defaultCertificates {
+		certificates = append(certificates, &elbv2sdk.Certificate{
+			CertificateArn: awssdk.String(awssdk.StringValue(cert.CertificateArn)),
+			IsDefault:      awssdk.Bool(true),
+		})
+	}
+	for _, certARN := range extraCertificateARNs {
+		certificates = append(certificates, &elbv2sdk.Certificate{
+			CertificateArn: awssdk.String(certARN),
+			IsDefault:      awssdk.Bool(false),
+		})
+	}
+	return certificates
+}
@@ -0,0 +1,341 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCloud constructs a fake Cloud with two subnets in different availability zones.
+func newTestCloud(t *testing.T, quotas Quotas) *Cloud {
+	cloud := NewCloud(Config{Quotas: quotas})
+	require.NoError(t, cloud.AddSubnet(&ec2sdk.Subnet{
+		SubnetId:           awssdk.String("subnet-a"),
+		CidrBlock:          awssdk.String("192.168.0.0/28"),
+		AvailabilityZone:   awssdk.String("us-west-2a"),
+		AvailabilityZoneId: awssdk.String("usw2-az1"),
+	}))
+	require.NoError(t, cloud.AddSubnet(&ec2sdk.Subnet{
+		SubnetId:           awssdk.String("subnet-b"),
+		CidrBlock:          awssdk.String("192.168.0.16/28"),
+		AvailabilityZone:   awssdk.String("us-west-2b"),
+		AvailabilityZoneId: awssdk.String("usw2-az2"),
+	}))
+	return cloud
+}
+
+func Test_fakeELBV2_CreateLoadBalancerWithContext(t *testing.T) {
+	tests := []struct {
+		name        string
+		quotas      Quotas
+		inputs      []*elbv2sdk.CreateLoadBalancerInput
+		wantErrCode string
+	}{
+		{
+			name: "application load balancer created",
+			inputs: []*elbv2sdk.CreateLoadBalancerInput{
+				{Name: awssdk.String("awesome-lb"), Subnets: awssdk.StringSlice([]string{"subnet-a", "subnet-b"})},
+			},
+		},
+		{
+			name: "application load balancer requires two availability zones",
+			inputs: []*elbv2sdk.CreateLoadBalancerInput{
+				{Name: awssdk.String("awesome-lb"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+			},
+			wantErrCode: "ValidationError",
+		},
+		{
+			name: "network load balancer with one availability zone",
+			inputs: []*elbv2sdk.CreateLoadBalancerInput{
+				{Name: awssdk.String("awesome-lb"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+			},
+		},
+		{
+			name: "subnet not found",
+			inputs: []*elbv2sdk.CreateLoadBalancerInput{
+				{Name: awssdk.String("awesome-lb"), Subnets: awssdk.StringSlice([]string{"subnet-a", "subnet-c"})},
+			},
+			wantErrCode: elbv2sdk.ErrCodeSubnetNotFoundException,
+		},
+		{
+			name: "security group not found",
+			inputs: []*elbv2sdk.CreateLoadBalancerInput{
+				{Name: awssdk.String("awesome-lb"), Subnets: awssdk.StringSlice([]string{"subnet-a", "subnet-b"}), SecurityGroups: awssdk.StringSlice([]string{"sg-unknown"})},
+			},
+			wantErrCode: elbv2sdk.ErrCodeInvalidSecurityGroupException,
+		},
+		{
+			name: "load balancer name is duplicated",
+			inputs: []*elbv2sdk.CreateLoadBalancerInput{
+				{Name: awssdk.String("awesome-lb"), Subnets: awssdk.StringSlice([]string{"subnet-a", "subnet-b"})},
+				{Name: awssdk.String("awesome-lb"), Subnets: awssdk.StringSlice([]string{"subnet-a", "subnet-b"})},
+			},
+			wantErrCode: elbv2sdk.ErrCodeDuplicateLoadBalancerNameException,
+		},
+		{
+			name:   "load balancer quota reached",
+			quotas: Quotas{LoadBalancers: 1},
+			inputs: []*elbv2sdk.CreateLoadBalancerInput{
+				{Name: awssdk.String("awesome-lb-1"), Subnets: awssdk.StringSlice([]string{"subnet-a", "subnet-b"})},
+				{Name: awssdk.String("awesome-lb-2"), Subnets: awssdk.StringSlice([]string{"subnet-a", "subnet-b"})},
+			},
+			wantErrCode: elbv2sdk.ErrCodeTooManyLoadBalancersException,
+		},
+		{
+			name: "subnet runs out of addresses",
+			inputs: []*elbv2sdk.CreateLoadBalancerInput{
+				{Name: awssdk.String("awesome-lb-1"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+				{Name: awssdk.String("awesome-lb-2"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+				{Name: awssdk.String("awesome-lb-3"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+				{Name: awssdk.String("awesome-lb-4"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+				{Name: awssdk.String("awesome-lb-5"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+				{Name: awssdk.String("awesome-lb-6"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+				{Name: awssdk.String("awesome-lb-7"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+				{Name: awssdk.String("awesome-lb-8"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+				{Name: awssdk.String("awesome-lb-9"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+				{Name: awssdk.String("awesome-lb-10"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+				{Name: awssdk.String("awesome-lb-11"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+				{Name: awssdk.String("awesome-lb-12"), Type: awssdk.String("network"), Subnets: awssdk.StringSlice([]string{"subnet-a"})},
+			},
+			wantErrCode: "InsufficientFreeAddressesInSubnet",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cloud := newTestCloud(t, tt.quotas)
+			var err error
+			var resp *elbv2sdk.CreateLoadBalancerOutput
+			for _, input := range tt.inputs {
+				resp, err = cloud.ELBV2().CreateLoadBalancerWithContext(context.Background(), input)
+				if err != nil {
+					break
+				}
+			}
+			if tt.wantErrCode != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErrCode, err.(awserr.Error).Code())
+				return
+			}
+			require.NoError(t, err)
+			lb := resp.LoadBalancers[0]
+			assert.Equal(t, elbv2sdk.LoadBalancerStateEnumActive, awssdk.StringValue(lb.State.Code))
+			assert.Len(t, lb.AvailabilityZones, len(tt.inputs[len(tt.inputs)-1].Subnets))
+
+			enis, err := cloud.EC2().DescribeNetworkInterfacesAsList(context.Background(), &ec2sdk.DescribeNetworkInterfacesInput{
+				Filters: []*ec2sdk.Filter{{Name: awssdk.String("description"), Values: awssdk.StringSlice([]string{"ELB *"})}},
+			})
+			require.NoError(t, err)
+			assert.Len(t, enis, len(lb.AvailabilityZones))
+		})
+	}
+}
+
+func Test_fakeELBV2_listenersAndRules(t *testing.T) {
+	ctx := context.Background()
+	cloud := newTestCloud(t, Quotas{RulesPerLoadBalancer: 2})
+	lbResp, err := cloud.ELBV2().CreateLoadBalancerWithContext(ctx, &elbv2sdk.CreateLoadBalancerInput{
+		Name:    awssdk.String("awesome-lb"),
+		Subnets: awssdk.StringSlice([]string{"subnet-a", "subnet-b"}),
+	})
+	require.NoError(t, err)
+	lbARN := lbResp.LoadBalancers[0].LoadBalancerArn
+	tgResp, err := cloud.ELBV2().CreateTargetGroupWithContext(ctx, &elbv2sdk.CreateTargetGroupInput{
+		Name:       awssdk.String("awesome-tg"),
+		TargetType: awssdk.String(elbv2sdk.TargetTypeEnumIp),
+		Protocol:   awssdk.String(elbv2sdk.ProtocolEnumHttp),
+		Port:       awssdk.Int64(8080),
+		VpcId:      awssdk.String(defaultVpcID),
+	})
+	require.NoError(t, err)
+	tgARN := tgResp.TargetGroups[0].TargetGroupArn
+	assert.Equal(t, "traffic-port", awssdk.StringValue(tgResp.TargetGroups[0].HealthCheckPort))
+	assert.Equal(t, "200", awssdk.StringValue(tgResp.TargetGroups[0].Matcher.HttpCode))
+
+	_, err = cloud.ELBV2().CreateListenerWithContext(ctx, &elbv2sdk.CreateListenerInput{
+		LoadBalancerArn: lbARN,
+		Port:            awssdk.Int64(443),
+		Protocol:        awssdk.String(elbv2sdk.ProtocolEnumHttps),
+		Certificates:    []*elbv2sdk.Certificate{{CertificateArn: awssdk.String("arn:aws:acm:us-west-2:123456789012:certificate/unknown")}},
+		DefaultActions:  []*elbv2sdk.Action{{Type: awssdk.String(elbv2sdk.ActionTypeEnumForward), TargetGroupArn: tgARN}},
+	})
+	require.Error(t, err)
+	assert.Equal(t, elbv2sdk.ErrCodeCertificateNotFoundException, err.(awserr.Error).Code())
+
+	lsResp, err := cloud.ELBV2().CreateListenerWithContext(ctx, &elbv2sdk.CreateListenerInput{
+		LoadBalancerArn: lbARN,
+		Port:            awssdk.Int64(80),
+		Protocol:        awssdk.String(elbv2sdk.ProtocolEnumHttp),
+		DefaultActions:  []*elbv2sdk.Action{{Type: awssdk.String(elbv2sdk.ActionTypeEnumForward), TargetGroupArn: tgARN}},
+	})
+	require.NoError(t, err)
+	lsARN := lsResp.Listeners[0].ListenerArn
+	assert.Equal(t, []*elbv2sdk.Action{
+		{
+			Type:           awssdk.String(elbv2sdk.ActionTypeEnumForward),
+			Order:          awssdk.Int64(1),
+			TargetGroupArn: tgARN,
+			ForwardConfig: &elbv2sdk.ForwardActionConfig{
+				TargetGroups:                []*elbv2sdk.TargetGroupTuple{{TargetGroupArn: tgARN, Weight: awssdk.Int64(1)}},
+				TargetGroupStickinessConfig: &elbv2sdk.TargetGroupStickinessConfig{Enabled: awssdk.Bool(false)},
+			},
+		},
+	}, lsResp.Listeners[0].DefaultActions)
+
+	_, err = cloud.ELBV2().CreateListenerWithContext(ctx, &elbv2sdk.CreateListenerInput{
+		LoadBalancerArn: lbARN,
+		Port:            awssdk.Int64(80),
+		Protocol:        awssdk.String(elbv2sdk.ProtocolEnumHttp),
+		DefaultActions:  []*elbv2sdk.Action{{Type: awssdk.String(elbv2sdk.ActionTypeEnumForward), TargetGroupArn: tgARN}},
+	})
+	require.Error(t, err)
+	assert.Equal(t, elbv2sdk.ErrCodeDuplicateListenerException, err.(awserr.Error).Code())
+
+	createRule := func(priority int64) error {
+		_, err := cloud.ELBV2().CreateRuleWithContext(ctx, &elbv2sdk.CreateRuleInput{
+			ListenerArn: lsARN,
+			Priority:    awssdk.Int64(priority),
+			Conditions: []*elbv2sdk.RuleCondition{
+				{
+					Field:             awssdk.String("path-pattern"),
+					PathPatternConfig: &elbv2sdk.PathPatternConditionConfig{Values: awssdk.StringSlice([]string{fmt.Sprintf("/%v", priority)})},
+				},
+			},
+			Actions: []*elbv2sdk.Action{{Type: awssdk.String(elbv2sdk.ActionTypeEnumForward), TargetGroupArn: tgARN}},
+		})
+		return err
+	}
+	require.NoError(t, createRule(10))
+	require.NoError(t, createRule(5))
+	err = createRule(5)
+	require.Error(t, err)
+	assert.Equal(t, elbv2sdk.ErrCodePriorityInUseException, err.(awserr.Error).Code())
+	err = createRule(20)
+	require.Error(t, err)
+	assert.Equal(t, elbv2sdk.ErrCodeTooManyRulesException, err.(awserr.Error).Code())
+
+	rules, err := cloud.ELBV2().DescribeRulesAsList(ctx, &elbv2sdk.DescribeRulesInput{ListenerArn: lsARN})
+	require.NoError(t, err)
+	var gotPriorities []string
+	for _, rule := range rules {
+		gotPriorities = append(gotPriorities, awssdk.StringValue(rule.Priority))
+	}
+	assert.Equal(t, []string{"5", "10", "default"}, gotPriorities)
+
+	_, err = cloud.ELBV2().DeleteRuleWithContext(ctx, &elbv2sdk.DeleteRuleInput{RuleArn: rules[2].RuleArn})
+	require.Error(t, err)
+	assert.Equal(t, elbv2sdk.ErrCodeOperationNotPermittedException, err.(awserr.Error).Code())
+
+	_, err = cloud.ELBV2().DeleteTargetGroupWithContext(ctx, &elbv2sdk.DeleteTargetGroupInput{TargetGroupArn: tgARN})
+	require.Error(t, err)
+	assert.Equal(t, elbv2sdk.ErrCodeResourceInUseException, err.(awserr.Error).Code())
+
+	_, err = cloud.ELBV2().DeleteLoadBalancerWithContext(ctx, &elbv2sdk.DeleteLoadBalancerInput{LoadBalancerArn: lbARN})
+	require.NoError(t, err)
+	_, err = cloud.ELBV2().DescribeRulesAsList(ctx, &elbv2sdk.DescribeRulesInput{ListenerArn: lsARN})
+	require.Error(t, err)
+	assert.Equal(t, elbv2sdk.ErrCodeListenerNotFoundException, err.(awserr.Error).Code())
+	_, err = cloud.ELBV2().DeleteTargetGroupWithContext(ctx, &elbv2sdk.DeleteTargetGroupInput{TargetGroupArn: tgARN})
+	require.NoError(t, err)
+}
+
+func Test_fakeELBV2_targets(t *testing.T) {
+	ctx := context.Background()
+	cloud := newTestCloud(t, Quotas{TargetsPerTargetGroup: 2})
+	tgResp, err := cloud.ELBV2().CreateTargetGroupWithContext(ctx, &elbv2sdk.CreateTargetGroupInput{
+		Name:       awssdk.String("awesome-tg"),
+		TargetType: awssdk.String(elbv2sdk.TargetTypeEnumIp),
+		Protocol:   awssdk.String(elbv2sdk.ProtocolEnumTcp),
+		Port:       awssdk.Int64(8080),
+		VpcId:      awssdk.String(defaultVpcID),
+	})
+	require.NoError(t, err)
+	tgARN := tgResp.TargetGroups[0].TargetGroupArn
+	register := func(ips ...string) error {
+		var targets []*elbv2sdk.TargetDescription
+		for _, ip := range ips {
+			targets = append(targets, &elbv2sdk.TargetDescription{Id: awssdk.String(ip)})
+		}
+		_, err := cloud.ELBV2().RegisterTargetsWithContext(ctx, &elbv2sdk.RegisterTargetsInput{TargetGroupArn: tgARN, Targets: targets})
+		return err
+	}
+
+	err = register("10.0.0.1")
+	require.Error(t, err)
+	assert.Equal(t, elbv2sdk.ErrCodeInvalidTargetException, err.(awserr.Error).Code())
+	require.NoError(t, register("192.168.0.5", "192.168.0.6"))
+	require.NoError(t, register("192.168.0.5"))
+	err = register("192.168.0.7")
+	require.Error(t, err)
+	assert.Equal(t, elbv2sdk.ErrCodeTooManyTargetsException, err.(awserr.Error).Code())
+
+	require.NoError(t, cloud.SetTargetHealth(awssdk.StringValue(tgARN), &elbv2sdk.TargetDescription{Id: awssdk.String("192.168.0.6")}, &elbv2sdk.TargetHealth{
+		State:  awssdk.String(elbv2sdk.TargetHealthStateEnumUnhealthy),
+		Reason: awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetFailedHealthChecks),
+	}))
+	healthResp, err := cloud.ELBV2().DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{TargetGroupArn: tgARN})
+	require.NoError(t, err)
+	require.Len(t, healthResp.TargetHealthDescriptions, 2)
+	assert.Equal(t, elbv2sdk.TargetHealthReasonEnumTargetNotInUse, awssdk.StringValue(healthResp.TargetHealthDescriptions[0].TargetHealth.Reason))
+	assert.Equal(t, "8080", awssdk.StringValue(healthResp.TargetHealthDescriptions[0].HealthCheckPort))
+	assert.Equal(t, elbv2sdk.TargetHealthStateEnumUnhealthy, awssdk.StringValue(healthResp.TargetHealthDescriptions[1].TargetHealth.State))
+
+	_, err = cloud.ELBV2().DeregisterTargetsWithContext(ctx, &elbv2sdk.DeregisterTargetsInput{
+		TargetGroupArn: tgARN,
+		Targets:        []*elbv2sdk.TargetDescription{{Id: awssdk.String("192.168.0.7")}},
+	})
+	require.Error(t, err)
+	assert.Equal(t, elbv2sdk.ErrCodeInvalidTargetException, err.(awserr.Error).Code())
+}
+
+func Test_fakeELBV2_tags(t *testing.T) {
+	ctx := context.Background()
+	cloud := newTestCloud(t, Quotas{TagsPerResource: 2})
+	lbResp, err := cloud.ELBV2().CreateLoadBalancerWithContext(ctx, &elbv2sdk.CreateLoadBalancerInput{
+		Name:    awssdk.String("awesome-lb"),
+		Subnets: awssdk.StringSlice([]string{"subnet-a", "subnet-b"}),
+		Tags:    []*elbv2sdk.Tag{{Key: awssdk.String("k1"), Value: awssdk.String("v1")}},
+	})
+	require.NoError(t, err)
+	lbARN := lbResp.LoadBalancers[0].LoadBalancerArn
+
+	_, err = cloud.ELBV2().AddTagsWithContext(ctx, &elbv2sdk.AddTagsInput{
+		ResourceArns: []*string{lbARN},
+		Tags:         []*elbv2sdk.Tag{{Key: awssdk.String("k1"), Value: awssdk.String("v1-updated")}, {Key: awssdk.String("k2"), Value: awssdk.String("v2")}},
+	})
+	require.NoError(t, err)
+	_, err = cloud.ELBV2().AddTagsWithContext(ctx, &elbv2sdk.AddTagsInput{
+		ResourceArns: []*string{lbARN},
+		Tags:         []*elbv2sdk.Tag{{Key: awssdk.String("k3"), Value: awssdk.String("v3")}},
+	})
+	require.Error(t, err)
+	assert.Equal(t, elbv2sdk.ErrCodeTooManyTagsException, err.(awserr.Error).Code())
+	_, err = cloud.ELBV2().RemoveTagsWithContext(ctx, &elbv2sdk.RemoveTagsInput{
+		ResourceArns: []*string{lbARN},
+		TagKeys:      awssdk.StringSlice([]string{"k2"}),
+	})
+	require.NoError(t, err)
+
+	tagsResp, err := cloud.ELBV2().DescribeTagsWithContext(ctx, &elbv2sdk.DescribeTagsInput{ResourceArns: []*string{lbARN}})
+	require.NoError(t, err)
+	assert.Equal(t, []*elbv2sdk.TagDescription{
+		{
+			ResourceArn: lbARN,
+			Tags:        []*elbv2sdk.Tag{{Key: awssdk.String("k1"), Value: awssdk.String("v1-updated")}},
+		},
+	}, tagsResp.TagDescriptions)
+
+	var resourceARNs []*string
+	for i := 0; i < 21; i++ {
+		resourceARNs = append(resourceARNs, lbARN)
+	}
+	_, err = cloud.ELBV2().DescribeTagsWithContext(ctx, &elbv2sdk.DescribeTagsInput{ResourceArns: resourceARNs})
+	require.Error(t, err)
+	assert.Equal(t, "ValidationError", err.(awserr.Error).Code())
+}
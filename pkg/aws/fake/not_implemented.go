@@ -0,0 +1,38 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	// errCodeNotImplemented is the error code for APIs that are not implemented by fake Cloud.
+	errCodeNotImplemented = "NotImplemented"
+)
+
+// newNotImplementedSession constructs a session whose requests fail with NotImplemented error before being sent.
+// The fake services embed the real service clients constructed with this session, so that the APIs they don't implement
+// return an AWS error instead of panicking on a nil interface.
+func newNotImplementedSession(region string) *session.Session {
+	cfg := defaults.Config().
+		WithRegion(region).
+		WithCredentials(credentials.AnonymousCredentials).
+		WithDisableParamValidation(true).
+		WithMaxRetries(0)
+	sess := &session.Session{
+		Config:   cfg,
+		Handlers: request.Handlers{},
+	}
+	sess.Handlers.Validate.PushBack(func(r *request.Request) {
+		r.Error = awserr.NewRequestFailure(
+			awserr.New(errCodeNotImplemented, fmt.Sprintf("%v is not implemented by fake Cloud", r.Operation.Name), nil),
+			http.StatusNotImplemented, "")
+	})
+	return sess
+}
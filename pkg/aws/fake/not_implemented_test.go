@@ -0,0 +1,76 @@
+package fake
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	acmsdk "github.com/aws/aws-sdk-go/service/acm"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	wafregionalsdk "github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Cloud_notImplementedAPIs(t *testing.T) {
+	cloud := NewCloud(Config{})
+	tests := []struct {
+		name       string
+		invoke     func(ctx context.Context) error
+		wantErrMsg string
+	}{
+		{
+			name: "ELBV2 API",
+			invoke: func(ctx context.Context) error {
+				_, err := cloud.ELBV2().DescribeAccountLimitsWithContext(ctx, &elbv2sdk.DescribeAccountLimitsInput{})
+				return err
+			},
+			wantErrMsg: "DescribeAccountLimits is not implemented by fake Cloud",
+		},
+		{
+			name: "ELBV2 API without context",
+			invoke: func(ctx context.Context) error {
+				_, err := cloud.ELBV2().DeleteLoadBalancer(&elbv2sdk.DeleteLoadBalancerInput{})
+				return err
+			},
+			wantErrMsg: "DeleteLoadBalancer is not implemented by fake Cloud",
+		},
+		{
+			name: "EC2 API with invalid input",
+			invoke: func(ctx context.Context) error {
+				_, err := cloud.EC2().DescribeRouteTablesWithContext(ctx, &ec2sdk.DescribeRouteTablesInput{MaxResults: awssdk.Int64(-1)})
+				return err
+			},
+			wantErrMsg: "DescribeRouteTables is not implemented by fake Cloud",
+		},
+		{
+			name: "ACM API",
+			invoke: func(ctx context.Context) error {
+				_, err := cloud.ACM().RequestCertificateWithContext(ctx, &acmsdk.RequestCertificateInput{})
+				return err
+			},
+			wantErrMsg: "RequestCertificate is not implemented by fake Cloud",
+		},
+		{
+			name: "WAFRegional API",
+			invoke: func(ctx context.Context) error {
+				_, err := cloud.WAFRegional().GetWebACLForResourceWithContext(ctx, &wafregionalsdk.GetWebACLForResourceInput{})
+				return err
+			},
+			wantErrMsg: "GetWebACLForResource is not implemented by fake Cloud",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.invoke(context.Background())
+			var reqErr awserr.RequestFailure
+			if assert.ErrorAs(t, err, &reqErr) {
+				assert.Equal(t, errCodeNotImplemented, reqErr.Code())
+				assert.Equal(t, tt.wantErrMsg, reqErr.Message())
+				assert.Equal(t, http.StatusNotImplemented, reqErr.StatusCode())
+			}
+		})
+	}
+}
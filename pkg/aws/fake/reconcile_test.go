@@ -0,0 +1,317 @@
+package fake_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	ingresscontroller "sigs.k8s.io/aws-load-balancer-controller/controllers/ingress"
+	servicecontroller "sigs.k8s.io/aws-load-balancer-controller/controllers/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/fake"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/shard"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+const (
+	reconcileTimeout  = 30 * time.Second
+	reconcileInterval = 200 * time.Millisecond
+)
+
+// Test_Cloud_reconcileIngressAndService reconciles an Ingress and a Service end to end,
+// with the controllers running against an envtest API server and the fake Cloud.
+// It's skipped unless KUBEBUILDER_ASSETS points to the envtest binaries, e.g. as set up by setup-envtest.
+func Test_Cloud_reconcileIngressAndService(t *testing.T) {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS must be set to run envtest based tests")
+	}
+	ctrl.SetLogger(zap.New(zap.UseDevMode(true), zap.WriteTo(os.Stderr)))
+
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+	}
+	restCFG, err := testEnv.Start()
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, testEnv.Stop())
+	}()
+
+	cloud := fake.NewCloud(fake.Config{})
+	for _, subnet := range []*ec2sdk.Subnet{
+		{
+			SubnetId:           awssdk.String("subnet-a"),
+			CidrBlock:          awssdk.String("192.168.0.0/24"),
+			AvailabilityZone:   awssdk.String("us-west-2a"),
+			AvailabilityZoneId: awssdk.String("usw2-az1"),
+		},
+		{
+			SubnetId:           awssdk.String("subnet-b"),
+			CidrBlock:          awssdk.String("192.168.1.0/24"),
+			AvailabilityZone:   awssdk.String("us-west-2b"),
+			AvailabilityZoneId: awssdk.String("usw2-az2"),
+		},
+	} {
+		require.NoError(t, cloud.AddSubnet(subnet))
+	}
+
+	controllerCFG := config.ControllerConfig{
+		FeatureGates: config.NewFeatureGates(),
+	}
+	fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+	controllerCFG.BindFlags(fs)
+	require.NoError(t, fs.Parse([]string{"--cluster-name=awesome-cluster"}))
+	require.NoError(t, controllerCFG.Validate())
+
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, elbv2api.AddToScheme(scheme))
+	mgr, err := ctrl.NewManager(restCFG, ctrl.Options{
+		Scheme:                 scheme,
+		MetricsBindAddress:     "0",
+		HealthProbeBindAddress: "0",
+	})
+	require.NoError(t, err)
+	clientSet, err := kubernetes.NewForConfig(restCFG)
+	require.NoError(t, err)
+
+	finalizerManager := k8s.NewDefaultFinalizerManager(mgr.GetClient(), ctrl.Log)
+	sgManager := networkingpkg.NewDefaultSecurityGroupManager(cloud.EC2(), ctrl.Log)
+	sgReconciler := networkingpkg.NewDefaultSecurityGroupReconciler(sgManager, ctrl.Log)
+	azInfoProvider := networkingpkg.NewDefaultAZInfoProvider(cloud.EC2(), ctrl.Log.WithName("az-info-provider"))
+	vpcInfoProvider := networkingpkg.NewDefaultVPCInfoProvider(cloud.EC2(), ctrl.Log.WithName("vpc-info-provider"))
+	subnetResolver := networkingpkg.NewDefaultSubnetsResolver(azInfoProvider, cloud.EC2(), cloud.VpcID(), controllerCFG.ClusterName, ctrl.Log.WithName("subnets-resolver"))
+	backendSGProvider := networkingpkg.NewBackendSGProvider(controllerCFG.ClusterName, controllerCFG.BackendSecurityGroup,
+		cloud.VpcID(), cloud.EC2(), mgr.GetClient(), controllerCFG.DefaultTags, ctrl.Log.WithName("backend-sg-provider"))
+	shardManager := shard.NewUnshardedManager()
+	ingGroupReconciler := ingresscontroller.NewGroupReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("ingress"),
+		finalizerManager, sgManager, sgReconciler, subnetResolver,
+		controllerCFG, backendSGProvider, shardManager, ctrl.Log.WithName("controllers").WithName("ingress"))
+	svcReconciler := servicecontroller.NewServiceReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("service"),
+		finalizerManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider,
+		controllerCFG, backendSGProvider, shardManager, ctrl.Log.WithName("controllers").WithName("service"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, ingGroupReconciler.SetupWithManager(ctx, mgr, clientSet))
+	require.NoError(t, svcReconciler.SetupWithManager(ctx, mgr))
+	mgrStopped := make(chan struct{})
+	go func() {
+		defer close(mgrStopped)
+		assert.NoError(t, mgr.Start(ctx))
+	}()
+	defer func() {
+		cancel()
+		<-mgrStopped
+	}()
+
+	k8sClient, err := client.New(restCFG, client.Options{Scheme: scheme})
+	require.NoError(t, err)
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "awesome-ns"}}
+	require.NoError(t, k8sClient.Create(ctx, ns))
+
+	t.Run("ingress", func(t *testing.T) {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "awesome-svc",
+			},
+			Spec: corev1.ServiceSpec{
+				Type:     corev1.ServiceTypeClusterIP,
+				Selector: map[string]string{"app": "awesome"},
+				Ports: []corev1.ServicePort{
+					{
+						Name:       "http",
+						Port:       80,
+						TargetPort: intstr.FromInt(8080),
+						Protocol:   corev1.ProtocolTCP,
+					},
+				},
+			},
+		}
+		require.NoError(t, k8sClient.Create(ctx, svc))
+		pathTypePrefix := networking.PathTypePrefix
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "awesome-ing",
+				Annotations: map[string]string{
+					"kubernetes.io/ingress.class":                "alb",
+					"alb.ingress.kubernetes.io/target-type":      "ip",
+					"alb.ingress.kubernetes.io/subnets":          "subnet-a,subnet-b",
+					"alb.ingress.kubernetes.io/listen-ports":     `[{"HTTP": 80}]`,
+					"alb.ingress.kubernetes.io/healthcheck-path": "/healthz",
+				},
+			},
+			Spec: networking.IngressSpec{
+				Rules: []networking.IngressRule{
+					{
+						Host: "www.example.com",
+						IngressRuleValue: networking.IngressRuleValue{
+							HTTP: &networking.HTTPIngressRuleValue{
+								Paths: []networking.HTTPIngressPath{
+									{
+										Path:     "/api",
+										PathType: &pathTypePrefix,
+										Backend: networking.IngressBackend{
+											Service: &networking.IngressServiceBackend{
+												Name: "awesome-svc",
+												Port: networking.ServiceBackendPort{Name: "http"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		require.NoError(t, k8sClient.Create(ctx, ing))
+
+		var lbHostname string
+		require.NoError(t, waitFor(func() (bool, error) {
+			if err := k8sClient.Get(ctx, k8s.NamespacedName(ing), ing); err != nil {
+				return false, err
+			}
+			if len(ing.Status.LoadBalancer.Ingress) == 0 {
+				return false, nil
+			}
+			lbHostname = ing.Status.LoadBalancer.Ingress[0].Hostname
+			return true, nil
+		}))
+		lb := findLoadBalancerByDNSName(ctx, t, cloud, lbHostname)
+		require.NotNil(t, lb)
+		assert.Equal(t, elbv2sdk.LoadBalancerTypeEnumApplication, awssdk.StringValue(lb.Type))
+		assert.Equal(t, elbv2sdk.LoadBalancerSchemeEnumInternal, awssdk.StringValue(lb.Scheme))
+		assert.Len(t, lb.AvailabilityZones, 2)
+
+		listeners, err := cloud.ELBV2().DescribeListenersAsList(ctx, &elbv2sdk.DescribeListenersInput{LoadBalancerArn: lb.LoadBalancerArn})
+		require.NoError(t, err)
+		require.Len(t, listeners, 1)
+		assert.Equal(t, int64(80), awssdk.Int64Value(listeners[0].Port))
+		rules, err := cloud.ELBV2().DescribeRulesAsList(ctx, &elbv2sdk.DescribeRulesInput{ListenerArn: listeners[0].ListenerArn})
+		require.NoError(t, err)
+		// the rule for the Ingress path, and the default rule.
+		assert.Len(t, rules, 2)
+
+		tgbList := &elbv2api.TargetGroupBindingList{}
+		require.NoError(t, k8sClient.List(ctx, tgbList, client.InNamespace("awesome-ns")))
+		require.Len(t, tgbList.Items, 1)
+		assert.Equal(t, "awesome-svc", tgbList.Items[0].Spec.ServiceRef.Name)
+		tgs, err := cloud.ELBV2().DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+			TargetGroupArns: []*string{awssdk.String(tgbList.Items[0].Spec.TargetGroupARN)},
+		})
+		require.NoError(t, err)
+		require.Len(t, tgs, 1)
+		assert.Equal(t, elbv2sdk.TargetTypeEnumIp, awssdk.StringValue(tgs[0].TargetType))
+		assert.Equal(t, "/healthz", awssdk.StringValue(tgs[0].HealthCheckPath))
+
+		require.NoError(t, k8sClient.Delete(ctx, ing))
+		require.NoError(t, waitFor(func() (bool, error) {
+			if err := k8sClient.Get(ctx, k8s.NamespacedName(ing), ing); err != nil {
+				return apierrors.IsNotFound(err), client.IgnoreNotFound(err)
+			}
+			return false, nil
+		}))
+		assert.Nil(t, findLoadBalancerByDNSName(ctx, t, cloud, lbHostname))
+	})
+
+	t.Run("service", func(t *testing.T) {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "awesome-nlb",
+				Annotations: map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+					"service.beta.kubernetes.io/aws-load-balancer-subnets":         "subnet-a,subnet-b",
+				},
+			},
+			Spec: corev1.ServiceSpec{
+				Type:              corev1.ServiceTypeLoadBalancer,
+				LoadBalancerClass: awssdk.String(controllerCFG.ServiceConfig.LoadBalancerClass),
+				Selector:          map[string]string{"app": "awesome"},
+				Ports: []corev1.ServicePort{
+					{
+						Name:       "tcp",
+						Port:       80,
+						TargetPort: intstr.FromInt(8080),
+						Protocol:   corev1.ProtocolTCP,
+					},
+				},
+			},
+		}
+		require.NoError(t, k8sClient.Create(ctx, svc))
+
+		var lbHostname string
+		require.NoError(t, waitFor(func() (bool, error) {
+			if err := k8sClient.Get(ctx, k8s.NamespacedName(svc), svc); err != nil {
+				return false, err
+			}
+			if len(svc.Status.LoadBalancer.Ingress) == 0 {
+				return false, nil
+			}
+			lbHostname = svc.Status.LoadBalancer.Ingress[0].Hostname
+			return true, nil
+		}))
+		lb := findLoadBalancerByDNSName(ctx, t, cloud, lbHostname)
+		require.NotNil(t, lb)
+		assert.Equal(t, elbv2sdk.LoadBalancerTypeEnumNetwork, awssdk.StringValue(lb.Type))
+		assert.Len(t, lb.AvailabilityZones, 2)
+
+		listeners, err := cloud.ELBV2().DescribeListenersAsList(ctx, &elbv2sdk.DescribeListenersInput{LoadBalancerArn: lb.LoadBalancerArn})
+		require.NoError(t, err)
+		require.Len(t, listeners, 1)
+		assert.Equal(t, elbv2sdk.ProtocolEnumTcp, awssdk.StringValue(listeners[0].Protocol))
+		assert.Equal(t, int64(80), awssdk.Int64Value(listeners[0].Port))
+
+		require.NoError(t, k8sClient.Delete(ctx, svc))
+		require.NoError(t, waitFor(func() (bool, error) {
+			if err := k8sClient.Get(ctx, k8s.NamespacedName(svc), svc); err != nil {
+				return apierrors.IsNotFound(err), client.IgnoreNotFound(err)
+			}
+			return false, nil
+		}))
+		assert.Nil(t, findLoadBalancerByDNSName(ctx, t, cloud, lbHostname))
+	})
+}
+
+// waitFor polls condition until it's met or reconcileTimeout elapses.
+func waitFor(condition wait.ConditionFunc) error {
+	return wait.PollImmediate(reconcileInterval, reconcileTimeout, condition)
+}
+
+// findLoadBalancerByDNSName finds the load balancer in fake Cloud by its DNS name, it returns nil if not found.
+func findLoadBalancerByDNSName(ctx context.Context, t *testing.T, cloud *fake.Cloud, dnsName string) *elbv2sdk.LoadBalancer {
+	lbs, err := cloud.ELBV2().DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{})
+	require.NoError(t, err)
+	for _, lb := range lbs {
+		if awssdk.StringValue(lb.DNSName) == dnsName {
+			return lb
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package fake
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+var _ services.RGT = &fakeRGT{}
+
+// fakeRGT serves the GetResources API for ELBV2 resources only, other Resource Groups Tagging APIs are not invoked by the controller.
+type fakeRGT struct {
+	services.RGT
+
+	store *store
+}
+
+func (c *fakeRGT) GetResourcesAsList(_ context.Context, input *rgtsdk.GetResourcesInput) ([]*rgtsdk.ResourceTagMapping, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	for _, resourceType := range awssdk.StringValueSlice(input.ResourceTypeFilters) {
+		if !strings.HasPrefix(resourceType, "elasticloadbalancing:") {
+			return nil, c.store.newAPIError(rgtsdk.ErrCodeInvalidParameterException, 400, "Resource type '%v' is not supported by fake Cloud", resourceType)
+		}
+	}
+
+	tagsByARN := make(map[string][]*elbv2sdk.Tag)
+	for lbARN, lb := range c.store.loadBalancers {
+		tagsByARN[lbARN] = lb.tags
+	}
+	for tgARN, tg := range c.store.targetGroups {
+		tagsByARN[tgARN] = tg.tags
+	}
+	for lsARN, ls := range c.store.listeners {
+		tagsByARN[lsARN] = ls.tags
+	}
+	for lrARN, lr := range c.store.rules {
+		tagsByARN[lrARN] = lr.tags
+	}
+	for tsARN, ts := range c.store.trustStores {
+		tagsByARN[tsARN] = ts.tags
+	}
+	arns := make([]string, 0, len(tagsByARN))
+	for arn := range tagsByARN {
+		arns = append(arns, arn)
+	}
+	sort.Strings(arns)
+
+	var result []*rgtsdk.ResourceTagMapping
+	for _, arn := range arns {
+		tags := tagsByARN[arn]
+		// AWS only returns resources that have tags.
+		if len(tags) == 0 {
+			continue
+		}
+		if !matchesRGTResourceTypeFilters(arn, awssdk.StringValueSlice(input.ResourceTypeFilters)) ||
+			!matchesRGTTagFilters(tags, input.TagFilters) {
+			continue
+		}
+		resource := &rgtsdk.ResourceTagMapping{
+			ResourceARN: awssdk.String(arn),
+		}
+		for _, tag := range tags {
+			resource.Tags = append(resource.Tags, &rgtsdk.Tag{
+				Key:   awssdk.String(awssdk.StringValue(tag.Key)),
+				Value: awssdk.String(awssdk.StringValue(tag.Value)),
+			})
+		}
+		result = append(result, resource)
+	}
+	return result, nil
+}
+
+// matchesRGTResourceTypeFilters checks whether the resource with arn matches any of the resourceTypeFilters, which are in service:resourceType format.
+func matchesRGTResourceTypeFilters(arn string, resourceTypeFilters []string) bool {
+	if len(resourceTypeFilters) == 0 {
+		return true
+	}
+	for _, resourceTypeFilter := range resourceTypeFilters {
+		resourceType := strings.TrimPrefix(resourceTypeFilter, "elasticloadbalancing:")
+		if strings.Contains(arn, ":"+resourceType+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesRGTTagFilters checks whether tags matches all of the tagFilters.
+func matchesRGTTagFilters(tags []*elbv2sdk.Tag, tagFilters []*rgtsdk.TagFilter) bool {
+	for _, tagFilter := range tagFilters {
+		matched := false
+		for _, tag := range tags {
+			if awssdk.StringValue(tag.Key) != awssdk.StringValue(tagFilter.Key) {
+				continue
+			}
+			if len(tagFilter.Values) == 0 {
+				matched = true
+				break
+			}
+			for _, value := range awssdk.StringValueSlice(tagFilter.Values) {
+				if value == awssdk.StringValue(tag.Value) {
+					matched = true
+					break
+				}
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
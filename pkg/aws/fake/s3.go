@@ -0,0 +1,50 @@
+package fake
+
+import (
+	"context"
+	"io"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	s3sdk "github.com/aws/aws-sdk-go/service/s3"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	s3ErrCodeInvalidArgument = "InvalidArgument"
+)
+
+var _ services.S3 = &fakeS3{}
+
+// fakeS3 serves the S3 APIs from store.
+type fakeS3 struct {
+	services.S3
+
+	store *store
+}
+
+func (c *fakeS3) PutObjectWithContext(_ context.Context, input *s3sdk.PutObjectInput, _ ...request.Option) (*s3sdk.PutObjectOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	bucket := awssdk.StringValue(input.Bucket)
+	key := awssdk.StringValue(input.Key)
+	if bucket == "" || key == "" {
+		return nil, c.store.newAPIError(s3ErrCodeInvalidArgument, 400, "Bucket and Key must be specified")
+	}
+	var body []byte
+	if input.Body != nil {
+		payload, err := io.ReadAll(input.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = payload
+	}
+	c.store.s3Objects[s3ObjectKey(bucket, key)] = body
+	return &s3sdk.PutObjectOutput{}, nil
+}
+
+// s3ObjectKey returns the key of S3 objects within store.
+func s3ObjectKey(bucket string, key string) string {
+	return bucket + "/" + key
+}
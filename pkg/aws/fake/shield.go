@@ -0,0 +1,85 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	shieldsdk "github.com/aws/aws-sdk-go/service/shield"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+var _ services.Shield = &fakeShield{}
+
+// fakeShield serves the Shield APIs from store.
+type fakeShield struct {
+	services.Shield
+
+	store *store
+}
+
+func (c *fakeShield) GetSubscriptionStateWithContext(_ context.Context, _ *shieldsdk.GetSubscriptionStateInput, _ ...request.Option) (*shieldsdk.GetSubscriptionStateOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	return &shieldsdk.GetSubscriptionStateOutput{
+		SubscriptionState: awssdk.String(c.store.shieldSubscriptionState),
+	}, nil
+}
+
+func (c *fakeShield) CreateProtectionWithContext(_ context.Context, input *shieldsdk.CreateProtectionInput, _ ...request.Option) (*shieldsdk.CreateProtectionOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	if c.store.shieldSubscriptionState != shieldsdk.SubscriptionStateActive {
+		return nil, c.store.newAPIError(shieldsdk.ErrCodeResourceNotFoundException, 400, "The subscription does not exist.")
+	}
+	resourceARN := awssdk.StringValue(input.ResourceArn)
+	if _, exists := c.store.loadBalancers[resourceARN]; !exists {
+		return nil, c.store.newAPIError(shieldsdk.ErrCodeInvalidResourceException, 400, "Unrecognized resource '%v'", resourceARN)
+	}
+	for _, protection := range c.store.protections {
+		if awssdk.StringValue(protection.ResourceArn) == resourceARN {
+			return nil, c.store.newAPIError(shieldsdk.ErrCodeResourceAlreadyExistsException, 400, "The referenced protection already exists.")
+		}
+	}
+	protectionID := fmt.Sprintf("%v-%v-%v-%v-%v", c.store.nextID(8), c.store.nextID(4), c.store.nextID(4), c.store.nextID(4), c.store.nextID(12))
+	c.store.protections[protectionID] = &shieldsdk.Protection{
+		Id:            awssdk.String(protectionID),
+		Name:          input.Name,
+		ResourceArn:   awssdk.String(resourceARN),
+		ProtectionArn: awssdk.String(fmt.Sprintf("arn:aws:shield::%v:protection/%v", c.store.cfg.AccountID, protectionID)),
+	}
+	return &shieldsdk.CreateProtectionOutput{
+		ProtectionId: awssdk.String(protectionID),
+	}, nil
+}
+
+func (c *fakeShield) DescribeProtectionWithContext(_ context.Context, input *shieldsdk.DescribeProtectionInput, _ ...request.Option) (*shieldsdk.DescribeProtectionOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	for _, protection := range c.store.protections {
+		if (input.ProtectionId != nil && awssdk.StringValue(protection.Id) == awssdk.StringValue(input.ProtectionId)) ||
+			(input.ResourceArn != nil && awssdk.StringValue(protection.ResourceArn) == awssdk.StringValue(input.ResourceArn)) {
+			return &shieldsdk.DescribeProtectionOutput{
+				Protection: copyOf(protection).(*shieldsdk.Protection),
+			}, nil
+		}
+	}
+	// like the AWS SDK, an empty output is returned along with the error.
+	return &shieldsdk.DescribeProtectionOutput{}, c.store.newAPIError(shieldsdk.ErrCodeResourceNotFoundException, 400, "The referenced protection does not exist.")
+}
+
+func (c *fakeShield) DeleteProtectionWithContext(_ context.Context, input *shieldsdk.DeleteProtectionInput, _ ...request.Option) (*shieldsdk.DeleteProtectionOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	protectionID := awssdk.StringValue(input.ProtectionId)
+	if _, exists := c.store.protections[protectionID]; !exists {
+		return nil, c.store.newAPIError(shieldsdk.ErrCodeResourceNotFoundException, 400, "The referenced protection does not exist.")
+	}
+	delete(c.store.protections, protectionID)
+	return &shieldsdk.DeleteProtectionOutput{}, nil
+}
@@ -0,0 +1,75 @@
+package fake
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	wafv2sdk "github.com/aws/aws-sdk-go/service/wafv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+var _ services.WAFv2 = &fakeWAFv2{}
+
+// fakeWAFv2 serves the WAFv2 APIs from store.
+type fakeWAFv2 struct {
+	services.WAFv2
+
+	store *store
+}
+
+func (c *fakeWAFv2) AssociateWebACLWithContext(_ context.Context, input *wafv2sdk.AssociateWebACLInput, _ ...request.Option) (*wafv2sdk.AssociateWebACLOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	webACLARN := awssdk.StringValue(input.WebACLArn)
+	resourceARN := awssdk.StringValue(input.ResourceArn)
+	if _, exists := c.store.webACLs[webACLARN]; !exists {
+		return nil, c.store.newAPIError(wafv2sdk.ErrCodeWAFNonexistentItemException, 400, "AWS WAF couldn't perform the operation because your resource doesn't exist.")
+	}
+	if _, exists := c.store.loadBalancers[resourceARN]; !exists {
+		return nil, c.store.newAPIError(wafv2sdk.ErrCodeWAFNonexistentItemException, 400, "AWS WAF couldn't perform the operation because your resource doesn't exist.")
+	}
+	c.store.webACLARNByResources[resourceARN] = webACLARN
+	return &wafv2sdk.AssociateWebACLOutput{}, nil
+}
+
+func (c *fakeWAFv2) DisassociateWebACLWithContext(_ context.Context, input *wafv2sdk.DisassociateWebACLInput, _ ...request.Option) (*wafv2sdk.DisassociateWebACLOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	resourceARN := awssdk.StringValue(input.ResourceArn)
+	if _, exists := c.store.loadBalancers[resourceARN]; !exists {
+		return nil, c.store.newAPIError(wafv2sdk.ErrCodeWAFNonexistentItemException, 400, "AWS WAF couldn't perform the operation because your resource doesn't exist.")
+	}
+	delete(c.store.webACLARNByResources, resourceARN)
+	return &wafv2sdk.DisassociateWebACLOutput{}, nil
+}
+
+func (c *fakeWAFv2) GetWebACLForResourceWithContext(_ context.Context, input *wafv2sdk.GetWebACLForResourceInput, _ ...request.Option) (*wafv2sdk.GetWebACLForResourceOutput, error) {
+	c.store.mutex.Lock()
+	defer c.store.mutex.Unlock()
+
+	resourceARN := awssdk.StringValue(input.ResourceArn)
+	if _, exists := c.store.loadBalancers[resourceARN]; !exists {
+		return nil, c.store.newAPIError(wafv2sdk.ErrCodeWAFNonexistentItemException, 400, "AWS WAF couldn't perform the operation because your resource doesn't exist.")
+	}
+	webACLARN, associated := c.store.webACLARNByResources[resourceARN]
+	if !associated {
+		return &wafv2sdk.GetWebACLForResourceOutput{}, nil
+	}
+	return &wafv2sdk.GetWebACLForResourceOutput{
+		WebACL: copyOf(c.store.webACLs[webACLARN]).(*wafv2sdk.WebACL),
+	}, nil
+}
+
+var _ services.WAFRegional = &fakeWAFRegional{}
+
+// fakeWAFRegional reports WAF Classic as unavailable, which is not emulated.
+type fakeWAFRegional struct {
+	services.WAFRegional
+}
+
+func (c *fakeWAFRegional) Available() bool {
+	return false
+}
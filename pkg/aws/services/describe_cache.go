@@ -0,0 +1,166 @@
+package services
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/cache"
+)
+
+const (
+	metricSubsystemAWS          = "aws"
+	metricDescribeCacheHits     = "describe_cache_hits_total"
+	metricDescribeCacheMisses   = "describe_cache_misses_total"
+	labelDescribeCacheService   = "service"
+	labelDescribeCacheOperation = "operation"
+)
+
+var _ prometheus.Collector = &describeCache{}
+
+// describeCache is a TTL based cache for results of AWS describe APIs, which is shared by cached service clients.
+// cached results are grouped by resource kind, so that they can be invalidated together when resources of that kind are changed.
+type describeCache struct {
+	ttl time.Duration
+
+	mutex sync.Mutex
+	// entries indexed by resource kind.
+	entriesByKind map[string]*cache.Expiring
+	// generation indexed by resource kind, which is bumped whenever resources of that kind are invalidated.
+	// results fetched before an invalidation won't be cached.
+	generationByKind map[string]uint64
+
+	hitsTotal   *prometheus.CounterVec
+	missesTotal *prometheus.CounterVec
+}
+
+// NewDescribeCache constructs new describeCache, results of describe APIs are cached for ttl.
+func NewDescribeCache(ttl time.Duration) *describeCache {
+	return &describeCache{
+		ttl:              ttl,
+		entriesByKind:    make(map[string]*cache.Expiring),
+		generationByKind: make(map[string]uint64),
+		hitsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: metricSubsystemAWS,
+			Name:      metricDescribeCacheHits,
+			Help:      "Total number of AWS describe API calls served from the describe cache",
+		}, []string{labelDescribeCacheService, labelDescribeCacheOperation}),
+		missesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: metricSubsystemAWS,
+			Name:      metricDescribeCacheMisses,
+			Help:      "Total number of AWS describe API calls not served from the describe cache",
+		}, []string{labelDescribeCacheService, labelDescribeCacheOperation}),
+	}
+}
+
+func (c *describeCache) Describe(ch chan<- *prometheus.Desc) {
+	c.hitsTotal.Describe(ch)
+	c.missesTotal.Describe(ch)
+}
+
+func (c *describeCache) Collect(ch chan<- prometheus.Metric) {
+	c.hitsTotal.Collect(ch)
+	c.missesTotal.Collect(ch)
+}
+
+// get returns the cached value for key of resource kind, and the current generation of that resource kind.
+func (c *describeCache) get(kind string, key string) (interface{}, uint64, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	generation := c.generationByKind[kind]
+	entries, ok := c.entriesByKind[kind]
+	if !ok {
+		return nil, generation, false
+	}
+	value, ok := entries.Get(key)
+	return value, generation, ok
+}
+
+// set caches the value for key of resource kind, if resources of that kind haven't been invalidated since generation.
+func (c *describeCache) set(kind string, key string, value interface{}, generation uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.generationByKind[kind] != generation {
+		return
+	}
+	entries, ok := c.entriesByKind[kind]
+	if !ok {
+		entries = cache.NewExpiring()
+		c.entriesByKind[kind] = entries
+	}
+	entries.Set(key, value, c.ttl)
+}
+
+// invalidate drops cached values for all keys of specified resource kinds.
+func (c *describeCache) invalidate(kinds ...string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, kind := range kinds {
+		delete(c.entriesByKind, kind)
+		c.generationByKind[kind]++
+	}
+}
+
+// invalidateKeys drops cached values for specified keys of resource kind.
+func (c *describeCache) invalidateKeys(kind string, keys ...string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generationByKind[kind]++
+	entries, ok := c.entriesByKind[kind]
+	if !ok {
+		return
+	}
+	for _, key := range keys {
+		entries.Delete(key)
+	}
+}
+
+// recordLookup records a cache hit or miss for operation of service.
+func (c *describeCache) recordLookup(service string, operation string, hit bool) {
+	if hit {
+		c.hitsTotal.WithLabelValues(service, operation).Inc()
+	} else {
+		c.missesTotal.WithLabelValues(service, operation).Inc()
+	}
+}
+
+// describeAsListWithCache serves the result of describe API from cache, or invokes fetch and caches its result.
+// fetch must return a slice of pointers to AWS SDK objects.
+func describeAsListWithCache(c *describeCache, service string, kind string, operation string, input interface{}, fetch func() (interface{}, error)) (interface{}, error) {
+	key := describeCacheKey(operation, input)
+	cached, generation, ok := c.get(kind, key)
+	c.recordLookup(service, operation, ok)
+	if ok {
+		return copySDKObjects(cached), nil
+	}
+	result, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	c.set(kind, key, copySDKObjects(result), generation)
+	return result, nil
+}
+
+// describeCacheKey computes the cache key for input of describe APIs.
+func describeCacheKey(operation string, input interface{}) string {
+	return operation + awsutil.Prettify(input)
+}
+
+// copySDKObjects returns a deep copy of objs, which must be a slice of pointers to AWS SDK objects.
+// cached results are always copied, so that callers are free to modify them.
+func copySDKObjects(objs interface{}) interface{} {
+	src := reflect.ValueOf(objs)
+	if src.IsNil() {
+		return objs
+	}
+	dst := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+	for i := 0; i < src.Len(); i++ {
+		if src.Index(i).IsNil() {
+			continue
+		}
+		dst.Index(i).Set(reflect.ValueOf(awsutil.CopyOf(src.Index(i).Interface())))
+	}
+	return dst.Interface()
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+const (
+	describeCacheKindSubnets        = "ec2/subnets"
+	describeCacheKindSecurityGroups = "ec2/securityGroups"
+)
+
+// NewCachedEC2 constructs new EC2 implementation, which serves subnets and securityGroups from describeCache.
+// Cached results are invalidated when resources are changed via the returned client, and expire after the cache TTL to pick up changes made elsewhere.
+func NewCachedEC2(ec2Client EC2, describeCache *describeCache) EC2 {
+	return &cachedEC2{
+		EC2:   ec2Client,
+		cache: describeCache,
+	}
+}
+
+var _ EC2 = &cachedEC2{}
+
+// cachedEC2 is an EC2 implementation with a read-through cache for describe APIs.
+// only the WithContext variants of APIs that change resources invalidate the cache.
+type cachedEC2 struct {
+	EC2
+
+	cache *describeCache
+}
+
+func (c *cachedEC2) DescribeSubnetsAsList(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]*ec2.Subnet, error) {
+	result, err := describeAsListWithCache(c.cache, ec2.ServiceID, describeCacheKindSubnets, "DescribeSubnets", input, func() (interface{}, error) {
+		return c.EC2.DescribeSubnetsAsList(ctx, input)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*ec2.Subnet), nil
+}
+
+func (c *cachedEC2) DescribeSecurityGroupsAsList(ctx context.Context, input *ec2.DescribeSecurityGroupsInput) ([]*ec2.SecurityGroup, error) {
+	result, err := describeAsListWithCache(c.cache, ec2.ServiceID, describeCacheKindSecurityGroups, "DescribeSecurityGroups", input, func() (interface{}, error) {
+		return c.EC2.DescribeSecurityGroupsAsList(ctx, input)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*ec2.SecurityGroup), nil
+}
+
+func (c *cachedEC2) CreateSecurityGroupWithContext(ctx context.Context, input *ec2.CreateSecurityGroupInput, opts ...request.Option) (*ec2.CreateSecurityGroupOutput, error) {
+	defer c.cache.invalidate(describeCacheKindSecurityGroups)
+	return c.EC2.CreateSecurityGroupWithContext(ctx, input, opts...)
+}
+
+func (c *cachedEC2) DeleteSecurityGroupWithContext(ctx context.Context, input *ec2.DeleteSecurityGroupInput, opts ...request.Option) (*ec2.DeleteSecurityGroupOutput, error) {
+	defer c.cache.invalidate(describeCacheKindSecurityGroups)
+	return c.EC2.DeleteSecurityGroupWithContext(ctx, input, opts...)
+}
+
+func (c *cachedEC2) AuthorizeSecurityGroupIngressWithContext(ctx context.Context, input *ec2.AuthorizeSecurityGroupIngressInput, opts ...request.Option) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	defer c.cache.invalidate(describeCacheKindSecurityGroups)
+	return c.EC2.AuthorizeSecurityGroupIngressWithContext(ctx, input, opts...)
+}
+
+func (c *cachedEC2) RevokeSecurityGroupIngressWithContext(ctx context.Context, input *ec2.RevokeSecurityGroupIngressInput, opts ...request.Option) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+	defer c.cache.invalidate(describeCacheKindSecurityGroups)
+	return c.EC2.RevokeSecurityGroupIngressWithContext(ctx, input, opts...)
+}
+
+// tags can be changed on both subnets and securityGroups.
+func (c *cachedEC2) CreateTagsWithContext(ctx context.Context, input *ec2.CreateTagsInput, opts ...request.Option) (*ec2.CreateTagsOutput, error) {
+	defer c.cache.invalidate(describeCacheKindSubnets, describeCacheKindSecurityGroups)
+	return c.EC2.CreateTagsWithContext(ctx, input, opts...)
+}
+
+func (c *cachedEC2) DeleteTagsWithContext(ctx context.Context, input *ec2.DeleteTagsInput, opts ...request.Option) (*ec2.DeleteTagsOutput, error) {
+	defer c.cache.invalidate(describeCacheKindSubnets, describeCacheKindSecurityGroups)
+	return c.EC2.DeleteTagsWithContext(ctx, input, opts...)
+}
@@ -25,18 +25,32 @@ type ELBV2 interface {
 
 	// wrapper to DescribeRulesWithContext API, which aggregates paged results into list.
 	DescribeRulesAsList(ctx context.Context, input *elbv2.DescribeRulesInput) ([]*elbv2.Rule, error)
+
+	// wrapper to DescribeTrustStoresPagesWithContext API, which aggregates paged results into list.
+	DescribeTrustStoresAsList(ctx context.Context, input *elbv2.DescribeTrustStoresInput) ([]*elbv2.TrustStore, error)
+
+	// DescribeListenerAttributesWithContext describes the attributes for the specified listener.
+	DescribeListenerAttributesWithContext(ctx context.Context, input *DescribeListenerAttributesInput, opts ...request.Option) (*DescribeListenerAttributesOutput, error)
+
+	// ModifyListenerAttributesWithContext modifies the specified attributes of the specified listener.
+	ModifyListenerAttributesWithContext(ctx context.Context, input *ModifyListenerAttributesInput, opts ...request.Option) (*ModifyListenerAttributesOutput, error)
 }
 
 // NewELBV2 constructs new ELBV2 implementation.
 func NewELBV2(session *session.Session) ELBV2 {
+	client := elbv2.New(session)
 	return &defaultELBV2{
-		ELBV2API: elbv2.New(session),
+		ELBV2API: client,
+		client:   client,
 	}
 }
 
 // default implementation for ELBV2.
 type defaultELBV2 struct {
 	elbv2iface.ELBV2API
+
+	// client is used to invoke APIs that are not modeled by elbv2iface.ELBV2API.
+	client *elbv2.ELBV2
 }
 
 func (c *defaultELBV2) DescribeLoadBalancersAsList(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) ([]*elbv2.LoadBalancer, error) {
@@ -72,6 +86,17 @@ func (c *defaultELBV2) DescribeListenersAsList(ctx context.Context, input *elbv2
 	return result, nil
 }
 
+func (c *defaultELBV2) DescribeTrustStoresAsList(ctx context.Context, input *elbv2.DescribeTrustStoresInput) ([]*elbv2.TrustStore, error) {
+	var result []*elbv2.TrustStore
+	if err := c.DescribeTrustStoresPagesWithContext(ctx, input, func(output *elbv2.DescribeTrustStoresOutput, _ bool) bool {
+		result = append(result, output.TrustStores...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *defaultELBV2) DescribeListenerCertificatesAsList(ctx context.Context, input *elbv2.DescribeListenerCertificatesInput) ([]*elbv2.Certificate, error) {
 	var certificates []*elbv2.Certificate
 	p := request.Pagination{
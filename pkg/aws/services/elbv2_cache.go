@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+const (
+	describeCacheKindLoadBalancers = "elbv2/loadBalancers"
+	describeCacheKindTargetGroups  = "elbv2/targetGroups"
+	describeCacheKindListeners     = "elbv2/listeners"
+	describeCacheKindRules         = "elbv2/rules"
+	describeCacheKindTags          = "elbv2/tags"
+)
+
+// NewCachedELBV2 constructs new ELBV2 implementation, which serves load balancers, target groups, listeners, rules and tags from describeCache.
+// Cached results are invalidated when resources are changed via the returned client, and expire after the cache TTL to pick up changes made elsewhere.
+func NewCachedELBV2(elbv2Client ELBV2, describeCache *describeCache) ELBV2 {
+	return &cachedELBV2{
+		ELBV2: elbv2Client,
+		cache: describeCache,
+	}
+}
+
+var _ ELBV2 = &cachedELBV2{}
+
+// cachedELBV2 is an ELBV2 implementation with a read-through cache for describe APIs.
+// only the WithContext variants of APIs that change resources invalidate the cache.
+type cachedELBV2 struct {
+	ELBV2
+
+	cache *describeCache
+}
+
+func (c *cachedELBV2) DescribeLoadBalancersAsList(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) ([]*elbv2.LoadBalancer, error) {
+	result, err := describeAsListWithCache(c.cache, elbv2.ServiceID, describeCacheKindLoadBalancers, "DescribeLoadBalancers", input, func() (interface{}, error) {
+		return c.ELBV2.DescribeLoadBalancersAsList(ctx, input)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*elbv2.LoadBalancer), nil
+}
+
+func (c *cachedELBV2) DescribeTargetGroupsAsList(ctx context.Context, input *elbv2.DescribeTargetGroupsInput) ([]*elbv2.TargetGroup, error) {
+	result, err := describeAsListWithCache(c.cache, elbv2.ServiceID, describeCacheKindTargetGroups, "DescribeTargetGroups", input, func() (interface{}, error) {
+		return c.ELBV2.DescribeTargetGroupsAsList(ctx, input)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*elbv2.TargetGroup), nil
+}
+
+func (c *cachedELBV2) DescribeListenersAsList(ctx context.Context, input *elbv2.DescribeListenersInput) ([]*elbv2.Listener, error) {
+	result, err := describeAsListWithCache(c.cache, elbv2.ServiceID, describeCacheKindListeners, "DescribeListeners", input, func() (interface{}, error) {
+		return c.ELBV2.DescribeListenersAsList(ctx, input)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*elbv2.Listener), nil
+}
+
+func (c *cachedELBV2) DescribeRulesAsList(ctx context.Context, input *elbv2.DescribeRulesInput) ([]*elbv2.Rule, error) {
+	result, err := describeAsListWithCache(c.cache, elbv2.ServiceID, describeCacheKindRules, "DescribeRules", input, func() (interface{}, error) {
+		return c.ELBV2.DescribeRulesAsList(ctx, input)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*elbv2.Rule), nil
+}
+
+// DescribeTagsWithContext serves tags of each resource from cache, only tags of resources not in cache are described.
+func (c *cachedELBV2) DescribeTagsWithContext(ctx context.Context, input *elbv2.DescribeTagsInput, opts ...request.Option) (*elbv2.DescribeTagsOutput, error) {
+	tagDescriptionByARN := make(map[string]*elbv2.TagDescription, len(input.ResourceArns))
+	var uncachedARNs []string
+	var generation uint64
+	for _, arn := range awssdk.StringValueSlice(input.ResourceArns) {
+		cached, gen, ok := c.cache.get(describeCacheKindTags, arn)
+		generation = gen
+		if ok {
+			tagDescriptionByARN[arn] = awsutil.CopyOf(cached).(*elbv2.TagDescription)
+		} else {
+			uncachedARNs = append(uncachedARNs, arn)
+		}
+	}
+	c.cache.recordLookup(elbv2.ServiceID, "DescribeTags", len(uncachedARNs) == 0)
+
+	if len(uncachedARNs) != 0 {
+		req := &elbv2.DescribeTagsInput{
+			ResourceArns: awssdk.StringSlice(uncachedARNs),
+		}
+		resp, err := c.ELBV2.DescribeTagsWithContext(ctx, req, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, tagDescription := range resp.TagDescriptions {
+			arn := awssdk.StringValue(tagDescription.ResourceArn)
+			c.cache.set(describeCacheKindTags, arn, awsutil.CopyOf(tagDescription), generation)
+			tagDescriptionByARN[arn] = tagDescription
+		}
+	}
+
+	output := &elbv2.DescribeTagsOutput{}
+	for _, arn := range awssdk.StringValueSlice(input.ResourceArns) {
+		if tagDescription, ok := tagDescriptionByARN[arn]; ok {
+			output.TagDescriptions = append(output.TagDescriptions, tagDescription)
+		}
+	}
+	return output, nil
+}
+
+func (c *cachedELBV2) CreateLoadBalancerWithContext(ctx context.Context, input *elbv2.CreateLoadBalancerInput, opts ...request.Option) (*elbv2.CreateLoadBalancerOutput, error) {
+	defer c.cache.invalidate(describeCacheKindLoadBalancers)
+	return c.ELBV2.CreateLoadBalancerWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) DeleteLoadBalancerWithContext(ctx context.Context, input *elbv2.DeleteLoadBalancerInput, opts ...request.Option) (*elbv2.DeleteLoadBalancerOutput, error) {
+	defer c.cache.invalidate(describeCacheKindLoadBalancers, describeCacheKindTargetGroups, describeCacheKindListeners, describeCacheKindRules)
+	defer c.cache.invalidateKeys(describeCacheKindTags, awssdk.StringValue(input.LoadBalancerArn))
+	return c.ELBV2.DeleteLoadBalancerWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) SetSubnetsWithContext(ctx context.Context, input *elbv2.SetSubnetsInput, opts ...request.Option) (*elbv2.SetSubnetsOutput, error) {
+	defer c.cache.invalidate(describeCacheKindLoadBalancers)
+	return c.ELBV2.SetSubnetsWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) SetSecurityGroupsWithContext(ctx context.Context, input *elbv2.SetSecurityGroupsInput, opts ...request.Option) (*elbv2.SetSecurityGroupsOutput, error) {
+	defer c.cache.invalidate(describeCacheKindLoadBalancers)
+	return c.ELBV2.SetSecurityGroupsWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) SetIpAddressTypeWithContext(ctx context.Context, input *elbv2.SetIpAddressTypeInput, opts ...request.Option) (*elbv2.SetIpAddressTypeOutput, error) {
+	defer c.cache.invalidate(describeCacheKindLoadBalancers)
+	return c.ELBV2.SetIpAddressTypeWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) CreateTargetGroupWithContext(ctx context.Context, input *elbv2.CreateTargetGroupInput, opts ...request.Option) (*elbv2.CreateTargetGroupOutput, error) {
+	defer c.cache.invalidate(describeCacheKindTargetGroups)
+	return c.ELBV2.CreateTargetGroupWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) ModifyTargetGroupWithContext(ctx context.Context, input *elbv2.ModifyTargetGroupInput, opts ...request.Option) (*elbv2.ModifyTargetGroupOutput, error) {
+	defer c.cache.invalidate(describeCacheKindTargetGroups)
+	return c.ELBV2.ModifyTargetGroupWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) DeleteTargetGroupWithContext(ctx context.Context, input *elbv2.DeleteTargetGroupInput, opts ...request.Option) (*elbv2.DeleteTargetGroupOutput, error) {
+	defer c.cache.invalidate(describeCacheKindTargetGroups)
+	defer c.cache.invalidateKeys(describeCacheKindTags, awssdk.StringValue(input.TargetGroupArn))
+	return c.ELBV2.DeleteTargetGroupWithContext(ctx, input, opts...)
+}
+
+// changes to listeners and rules also invalidate target groups, since they change the load balancers that target groups are associated with.
+func (c *cachedELBV2) CreateListenerWithContext(ctx context.Context, input *elbv2.CreateListenerInput, opts ...request.Option) (*elbv2.CreateListenerOutput, error) {
+	defer c.cache.invalidate(describeCacheKindListeners, describeCacheKindRules, describeCacheKindTargetGroups)
+	return c.ELBV2.CreateListenerWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) ModifyListenerWithContext(ctx context.Context, input *elbv2.ModifyListenerInput, opts ...request.Option) (*elbv2.ModifyListenerOutput, error) {
+	defer c.cache.invalidate(describeCacheKindListeners, describeCacheKindRules, describeCacheKindTargetGroups)
+	return c.ELBV2.ModifyListenerWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) DeleteListenerWithContext(ctx context.Context, input *elbv2.DeleteListenerInput, opts ...request.Option) (*elbv2.DeleteListenerOutput, error) {
+	defer c.cache.invalidate(describeCacheKindListeners, describeCacheKindRules, describeCacheKindTargetGroups)
+	defer c.cache.invalidateKeys(describeCacheKindTags, awssdk.StringValue(input.ListenerArn))
+	return c.ELBV2.DeleteListenerWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) CreateRuleWithContext(ctx context.Context, input *elbv2.CreateRuleInput, opts ...request.Option) (*elbv2.CreateRuleOutput, error) {
+	defer c.cache.invalidate(describeCacheKindRules, describeCacheKindTargetGroups)
+	return c.ELBV2.CreateRuleWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) ModifyRuleWithContext(ctx context.Context, input *elbv2.ModifyRuleInput, opts ...request.Option) (*elbv2.ModifyRuleOutput, error) {
+	defer c.cache.invalidate(describeCacheKindRules, describeCacheKindTargetGroups)
+	return c.ELBV2.ModifyRuleWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) DeleteRuleWithContext(ctx context.Context, input *elbv2.DeleteRuleInput, opts ...request.Option) (*elbv2.DeleteRuleOutput, error) {
+	defer c.cache.invalidate(describeCacheKindRules, describeCacheKindTargetGroups)
+	defer c.cache.invalidateKeys(describeCacheKindTags, awssdk.StringValue(input.RuleArn))
+	return c.ELBV2.DeleteRuleWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) SetRulePrioritiesWithContext(ctx context.Context, input *elbv2.SetRulePrioritiesInput, opts ...request.Option) (*elbv2.SetRulePrioritiesOutput, error) {
+	defer c.cache.invalidate(describeCacheKindRules)
+	return c.ELBV2.SetRulePrioritiesWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) AddTagsWithContext(ctx context.Context, input *elbv2.AddTagsInput, opts ...request.Option) (*elbv2.AddTagsOutput, error) {
+	defer c.cache.invalidateKeys(describeCacheKindTags, awssdk.StringValueSlice(input.ResourceArns)...)
+	return c.ELBV2.AddTagsWithContext(ctx, input, opts...)
+}
+
+func (c *cachedELBV2) RemoveTagsWithContext(ctx context.Context, input *elbv2.RemoveTagsInput, opts ...request.Option) (*elbv2.RemoveTagsOutput, error) {
+	defer c.cache.invalidateKeys(describeCacheKindTags, awssdk.StringValueSlice(input.ResourceArns)...)
+	return c.ELBV2.RemoveTagsWithContext(ctx, input, opts...)
+}
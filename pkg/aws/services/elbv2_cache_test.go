@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_cachedELBV2_DescribeLoadBalancersAsList(t *testing.T) {
+	tests := []struct {
+		name           string
+		invalidate     func(ctx context.Context, client ELBV2) error
+		wantDescribes  int
+		wantHits       float64
+		wantMisses     float64
+		describeErrors int
+	}{
+		{
+			name:          "second describe is served from cache",
+			wantDescribes: 1,
+			wantHits:      1,
+			wantMisses:    1,
+		},
+		{
+			name: "creating load balancer invalidates cache",
+			invalidate: func(ctx context.Context, client ELBV2) error {
+				_, err := client.CreateLoadBalancerWithContext(ctx, &elbv2.CreateLoadBalancerInput{Name: awssdk.String("lb-2")})
+				return err
+			},
+			wantDescribes: 2,
+			wantHits:      0,
+			wantMisses:    2,
+		},
+		{
+			name: "modifying target group doesn't invalidate load balancers",
+			invalidate: func(ctx context.Context, client ELBV2) error {
+				_, err := client.ModifyTargetGroupWithContext(ctx, &elbv2.ModifyTargetGroupInput{TargetGroupArn: awssdk.String("tg-1")})
+				return err
+			},
+			wantDescribes: 1,
+			wantHits:      1,
+			wantMisses:    1,
+		},
+		{
+			name:           "errors are not cached",
+			describeErrors: 1,
+			wantDescribes:  2,
+			wantHits:       0,
+			wantMisses:     2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			input := &elbv2.DescribeLoadBalancersInput{}
+			mockELBV2 := NewMockELBV2(ctrl)
+			if tt.describeErrors > 0 {
+				mockELBV2.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), input).Return(nil, errors.New("some error")).Times(tt.describeErrors)
+			}
+			mockELBV2.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), input).DoAndReturn(
+				func(_ context.Context, _ *elbv2.DescribeLoadBalancersInput) ([]*elbv2.LoadBalancer, error) {
+					return []*elbv2.LoadBalancer{
+						{
+							LoadBalancerArn:  awssdk.String("lb-1"),
+							LoadBalancerName: awssdk.String("lb-1"),
+						},
+					}, nil
+				}).Times(tt.wantDescribes - tt.describeErrors)
+			mockELBV2.EXPECT().CreateLoadBalancerWithContext(gomock.Any(), gomock.Any()).Return(&elbv2.CreateLoadBalancerOutput{}, nil).AnyTimes()
+			mockELBV2.EXPECT().ModifyTargetGroupWithContext(gomock.Any(), gomock.Any()).Return(&elbv2.ModifyTargetGroupOutput{}, nil).AnyTimes()
+
+			describeCache := NewDescribeCache(1 * time.Minute)
+			client := NewCachedELBV2(mockELBV2, describeCache)
+
+			got, err := client.DescribeLoadBalancersAsList(ctx, input)
+			if tt.describeErrors > 0 {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "lb-1", awssdk.StringValue(got[0].LoadBalancerName))
+				// modifications to returned results shouldn't affect cache.
+				got[0].LoadBalancerName = awssdk.String("modified")
+			}
+			if tt.invalidate != nil {
+				require.NoError(t, tt.invalidate(ctx, client))
+			}
+			got, err = client.DescribeLoadBalancersAsList(ctx, input)
+			require.NoError(t, err)
+			assert.Equal(t, "lb-1", awssdk.StringValue(got[0].LoadBalancerName))
+
+			assert.Equal(t, tt.wantHits, testutil.ToFloat64(describeCache.hitsTotal.WithLabelValues(elbv2.ServiceID, "DescribeLoadBalancers")))
+			assert.Equal(t, tt.wantMisses, testutil.ToFloat64(describeCache.missesTotal.WithLabelValues(elbv2.ServiceID, "DescribeLoadBalancers")))
+		})
+	}
+}
+
+func Test_cachedELBV2_DescribeTagsWithContext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	tagDescription := func(arn string, value string) *elbv2.TagDescription {
+		return &elbv2.TagDescription{
+			ResourceArn: awssdk.String(arn),
+			Tags:        []*elbv2.Tag{{Key: awssdk.String("key"), Value: awssdk.String(value)}},
+		}
+	}
+	mockELBV2 := NewMockELBV2(ctrl)
+	gomock.InOrder(
+		mockELBV2.EXPECT().DescribeTagsWithContext(gomock.Any(), &elbv2.DescribeTagsInput{
+			ResourceArns: awssdk.StringSlice([]string{"arn-1", "arn-2"}),
+		}).Return(&elbv2.DescribeTagsOutput{
+			TagDescriptions: []*elbv2.TagDescription{tagDescription("arn-1", "v1"), tagDescription("arn-2", "v1")},
+		}, nil),
+		mockELBV2.EXPECT().DescribeTagsWithContext(gomock.Any(), &elbv2.DescribeTagsInput{
+			ResourceArns: awssdk.StringSlice([]string{"arn-3"}),
+		}).Return(&elbv2.DescribeTagsOutput{
+			TagDescriptions: []*elbv2.TagDescription{tagDescription("arn-3", "v1")},
+		}, nil),
+		mockELBV2.EXPECT().AddTagsWithContext(gomock.Any(), gomock.Any()).Return(&elbv2.AddTagsOutput{}, nil),
+		mockELBV2.EXPECT().DescribeTagsWithContext(gomock.Any(), &elbv2.DescribeTagsInput{
+			ResourceArns: awssdk.StringSlice([]string{"arn-2"}),
+		}).Return(&elbv2.DescribeTagsOutput{
+			TagDescriptions: []*elbv2.TagDescription{tagDescription("arn-2", "v2")},
+		}, nil),
+	)
+	client := NewCachedELBV2(mockELBV2, NewDescribeCache(1*time.Minute))
+
+	resp, err := client.DescribeTagsWithContext(ctx, &elbv2.DescribeTagsInput{
+		ResourceArns: awssdk.StringSlice([]string{"arn-1", "arn-2"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*elbv2.TagDescription{tagDescription("arn-1", "v1"), tagDescription("arn-2", "v1")}, resp.TagDescriptions)
+
+	// only tags of arn-3 are described.
+	resp, err = client.DescribeTagsWithContext(ctx, &elbv2.DescribeTagsInput{
+		ResourceArns: awssdk.StringSlice([]string{"arn-3", "arn-1"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*elbv2.TagDescription{tagDescription("arn-3", "v1"), tagDescription("arn-1", "v1")}, resp.TagDescriptions)
+
+	// adding tags to arn-2 invalidates its cached tags only.
+	_, err = client.AddTagsWithContext(ctx, &elbv2.AddTagsInput{
+		ResourceArns: awssdk.StringSlice([]string{"arn-2"}),
+		Tags:         []*elbv2.Tag{{Key: awssdk.String("key"), Value: awssdk.String("v2")}},
+	})
+	require.NoError(t, err)
+	resp, err = client.DescribeTagsWithContext(ctx, &elbv2.DescribeTagsInput{
+		ResourceArns: awssdk.StringSlice([]string{"arn-1", "arn-2", "arn-3"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*elbv2.TagDescription{tagDescription("arn-1", "v1"), tagDescription("arn-2", "v2"), tagDescription("arn-3", "v1")}, resp.TagDescriptions)
+}
+
+func Test_describeCache_set(t *testing.T) {
+	c := NewDescribeCache(1 * time.Minute)
+	_, generation, ok := c.get(describeCacheKindRules, "key")
+	assert.False(t, ok)
+
+	// results fetched before invalidation are not cached.
+	c.invalidate(describeCacheKindRules)
+	c.set(describeCacheKindRules, "key", "stale", generation)
+	_, _, ok = c.get(describeCacheKindRules, "key")
+	assert.False(t, ok)
+
+	_, generation, _ = c.get(describeCacheKindRules, "key")
+	c.set(describeCacheKindRules, "key", "fresh", generation)
+	value, _, ok := c.get(describeCacheKindRules, "key")
+	assert.True(t, ok)
+	assert.Equal(t, "fresh", value)
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// NOTE: the ELBV2 client of aws-sdk-go doesn't model the listener attributes APIs.
+// these types mirror the API shapes, so that the APIs can be invoked through the query protocol of ELBV2 client.
+
+const (
+	opDescribeListenerAttributes = "DescribeListenerAttributes"
+	opModifyListenerAttributes   = "ModifyListenerAttributes"
+)
+
+// Information about a listener attribute.
+type ListenerAttribute struct {
+	_ struct{} `type:"structure"`
+
+	// The name of the attribute.
+	Key *string `type:"string"`
+
+	// The value of the attribute.
+	Value *string `type:"string"`
+}
+
+func (s ListenerAttribute) String() string {
+	return awsutil.Prettify(s)
+}
+
+type DescribeListenerAttributesInput struct {
+	_ struct{} `type:"structure"`
+
+	// The Amazon Resource Name (ARN) of the listener.
+	ListenerArn *string `type:"string" required:"true"`
+}
+
+func (s DescribeListenerAttributesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *DescribeListenerAttributesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "DescribeListenerAttributesInput"}
+	if s.ListenerArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ListenerArn"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+type DescribeListenerAttributesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the listener attributes.
+	Attributes []*ListenerAttribute `type:"list"`
+}
+
+func (s DescribeListenerAttributesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+type ModifyListenerAttributesInput struct {
+	_ struct{} `type:"structure"`
+
+	// The listener attributes.
+	Attributes []*ListenerAttribute `type:"list" required:"true"`
+
+	// The Amazon Resource Name (ARN) of the listener.
+	ListenerArn *string `type:"string" required:"true"`
+}
+
+func (s ModifyListenerAttributesInput) String() string {
+	return awsutil.Prettify(s)
+}
+
+// Validate inspects the fields of the type to determine if they are valid.
+func (s *ModifyListenerAttributesInput) Validate() error {
+	invalidParams := request.ErrInvalidParams{Context: "ModifyListenerAttributesInput"}
+	if s.Attributes == nil {
+		invalidParams.Add(request.NewErrParamRequired("Attributes"))
+	}
+	if s.ListenerArn == nil {
+		invalidParams.Add(request.NewErrParamRequired("ListenerArn"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+type ModifyListenerAttributesOutput struct {
+	_ struct{} `type:"structure"`
+
+	// Information about the listener attributes.
+	Attributes []*ListenerAttribute `type:"list"`
+}
+
+func (s ModifyListenerAttributesOutput) String() string {
+	return awsutil.Prettify(s)
+}
+
+func (c *defaultELBV2) DescribeListenerAttributesWithContext(ctx context.Context, input *DescribeListenerAttributesInput, opts ...request.Option) (*DescribeListenerAttributesOutput, error) {
+	op := &request.Operation{
+		Name:       opDescribeListenerAttributes,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+	output := &DescribeListenerAttributesOutput{}
+	req := c.client.NewRequest(op, input, output)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return output, req.Send()
+}
+
+func (c *defaultELBV2) ModifyListenerAttributesWithContext(ctx context.Context, input *ModifyListenerAttributesInput, opts ...request.Option) (*ModifyListenerAttributesOutput, error) {
+	op := &request.Operation{
+		Name:       opModifyListenerAttributes,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+	output := &ModifyListenerAttributesOutput{}
+	req := c.client.NewRequest(op, input, output)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+	return output, req.Send()
+}
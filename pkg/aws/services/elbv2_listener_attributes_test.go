@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestELBV2(t *testing.T, handler http.HandlerFunc) ELBV2 {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	sess := session.Must(session.NewSession(&awssdk.Config{
+		Region:      awssdk.String("us-west-2"),
+		Endpoint:    awssdk.String(server.URL),
+		Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		MaxRetries:  awssdk.Int(0),
+	}))
+	return NewELBV2(sess)
+}
+
+func Test_defaultELBV2_DescribeListenerAttributesWithContext(t *testing.T) {
+	var gotForm url.Values
+	elbv2Client := newTestELBV2(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		gotForm = r.PostForm
+		_, _ = w.Write([]byte(`<DescribeListenerAttributesResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <DescribeListenerAttributesResult>
+    <Attributes>
+      <member>
+        <Key>tcp.idle_timeout.seconds</Key>
+        <Value>350</Value>
+      </member>
+    </Attributes>
+  </DescribeListenerAttributesResult>
+</DescribeListenerAttributesResponse>`))
+	})
+
+	got, err := elbv2Client.DescribeListenerAttributesWithContext(context.Background(), &DescribeListenerAttributesInput{
+		ListenerArn: awssdk.String("ls-arn"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "DescribeListenerAttributes", gotForm.Get("Action"))
+	assert.Equal(t, "ls-arn", gotForm.Get("ListenerArn"))
+	assert.Equal(t, []*ListenerAttribute{
+		{
+			Key:   awssdk.String("tcp.idle_timeout.seconds"),
+			Value: awssdk.String("350"),
+		},
+	}, got.Attributes)
+}
+
+func Test_defaultELBV2_ModifyListenerAttributesWithContext(t *testing.T) {
+	var gotForm url.Values
+	elbv2Client := newTestELBV2(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		gotForm = r.PostForm
+		_, _ = w.Write([]byte(`<ModifyListenerAttributesResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <ModifyListenerAttributesResult>
+    <Attributes>
+      <member>
+        <Key>routing.http.response.server.enabled</Key>
+        <Value>false</Value>
+      </member>
+    </Attributes>
+  </ModifyListenerAttributesResult>
+</ModifyListenerAttributesResponse>`))
+	})
+
+	got, err := elbv2Client.ModifyListenerAttributesWithContext(context.Background(), &ModifyListenerAttributesInput{
+		ListenerArn: awssdk.String("ls-arn"),
+		Attributes: []*ListenerAttribute{
+			{
+				Key:   awssdk.String("routing.http.response.server.enabled"),
+				Value: awssdk.String("false"),
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ModifyListenerAttributes", gotForm.Get("Action"))
+	assert.Equal(t, "ls-arn", gotForm.Get("ListenerArn"))
+	assert.Equal(t, "routing.http.response.server.enabled", gotForm.Get("Attributes.member.1.Key"))
+	assert.Equal(t, "false", gotForm.Get("Attributes.member.1.Value"))
+	assert.Equal(t, []*ListenerAttribute{
+		{
+			Key:   awssdk.String("routing.http.response.server.enabled"),
+			Value: awssdk.String("false"),
+		},
+	}, got.Attributes)
+}
+
+func Test_defaultELBV2_ModifyListenerAttributesWithContext_validation(t *testing.T) {
+	elbv2Client := newTestELBV2(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request")
+	})
+	_, err := elbv2Client.ModifyListenerAttributesWithContext(context.Background(), &ModifyListenerAttributesInput{})
+	assert.Error(t, err)
+}
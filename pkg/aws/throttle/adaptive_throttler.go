@@ -0,0 +1,188 @@
+package throttle
+
+import (
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	sdkHandlerAdaptiveRequestThrottle  = "adaptiveRequestThrottle"
+	sdkHandlerAdaptiveThrottleFeedback = "adaptiveThrottleFeedback"
+
+	// the rate is multiplied by decreaseFactor when requests are throttled by AWS.
+	defaultDecreaseFactor = 0.5
+	// the rate is decreased at most once per decreaseCooldown, since requests in flight are likely throttled as well.
+	defaultDecreaseCooldown = 1 * time.Second
+	// the rate never drops below minRateFactor of the configured rate.
+	defaultMinRateFactor = 0.1
+	// the rate is increased by recoveryFactor of the configured rate for every recoveryInterval without throttling.
+	defaultRecoveryFactor   = 0.1
+	defaultRecoveryInterval = 10 * time.Second
+
+	metricAdaptiveThrottleRate = "aws_api_adaptive_throttle_rate"
+	labelService               = "service"
+	labelOperation             = "operation"
+)
+
+// adaptiveLimiter is a rate limiter whose rate adapts to the throttling feedback from AWS.
+// The rate decreases multiplicatively when requests are throttled, and recovers additively up to the configured rate.
+type adaptiveLimiter struct {
+	serviceID    string
+	operationPtn *regexp.Regexp
+	condition    Condition
+	maxRate      rate.Limit
+	maxBurst     int
+
+	mutex            sync.Mutex
+	limiter          *rate.Limiter
+	lastDecreaseTime time.Time
+	lastRecoveryTime time.Time
+}
+
+func newAdaptiveLimiter(serviceID string, operationPtn *regexp.Regexp, r rate.Limit, burst int) *adaptiveLimiter {
+	return &adaptiveLimiter{
+		serviceID:    serviceID,
+		operationPtn: operationPtn,
+		condition:    matchServiceOperationPattern(serviceID, operationPtn),
+		maxRate:      r,
+		maxBurst:     burst,
+		limiter:      rate.NewLimiter(r, burst),
+	}
+}
+
+// Limit returns the current rate of limiter.
+func (l *adaptiveLimiter) Limit() rate.Limit {
+	return l.limiter.Limit()
+}
+
+// onThrottled decreases the rate of limiter after a request is throttled at now.
+func (l *adaptiveLimiter) onThrottled(now time.Time) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if now.Sub(l.lastDecreaseTime) < defaultDecreaseCooldown {
+		return
+	}
+	newRate := l.limiter.Limit() * defaultDecreaseFactor
+	if minRate := l.maxRate * defaultMinRateFactor; newRate < minRate {
+		newRate = minRate
+	}
+	l.setRateAt(now, newRate)
+	l.lastDecreaseTime = now
+	l.lastRecoveryTime = now
+}
+
+// onSucceeded recovers the rate of limiter after a request succeeded at now.
+func (l *adaptiveLimiter) onSucceeded(now time.Time) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	currentRate := l.limiter.Limit()
+	if currentRate >= l.maxRate || now.Sub(l.lastRecoveryTime) < defaultRecoveryInterval {
+		return
+	}
+	newRate := currentRate + l.maxRate*defaultRecoveryFactor
+	if newRate > l.maxRate {
+		newRate = l.maxRate
+	}
+	l.setRateAt(now, newRate)
+	l.lastRecoveryTime = now
+}
+
+// setRateAt sets the rate of limiter, the burst is scaled in proportion to the rate.
+func (l *adaptiveLimiter) setRateAt(now time.Time, r rate.Limit) {
+	burst := int(float64(l.maxBurst) * float64(r/l.maxRate))
+	if burst < 1 {
+		burst = 1
+	}
+	l.limiter.SetLimitAt(now, r)
+	l.limiter.SetBurstAt(now, burst)
+}
+
+var _ prometheus.Collector = &adaptiveThrottler{}
+
+// adaptiveThrottler throttles requests with adaptiveLimiters, and exposes their current rates as metrics.
+type adaptiveThrottler struct {
+	limiters []*adaptiveLimiter
+	rateDesc *prometheus.Desc
+}
+
+// NewAdaptiveThrottler constructs new adaptive request throttler instance.
+// The rates in config are the maximum rates, which will be lowered when AWS throttles requests.
+func NewAdaptiveThrottler(config *ServiceOperationsThrottleConfig) *adaptiveThrottler {
+	throttler := &adaptiveThrottler{
+		rateDesc: prometheus.NewDesc(metricAdaptiveThrottleRate,
+			"Current rate of requests per second allowed by the adaptive throttle for AWS APIs",
+			[]string{labelService, labelOperation}, nil),
+	}
+	var serviceIDs []string
+	for serviceID := range config.value {
+		serviceIDs = append(serviceIDs, serviceID)
+	}
+	sort.Strings(serviceIDs)
+	for _, serviceID := range serviceIDs {
+		for _, operationsThrottleConfig := range config.value[serviceID] {
+			throttler.limiters = append(throttler.limiters, newAdaptiveLimiter(serviceID,
+				operationsThrottleConfig.operationPtn,
+				operationsThrottleConfig.r,
+				operationsThrottleConfig.burst))
+		}
+	}
+	return throttler
+}
+
+func (t *adaptiveThrottler) InjectHandlers(handlers *request.Handlers) {
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: sdkHandlerAdaptiveRequestThrottle,
+		Fn:   t.beforeSign,
+	})
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: sdkHandlerAdaptiveThrottleFeedback,
+		Fn:   t.afterAttempt,
+	})
+}
+
+func (t *adaptiveThrottler) Describe(ch chan<- *prometheus.Desc) {
+	ch <- t.rateDesc
+}
+
+func (t *adaptiveThrottler) Collect(ch chan<- prometheus.Metric) {
+	for _, limiter := range t.limiters {
+		ch <- prometheus.MustNewConstMetric(t.rateDesc, prometheus.GaugeValue, float64(limiter.Limit()),
+			limiter.serviceID, limiter.operationPtn.String())
+	}
+}
+
+// beforeSign is added to the Sign chain; called before each request
+func (t *adaptiveThrottler) beforeSign(r *request.Request) {
+	for _, limiter := range t.limiters {
+		if limiter.condition(r) {
+			limiter.limiter.Wait(r.Context())
+		}
+	}
+}
+
+// afterAttempt is added to the CompleteAttempt chain; called after each request attempt, including retries.
+func (t *adaptiveThrottler) afterAttempt(r *request.Request) {
+	throttled := request.IsErrorThrottle(r.Error)
+	if !throttled && r.Error != nil {
+		return
+	}
+	now := time.Now()
+	for _, limiter := range t.limiters {
+		if !limiter.condition(r) {
+			continue
+		}
+		if throttled {
+			limiter.onThrottled(now)
+		} else {
+			limiter.onSucceeded(now)
+		}
+	}
+}
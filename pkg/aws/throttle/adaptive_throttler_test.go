@@ -0,0 +1,226 @@
+package throttle
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func Test_adaptiveLimiter(t *testing.T) {
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	type feedback struct {
+		after     time.Duration
+		throttled bool
+	}
+	tests := []struct {
+		name      string
+		feedbacks []feedback
+		wantRate  rate.Limit
+		wantBurst int
+	}{
+		{
+			name:      "no feedback",
+			wantRate:  10,
+			wantBurst: 40,
+		},
+		{
+			name: "rate is halved when throttled",
+			feedbacks: []feedback{
+				{after: 0, throttled: true},
+			},
+			wantRate:  5,
+			wantBurst: 20,
+		},
+		{
+			name: "rate is decreased once within cooldown",
+			feedbacks: []feedback{
+				{after: 0, throttled: true},
+				{after: 100 * time.Millisecond, throttled: true},
+				{after: 200 * time.Millisecond, throttled: true},
+			},
+			wantRate:  5,
+			wantBurst: 20,
+		},
+		{
+			name: "rate is decreased again after cooldown",
+			feedbacks: []feedback{
+				{after: 0, throttled: true},
+				{after: 1 * time.Second, throttled: true},
+			},
+			wantRate:  2.5,
+			wantBurst: 10,
+		},
+		{
+			name: "rate doesn't drop below minimum rate",
+			feedbacks: []feedback{
+				{after: 0, throttled: true},
+				{after: 1 * time.Second, throttled: true},
+				{after: 2 * time.Second, throttled: true},
+				{after: 3 * time.Second, throttled: true},
+				{after: 4 * time.Second, throttled: true},
+			},
+			wantRate:  1,
+			wantBurst: 4,
+		},
+		{
+			name: "rate doesn't recover within recovery interval",
+			feedbacks: []feedback{
+				{after: 0, throttled: true},
+				{after: 5 * time.Second, throttled: false},
+			},
+			wantRate:  5,
+			wantBurst: 20,
+		},
+		{
+			name: "rate recovers gradually after recovery interval",
+			feedbacks: []feedback{
+				{after: 0, throttled: true},
+				{after: 10 * time.Second, throttled: false},
+				{after: 20 * time.Second, throttled: false},
+			},
+			wantRate:  7,
+			wantBurst: 28,
+		},
+		{
+			name: "rate doesn't recover beyond configured rate",
+			feedbacks: []feedback{
+				{after: 0, throttled: true},
+				{after: 10 * time.Second, throttled: false},
+				{after: 20 * time.Second, throttled: false},
+				{after: 30 * time.Second, throttled: false},
+				{after: 40 * time.Second, throttled: false},
+				{after: 50 * time.Second, throttled: false},
+				{after: 60 * time.Second, throttled: false},
+			},
+			wantRate:  10,
+			wantBurst: 40,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newAdaptiveLimiter(elbv2.ServiceID, regexp.MustCompile(".*"), 10, 40)
+			for _, fb := range tt.feedbacks {
+				if fb.throttled {
+					limiter.onThrottled(now.Add(fb.after))
+				} else {
+					limiter.onSucceeded(now.Add(fb.after))
+				}
+			}
+			assert.InDelta(t, float64(tt.wantRate), float64(limiter.Limit()), 1e-9)
+			assert.Equal(t, tt.wantBurst, limiter.limiter.Burst())
+		})
+	}
+}
+
+func Test_adaptiveThrottler_afterAttempt(t *testing.T) {
+	config := ServiceOperationsThrottleConfig{
+		value: map[string][]throttleConfig{
+			elbv2.ServiceID: {
+				{
+					operationPtn: regexp.MustCompile("^RegisterTargets"),
+					r:            4,
+					burst:        20,
+				},
+				{
+					operationPtn: regexp.MustCompile(".*"),
+					r:            10,
+					burst:        40,
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name      string
+		req       *request.Request
+		wantRates []rate.Limit
+	}{
+		{
+			name: "throttled request lowers rates of matching limiters",
+			req: &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceID: elbv2.ServiceID},
+				Operation:  &request.Operation{Name: "DescribeLoadBalancers"},
+				Error:      awserr.New("Throttling", "Rate exceeded", nil),
+			},
+			wantRates: []rate.Limit{4, 5},
+		},
+		{
+			name: "request limit exceeded lowers rates of matching limiters",
+			req: &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceID: elbv2.ServiceID},
+				Operation:  &request.Operation{Name: "RegisterTargets"},
+				Error:      awserr.New("RequestLimitExceeded", "Request limit exceeded", nil),
+			},
+			wantRates: []rate.Limit{2, 5},
+		},
+		{
+			name: "other errors don't affect rates",
+			req: &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceID: elbv2.ServiceID},
+				Operation:  &request.Operation{Name: "RegisterTargets"},
+				Error:      awserr.New("TargetGroupNotFound", "Target group not found", nil),
+			},
+			wantRates: []rate.Limit{4, 10},
+		},
+		{
+			name: "throttled requests of other services don't affect rates",
+			req: &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceID: appmesh.ServiceID},
+				Operation:  &request.Operation{Name: "DescribeMesh"},
+				Error:      awserr.New("Throttling", "Rate exceeded", nil),
+			},
+			wantRates: []rate.Limit{4, 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttler := NewAdaptiveThrottler(&config)
+			throttler.afterAttempt(tt.req)
+			var gotRates []rate.Limit
+			for _, limiter := range throttler.limiters {
+				gotRates = append(gotRates, limiter.Limit())
+			}
+			assert.Equal(t, tt.wantRates, gotRates)
+		})
+	}
+}
+
+func Test_adaptiveThrottler_Collect(t *testing.T) {
+	config := ServiceOperationsThrottleConfig{
+		value: map[string][]throttleConfig{
+			elbv2.ServiceID: {
+				{
+					operationPtn: regexp.MustCompile(".*"),
+					r:            10,
+					burst:        40,
+				},
+			},
+			appmesh.ServiceID: {
+				{
+					operationPtn: regexp.MustCompile("^Describe"),
+					r:            4.2,
+					burst:        5,
+				},
+			},
+		},
+	}
+	throttler := NewAdaptiveThrottler(&config)
+	throttler.limiters[1].onThrottled(time.Now())
+
+	want := `
+# HELP aws_api_adaptive_throttle_rate Current rate of requests per second allowed by the adaptive throttle for AWS APIs
+# TYPE aws_api_adaptive_throttle_rate gauge
+aws_api_adaptive_throttle_rate{operation="^Describe",service="App Mesh"} 4.2
+aws_api_adaptive_throttle_rate{operation=".*",service="Elastic Load Balancing v2"} 5
+`
+	assert.NoError(t, testutil.CollectAndCompare(throttler, strings.NewReader(want)))
+}
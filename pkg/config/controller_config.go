@@ -19,6 +19,7 @@ const (
 	flagDefaultTargetType                            = "default-target-type"
 	flagExternalManagedTags                          = "external-managed-tags"
 	flagServiceMaxConcurrentReconciles               = "service-max-concurrent-reconciles"
+	flagGatewayMaxConcurrentReconciles               = "gateway-max-concurrent-reconciles"
 	flagTargetGroupBindingMaxConcurrentReconciles    = "targetgroupbinding-max-concurrent-reconciles"
 	flagTargetGroupBindingMaxExponentialBackoffDelay = "targetgroupbinding-max-exponential-backoff-delay"
 	flagDefaultSSLPolicy                             = "default-ssl-policy"
@@ -64,6 +65,8 @@ type ControllerConfig struct {
 	AddonsConfig AddonsConfig
 	// Configurations for the Service controller
 	ServiceConfig ServiceConfig
+	// Configurations for sharding across controller replicas
+	ReplicaShardingConfig ReplicaShardingConfig
 
 	// Default AWS Tags that will be applied to all AWS resources managed by this controller.
 	DefaultTags map[string]string
@@ -83,6 +86,8 @@ type ControllerConfig struct {
 
 	// Max concurrent reconcile loops for Service objects
 	ServiceMaxConcurrentReconciles int
+	// Max concurrent reconcile loops for Gateway objects
+	GatewayMaxConcurrentReconciles int
 	// Max concurrent reconcile loops for TargetGroupBinding objects
 	TargetGroupBindingMaxConcurrentReconciles int
 	// Max exponential backoff delay for reconcile failures of TargetGroupBinding
@@ -114,6 +119,8 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 		"List of Tag keys on AWS resources that will be managed externally")
 	fs.IntVar(&cfg.ServiceMaxConcurrentReconciles, flagServiceMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
 		"Maximum number of concurrently running reconcile loops for service")
+	fs.IntVar(&cfg.GatewayMaxConcurrentReconciles, flagGatewayMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
+		"Maximum number of concurrently running reconcile loops for gateway")
 	fs.IntVar(&cfg.TargetGroupBindingMaxConcurrentReconciles, flagTargetGroupBindingMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
 		"Maximum number of concurrently running reconcile loops for targetGroupBinding")
 	fs.DurationVar(&cfg.TargetGroupBindingMaxExponentialBackoffDelay, flagTargetGroupBindingMaxExponentialBackoffDelay, defaultMaxExponentialBackoffDelay,
@@ -137,6 +144,7 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 	cfg.IngressConfig.BindFlags(fs)
 	cfg.AddonsConfig.BindFlags(fs)
 	cfg.ServiceConfig.BindFlags(fs)
+	cfg.ReplicaShardingConfig.BindFlags(fs)
 }
 
 // Validate the controller configuration
@@ -160,6 +168,12 @@ func (cfg *ControllerConfig) Validate() error {
 	if err := cfg.validateBackendSecurityGroupConfiguration(); err != nil {
 		return err
 	}
+	if err := cfg.IngressConfig.validateRuleConflictPolicy(); err != nil {
+		return err
+	}
+	if err := cfg.validateReplicaShardingConfiguration(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -209,3 +223,20 @@ func (cfg *ControllerConfig) validateBackendSecurityGroupConfiguration() error {
 	}
 	return nil
 }
+
+// validateReplicaShardingConfiguration validates the sharding configuration, only the Ingress, Service and TargetGroupBinding
+// controllers are aware of shards, thus other controllers that rely on leader election cannot be enabled together.
+func (cfg *ControllerConfig) validateReplicaShardingConfiguration() error {
+	if !cfg.ReplicaShardingConfig.EnableReplicaSharding {
+		return nil
+	}
+	if err := cfg.ReplicaShardingConfig.validate(); err != nil {
+		return err
+	}
+	for _, feature := range []Feature{EnableGatewayController, EnableTrafficSplitController} {
+		if cfg.FeatureGates != nil && cfg.FeatureGates.Enabled(feature) {
+			return errors.Errorf("feature gate %v cannot be enabled together with %v flag", feature, flagEnableReplicaSharding)
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestControllerConfig_validateDefaultTagsCollisionWithTrackingTags(t *testing.T) {
@@ -164,3 +165,76 @@ func TestControllerConfig_validateExternalManagedTagsCollisionWithDefaultTags(t
 		})
 	}
 }
+
+func TestControllerConfig_validateReplicaShardingConfiguration(t *testing.T) {
+	tests := []struct {
+		name                  string
+		replicaShardingConfig ReplicaShardingConfig
+		enabledFeatures       []Feature
+		wantErr               error
+	}{
+		{
+			name: "sharding disabled",
+			replicaShardingConfig: ReplicaShardingConfig{
+				EnableReplicaSharding: false,
+			},
+			enabledFeatures: []Feature{EnableGatewayController},
+			wantErr:         nil,
+		},
+		{
+			name: "sharding enabled",
+			replicaShardingConfig: ReplicaShardingConfig{
+				EnableReplicaSharding: true,
+				LeaseDuration:         15 * time.Second,
+				RenewInterval:         5 * time.Second,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "renew interval isn't less than lease duration",
+			replicaShardingConfig: ReplicaShardingConfig{
+				EnableReplicaSharding: true,
+				LeaseDuration:         15 * time.Second,
+				RenewInterval:         15 * time.Second,
+			},
+			wantErr: errors.New("replica-sharding-renew-interval must be positive and less than replica-sharding-lease-duration"),
+		},
+		{
+			name: "lease duration is too short",
+			replicaShardingConfig: ReplicaShardingConfig{
+				EnableReplicaSharding: true,
+				LeaseDuration:         500 * time.Millisecond,
+				RenewInterval:         100 * time.Millisecond,
+			},
+			wantErr: errors.New("replica-sharding-lease-duration must be at least 1s"),
+		},
+		{
+			name: "sharding enabled together with trafficSplit controller",
+			replicaShardingConfig: ReplicaShardingConfig{
+				EnableReplicaSharding: true,
+				LeaseDuration:         15 * time.Second,
+				RenewInterval:         5 * time.Second,
+			},
+			enabledFeatures: []Feature{EnableTrafficSplitController},
+			wantErr:         errors.New("feature gate EnableTrafficSplitController cannot be enabled together with enable-replica-sharding flag"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := NewFeatureGates()
+			for _, feature := range tt.enabledFeatures {
+				featureGates.Enable(feature)
+			}
+			cfg := &ControllerConfig{
+				ReplicaShardingConfig: tt.replicaShardingConfig,
+				FeatureGates:          featureGates,
+			}
+			err := cfg.validateReplicaShardingConfiguration()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
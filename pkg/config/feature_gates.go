@@ -19,6 +19,12 @@ const (
 	EnableIPTargetType           Feature = "EnableIPTargetType"
 	SubnetsClusterTagCheck       Feature = "SubnetsClusterTagCheck"
 	NLBHealthCheckAdvancedConfig Feature = "NLBHealthCheckAdvancedConfig"
+	EnableGatewayController      Feature = "EnableGatewayController"
+	NLBSecurityGroup             Feature = "NLBSecurityGroup"
+	IngressGroupSharding         Feature = "IngressGroupSharding"
+	EnableTrafficSplitController Feature = "EnableTrafficSplitController"
+	IngressGroupPolicy           Feature = "IngressGroupPolicy"
+	EnableRGTAPI                 Feature = "EnableRGTAPI"
 )
 
 type FeatureGates interface {
@@ -54,6 +60,12 @@ func NewFeatureGates() FeatureGates {
 			EnableIPTargetType:           true,
 			SubnetsClusterTagCheck:       true,
 			NLBHealthCheckAdvancedConfig: true,
+			EnableGatewayController:      false,
+			NLBSecurityGroup:             false,
+			IngressGroupSharding:         false,
+			EnableTrafficSplitController: false,
+			IngressGroupPolicy:           false,
+			EnableRGTAPI:                 false,
 		},
 	}
 }
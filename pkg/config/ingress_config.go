@@ -1,16 +1,41 @@
 package config
 
-import "github.com/spf13/pflag"
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
 
 const (
 	flagIngressClass                         = "ingress-class"
 	flagDisableIngressClassAnnotation        = "disable-ingress-class-annotation"
 	flagDisableIngressGroupNameAnnotation    = "disable-ingress-group-name-annotation"
 	flagIngressMaxConcurrentReconciles       = "ingress-max-concurrent-reconciles"
+	flagIngressMaxListenerRules              = "ingress-max-listener-rules"
+	flagIngressMaxTargetGroups               = "ingress-max-target-groups"
+	flagIngressMaxCertificates               = "ingress-max-certificates"
+	flagIngressRuleConflictPolicy            = "ingress-rule-conflict-policy"
 	defaultIngressClass                      = "alb"
 	defaultDisableIngressClassAnnotation     = false
 	defaultDisableIngressGroupNameAnnotation = false
 	defaultMaxIngressConcurrentReconciles    = 3
+	defaultIngressMaxListenerRules           = 0
+	defaultIngressMaxTargetGroups            = 0
+	defaultIngressMaxCertificates            = 0
+	defaultIngressRuleConflictPolicy         = RuleConflictPolicyGroupOrder
+)
+
+// RuleConflictPolicy defines how conflicting rules from different Ingresses within IngressGroup are resolved.
+// Rules conflict when they have identical conditions, such as the same host and path,
+// or when a rule evaluated first matches every request of another rule, such as path /api/* over /api/v1/*.
+type RuleConflictPolicy string
+
+const (
+	// RuleConflictPolicyGroupOrder keeps the rule from the Ingress that comes first in the IngressGroup's order.
+	RuleConflictPolicyGroupOrder RuleConflictPolicy = "GroupOrder"
+	// RuleConflictPolicyFirstCreated keeps the rule from the Ingress that is created first.
+	RuleConflictPolicyFirstCreated RuleConflictPolicy = "FirstCreated"
+	// RuleConflictPolicyRejectAll drops the conflicting rules from all Ingresses.
+	RuleConflictPolicyRejectAll RuleConflictPolicy = "RejectAll"
 )
 
 // IngressConfig contains the configurations for the Ingress controller
@@ -27,6 +52,21 @@ type IngressConfig struct {
 
 	// Max concurrent reconcile loops for Ingress objects
 	MaxConcurrentReconciles int
+
+	// MaxListenerRules is the quota of listener rules per ALB, excluding the default rules.
+	// 0 disables the check.
+	MaxListenerRules int
+
+	// MaxTargetGroups is the quota of target groups per ALB.
+	// 0 disables the check.
+	MaxTargetGroups int
+
+	// MaxCertificates is the quota of certificates per ALB, excluding the default certificates.
+	// 0 disables the check.
+	MaxCertificates int
+
+	// RuleConflictPolicy is how conflicting rules from different Ingresses within IngressGroup are resolved.
+	RuleConflictPolicy string
 }
 
 // BindFlags binds the command line flags to the fields in the config object
@@ -39,4 +79,22 @@ func (cfg *IngressConfig) BindFlags(fs *pflag.FlagSet) {
 		"Disable new usage of alb.ingress.kubernetes.io/group.name annotation")
 	fs.IntVar(&cfg.MaxConcurrentReconciles, flagIngressMaxConcurrentReconciles, defaultMaxIngressConcurrentReconciles,
 		"Maximum number of concurrently running reconcile loops for ingress")
+	fs.IntVar(&cfg.MaxListenerRules, flagIngressMaxListenerRules, defaultIngressMaxListenerRules,
+		"Maximum number of listener rules per ALB, excluding the default rules. Set to 0 to disable the check")
+	fs.IntVar(&cfg.MaxTargetGroups, flagIngressMaxTargetGroups, defaultIngressMaxTargetGroups,
+		"Maximum number of target groups per ALB. Set to 0 to disable the check")
+	fs.IntVar(&cfg.MaxCertificates, flagIngressMaxCertificates, defaultIngressMaxCertificates,
+		"Maximum number of certificates per ALB, excluding the default certificates. Set to 0 to disable the check")
+	fs.StringVar(&cfg.RuleConflictPolicy, flagIngressRuleConflictPolicy, string(defaultIngressRuleConflictPolicy),
+		"How conflicting rules from different Ingresses within IngressGroup are resolved: GroupOrder, FirstCreated or RejectAll")
+}
+
+// validateRuleConflictPolicy validates whether RuleConflictPolicy is a known policy.
+func (cfg *IngressConfig) validateRuleConflictPolicy() error {
+	switch RuleConflictPolicy(cfg.RuleConflictPolicy) {
+	case RuleConflictPolicyGroupOrder, RuleConflictPolicyFirstCreated, RuleConflictPolicyRejectAll:
+		return nil
+	default:
+		return errors.Errorf("invalid value %v for %v flag", cfg.RuleConflictPolicy, flagIngressRuleConflictPolicy)
+	}
 }
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngressConfig_validateRuleConflictPolicy(t *testing.T) {
+	tests := []struct {
+		name               string
+		ruleConflictPolicy string
+		wantErr            error
+	}{
+		{
+			name:               "GroupOrder",
+			ruleConflictPolicy: "GroupOrder",
+		},
+		{
+			name:               "FirstCreated",
+			ruleConflictPolicy: "FirstCreated",
+		},
+		{
+			name:               "RejectAll",
+			ruleConflictPolicy: "RejectAll",
+		},
+		{
+			name:               "unknown policy",
+			ruleConflictPolicy: "LastCreated",
+			wantErr:            errors.New("invalid value LastCreated for ingress-rule-conflict-policy flag"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &IngressConfig{
+				RuleConflictPolicy: tt.ruleConflictPolicy,
+			}
+			err := cfg.validateRuleConflictPolicy()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagEnableReplicaSharding           = "enable-replica-sharding"
+	flagReplicaShardingLeaseDuration    = "replica-sharding-lease-duration"
+	flagReplicaShardingRenewInterval    = "replica-sharding-renew-interval"
+	defaultReplicaShardingLeaseDuration = 15 * time.Second
+	defaultReplicaShardingRenewInterval = 5 * time.Second
+)
+
+// ReplicaShardingConfig contains the configurations for sharding reconciles across controller replicas
+type ReplicaShardingConfig struct {
+	// EnableReplicaSharding specifies whether all replicas split Ingress groups, Services and TargetGroupBindings, while other controllers still run on the leader
+	EnableReplicaSharding bool
+
+	// LeaseDuration is the duration after which a replica that didn't renew its Lease is considered dead
+	LeaseDuration time.Duration
+
+	// RenewInterval is the interval at which replicas renew their Lease and refresh shard members
+	RenewInterval time.Duration
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *ReplicaShardingConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&cfg.EnableReplicaSharding, flagEnableReplicaSharding, false,
+		"Enable sharding of Ingress groups, Services and TargetGroupBindings across all controller replicas, other controllers still run on the leader replica")
+	fs.DurationVar(&cfg.LeaseDuration, flagReplicaShardingLeaseDuration, defaultReplicaShardingLeaseDuration,
+		"Duration after which objects of a replica that stopped renewing its shard Lease are handed off to other replicas")
+	fs.DurationVar(&cfg.RenewInterval, flagReplicaShardingRenewInterval, defaultReplicaShardingRenewInterval,
+		"Interval at which controller replicas renew their shard Lease")
+}
+
+func (cfg *ReplicaShardingConfig) validate() error {
+	if !cfg.EnableReplicaSharding {
+		return nil
+	}
+	if cfg.LeaseDuration < time.Second {
+		return errors.Errorf("%v must be at least 1s", flagReplicaShardingLeaseDuration)
+	}
+	if cfg.RenewInterval <= 0 || cfg.RenewInterval >= cfg.LeaseDuration {
+		return errors.Errorf("%v must be positive and less than %v", flagReplicaShardingRenewInterval, flagReplicaShardingLeaseDuration)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+const (
+	resourceTypeSecurityGroup = "AWS::EC2::SecurityGroup"
+)
+
+// NewPlanSecurityGroupManager constructs new planSecurityGroupManager.
+func NewPlanSecurityGroupManager(trackingProvider tracking.Provider, externalManagedTags []string,
+	changes *plan.Plan, logger logr.Logger) *planSecurityGroupManager {
+	return &planSecurityGroupManager{
+		trackingProvider:    trackingProvider,
+		externalManagedTags: externalManagedTags,
+		changes:             changes,
+		logger:              logger,
+	}
+}
+
+var _ SecurityGroupManager = &planSecurityGroupManager{}
+
+// planSecurityGroupManager implements SecurityGroupManager by recording the changes into plan instead of applying them.
+type planSecurityGroupManager struct {
+	trackingProvider    tracking.Provider
+	externalManagedTags []string
+	changes             *plan.Plan
+
+	logger logr.Logger
+}
+
+func (m *planSecurityGroupManager) Create(_ context.Context, resSG *ec2model.SecurityGroup) (ec2model.SecurityGroupStatus, error) {
+	if _, err := buildIPPermissionInfos(resSG.Spec.Ingress); err != nil {
+		return ec2model.SecurityGroupStatus{}, err
+	}
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionCreate,
+		ResourceType: resSG.Type(),
+		ResourceID:   resSG.ID(),
+	})
+	return ec2model.SecurityGroupStatus{
+		GroupID: plan.UnknownValue(resSG.Type(), resSG.ID()),
+	}, nil
+}
+
+func (m *planSecurityGroupManager) Update(_ context.Context, resSG *ec2model.SecurityGroup, sdkSG networking.SecurityGroupInfo) (ec2model.SecurityGroupStatus, error) {
+	diffs, err := m.buildSecurityGroupDiffs(resSG, sdkSG)
+	if err != nil {
+		return ec2model.SecurityGroupStatus{}, err
+	}
+	if len(diffs) != 0 {
+		m.changes.Record(plan.Change{
+			Action:       plan.ActionUpdate,
+			ResourceType: resSG.Type(),
+			ResourceID:   resSG.ID(),
+			Identifier:   sdkSG.SecurityGroupID,
+			Diffs:        diffs,
+		})
+	}
+	return ec2model.SecurityGroupStatus{
+		GroupID: sdkSG.SecurityGroupID,
+	}, nil
+}
+
+func (m *planSecurityGroupManager) Delete(_ context.Context, sdkSG networking.SecurityGroupInfo) error {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionDelete,
+		ResourceType: resourceTypeSecurityGroup,
+		Identifier:   sdkSG.SecurityGroupID,
+	})
+	return nil
+}
+
+// buildSecurityGroupDiffs returns the settings of sdkSG that would be modified by defaultSecurityGroupManager.
+func (m *planSecurityGroupManager) buildSecurityGroupDiffs(resSG *ec2model.SecurityGroup, sdkSG networking.SecurityGroupInfo) ([]string, error) {
+	var diffs []string
+	desiredSGTags := m.trackingProvider.ResourceTags(resSG.Stack(), resSG, resSG.Spec.Tags)
+	tagsToUpdate, tagsToRemove := algorithm.DiffStringMap(desiredSGTags, sdkSG.Tags)
+	for _, ignoredTagKey := range append(m.trackingProvider.LegacyTagKeys(), m.externalManagedTags...) {
+		delete(tagsToUpdate, ignoredTagKey)
+		delete(tagsToRemove, ignoredTagKey)
+	}
+	if len(tagsToUpdate) != 0 || len(tagsToRemove) != 0 {
+		diffs = append(diffs, "tags")
+	}
+
+	permissionInfos, err := buildIPPermissionInfos(resSG.Spec.Ingress)
+	if err != nil {
+		return nil, err
+	}
+	desiredPermissions := sets.NewString()
+	for _, permission := range permissionInfos {
+		desiredPermissions.Insert(permission.HashCode())
+	}
+	currentPermissions := sets.NewString()
+	for _, permission := range sdkSG.Ingress {
+		currentPermissions.Insert(permission.HashCode())
+	}
+	if !desiredPermissions.Equal(currentPermissions) {
+		diffs = append(diffs, "ingress")
+	}
+	return diffs, nil
+}
@@ -0,0 +1,88 @@
+package ec2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_planSecurityGroupManager_Update(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	trackingProvider := tracking.NewDefaultProvider("elbv2.k8s.aws", "cluster-name")
+	resSG := &ec2model.SecurityGroup{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::EC2::SecurityGroup", "ManagedLBSecurityGroup"),
+		Spec: ec2model.SecurityGroupSpec{
+			GroupName: "my-sg",
+			Ingress: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges: []ec2model.IPRange{
+						{
+							CIDRIP: "0.0.0.0/0",
+						},
+					},
+				},
+			},
+		},
+	}
+	desiredTags := trackingProvider.ResourceTags(stack, resSG, nil)
+	desiredIngress := []networking.IPPermissionInfo{
+		networking.NewCIDRIPPermission("tcp", awssdk.Int64(80), awssdk.Int64(80), "0.0.0.0/0", nil),
+	}
+
+	tests := []struct {
+		name        string
+		sdkSG       networking.SecurityGroupInfo
+		wantChanges []plan.Change
+	}{
+		{
+			name: "securityGroup isn't drifted",
+			sdkSG: networking.SecurityGroupInfo{
+				SecurityGroupID: "sg-1",
+				Tags:            desiredTags,
+				Ingress:         desiredIngress,
+			},
+			wantChanges: []plan.Change{},
+		},
+		{
+			name: "tags and ingress drifted",
+			sdkSG: networking.SecurityGroupInfo{
+				SecurityGroupID: "sg-1",
+				Tags:            map[string]string{},
+				Ingress: []networking.IPPermissionInfo{
+					networking.NewCIDRIPPermission("tcp", awssdk.Int64(443), awssdk.Int64(443), "0.0.0.0/0", nil),
+				},
+			},
+			wantChanges: []plan.Change{
+				{
+					Action:       plan.ActionUpdate,
+					ResourceType: "AWS::EC2::SecurityGroup",
+					ResourceID:   "ManagedLBSecurityGroup",
+					Identifier:   "sg-1",
+					Diffs:        []string{"tags", "ingress"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := plan.NewPlan(stack.StackID().String())
+			m := NewPlanSecurityGroupManager(trackingProvider, nil, changes, logr.New(&log.NullLogSink{}))
+			got, err := m.Update(context.Background(), resSG, tt.sdkSG)
+			assert.NoError(t, err)
+			assert.Equal(t, "sg-1", got.GroupID)
+			assert.Equal(t, tt.wantChanges, changes.Changes)
+		})
+	}
+}
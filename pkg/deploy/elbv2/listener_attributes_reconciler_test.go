@@ -0,0 +1,244 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultListenerAttributesReconciler_Reconcile(t *testing.T) {
+	type describeListenerAttributesWithContextCall struct {
+		req  *services.DescribeListenerAttributesInput
+		resp *services.DescribeListenerAttributesOutput
+		err  error
+	}
+
+	type modifyListenerAttributesWithContextCall struct {
+		req  *services.ModifyListenerAttributesInput
+		resp *services.ModifyListenerAttributesOutput
+		err  error
+	}
+
+	type fields struct {
+		describeListenerAttributesWithContextCalls []describeListenerAttributesWithContextCall
+		modifyListenerAttributesWithContextCalls   []modifyListenerAttributesWithContextCall
+	}
+	type args struct {
+		sdkLS ListenerWithTags
+		resLS *elbv2model.Listener
+	}
+
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	sdkLS := ListenerWithTags{
+		Listener: &elbv2sdk.Listener{
+			ListenerArn: awssdk.String("my-arn"),
+		},
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "attributes should be updated",
+			fields: fields{
+				describeListenerAttributesWithContextCalls: []describeListenerAttributesWithContextCall{
+					{
+						req: &services.DescribeListenerAttributesInput{
+							ListenerArn: awssdk.String("my-arn"),
+						},
+						resp: &services.DescribeListenerAttributesOutput{
+							Attributes: []*services.ListenerAttribute{
+								{
+									Key:   awssdk.String("tcp.idle_timeout.seconds"),
+									Value: awssdk.String("350"),
+								},
+							},
+						},
+					},
+				},
+				modifyListenerAttributesWithContextCalls: []modifyListenerAttributesWithContextCall{
+					{
+						req: &services.ModifyListenerAttributesInput{
+							ListenerArn: awssdk.String("my-arn"),
+							Attributes: []*services.ListenerAttribute{
+								{
+									Key:   awssdk.String("tcp.idle_timeout.seconds"),
+									Value: awssdk.String("400"),
+								},
+							},
+						},
+						resp: &services.ModifyListenerAttributesOutput{},
+					},
+				},
+			},
+			args: args{
+				sdkLS: sdkLS,
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "id-1"),
+					Spec: elbv2model.ListenerSpec{
+						ListenerAttributes: []elbv2model.ListenerAttribute{
+							{
+								Key:   "tcp.idle_timeout.seconds",
+								Value: "400",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no attributes should be updated",
+			fields: fields{
+				describeListenerAttributesWithContextCalls: []describeListenerAttributesWithContextCall{
+					{
+						req: &services.DescribeListenerAttributesInput{
+							ListenerArn: awssdk.String("my-arn"),
+						},
+						resp: &services.DescribeListenerAttributesOutput{
+							Attributes: []*services.ListenerAttribute{
+								{
+									Key:   awssdk.String("tcp.idle_timeout.seconds"),
+									Value: awssdk.String("400"),
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				sdkLS: sdkLS,
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "id-1"),
+					Spec: elbv2model.ListenerSpec{
+						ListenerAttributes: []elbv2model.ListenerAttribute{
+							{
+								Key:   "tcp.idle_timeout.seconds",
+								Value: "400",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "attributes that aren't desired shouldn't be updated",
+			fields: fields{
+				describeListenerAttributesWithContextCalls: []describeListenerAttributesWithContextCall{
+					{
+						req: &services.DescribeListenerAttributesInput{
+							ListenerArn: awssdk.String("my-arn"),
+						},
+						resp: &services.DescribeListenerAttributesOutput{
+							Attributes: []*services.ListenerAttribute{
+								{
+									Key:   awssdk.String("routing.http.response.server.enabled"),
+									Value: awssdk.String("true"),
+								},
+								{
+									Key:   awssdk.String("routing.http.request.x_amzn_mtls_clientcert.header_name"),
+									Value: awssdk.String("X-Amzn-Mtls-Clientcert"),
+								},
+								{
+									Key:   awssdk.String("routing.http.request.x_amzn_tls_version.header_name"),
+									Value: awssdk.String("X-Amzn-Tls-Version"),
+								},
+								{
+									Key:   awssdk.String("routing.http.response.strict_transport_security.header_value"),
+									Value: awssdk.String("max-age=31536000"),
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				sdkLS: sdkLS,
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "id-1"),
+					Spec: elbv2model.ListenerSpec{
+						ListenerAttributes: []elbv2model.ListenerAttribute{
+							{
+								Key:   "routing.http.response.server.enabled",
+								Value: "true",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "attributes shouldn't be described when none desired",
+			fields: fields{},
+			args: args{
+				sdkLS: sdkLS,
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "id-1"),
+					Spec:         elbv2model.ListenerSpec{},
+				},
+			},
+		},
+		{
+			name: "describe attributes failed",
+			fields: fields{
+				describeListenerAttributesWithContextCalls: []describeListenerAttributesWithContextCall{
+					{
+						req: &services.DescribeListenerAttributesInput{
+							ListenerArn: awssdk.String("my-arn"),
+						},
+						err: errors.New("some error"),
+					},
+				},
+			},
+			args: args{
+				sdkLS: sdkLS,
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "id-1"),
+					Spec: elbv2model.ListenerSpec{
+						ListenerAttributes: []elbv2model.ListenerAttribute{
+							{
+								Key:   "tcp.idle_timeout.seconds",
+								Value: "400",
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.fields.describeListenerAttributesWithContextCalls {
+				elbv2Client.EXPECT().DescribeListenerAttributesWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.modifyListenerAttributesWithContextCalls {
+				elbv2Client.EXPECT().ModifyListenerAttributesWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			r := &defaultListenerAttributesReconciler{
+				elbv2Client: elbv2Client,
+				logger:      logr.New(&log.NullLogSink{}),
+			}
+			err := r.Reconcile(context.Background(), tt.args.resLS, tt.args.sdkLS)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -35,6 +35,7 @@ func NewDefaultListenerManager(elbv2Client services.ELBV2, trackingProvider trac
 		taggingManager:              taggingManager,
 		externalManagedTags:         externalManagedTags,
 		featureGates:                featureGates,
+		attributesReconciler:        NewDefaultListenerAttributesReconciler(elbv2Client, logger),
 		logger:                      logger,
 		waitLSExistencePollInterval: defaultWaitLSExistencePollInterval,
 		waitLSExistenceTimeout:      defaultWaitLSExistenceTimeout,
@@ -45,12 +46,13 @@ var _ ListenerManager = &defaultListenerManager{}
 
 // default implementation for ListenerManager
 type defaultListenerManager struct {
-	elbv2Client         services.ELBV2
-	trackingProvider    tracking.Provider
-	taggingManager      TaggingManager
-	externalManagedTags []string
-	featureGates        config.FeatureGates
-	logger              logr.Logger
+	elbv2Client          services.ELBV2
+	trackingProvider     tracking.Provider
+	taggingManager       TaggingManager
+	externalManagedTags  []string
+	featureGates         config.FeatureGates
+	attributesReconciler ListenerAttributesReconciler
+	logger               logr.Logger
 
 	waitLSExistencePollInterval time.Duration
 	waitLSExistenceTimeout      time.Duration
@@ -88,6 +90,11 @@ func (m *defaultListenerManager) Create(ctx context.Context, resLS *elbv2model.L
 	}); err != nil {
 		return elbv2model.ListenerStatus{}, errors.Wrap(err, "failed to update extra certificates on listener")
 	}
+	if err := runtime.RetryImmediateOnError(m.waitLSExistencePollInterval, m.waitLSExistenceTimeout, isListenerNotFoundError, func() error {
+		return m.attributesReconciler.Reconcile(ctx, resLS, sdkLS)
+	}); err != nil {
+		return elbv2model.ListenerStatus{}, errors.Wrap(err, "failed to update listener attributes")
+	}
 	return buildResListenerStatus(sdkLS), nil
 }
 
@@ -103,6 +110,9 @@ func (m *defaultListenerManager) Update(ctx context.Context, resLS *elbv2model.L
 	if err := m.updateSDKListenerWithExtraCertificates(ctx, resLS, sdkLS, false); err != nil {
 		return elbv2model.ListenerStatus{}, err
 	}
+	if err := m.attributesReconciler.Reconcile(ctx, resLS, sdkLS); err != nil {
+		return elbv2model.ListenerStatus{}, err
+	}
 	return buildResListenerStatus(sdkLS), nil
 }
 
@@ -133,11 +143,17 @@ func (m *defaultListenerManager) updateSDKListenerWithSettings(ctx context.Conte
 		return err
 	}
 	desiredDefaultCerts, _ := buildSDKCertificates(resLS.Spec.Certificates)
-	if !isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts) {
+	desiredMutualAuthentication, err := buildSDKListenerMutualAuthentication(resLS.Spec, sdkLS)
+	if err != nil {
+		return err
+	}
+	if !isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts) &&
+		!isSDKListenerMutualAuthenticationDrifted(desiredMutualAuthentication, sdkLS) {
 		return nil
 	}
 	req := buildSDKModifyListenerInput(resLS.Spec, desiredDefaultActions, desiredDefaultCerts)
 	req.ListenerArn = sdkLS.Listener.ListenerArn
+	req.MutualAuthentication = desiredMutualAuthentication
 	m.logger.Info("modifying listener",
 		"stackID", resLS.Stack().StackID(),
 		"resourceID", resLS.ID(),
@@ -268,6 +284,64 @@ func isSDKListenerSettingsDrifted(lsSpec elbv2model.ListenerSpec, sdkLS Listener
 	return false
 }
 
+// isSDKListenerMutualAuthenticationDrifted checks whether the mutual authentication settings of sdkLS differ from desired ones.
+// a nil desiredMutualAuthentication means the mutual authentication settings are not managed.
+func isSDKListenerMutualAuthenticationDrifted(desiredMutualAuthentication *elbv2sdk.MutualAuthenticationAttributes, sdkLS ListenerWithTags) bool {
+	if desiredMutualAuthentication == nil {
+		return false
+	}
+	currentMutualAuthentication := sdkLS.Listener.MutualAuthentication
+	if currentMutualAuthentication == nil {
+		currentMutualAuthentication = &elbv2sdk.MutualAuthenticationAttributes{
+			Mode: awssdk.String(string(elbv2model.MutualAuthenticationModeOff)),
+		}
+	}
+	if awssdk.StringValue(desiredMutualAuthentication.Mode) != awssdk.StringValue(currentMutualAuthentication.Mode) {
+		return true
+	}
+	if awssdk.StringValue(desiredMutualAuthentication.Mode) != string(elbv2model.MutualAuthenticationModeVerify) {
+		return false
+	}
+	if awssdk.StringValue(desiredMutualAuthentication.TrustStoreArn) != awssdk.StringValue(currentMutualAuthentication.TrustStoreArn) {
+		return true
+	}
+	return awssdk.BoolValue(desiredMutualAuthentication.IgnoreClientCertificateExpiry) != awssdk.BoolValue(currentMutualAuthentication.IgnoreClientCertificateExpiry)
+}
+
+// buildSDKListenerMutualAuthentication builds the desired mutual authentication settings for an existing listener.
+// mutual authentication is turned off if it's no longer desired on a HTTPS listener, so that trust stores can be released.
+func buildSDKListenerMutualAuthentication(lsSpec elbv2model.ListenerSpec, sdkLS ListenerWithTags) (*elbv2sdk.MutualAuthenticationAttributes, error) {
+	if lsSpec.MutualAuthentication != nil {
+		return buildSDKMutualAuthentication(*lsSpec.MutualAuthentication)
+	}
+	if lsSpec.Protocol != elbv2model.ProtocolHTTPS || sdkLS.Listener.MutualAuthentication == nil ||
+		awssdk.StringValue(sdkLS.Listener.MutualAuthentication.Mode) == string(elbv2model.MutualAuthenticationModeOff) {
+		return nil, nil
+	}
+	return &elbv2sdk.MutualAuthenticationAttributes{
+		Mode: awssdk.String(string(elbv2model.MutualAuthenticationModeOff)),
+	}, nil
+}
+
+func buildSDKMutualAuthentication(modelMutualAuthentication elbv2model.MutualAuthenticationAttributes) (*elbv2sdk.MutualAuthenticationAttributes, error) {
+	sdkObj := &elbv2sdk.MutualAuthenticationAttributes{
+		Mode: awssdk.String(string(modelMutualAuthentication.Mode)),
+	}
+	if modelMutualAuthentication.Mode != elbv2model.MutualAuthenticationModeVerify {
+		return sdkObj, nil
+	}
+	if modelMutualAuthentication.TrustStoreARN == nil {
+		return nil, errors.New("trustStoreARN is required for verify mode")
+	}
+	trustStoreARN, err := modelMutualAuthentication.TrustStoreARN.Resolve(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	sdkObj.TrustStoreArn = awssdk.String(trustStoreARN)
+	sdkObj.IgnoreClientCertificateExpiry = modelMutualAuthentication.IgnoreClientCertificateExpiry
+	return sdkObj, nil
+}
+
 func buildSDKCreateListenerInput(lsSpec elbv2model.ListenerSpec, featureGates config.FeatureGates) (*elbv2sdk.CreateListenerInput, error) {
 	ctx := context.Background()
 	lbARN, err := lsSpec.LoadBalancerARN.Resolve(ctx)
@@ -288,6 +362,12 @@ func buildSDKCreateListenerInput(lsSpec elbv2model.ListenerSpec, featureGates co
 	if len(lsSpec.ALPNPolicy) != 0 {
 		sdkObj.AlpnPolicy = awssdk.StringSlice(lsSpec.ALPNPolicy)
 	}
+	if lsSpec.MutualAuthentication != nil {
+		sdkObj.MutualAuthentication, err = buildSDKMutualAuthentication(*lsSpec.MutualAuthentication)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return sdkObj, nil
 }
 
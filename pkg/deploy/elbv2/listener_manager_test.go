@@ -3,7 +3,9 @@ package elbv2
 import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
 )
@@ -184,3 +186,228 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 		})
 	}
 }
+
+func Test_isSDKListenerMutualAuthenticationDrifted(t *testing.T) {
+	type args struct {
+		desiredMutualAuthentication *elbv2sdk.MutualAuthenticationAttributes
+		sdkLS                       ListenerWithTags
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "mutual authentication not managed",
+			args: args{
+				desiredMutualAuthentication: nil,
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{
+						MutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+							Mode: awssdk.String("passthrough"),
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "off mode hasn't drifted from listener without mutual authentication",
+			args: args{
+				desiredMutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode: awssdk.String("off"),
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "verify mode hasn't drifted",
+			args: args{
+				desiredMutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:          awssdk.String("verify"),
+					TrustStoreArn: awssdk.String("ts-arn"),
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{
+						MutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+							Mode:                          awssdk.String("verify"),
+							TrustStoreArn:                 awssdk.String("ts-arn"),
+							IgnoreClientCertificateExpiry: awssdk.Bool(false),
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "mode drifted",
+			args: args{
+				desiredMutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode: awssdk.String("passthrough"),
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{
+						MutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+							Mode: awssdk.String("off"),
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "trust store drifted",
+			args: args{
+				desiredMutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:          awssdk.String("verify"),
+					TrustStoreArn: awssdk.String("ts-arn-2"),
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{
+						MutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+							Mode:          awssdk.String("verify"),
+							TrustStoreArn: awssdk.String("ts-arn-1"),
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "ignoreClientCertificateExpiry drifted",
+			args: args{
+				desiredMutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:                          awssdk.String("verify"),
+					TrustStoreArn:                 awssdk.String("ts-arn"),
+					IgnoreClientCertificateExpiry: awssdk.Bool(true),
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{
+						MutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+							Mode:          awssdk.String("verify"),
+							TrustStoreArn: awssdk.String("ts-arn"),
+						},
+					},
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isSDKListenerMutualAuthenticationDrifted(tt.args.desiredMutualAuthentication, tt.args.sdkLS)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_buildSDKListenerMutualAuthentication(t *testing.T) {
+	type args struct {
+		lsSpec elbv2model.ListenerSpec
+		sdkLS  ListenerWithTags
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *elbv2sdk.MutualAuthenticationAttributes
+		wantErr error
+	}{
+		{
+			name: "verify mode",
+			args: args{
+				lsSpec: elbv2model.ListenerSpec{
+					Protocol: elbv2model.ProtocolHTTPS,
+					MutualAuthentication: &elbv2model.MutualAuthenticationAttributes{
+						Mode:                          elbv2model.MutualAuthenticationModeVerify,
+						TrustStoreARN:                 coremodel.LiteralStringToken("ts-arn"),
+						IgnoreClientCertificateExpiry: awssdk.Bool(true),
+					},
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{},
+				},
+			},
+			want: &elbv2sdk.MutualAuthenticationAttributes{
+				Mode:                          awssdk.String("verify"),
+				TrustStoreArn:                 awssdk.String("ts-arn"),
+				IgnoreClientCertificateExpiry: awssdk.Bool(true),
+			},
+		},
+		{
+			name: "verify mode without trust store",
+			args: args{
+				lsSpec: elbv2model.ListenerSpec{
+					Protocol: elbv2model.ProtocolHTTPS,
+					MutualAuthentication: &elbv2model.MutualAuthenticationAttributes{
+						Mode: elbv2model.MutualAuthenticationModeVerify,
+					},
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{},
+				},
+			},
+			wantErr: errors.New("trustStoreARN is required for verify mode"),
+		},
+		{
+			name: "mutual authentication removed from HTTPS listener",
+			args: args{
+				lsSpec: elbv2model.ListenerSpec{
+					Protocol: elbv2model.ProtocolHTTPS,
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{
+						MutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+							Mode:          awssdk.String("verify"),
+							TrustStoreArn: awssdk.String("ts-arn"),
+						},
+					},
+				},
+			},
+			want: &elbv2sdk.MutualAuthenticationAttributes{
+				Mode: awssdk.String("off"),
+			},
+		},
+		{
+			name: "mutual authentication never configured on HTTPS listener",
+			args: args{
+				lsSpec: elbv2model.ListenerSpec{
+					Protocol: elbv2model.ProtocolHTTPS,
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{
+						MutualAuthentication: &elbv2sdk.MutualAuthenticationAttributes{
+							Mode: awssdk.String("off"),
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "HTTP listener",
+			args: args{
+				lsSpec: elbv2model.ListenerSpec{
+					Protocol: elbv2model.ProtocolHTTP,
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2sdk.Listener{},
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildSDKListenerMutualAuthentication(tt.args.lsSpec, tt.args.sdkLS)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package elbv2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// NewPlanListenerManager constructs new planListenerManager.
+func NewPlanListenerManager(elbv2Client services.ELBV2, trackingProvider tracking.Provider,
+	externalManagedTags []string, featureGates config.FeatureGates, changes *plan.Plan, logger logr.Logger) *planListenerManager {
+	return &planListenerManager{
+		lsManager:            NewDefaultListenerManager(elbv2Client, trackingProvider, nil, externalManagedTags, featureGates, logger),
+		attributesReconciler: NewDefaultListenerAttributesReconciler(elbv2Client, logger),
+		trackingProvider:     trackingProvider,
+		externalManagedTags:  externalManagedTags,
+		featureGates:         featureGates,
+		changes:              changes,
+		logger:               logger,
+	}
+}
+
+var _ ListenerManager = &planListenerManager{}
+
+// planListenerManager implements ListenerManager by recording the changes into plan instead of applying them.
+type planListenerManager struct {
+	// lsManager is only used to read the current settings of listeners.
+	lsManager            *defaultListenerManager
+	attributesReconciler *defaultListenerAttributesReconciler
+	trackingProvider     tracking.Provider
+	externalManagedTags  []string
+	featureGates         config.FeatureGates
+	changes              *plan.Plan
+
+	logger logr.Logger
+}
+
+func (m *planListenerManager) Create(_ context.Context, resLS *elbv2model.Listener) (elbv2model.ListenerStatus, error) {
+	if _, err := buildSDKCreateListenerInput(resLS.Spec, m.featureGates); err != nil {
+		return elbv2model.ListenerStatus{}, err
+	}
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionCreate,
+		ResourceType: resLS.Type(),
+		ResourceID:   resLS.ID(),
+	})
+	return elbv2model.ListenerStatus{
+		ListenerARN: plan.UnknownValue(resLS.Type(), resLS.ID()),
+	}, nil
+}
+
+func (m *planListenerManager) Update(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) (elbv2model.ListenerStatus, error) {
+	diffs, err := m.buildListenerDiffs(ctx, resLS, sdkLS)
+	if err != nil {
+		return elbv2model.ListenerStatus{}, err
+	}
+	if len(diffs) != 0 {
+		m.changes.Record(plan.Change{
+			Action:       plan.ActionUpdate,
+			ResourceType: resLS.Type(),
+			ResourceID:   resLS.ID(),
+			Identifier:   awssdk.StringValue(sdkLS.Listener.ListenerArn),
+			Diffs:        diffs,
+		})
+	}
+	return buildResListenerStatus(sdkLS), nil
+}
+
+func (m *planListenerManager) Delete(_ context.Context, sdkLS ListenerWithTags) error {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionDelete,
+		ResourceType: resourceTypeListener,
+		Identifier:   awssdk.StringValue(sdkLS.Listener.ListenerArn),
+	})
+	return nil
+}
+
+// buildListenerDiffs returns the settings of sdkLS that would be modified by defaultListenerManager.
+func (m *planListenerManager) buildListenerDiffs(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) ([]string, error) {
+	var diffs []string
+	if m.featureGates.Enabled(config.ListenerRulesTagging) {
+		desiredLSTags := m.trackingProvider.ResourceTags(resLS.Stack(), resLS, resLS.Spec.Tags)
+		if isSDKTagsDrifted(desiredLSTags, sdkLS.Tags, m.externalManagedTags) {
+			diffs = append(diffs, "tags")
+		}
+	}
+
+	desiredDefaultActions, err := buildSDKActions(resLS.Spec.DefaultActions, m.featureGates)
+	if err != nil {
+		return nil, err
+	}
+	desiredDefaultCerts, desiredExtraCerts := buildSDKCertificates(resLS.Spec.Certificates)
+	if isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts) {
+		diffs = append(diffs, "settings")
+	}
+	desiredMutualAuthentication, err := buildSDKListenerMutualAuthentication(resLS.Spec, sdkLS)
+	if err != nil {
+		return nil, err
+	}
+	if isSDKListenerMutualAuthenticationDrifted(desiredMutualAuthentication, sdkLS) {
+		diffs = append(diffs, "mutualAuthentication")
+	}
+
+	// extra certificates are only reconciled for TLS listeners.
+	if sdkLS.Listener.SslPolicy != nil {
+		desiredExtraCertARNs := sets.NewString()
+		for _, cert := range desiredExtraCerts {
+			desiredExtraCertARNs.Insert(awssdk.StringValue(cert.CertificateArn))
+		}
+		currentExtraCertARNs, err := m.lsManager.fetchSDKListenerExtraCertificateARNs(ctx, sdkLS)
+		if err != nil {
+			return nil, err
+		}
+		if !desiredExtraCertARNs.Equal(sets.NewString(currentExtraCertARNs...)) {
+			diffs = append(diffs, "extraCertificates")
+		}
+	}
+
+	desiredAttrs := m.attributesReconciler.getDesiredListenerAttributes(ctx, resLS)
+	if len(desiredAttrs) != 0 {
+		currentAttrs, err := m.attributesReconciler.getCurrentListenerAttributes(ctx, sdkLS)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, buildAttributesDiffs(desiredAttrs, currentAttrs)...)
+	}
+	return diffs, nil
+}
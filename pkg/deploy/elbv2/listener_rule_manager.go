@@ -23,6 +23,17 @@ type ListenerRuleManager interface {
 	Update(ctx context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) (elbv2model.ListenerRuleStatus, error)
 
 	Delete(ctx context.Context, sdkLR ListenerRuleWithTags) error
+
+	// SetPriorities sets the priorities of existing listener rules in a single batch.
+	SetPriorities(ctx context.Context, changes []ListenerRulePriorityChange) error
+}
+
+// ListenerRulePriorityChange is a priority change to an existing listener rule.
+type ListenerRulePriorityChange struct {
+	// ResLR is the desired listener rule, whose spec contains the new priority.
+	ResLR *elbv2model.ListenerRule
+	// SDKLR is the existing listener rule.
+	SDKLR ListenerRuleWithTags
 }
 
 // NewDefaultListenerRuleManager constructs new defaultListenerRuleManager.
@@ -115,6 +126,24 @@ func (m *defaultListenerRuleManager) Delete(ctx context.Context, sdkLR ListenerR
 	return nil
 }
 
+func (m *defaultListenerRuleManager) SetPriorities(ctx context.Context, changes []ListenerRulePriorityChange) error {
+	req := &elbv2sdk.SetRulePrioritiesInput{}
+	for _, change := range changes {
+		req.RulePriorities = append(req.RulePriorities, &elbv2sdk.RulePriorityPair{
+			RuleArn:  change.SDKLR.ListenerRule.RuleArn,
+			Priority: awssdk.Int64(change.ResLR.Spec.Priority),
+		})
+	}
+	m.logger.Info("setting listener rule priorities",
+		"count", len(req.RulePriorities))
+	if _, err := m.elbv2Client.SetRulePrioritiesWithContext(ctx, req); err != nil {
+		return errors.Wrap(err, "failed to set listener rule priorities")
+	}
+	m.logger.Info("set listener rule priorities",
+		"count", len(req.RulePriorities))
+	return nil
+}
+
 func (m *defaultListenerRuleManager) updateSDKListenerRuleWithSettings(ctx context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) error {
 	desiredActions, err := buildSDKActions(resLR.Spec.Actions, m.featureGates)
 	if err != nil {
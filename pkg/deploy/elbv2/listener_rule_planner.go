@@ -0,0 +1,110 @@
+package elbv2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// NewPlanListenerRuleManager constructs new planListenerRuleManager.
+func NewPlanListenerRuleManager(trackingProvider tracking.Provider, externalManagedTags []string,
+	featureGates config.FeatureGates, changes *plan.Plan, logger logr.Logger) *planListenerRuleManager {
+	return &planListenerRuleManager{
+		trackingProvider:    trackingProvider,
+		externalManagedTags: externalManagedTags,
+		featureGates:        featureGates,
+		changes:             changes,
+		logger:              logger,
+	}
+}
+
+var _ ListenerRuleManager = &planListenerRuleManager{}
+
+// planListenerRuleManager implements ListenerRuleManager by recording the changes into plan instead of applying them.
+type planListenerRuleManager struct {
+	trackingProvider    tracking.Provider
+	externalManagedTags []string
+	featureGates        config.FeatureGates
+	changes             *plan.Plan
+
+	logger logr.Logger
+}
+
+func (m *planListenerRuleManager) Create(_ context.Context, resLR *elbv2model.ListenerRule) (elbv2model.ListenerRuleStatus, error) {
+	if _, err := buildSDKCreateListenerRuleInput(resLR.Spec, m.featureGates); err != nil {
+		return elbv2model.ListenerRuleStatus{}, err
+	}
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionCreate,
+		ResourceType: resLR.Type(),
+		ResourceID:   resLR.ID(),
+	})
+	return elbv2model.ListenerRuleStatus{
+		RuleARN: plan.UnknownValue(resLR.Type(), resLR.ID()),
+	}, nil
+}
+
+func (m *planListenerRuleManager) Update(_ context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) (elbv2model.ListenerRuleStatus, error) {
+	diffs, err := m.buildListenerRuleDiffs(resLR, sdkLR)
+	if err != nil {
+		return elbv2model.ListenerRuleStatus{}, err
+	}
+	if len(diffs) != 0 {
+		m.changes.Record(plan.Change{
+			Action:       plan.ActionUpdate,
+			ResourceType: resLR.Type(),
+			ResourceID:   resLR.ID(),
+			Identifier:   awssdk.StringValue(sdkLR.ListenerRule.RuleArn),
+			Diffs:        diffs,
+		})
+	}
+	return buildResListenerRuleStatus(sdkLR), nil
+}
+
+func (m *planListenerRuleManager) Delete(_ context.Context, sdkLR ListenerRuleWithTags) error {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionDelete,
+		ResourceType: resourceTypeListenerRule,
+		Identifier:   awssdk.StringValue(sdkLR.ListenerRule.RuleArn),
+	})
+	return nil
+}
+
+func (m *planListenerRuleManager) SetPriorities(_ context.Context, changes []ListenerRulePriorityChange) error {
+	for _, change := range changes {
+		m.changes.Record(plan.Change{
+			Action:       plan.ActionUpdate,
+			ResourceType: change.ResLR.Type(),
+			ResourceID:   change.ResLR.ID(),
+			Identifier:   awssdk.StringValue(change.SDKLR.ListenerRule.RuleArn),
+			Diffs:        []string{"priority"},
+		})
+	}
+	return nil
+}
+
+// buildListenerRuleDiffs returns the settings of sdkLR that would be modified by defaultListenerRuleManager.
+func (m *planListenerRuleManager) buildListenerRuleDiffs(resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) ([]string, error) {
+	var diffs []string
+	if m.featureGates.Enabled(config.ListenerRulesTagging) {
+		desiredTags := m.trackingProvider.ResourceTags(resLR.Stack(), resLR, resLR.Spec.Tags)
+		if isSDKTagsDrifted(desiredTags, sdkLR.Tags, m.externalManagedTags) {
+			diffs = append(diffs, "tags")
+		}
+	}
+
+	desiredActions, err := buildSDKActions(resLR.Spec.Actions, m.featureGates)
+	if err != nil {
+		return nil, err
+	}
+	desiredConditions := buildSDKRuleConditions(resLR.Spec.Conditions)
+	if isSDKListenerRuleSettingsDrifted(resLR.Spec, sdkLR, desiredActions, desiredConditions) {
+		diffs = append(diffs, "settings")
+	}
+	return diffs, nil
+}
@@ -0,0 +1,175 @@
+package elbv2
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// the minimum priority of listener rules.
+	minListenerRulePriority int64 = 1
+	// the maximum priority of listener rules.
+	maxListenerRulePriority int64 = 50000
+	// the spacing between priorities of rules allocated after the last existing rule.
+	listenerRulePriorityStride int64 = 100
+)
+
+// allocateListenerRulePriorities allocates the priorities for an ordered list of listener rules.
+// currentPriorities contains the existing priority of each rule, or 0 if the rule doesn't exist yet.
+//
+// The longest sequence of existing rules whose priorities are already in order keeps their priorities,
+// and the remaining rules are allocated priorities within the gaps between them.
+// When a gap cannot fit its rules, it's widened by reallocating the fewest neighbouring rules.
+// Rules allocated within a gap are spread across it, so that later inserts rarely need to reallocate existing rules.
+func allocateListenerRulePriorities(currentPriorities []int64) ([]int64, error) {
+	if int64(len(currentPriorities)) > maxListenerRulePriority-minListenerRulePriority+1 {
+		return nil, errors.Errorf("too many listener rules: %v", len(currentPriorities))
+	}
+	anchors := findInOrderListenerRules(currentPriorities)
+	anchors = widenListenerRulePriorityGaps(currentPriorities, anchors)
+
+	allocated := make([]int64, len(currentPriorities))
+	start := 0
+	lo := minListenerRulePriority - 1
+	for _, anchor := range anchors {
+		allocateListenerRulePrioritiesInGap(currentPriorities, allocated, start, anchor, lo, currentPriorities[anchor])
+		allocated[anchor] = currentPriorities[anchor]
+		start = anchor + 1
+		lo = currentPriorities[anchor]
+	}
+	allocateListenerRulePrioritiesInGap(currentPriorities, allocated, start, len(currentPriorities), lo, maxListenerRulePriority+1)
+	return allocated, nil
+}
+
+// findInOrderListenerRules returns the indexes of the longest sequence of existing rules with increasing priorities.
+func findInOrderListenerRules(currentPriorities []int64) []int {
+	// tails[k] is the index of the rule with smallest priority that ends an increasing sequence of length k+1.
+	var tails []int
+	prevs := make([]int, len(currentPriorities))
+	for i, priority := range currentPriorities {
+		if priority == 0 {
+			continue
+		}
+		k := sort.Search(len(tails), func(k int) bool {
+			return currentPriorities[tails[k]] >= priority
+		})
+		prevs[i] = -1
+		if k > 0 {
+			prevs[i] = tails[k-1]
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+	if len(tails) == 0 {
+		return nil
+	}
+	indexes := make([]int, len(tails))
+	for k, i := len(tails)-1, tails[len(tails)-1]; k >= 0; k, i = k-1, prevs[i] {
+		indexes[k] = i
+	}
+	return indexes
+}
+
+// widenListenerRulePriorityGaps removes anchors around gaps that cannot fit the rules within them.
+// for each such gap, it removes the fewest anchors either after or before it until the merged gap fits.
+func widenListenerRulePriorityGaps(currentPriorities []int64, anchors []int) []int {
+	// boundary returns the index and priority of i-th boundary, where boundary 0 and len(anchors)+1 are sentinels.
+	boundary := func(anchors []int, i int) (int, int64) {
+		if i == 0 {
+			return -1, minListenerRulePriority - 1
+		}
+		if i == len(anchors)+1 {
+			return len(currentPriorities), maxListenerRulePriority + 1
+		}
+		return anchors[i-1], currentPriorities[anchors[i-1]]
+	}
+	fits := func(anchors []int, lower int, upper int) bool {
+		loIndex, loPriority := boundary(anchors, lower)
+		hiIndex, hiPriority := boundary(anchors, upper)
+		return int64(hiIndex-loIndex-1) <= hiPriority-loPriority-1
+	}
+
+	for {
+		gap := -1
+		for i := 0; i <= len(anchors); i++ {
+			if !fits(anchors, i, i+1) {
+				gap = i
+				break
+			}
+		}
+		if gap == -1 {
+			return anchors
+		}
+
+		upper := gap + 1
+		for upper <= len(anchors) && !fits(anchors, gap, upper) {
+			upper++
+		}
+		lower := gap
+		for lower > 0 && !fits(anchors, lower, gap+1) {
+			lower--
+		}
+		upperFits := fits(anchors, gap, upper)
+		lowerFits := fits(anchors, lower, gap+1)
+		switch {
+		case lowerFits && (!upperFits || gap-lower < upper-gap-1):
+			anchors = append(anchors[:lower:lower], anchors[gap:]...)
+		case upperFits:
+			anchors = append(anchors[:gap:gap], anchors[upper-1:]...)
+		default:
+			anchors = append(anchors[:lower:lower], anchors[upper-1:]...)
+		}
+	}
+}
+
+// allocateListenerRulePrioritiesInGap allocates increasing priorities between lo and hi(exclusive) for rules within [start, end).
+// existing rules are allocated the nearest priority to their current one that leaves enough room for other rules,
+// then the remaining rules are spread evenly between the rules that kept their current priority.
+func allocateListenerRulePrioritiesInGap(currentPriorities []int64, allocated []int64, start int, end int, lo int64, hi int64) {
+	next := lo + 1
+	for i := start; i < end; i++ {
+		maxPriority := hi - int64(end-i)
+		priority := currentPriorities[i]
+		if priority > maxPriority {
+			priority = maxPriority
+		}
+		if priority < next {
+			priority = next
+		}
+		allocated[i] = priority
+		next = priority + 1
+	}
+	spreadListenerRulePrioritiesInGap(currentPriorities, allocated, start, end, lo, hi)
+}
+
+// spreadListenerRulePrioritiesInGap reallocates each run of new or moved rules within [start, end) evenly between
+// the priorities of its neighbouring rules. A run after the last rule is spaced by listenerRulePriorityStride instead,
+// leaving room for rules appended later.
+func spreadListenerRulePrioritiesInGap(currentPriorities []int64, allocated []int64, start int, end int, lo int64, hi int64) {
+	runStart := start
+	prev := lo
+	for i := start; i <= end; i++ {
+		if i < end && (currentPriorities[i] == 0 || allocated[i] != currentPriorities[i]) {
+			continue
+		}
+		next := hi
+		if i < end {
+			next = allocated[i]
+		}
+		if count := int64(i - runStart); count > 0 {
+			step := (next - prev) / (count + 1)
+			if next == maxListenerRulePriority+1 && step > listenerRulePriorityStride {
+				step = listenerRulePriorityStride
+			}
+			for j := runStart; j < i; j++ {
+				allocated[j] = prev + step*int64(j-runStart+1)
+			}
+		}
+		prev = next
+		runStart = i + 1
+	}
+}
@@ -0,0 +1,137 @@
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_allocateListenerRulePriorities(t *testing.T) {
+	tests := []struct {
+		name              string
+		currentPriorities []int64
+		want              []int64
+		wantErr           error
+	}{
+		{
+			name:              "no rules",
+			currentPriorities: nil,
+			want:              []int64{},
+		},
+		{
+			name:              "all new rules",
+			currentPriorities: []int64{0, 0, 0},
+			want:              []int64{100, 200, 300},
+		},
+		{
+			name:              "all existing rules in order",
+			currentPriorities: []int64{3, 10, 11},
+			want:              []int64{3, 10, 11},
+		},
+		{
+			name:              "new rule inserted within gap",
+			currentPriorities: []int64{1, 0, 5, 6},
+			want:              []int64{1, 3, 5, 6},
+		},
+		{
+			name:              "new rule appended",
+			currentPriorities: []int64{1, 2, 3, 0},
+			want:              []int64{1, 2, 3, 103},
+		},
+		{
+			name:              "new rule inserted at front without gap",
+			currentPriorities: []int64{0, 1, 2, 3},
+			want:              []int64{100, 200, 300, 400},
+		},
+		{
+			name:              "new rule inserted in middle without gap - rules after it are shifted",
+			currentPriorities: []int64{1, 2, 0, 3, 4, 10},
+			want:              []int64{1, 2, 4, 6, 8, 10},
+		},
+		{
+			name:              "new rule inserted in middle without gap - rules before it are shifted",
+			currentPriorities: []int64{3, 4, 0, 5, 6, 7, 8},
+			want:              []int64{1, 2, 3, 5, 6, 7, 8},
+		},
+		{
+			name:              "removed rules leave gaps",
+			currentPriorities: []int64{1, 4, 7},
+			want:              []int64{1, 4, 7},
+		},
+		{
+			name:              "reordered rule is moved",
+			currentPriorities: []int64{3, 1, 2},
+			want:              []int64{3, 103, 203},
+		},
+		{
+			name:              "reordered rule is moved into gap",
+			currentPriorities: []int64{1, 20, 10, 30},
+			want:              []int64{1, 5, 10, 30},
+		},
+		{
+			name:              "rules at maximum priority",
+			currentPriorities: []int64{49999, 50000, 0},
+			want:              []int64{100, 200, 300},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := allocateListenerRulePriorities(tt.currentPriorities)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+	t.Run("too many rules", func(t *testing.T) {
+		_, err := allocateListenerRulePriorities(make([]int64, 50001))
+		assert.EqualError(t, err, errors.New("too many listener rules: 50001").Error())
+	})
+	t.Run("new rules inserted repeatedly between existing rules", func(t *testing.T) {
+		priorities, err := allocateListenerRulePriorities([]int64{0, 0, 0})
+		assert.NoError(t, err)
+		for i := 0; i < 5; i++ {
+			currentPriorities := append([]int64{priorities[0], 0}, priorities[1:]...)
+			got, err := allocateListenerRulePriorities(currentPriorities)
+			assert.NoError(t, err)
+			assert.Equal(t, priorities[0], got[0])
+			assert.Equal(t, priorities[1:], got[2:])
+			assert.True(t, got[0] < got[1] && got[1] < got[2])
+			priorities = got
+		}
+		assert.Equal(t, []int64{100, 103, 106, 112, 125, 150, 200, 300}, priorities)
+	})
+}
+
+func Test_findInOrderListenerRules(t *testing.T) {
+	tests := []struct {
+		name              string
+		currentPriorities []int64
+		want              []int
+	}{
+		{
+			name:              "no existing rules",
+			currentPriorities: []int64{0, 0},
+			want:              nil,
+		},
+		{
+			name:              "all rules in order",
+			currentPriorities: []int64{1, 2, 0, 5},
+			want:              []int{0, 1, 3},
+		},
+		{
+			name:              "some rules out of order",
+			currentPriorities: []int64{5, 1, 2, 0, 3, 4},
+			want:              []int{1, 2, 4, 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findInOrderListenerRules(tt.currentPriorities)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,13 +2,20 @@ package elbv2
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	elbv2equality "sigs.k8s.io/aws-load-balancer-controller/pkg/equality/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
-	"strconv"
 )
 
 // NewListenerRuleSynthesizer constructs new listenerRuleSynthesizer.
@@ -60,24 +67,71 @@ func (s *listenerRuleSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
+// synthesizeListenerRulesOnListener reconciles the listenerRules on Listener.
+// resLRs' priorities only denotes the desired order of rules, the actual priorities are allocated to keep existing rules' priorities stable,
+// so that inserting or removing a rule won't renumber all rules after it.
 func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.Context, lsARN string, resLRs []*elbv2model.ListenerRule) error {
 	sdkLRs, err := s.findSDKListenersRulesOnLS(ctx, lsARN)
 	if err != nil {
 		return err
 	}
 
-	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(resLRs, sdkLRs)
-	for _, sdkLR := range unmatchedSDKLRs {
-		if err := s.lrManager.Delete(ctx, sdkLR); err != nil {
+	sort.SliceStable(resLRs, func(i, j int) bool {
+		return resLRs[i].Spec.Priority < resLRs[j].Spec.Priority
+	})
+	sdkLRByResLR, unmatchedSDKLRs := matchResAndSDKListenerRulesByConditions(resLRs, sdkLRs)
+	currentPriorities := make([]int64, len(resLRs))
+	for i, resLR := range resLRs {
+		if sdkLR, ok := sdkLRByResLR[resLR]; ok {
+			currentPriorities[i] = sdkListenerRulePriority(sdkLR)
+		}
+	}
+	priorities, err := allocateListenerRulePriorities(currentPriorities)
+	if err != nil {
+		return err
+	}
+
+	// rules without existing rule of same conditions reuse the existing rule at its allocated priority if any,
+	// so that they are modified in place instead of being recreated.
+	unmatchedSDKLRByPriority := mapSDKListenerRuleByPriority(unmatchedSDKLRs)
+	var matchedResAndSDKLRs []resAndSDKListenerRulePair
+	var unmatchedResLRs []*elbv2model.ListenerRule
+	var priorityChanges []ListenerRulePriorityChange
+	for i, resLR := range resLRs {
+		resLR.Spec.Priority = priorities[i]
+		if sdkLR, ok := sdkLRByResLR[resLR]; ok {
+			matchedResAndSDKLRs = append(matchedResAndSDKLRs, resAndSDKListenerRulePair{
+				resLR: resLR,
+				sdkLR: sdkLR,
+			})
+			if currentPriorities[i] != priorities[i] {
+				priorityChanges = append(priorityChanges, ListenerRulePriorityChange{
+					ResLR: resLR,
+					SDKLR: sdkLR,
+				})
+			}
+			continue
+		}
+		if sdkLR, ok := unmatchedSDKLRByPriority[priorities[i]]; ok {
+			matchedResAndSDKLRs = append(matchedResAndSDKLRs, resAndSDKListenerRulePair{
+				resLR: resLR,
+				sdkLR: sdkLR,
+			})
+			delete(unmatchedSDKLRByPriority, priorities[i])
+			continue
+		}
+		unmatchedResLRs = append(unmatchedResLRs, resLR)
+	}
+
+	for _, priority := range sets.Int64KeySet(unmatchedSDKLRByPriority).List() {
+		if err := s.lrManager.Delete(ctx, unmatchedSDKLRByPriority[priority]); err != nil {
 			return err
 		}
 	}
-	for _, resLR := range unmatchedResLRs {
-		lrStatus, err := s.lrManager.Create(ctx, resLR)
-		if err != nil {
+	if len(priorityChanges) != 0 {
+		if err := s.lrManager.SetPriorities(ctx, priorityChanges); err != nil {
 			return err
 		}
-		resLR.SetStatus(lrStatus)
 	}
 	for _, resAndSDKLR := range matchedResAndSDKLRs {
 		lsStatus, err := s.lrManager.Update(ctx, resAndSDKLR.resLR, resAndSDKLR.sdkLR)
@@ -86,6 +140,13 @@ func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.
 		}
 		resAndSDKLR.resLR.SetStatus(lsStatus)
 	}
+	for _, resLR := range unmatchedResLRs {
+		lrStatus, err := s.lrManager.Create(ctx, resLR)
+		if err != nil {
+			return err
+		}
+		resLR.SetStatus(lrStatus)
+	}
 	return nil
 }
 
@@ -110,46 +171,72 @@ type resAndSDKListenerRulePair struct {
 	sdkLR ListenerRuleWithTags
 }
 
-func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags) ([]resAndSDKListenerRulePair, []*elbv2model.ListenerRule, []ListenerRuleWithTags) {
-	var matchedResAndSDKLRs []resAndSDKListenerRulePair
-	var unmatchedResLRs []*elbv2model.ListenerRule
-	var unmatchedSDKLRs []ListenerRuleWithTags
-
-	resLRByPriority := mapResListenerRuleByPriority(resLRs)
-	sdkLRByPriority := mapSDKListenerRuleByPriority(sdkLRs)
-	resLRPriorities := sets.Int64KeySet(resLRByPriority)
-	sdkLRPriorities := sets.Int64KeySet(sdkLRByPriority)
-	for _, priority := range resLRPriorities.Intersection(sdkLRPriorities).List() {
-		resLR := resLRByPriority[priority]
-		sdkLR := sdkLRByPriority[priority]
-		matchedResAndSDKLRs = append(matchedResAndSDKLRs, resAndSDKListenerRulePair{
-			resLR: resLR,
-			sdkLR: sdkLR,
-		})
+// matchResAndSDKListenerRulesByConditions matches resLRs with sdkLRs that have the same conditions.
+// when multiple rules have same conditions, they are matched in the order of priority.
+func matchResAndSDKListenerRulesByConditions(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags) (map[*elbv2model.ListenerRule]ListenerRuleWithTags, []ListenerRuleWithTags) {
+	sortedSDKLRs := append([]ListenerRuleWithTags(nil), sdkLRs...)
+	sort.SliceStable(sortedSDKLRs, func(i, j int) bool {
+		return sdkListenerRulePriority(sortedSDKLRs[i]) < sdkListenerRulePriority(sortedSDKLRs[j])
+	})
+	sdkLRsByConditionsKey := make(map[string][]ListenerRuleWithTags)
+	for _, sdkLR := range sortedSDKLRs {
+		key := buildSDKRuleConditionsKey(sdkLR.ListenerRule.Conditions)
+		sdkLRsByConditionsKey[key] = append(sdkLRsByConditionsKey[key], sdkLR)
 	}
-	for _, priority := range resLRPriorities.Difference(sdkLRPriorities).List() {
-		unmatchedResLRs = append(unmatchedResLRs, resLRByPriority[priority])
+
+	matchedSDKLRByResLR := make(map[*elbv2model.ListenerRule]ListenerRuleWithTags, len(resLRs))
+	matchedSDKLRARNs := sets.NewString()
+	for _, resLR := range resLRs {
+		desiredConditions := buildSDKRuleConditions(resLR.Spec.Conditions)
+		for _, sdkLR := range sdkLRsByConditionsKey[buildSDKRuleConditionsKey(desiredConditions)] {
+			sdkLRARN := awssdk.StringValue(sdkLR.ListenerRule.RuleArn)
+			if matchedSDKLRARNs.Has(sdkLRARN) {
+				continue
+			}
+			if cmp.Equal(desiredConditions, sdkLR.ListenerRule.Conditions, elbv2equality.CompareOptionForRuleConditions()) {
+				matchedSDKLRByResLR[resLR] = sdkLR
+				matchedSDKLRARNs.Insert(sdkLRARN)
+				break
+			}
+		}
 	}
-	for _, priority := range sdkLRPriorities.Difference(resLRPriorities).List() {
-		unmatchedSDKLRs = append(unmatchedSDKLRs, sdkLRByPriority[priority])
+	var unmatchedSDKLRs []ListenerRuleWithTags
+	for _, sdkLR := range sortedSDKLRs {
+		if !matchedSDKLRARNs.Has(awssdk.StringValue(sdkLR.ListenerRule.RuleArn)) {
+			unmatchedSDKLRs = append(unmatchedSDKLRs, sdkLR)
+		}
 	}
-
-	return matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs
+	return matchedSDKLRByResLR, unmatchedSDKLRs
 }
 
-func mapResListenerRuleByPriority(resLRs []*elbv2model.ListenerRule) map[int64]*elbv2model.ListenerRule {
-	resLRByPriority := make(map[int64]*elbv2model.ListenerRule, len(resLRs))
-	for _, resLR := range resLRs {
-		resLRByPriority[resLR.Spec.Priority] = resLR
+// buildSDKRuleConditionsKey builds a key from the host-header and path-pattern of conditions.
+// rules with same conditions always have the same key, while rules with same key don't necessarily have same conditions.
+func buildSDKRuleConditionsKey(conditions []*elbv2sdk.RuleCondition) string {
+	parts := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		var values []string
+		if condition.HostHeaderConfig != nil {
+			values = append(values, awssdk.StringValueSlice(condition.HostHeaderConfig.Values)...)
+		}
+		if condition.PathPatternConfig != nil {
+			values = append(values, awssdk.StringValueSlice(condition.PathPatternConfig.Values)...)
+		}
+		sort.Strings(values)
+		parts = append(parts, fmt.Sprintf("%v=%v", awssdk.StringValue(condition.Field), strings.Join(values, ",")))
 	}
-	return resLRByPriority
+	sort.Strings(parts)
+	return strings.Join(parts, ";")
+}
+
+func sdkListenerRulePriority(sdkLR ListenerRuleWithTags) int64 {
+	priority, _ := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
+	return priority
 }
 
 func mapSDKListenerRuleByPriority(sdkLRs []ListenerRuleWithTags) map[int64]ListenerRuleWithTags {
 	sdkLRByPriority := make(map[int64]ListenerRuleWithTags, len(sdkLRs))
 	for _, sdkLR := range sdkLRs {
-		priority, _ := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
-		sdkLRByPriority[priority] = sdkLR
+		sdkLRByPriority[sdkListenerRulePriority(sdkLR)] = sdkLR
 	}
 	return sdkLRByPriority
 }
@@ -0,0 +1,144 @@
+package elbv2
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_matchResAndSDKListenerRulesByConditions(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	newResLR := func(id string, priority int64, paths ...string) *elbv2model.ListenerRule {
+		return &elbv2model.ListenerRule{
+			ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ListenerRule", id),
+			Spec: elbv2model.ListenerRuleSpec{
+				Priority: priority,
+				Conditions: []elbv2model.RuleCondition{
+					{
+						Field: elbv2model.RuleConditionFieldPathPattern,
+						PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+							Values: paths,
+						},
+					},
+				},
+			},
+		}
+	}
+	newSDKLR := func(arn string, priority string, paths ...string) ListenerRuleWithTags {
+		return ListenerRuleWithTags{
+			ListenerRule: &elbv2sdk.Rule{
+				RuleArn:  awssdk.String(arn),
+				Priority: awssdk.String(priority),
+				Conditions: []*elbv2sdk.RuleCondition{
+					{
+						Field:  awssdk.String("path-pattern"),
+						Values: awssdk.StringSlice(paths),
+						PathPatternConfig: &elbv2sdk.PathPatternConditionConfig{
+							Values: awssdk.StringSlice(paths),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	resLR1 := newResLR("80:1", 1, "/a")
+	resLR2 := newResLR("80:2", 2, "/b")
+	resLR3 := newResLR("80:3", 3, "/b")
+	resLR4 := newResLR("80:4", 4, "/c", "/d")
+	sdkLR1 := newSDKLR("arn-1", "5", "/b")
+	sdkLR2 := newSDKLR("arn-2", "3", "/b")
+	sdkLR3 := newSDKLR("arn-3", "7", "/a")
+	sdkLR4 := newSDKLR("arn-4", "8", "/d", "/c")
+	sdkLR5 := newSDKLR("arn-5", "1", "/e")
+
+	tests := []struct {
+		name                string
+		resLRs              []*elbv2model.ListenerRule
+		sdkLRs              []ListenerRuleWithTags
+		wantMatchedSDKLRs   map[*elbv2model.ListenerRule]ListenerRuleWithTags
+		wantUnmatchedSDKLRs []ListenerRuleWithTags
+	}{
+		{
+			name:                "no rules",
+			wantMatchedSDKLRs:   map[*elbv2model.ListenerRule]ListenerRuleWithTags{},
+			wantUnmatchedSDKLRs: nil,
+		},
+		{
+			name:   "rules with same conditions are matched in the order of priority",
+			resLRs: []*elbv2model.ListenerRule{resLR1, resLR2, resLR3},
+			sdkLRs: []ListenerRuleWithTags{sdkLR1, sdkLR2, sdkLR3},
+			wantMatchedSDKLRs: map[*elbv2model.ListenerRule]ListenerRuleWithTags{
+				resLR1: sdkLR3,
+				resLR2: sdkLR2,
+				resLR3: sdkLR1,
+			},
+			wantUnmatchedSDKLRs: nil,
+		},
+		{
+			name:   "rules with different conditions are not matched",
+			resLRs: []*elbv2model.ListenerRule{resLR2, resLR4},
+			sdkLRs: []ListenerRuleWithTags{sdkLR1, sdkLR4, sdkLR5},
+			wantMatchedSDKLRs: map[*elbv2model.ListenerRule]ListenerRuleWithTags{
+				resLR2: sdkLR1,
+			},
+			wantUnmatchedSDKLRs: []ListenerRuleWithTags{sdkLR5, sdkLR4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMatchedSDKLRs, gotUnmatchedSDKLRs := matchResAndSDKListenerRulesByConditions(tt.resLRs, tt.sdkLRs)
+			assert.Equal(t, tt.wantMatchedSDKLRs, gotMatchedSDKLRs)
+			assert.Equal(t, tt.wantUnmatchedSDKLRs, gotUnmatchedSDKLRs)
+		})
+	}
+}
+
+func Test_buildSDKRuleConditionsKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []*elbv2sdk.RuleCondition
+		want       string
+	}{
+		{
+			name:       "no conditions",
+			conditions: nil,
+			want:       "",
+		},
+		{
+			name: "host-header, path-pattern and http-header conditions",
+			conditions: []*elbv2sdk.RuleCondition{
+				{
+					Field: awssdk.String("path-pattern"),
+					PathPatternConfig: &elbv2sdk.PathPatternConditionConfig{
+						Values: awssdk.StringSlice([]string{"/b", "/a"}),
+					},
+				},
+				{
+					Field: awssdk.String("http-header"),
+					HttpHeaderConfig: &elbv2sdk.HttpHeaderConditionConfig{
+						HttpHeaderName: awssdk.String("header"),
+						Values:         awssdk.StringSlice([]string{"value"}),
+					},
+				},
+				{
+					Field: awssdk.String("host-header"),
+					HostHeaderConfig: &elbv2sdk.HostHeaderConditionConfig{
+						Values: awssdk.StringSlice([]string{"www.example.com"}),
+					},
+				},
+			},
+			want: "host-header=www.example.com;http-header=;path-pattern=/a,/b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildSDKRuleConditionsKey(tt.conditions)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package elbv2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// NewPlanLoadBalancerManager constructs new planLoadBalancerManager.
+func NewPlanLoadBalancerManager(elbv2Client services.ELBV2, trackingProvider tracking.Provider,
+	externalManagedTags []string, changes *plan.Plan, logger logr.Logger) *planLoadBalancerManager {
+	return &planLoadBalancerManager{
+		trackingProvider:     trackingProvider,
+		attributesReconciler: NewDefaultLoadBalancerAttributeReconciler(elbv2Client, logger),
+		externalManagedTags:  externalManagedTags,
+		changes:              changes,
+		logger:               logger,
+	}
+}
+
+var _ LoadBalancerManager = &planLoadBalancerManager{}
+
+// planLoadBalancerManager implements LoadBalancerManager by recording the changes into plan instead of applying them.
+type planLoadBalancerManager struct {
+	trackingProvider     tracking.Provider
+	attributesReconciler *defaultLoadBalancerAttributeReconciler
+	externalManagedTags  []string
+	changes              *plan.Plan
+
+	logger logr.Logger
+}
+
+func (m *planLoadBalancerManager) Create(_ context.Context, resLB *elbv2model.LoadBalancer) (elbv2model.LoadBalancerStatus, error) {
+	if _, err := buildSDKCreateLoadBalancerInput(resLB.Spec); err != nil {
+		return elbv2model.LoadBalancerStatus{}, err
+	}
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionCreate,
+		ResourceType: resLB.Type(),
+		ResourceID:   resLB.ID(),
+	})
+	return elbv2model.LoadBalancerStatus{
+		LoadBalancerARN: plan.UnknownValue(resLB.Type(), resLB.ID()),
+		DNSName:         plan.UnknownValue(resLB.Type(), resLB.ID()),
+	}, nil
+}
+
+func (m *planLoadBalancerManager) Update(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (elbv2model.LoadBalancerStatus, error) {
+	diffs, err := m.buildLoadBalancerDiffs(ctx, resLB, sdkLB)
+	if err != nil {
+		return elbv2model.LoadBalancerStatus{}, err
+	}
+	if len(diffs) != 0 {
+		m.changes.Record(plan.Change{
+			Action:       plan.ActionUpdate,
+			ResourceType: resLB.Type(),
+			ResourceID:   resLB.ID(),
+			Identifier:   awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
+			Diffs:        diffs,
+		})
+	}
+	return buildResLoadBalancerStatus(sdkLB), nil
+}
+
+func (m *planLoadBalancerManager) Delete(_ context.Context, sdkLB LoadBalancerWithTags) error {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionDelete,
+		ResourceType: resourceTypeLoadBalancer,
+		Identifier:   awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
+	})
+	return nil
+}
+
+// buildLoadBalancerDiffs returns the settings of sdkLB that would be modified by defaultLoadBalancerManager.
+func (m *planLoadBalancerManager) buildLoadBalancerDiffs(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) ([]string, error) {
+	var diffs []string
+	desiredLBTags := m.trackingProvider.ResourceTags(resLB.Stack(), resLB, resLB.Spec.Tags)
+	ignoredTagKeys := append(m.trackingProvider.LegacyTagKeys(), m.externalManagedTags...)
+	if isSDKTagsDrifted(desiredLBTags, sdkLB.Tags, ignoredTagKeys) {
+		diffs = append(diffs, "tags")
+	}
+
+	securityGroups, err := buildSDKSecurityGroups(resLB.Spec.SecurityGroups)
+	if err != nil {
+		return nil, err
+	}
+	desiredSecurityGroups := sets.NewString(awssdk.StringValueSlice(securityGroups)...)
+	currentSecurityGroups := sets.NewString(awssdk.StringValueSlice(sdkLB.LoadBalancer.SecurityGroups)...)
+	if !desiredSecurityGroups.Equal(currentSecurityGroups) {
+		diffs = append(diffs, "securityGroups")
+	}
+
+	desiredSubnets := sets.NewString()
+	for _, mapping := range resLB.Spec.SubnetMappings {
+		desiredSubnets.Insert(mapping.SubnetID)
+	}
+	currentSubnets := sets.NewString()
+	for _, az := range sdkLB.LoadBalancer.AvailabilityZones {
+		currentSubnets.Insert(awssdk.StringValue(az.SubnetId))
+	}
+	if !desiredSubnets.Equal(currentSubnets) {
+		diffs = append(diffs, "subnetMappings")
+	}
+
+	if resLB.Spec.IPAddressType != nil && string(*resLB.Spec.IPAddressType) != awssdk.StringValue(sdkLB.LoadBalancer.IpAddressType) {
+		diffs = append(diffs, "ipAddressType")
+	}
+
+	desiredAttrs := m.attributesReconciler.getDesiredLoadBalancerAttributes(ctx, resLB)
+	currentAttrs, err := m.attributesReconciler.getCurrentLoadBalancerAttributes(ctx, sdkLB)
+	if err != nil {
+		return nil, err
+	}
+	diffs = append(diffs, buildAttributesDiffs(desiredAttrs, currentAttrs)...)
+	return diffs, nil
+}
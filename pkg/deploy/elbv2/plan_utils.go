@@ -0,0 +1,36 @@
+package elbv2
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+)
+
+// resource types for the changes recorded into plan.
+const (
+	resourceTypeLoadBalancer       = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+	resourceTypeTargetGroup        = "AWS::ElasticLoadBalancingV2::TargetGroup"
+	resourceTypeTrustStore         = "AWS::ElasticLoadBalancingV2::TrustStore"
+	resourceTypeListener           = "AWS::ElasticLoadBalancingV2::Listener"
+	resourceTypeListenerRule       = "AWS::ElasticLoadBalancingV2::ListenerRule"
+	resourceTypeTargetGroupBinding = "K8S::ElasticLoadBalancingV2::TargetGroupBinding"
+)
+
+// isSDKTagsDrifted checks whether the current tags on resource differ from the desired tags, tags with ignoredTagKeys are not compared.
+func isSDKTagsDrifted(desiredTags map[string]string, currentTags map[string]string, ignoredTagKeys []string) bool {
+	tagsToUpdate, tagsToRemove := algorithm.DiffStringMap(desiredTags, currentTags)
+	for _, ignoredTagKey := range ignoredTagKeys {
+		delete(tagsToUpdate, ignoredTagKey)
+		delete(tagsToRemove, ignoredTagKey)
+	}
+	return len(tagsToUpdate) != 0 || len(tagsToRemove) != 0
+}
+
+// buildAttributesDiffs returns the diffs for attributes that needs to be modified, in the format of "attributes.<key>".
+func buildAttributesDiffs(desiredAttrs map[string]string, currentAttrs map[string]string) []string {
+	attributesToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs)
+	diffs := make([]string, 0, len(attributesToUpdate))
+	for _, attrKey := range sets.StringKeySet(attributesToUpdate).List() {
+		diffs = append(diffs, "attributes."+attrKey)
+	}
+	return diffs
+}
@@ -2,9 +2,15 @@ package elbv2
 
 import (
 	"context"
+	"strings"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
@@ -15,6 +21,12 @@ import (
 const (
 	// ELBV2 API supports up to 20 resource per DescribeTags API call.
 	defaultDescribeTagsChunkSize = 20
+	// ELBV2 API supports up to 20 resource per DescribeLoadBalancers or DescribeTargetGroups API call.
+	defaultDescribeResourcesChunkSize = 20
+
+	// resource types of ELBV2 resources in Resource Groups Tagging API.
+	rgtResourceTypeLoadBalancer = "elasticloadbalancing:loadbalancer"
+	rgtResourceTypeTargetGroup  = "elasticloadbalancing:targetgroup"
 )
 
 // LoadBalancer with it's tags.
@@ -29,6 +41,12 @@ type TargetGroupWithTags struct {
 	Tags        map[string]string
 }
 
+// TrustStore with it's tags.
+type TrustStoreWithTags struct {
+	TrustStore *elbv2sdk.TrustStore
+	Tags       map[string]string
+}
+
 // Listener with it's tags.
 type ListenerWithTags struct {
 	Listener *elbv2sdk.Listener
@@ -85,6 +103,9 @@ type TaggingManager interface {
 	// ListTargetGroups returns TargetGroups that matches any of the tagging requirements.
 	ListTargetGroups(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TargetGroupWithTags, error)
 
+	// ListTrustStores returns TrustStores that matches any of the tagging requirements.
+	ListTrustStores(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TrustStoreWithTags, error)
+
 	// ListListeners returns the LoadBalancer listeners along with tags
 	ListListeners(ctx context.Context, lbARN string) ([]ListenerWithTags, error)
 
@@ -93,26 +114,34 @@ type TaggingManager interface {
 }
 
 // NewDefaultTaggingManager constructs default TaggingManager.
-func NewDefaultTaggingManager(elbv2Client services.ELBV2, vpcID string, featureGates config.FeatureGates, logger logr.Logger) *defaultTaggingManager {
-	return &defaultTaggingManager{
-		elbv2Client:           elbv2Client,
-		vpcID:                 vpcID,
-		featureGates:          featureGates,
-		logger:                logger,
-		describeTagsChunkSize: defaultDescribeTagsChunkSize,
+func NewDefaultTaggingManager(elbv2Client services.ELBV2, rgtClient services.RGT, vpcID string, featureGates config.FeatureGates, logger logr.Logger) *defaultTaggingManager {
+	m := &defaultTaggingManager{
+		elbv2Client:                elbv2Client,
+		vpcID:                      vpcID,
+		featureGates:               featureGates,
+		logger:                     logger,
+		describeTagsChunkSize:      defaultDescribeTagsChunkSize,
+		describeResourcesChunkSize: defaultDescribeResourcesChunkSize,
+	}
+	if featureGates.Enabled(config.EnableRGTAPI) {
+		m.rgtClient = rgtClient
 	}
+	return m
 }
 
 var _ TaggingManager = &defaultTaggingManager{}
 
 // default implementation for TaggingManager
-// @TODO: use AWS Resource Groups Tagging API to optimize this implementation once it have PrivateLink support.
 type defaultTaggingManager struct {
-	elbv2Client           services.ELBV2
-	vpcID                 string
-	featureGates          config.FeatureGates
-	logger                logr.Logger
-	describeTagsChunkSize int
+	elbv2Client services.ELBV2
+	// rgtClient is used to discover LoadBalancers and TargetGroups by tags when EnableRGTAPI feature is enabled, it's nil otherwise.
+	// Resource Groups Tagging API doesn't have PrivateLink support, thus it's opt-in.
+	rgtClient                  services.RGT
+	vpcID                      string
+	featureGates               config.FeatureGates
+	logger                     logr.Logger
+	describeTagsChunkSize      int
+	describeResourcesChunkSize int
 }
 
 func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, arn string, desiredTags map[string]string, opts ...ReconcileTagsOption) error {
@@ -238,6 +267,9 @@ func (m *defaultTaggingManager) ListListenerRules(ctx context.Context, lsARN str
 }
 
 func (m *defaultTaggingManager) ListLoadBalancers(ctx context.Context, tagFilters ...tracking.TagFilter) ([]LoadBalancerWithTags, error) {
+	if m.rgtClient != nil {
+		return m.listLoadBalancersByRGT(ctx, tagFilters)
+	}
 	req := &elbv2sdk.DescribeLoadBalancersInput{}
 	lbs, err := m.elbv2Client.DescribeLoadBalancersAsList(ctx, req)
 	if err != nil {
@@ -280,6 +312,9 @@ func (m *defaultTaggingManager) ListLoadBalancers(ctx context.Context, tagFilter
 }
 
 func (m *defaultTaggingManager) ListTargetGroups(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TargetGroupWithTags, error) {
+	if m.rgtClient != nil {
+		return m.listTargetGroupsByRGT(ctx, tagFilters)
+	}
 	req := &elbv2sdk.DescribeTargetGroupsInput{}
 	tgs, err := m.elbv2Client.DescribeTargetGroupsAsList(ctx, req)
 	if err != nil {
@@ -321,6 +356,192 @@ func (m *defaultTaggingManager) ListTargetGroups(ctx context.Context, tagFilters
 	return matchedTGs, nil
 }
 
+// trust stores are not bound to a VPC, so we rely on the tag filters only.
+func (m *defaultTaggingManager) ListTrustStores(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TrustStoreWithTags, error) {
+	req := &elbv2sdk.DescribeTrustStoresInput{}
+	trustStores, err := m.elbv2Client.DescribeTrustStoresAsList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	tsARNs := make([]string, 0, len(trustStores))
+	tsByARN := make(map[string]*elbv2sdk.TrustStore, len(trustStores))
+	for _, ts := range trustStores {
+		tsARN := awssdk.StringValue(ts.TrustStoreArn)
+		tsARNs = append(tsARNs, tsARN)
+		tsByARN[tsARN] = ts
+	}
+	tagsByARN, err := m.describeResourceTags(ctx, tsARNs)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchedTSs []TrustStoreWithTags
+	for _, arn := range tsARNs {
+		tags := tagsByARN[arn]
+		matchedAnyTagFilter := false
+		for _, tagFilter := range tagFilters {
+			if tagFilter.Matches(tags) {
+				matchedAnyTagFilter = true
+				break
+			}
+		}
+		if matchedAnyTagFilter {
+			matchedTSs = append(matchedTSs, TrustStoreWithTags{
+				TrustStore: tsByARN[arn],
+				Tags:       tags,
+			})
+		}
+	}
+	return matchedTSs, nil
+}
+
+// listLoadBalancersByRGT lists LoadBalancers that matches any of the tagging requirements via Resource Groups Tagging API.
+// only the matched LoadBalancers are described, instead of all LoadBalancers in the region.
+func (m *defaultTaggingManager) listLoadBalancersByRGT(ctx context.Context, tagFilters []tracking.TagFilter) ([]LoadBalancerWithTags, error) {
+	lbARNs, tagsByARN, err := m.getResourcesByRGT(ctx, rgtResourceTypeLoadBalancer, tagFilters)
+	if err != nil {
+		return nil, err
+	}
+	// Resource Groups Tagging API returns classic load balancers as well.
+	elbv2LBARNs := make([]string, 0, len(lbARNs))
+	for _, lbARN := range lbARNs {
+		if isELBV2LoadBalancerARN(lbARN) {
+			elbv2LBARNs = append(elbv2LBARNs, lbARN)
+		}
+	}
+
+	var matchedLBs []LoadBalancerWithTags
+	for _, lbARNsChunk := range algorithm.ChunkStrings(elbv2LBARNs, m.describeResourcesChunkSize) {
+		lbs, err := m.describeLoadBalancersByARNs(ctx, lbARNsChunk)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range lbs {
+			if awssdk.StringValue(lb.VpcId) != m.vpcID {
+				continue
+			}
+			matchedLBs = append(matchedLBs, LoadBalancerWithTags{
+				LoadBalancer: lb,
+				Tags:         tagsByARN[awssdk.StringValue(lb.LoadBalancerArn)],
+			})
+		}
+	}
+	return matchedLBs, nil
+}
+
+// listTargetGroupsByRGT lists TargetGroups that matches any of the tagging requirements via Resource Groups Tagging API.
+// only the matched TargetGroups are described, instead of all TargetGroups in the region.
+func (m *defaultTaggingManager) listTargetGroupsByRGT(ctx context.Context, tagFilters []tracking.TagFilter) ([]TargetGroupWithTags, error) {
+	tgARNs, tagsByARN, err := m.getResourcesByRGT(ctx, rgtResourceTypeTargetGroup, tagFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchedTGs []TargetGroupWithTags
+	for _, tgARNsChunk := range algorithm.ChunkStrings(tgARNs, m.describeResourcesChunkSize) {
+		tgs, err := m.describeTargetGroupsByARNs(ctx, tgARNsChunk)
+		if err != nil {
+			return nil, err
+		}
+		for _, tg := range tgs {
+			if awssdk.StringValue(tg.VpcId) != m.vpcID {
+				continue
+			}
+			matchedTGs = append(matchedTGs, TargetGroupWithTags{
+				TargetGroup: tg,
+				Tags:        tagsByARN[awssdk.StringValue(tg.TargetGroupArn)],
+			})
+		}
+	}
+	return matchedTGs, nil
+}
+
+// getResourcesByRGT gets resources of resourceType that matches any of the tagFilters via Resource Groups Tagging API.
+// returns the ARNs of matched resources, and tags indexed by resource ARN.
+func (m *defaultTaggingManager) getResourcesByRGT(ctx context.Context, resourceType string, tagFilters []tracking.TagFilter) ([]string, map[string]map[string]string, error) {
+	var arns []string
+	tagsByARN := make(map[string]map[string]string)
+	for _, tagFilter := range tagFilters {
+		req := &rgtsdk.GetResourcesInput{
+			ResourceTypeFilters: awssdk.StringSlice([]string{resourceType}),
+			TagFilters:          convertTagFilterToRGTTagFilters(tagFilter),
+		}
+		resources, err := m.rgtClient.GetResourcesAsList(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, resource := range resources {
+			arn := awssdk.StringValue(resource.ResourceARN)
+			if _, ok := tagsByARN[arn]; ok {
+				continue
+			}
+			tags := convertRGTTagsToTags(resource.Tags)
+			// Resource Groups Tagging API is eventually consistent, thus tags are double-checked.
+			if !tagFilter.Matches(tags) {
+				continue
+			}
+			arns = append(arns, arn)
+			tagsByARN[arn] = tags
+		}
+	}
+	return arns, tagsByARN, nil
+}
+
+// describeLoadBalancersByARNs describes LoadBalancers by ARNs, LoadBalancers that no longer exist are ignored.
+// Resource Groups Tagging API might still return LoadBalancers that are recently deleted.
+func (m *defaultTaggingManager) describeLoadBalancersByARNs(ctx context.Context, lbARNs []string) ([]*elbv2sdk.LoadBalancer, error) {
+	req := &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: awssdk.StringSlice(lbARNs),
+	}
+	lbs, err := m.elbv2Client.DescribeLoadBalancersAsList(ctx, req)
+	if err == nil || !isLoadBalancerNotFoundError(err) {
+		return lbs, err
+	}
+	lbs = nil
+	for _, lbARN := range lbARNs {
+		req := &elbv2sdk.DescribeLoadBalancersInput{
+			LoadBalancerArns: awssdk.StringSlice([]string{lbARN}),
+		}
+		lbsForARN, err := m.elbv2Client.DescribeLoadBalancersAsList(ctx, req)
+		if err != nil {
+			if isLoadBalancerNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		lbs = append(lbs, lbsForARN...)
+	}
+	return lbs, nil
+}
+
+// describeTargetGroupsByARNs describes TargetGroups by ARNs, TargetGroups that no longer exist are ignored.
+// Resource Groups Tagging API might still return TargetGroups that are recently deleted.
+func (m *defaultTaggingManager) describeTargetGroupsByARNs(ctx context.Context, tgARNs []string) ([]*elbv2sdk.TargetGroup, error) {
+	req := &elbv2sdk.DescribeTargetGroupsInput{
+		TargetGroupArns: awssdk.StringSlice(tgARNs),
+	}
+	tgs, err := m.elbv2Client.DescribeTargetGroupsAsList(ctx, req)
+	if err == nil || !isTargetGroupNotFoundError(err) {
+		return tgs, err
+	}
+	tgs = nil
+	for _, tgARN := range tgARNs {
+		req := &elbv2sdk.DescribeTargetGroupsInput{
+			TargetGroupArns: awssdk.StringSlice([]string{tgARN}),
+		}
+		tgsForARN, err := m.elbv2Client.DescribeTargetGroupsAsList(ctx, req)
+		if err != nil {
+			if isTargetGroupNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		tgs = append(tgs, tgsForARN...)
+	}
+	return tgs, nil
+}
+
 // describeResourceTags describes tags for elbv2 resources.
 // returns tags indexed by resource ARN.
 func (m *defaultTaggingManager) describeResourceTags(ctx context.Context, arns []string) (map[string]map[string]string, error) {
@@ -365,3 +586,54 @@ func convertSDKTagsToTags(sdkTags []*elbv2sdk.Tag) map[string]string {
 	}
 	return tags
 }
+
+// convert tagFilter into Resource Groups Tagging API tag filters presentation.
+func convertTagFilterToRGTTagFilters(tagFilter tracking.TagFilter) []*rgtsdk.TagFilter {
+	rgtTagFilters := make([]*rgtsdk.TagFilter, 0, len(tagFilter))
+	for _, key := range sets.StringKeySet(tagFilter).List() {
+		rgtTagFilters = append(rgtTagFilters, &rgtsdk.TagFilter{
+			Key:    awssdk.String(key),
+			Values: awssdk.StringSlice(tagFilter[key]),
+		})
+	}
+	return rgtTagFilters
+}
+
+// convert Resource Groups Tagging API tag presentation into tags.
+func convertRGTTagsToTags(rgtTags []*rgtsdk.Tag) map[string]string {
+	tags := make(map[string]string, len(rgtTags))
+	for _, rgtTag := range rgtTags {
+		tags[awssdk.StringValue(rgtTag.Key)] = awssdk.StringValue(rgtTag.Value)
+	}
+	return tags
+}
+
+// isELBV2LoadBalancerARN checks whether lbARN is an ARN of application, network or gateway load balancer.
+func isELBV2LoadBalancerARN(lbARN string) bool {
+	parsedARN, err := arn.Parse(lbARN)
+	if err != nil {
+		return false
+	}
+	for _, prefix := range []string{"loadbalancer/app/", "loadbalancer/net/", "loadbalancer/gwy/"} {
+		if strings.HasPrefix(parsedARN.Resource, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isLoadBalancerNotFoundError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == elbv2sdk.ErrCodeLoadBalancerNotFoundException
+	}
+	return false
+}
+
+func isTargetGroupNotFoundError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == elbv2sdk.ErrCodeTargetGroupNotFoundException
+	}
+	return false
+}
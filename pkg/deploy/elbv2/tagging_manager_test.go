@@ -2,14 +2,20 @@ package elbv2
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/fake"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -1375,3 +1381,195 @@ func Test_convertSDKTagsToTags(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultTaggingManager_ListLoadBalancersAndTargetGroups_withRGTAPI(t *testing.T) {
+	ctx := context.Background()
+	cloud := fake.NewCloud(fake.Config{})
+	for _, subnet := range []*ec2sdk.Subnet{
+		{SubnetId: awssdk.String("subnet-a"), CidrBlock: awssdk.String("192.168.0.0/19"), AvailabilityZone: awssdk.String("us-west-2a"), AvailabilityZoneId: awssdk.String("usw2-az1")},
+		{SubnetId: awssdk.String("subnet-b"), CidrBlock: awssdk.String("192.168.32.0/19"), AvailabilityZone: awssdk.String("us-west-2b"), AvailabilityZoneId: awssdk.String("usw2-az2")},
+	} {
+		require.NoError(t, cloud.AddSubnet(subnet))
+	}
+	stackTags := func(stack string) []*elbv2sdk.Tag {
+		return []*elbv2sdk.Tag{
+			{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("cluster-name")},
+			{Key: awssdk.String("ingress.k8s.aws/stack"), Value: awssdk.String(stack)},
+		}
+	}
+	lbARNByStack := make(map[string]string)
+	tgARNByStack := make(map[string]string)
+	for _, stack := range []string{"stack-a", "stack-b"} {
+		lbResp, err := cloud.ELBV2().CreateLoadBalancerWithContext(ctx, &elbv2sdk.CreateLoadBalancerInput{
+			Name:    awssdk.String("lb-" + stack),
+			Subnets: awssdk.StringSlice([]string{"subnet-a", "subnet-b"}),
+			Tags:    stackTags(stack),
+		})
+		require.NoError(t, err)
+		lbARNByStack[stack] = awssdk.StringValue(lbResp.LoadBalancers[0].LoadBalancerArn)
+		tgResp, err := cloud.ELBV2().CreateTargetGroupWithContext(ctx, &elbv2sdk.CreateTargetGroupInput{
+			Name:       awssdk.String("tg-" + stack),
+			Protocol:   awssdk.String(elbv2sdk.ProtocolEnumHttp),
+			Port:       awssdk.Int64(80),
+			VpcId:      awssdk.String(cloud.VpcID()),
+			TargetType: awssdk.String(elbv2sdk.TargetTypeEnumIp),
+			Tags:       stackTags(stack),
+		})
+		require.NoError(t, err)
+		tgARNByStack[stack] = awssdk.StringValue(tgResp.TargetGroups[0].TargetGroupArn)
+	}
+	// resources without tags are never returned by Resource Groups Tagging API.
+	_, err := cloud.ELBV2().CreateTargetGroupWithContext(ctx, &elbv2sdk.CreateTargetGroupInput{
+		Name:       awssdk.String("tg-untagged"),
+		Protocol:   awssdk.String(elbv2sdk.ProtocolEnumHttp),
+		Port:       awssdk.Int64(80),
+		VpcId:      awssdk.String(cloud.VpcID()),
+		TargetType: awssdk.String(elbv2sdk.TargetTypeEnumIp),
+	})
+	require.NoError(t, err)
+
+	featureGates := config.NewFeatureGates()
+	featureGates.Enable(config.EnableRGTAPI)
+	m := NewDefaultTaggingManager(cloud.ELBV2(), cloud.RGT(), cloud.VpcID(), featureGates, logr.Discard())
+
+	tagFilters := []tracking.TagFilter{
+		{
+			"elbv2.k8s.aws/cluster": {"cluster-name"},
+			"ingress.k8s.aws/stack": {"stack-a"},
+		},
+		{
+			"elbv2.k8s.aws/cluster": {"other-cluster"},
+			"ingress.k8s.aws/stack": {"stack-b"},
+		},
+	}
+	wantTags := map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"ingress.k8s.aws/stack": "stack-a",
+	}
+
+	lbs, err := m.ListLoadBalancers(ctx, tagFilters...)
+	require.NoError(t, err)
+	require.Len(t, lbs, 1)
+	assert.Equal(t, lbARNByStack["stack-a"], awssdk.StringValue(lbs[0].LoadBalancer.LoadBalancerArn))
+	assert.Equal(t, wantTags, lbs[0].Tags)
+
+	tgs, err := m.ListTargetGroups(ctx, tagFilters...)
+	require.NoError(t, err)
+	require.Len(t, tgs, 1)
+	assert.Equal(t, tgARNByStack["stack-a"], awssdk.StringValue(tgs[0].TargetGroup.TargetGroupArn))
+	assert.Equal(t, wantTags, tgs[0].Tags)
+}
+
+func Test_defaultTaggingManager_describeLoadBalancersByARNs(t *testing.T) {
+	type describeLoadBalancersAsListCall struct {
+		req  *elbv2sdk.DescribeLoadBalancersInput
+		resp []*elbv2sdk.LoadBalancer
+		err  error
+	}
+	tests := []struct {
+		name                             string
+		lbARNs                           []string
+		describeLoadBalancersAsListCalls []describeLoadBalancersAsListCall
+		want                             []*elbv2sdk.LoadBalancer
+		wantErr                          error
+	}{
+		{
+			name:   "all load balancers found",
+			lbARNs: []string{"lb-1", "lb-2"},
+			describeLoadBalancersAsListCalls: []describeLoadBalancersAsListCall{
+				{
+					req:  &elbv2sdk.DescribeLoadBalancersInput{LoadBalancerArns: awssdk.StringSlice([]string{"lb-1", "lb-2"})},
+					resp: []*elbv2sdk.LoadBalancer{{LoadBalancerArn: awssdk.String("lb-1")}, {LoadBalancerArn: awssdk.String("lb-2")}},
+				},
+			},
+			want: []*elbv2sdk.LoadBalancer{{LoadBalancerArn: awssdk.String("lb-1")}, {LoadBalancerArn: awssdk.String("lb-2")}},
+		},
+		{
+			name:   "deleted load balancers are ignored",
+			lbARNs: []string{"lb-1", "lb-2"},
+			describeLoadBalancersAsListCalls: []describeLoadBalancersAsListCall{
+				{
+					req: &elbv2sdk.DescribeLoadBalancersInput{LoadBalancerArns: awssdk.StringSlice([]string{"lb-1", "lb-2"})},
+					err: awserr.New(elbv2sdk.ErrCodeLoadBalancerNotFoundException, "One or more load balancers not found", nil),
+				},
+				{
+					req: &elbv2sdk.DescribeLoadBalancersInput{LoadBalancerArns: awssdk.StringSlice([]string{"lb-1"})},
+					err: awserr.New(elbv2sdk.ErrCodeLoadBalancerNotFoundException, "One or more load balancers not found", nil),
+				},
+				{
+					req:  &elbv2sdk.DescribeLoadBalancersInput{LoadBalancerArns: awssdk.StringSlice([]string{"lb-2"})},
+					resp: []*elbv2sdk.LoadBalancer{{LoadBalancerArn: awssdk.String("lb-2")}},
+				},
+			},
+			want: []*elbv2sdk.LoadBalancer{{LoadBalancerArn: awssdk.String("lb-2")}},
+		},
+		{
+			name:   "other errors are returned",
+			lbARNs: []string{"lb-1"},
+			describeLoadBalancersAsListCalls: []describeLoadBalancersAsListCall{
+				{
+					req: &elbv2sdk.DescribeLoadBalancersInput{LoadBalancerArns: awssdk.StringSlice([]string{"lb-1"})},
+					err: errors.New("some error"),
+				},
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.describeLoadBalancersAsListCalls {
+				elbv2Client.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			m := &defaultTaggingManager{
+				elbv2Client:                elbv2Client,
+				vpcID:                      "vpc-xxxxxxx",
+				describeResourcesChunkSize: defaultDescribeResourcesChunkSize,
+			}
+			got, err := m.describeLoadBalancersByARNs(context.Background(), tt.lbARNs)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_isELBV2LoadBalancerARN(t *testing.T) {
+	tests := []struct {
+		name  string
+		lbARN string
+		want  bool
+	}{
+		{
+			name:  "application load balancer",
+			lbARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188",
+			want:  true,
+		},
+		{
+			name:  "network load balancer",
+			lbARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/my-lb/50dc6c495c0c9188",
+			want:  true,
+		},
+		{
+			name:  "classic load balancer",
+			lbARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/my-lb",
+			want:  false,
+		},
+		{
+			name:  "invalid ARN",
+			lbARN: "my-lb",
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isELBV2LoadBalancerARN(tt.lbARN))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package elbv2
+
+import (
+	"context"
+
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+)
+
+// NewPlanTaggingManager constructs new planTaggingManager.
+func NewPlanTaggingManager(taggingManager TaggingManager) *planTaggingManager {
+	return &planTaggingManager{
+		TaggingManager: taggingManager,
+	}
+}
+
+var _ TaggingManager = &planTaggingManager{}
+
+// planTaggingManager wraps TaggingManager for planning, where resources to be created are referenced by placeholder ARNs.
+// resources under a resource to be created don't exist yet, so they are listed as empty without calling AWS.
+type planTaggingManager struct {
+	TaggingManager
+}
+
+func (m *planTaggingManager) ListListeners(ctx context.Context, lbARN string) ([]ListenerWithTags, error) {
+	if plan.IsUnknownValue(lbARN) {
+		return nil, nil
+	}
+	return m.TaggingManager.ListListeners(ctx, lbARN)
+}
+
+func (m *planTaggingManager) ListListenerRules(ctx context.Context, lsARN string) ([]ListenerRuleWithTags, error) {
+	if plan.IsUnknownValue(lsARN) {
+		return nil, nil
+	}
+	return m.TaggingManager.ListListenerRules(ctx, lsARN)
+}
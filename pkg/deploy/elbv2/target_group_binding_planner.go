@@ -0,0 +1,73 @@
+package elbv2
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// NewPlanTargetGroupBindingManager constructs new planTargetGroupBindingManager.
+func NewPlanTargetGroupBindingManager(changes *plan.Plan, logger logr.Logger) *planTargetGroupBindingManager {
+	return &planTargetGroupBindingManager{
+		changes: changes,
+		logger:  logger,
+	}
+}
+
+var _ TargetGroupBindingManager = &planTargetGroupBindingManager{}
+
+// planTargetGroupBindingManager implements TargetGroupBindingManager by recording the changes into plan instead of applying them.
+type planTargetGroupBindingManager struct {
+	changes *plan.Plan
+
+	logger logr.Logger
+}
+
+func (m *planTargetGroupBindingManager) Create(ctx context.Context, resTGB *elbv2model.TargetGroupBindingResource) (elbv2model.TargetGroupBindingResourceStatus, error) {
+	if _, err := buildK8sTargetGroupBindingSpec(ctx, resTGB); err != nil {
+		return elbv2model.TargetGroupBindingResourceStatus{}, err
+	}
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionCreate,
+		ResourceType: resTGB.Type(),
+		ResourceID:   resTGB.ID(),
+	})
+	return elbv2model.TargetGroupBindingResourceStatus{
+		TargetGroupBindingRef: corev1.ObjectReference{
+			Namespace: resTGB.Spec.Template.Namespace,
+			Name:      resTGB.Spec.Template.Name,
+		},
+	}, nil
+}
+
+func (m *planTargetGroupBindingManager) Update(ctx context.Context, resTGB *elbv2model.TargetGroupBindingResource, k8sTGB *elbv2api.TargetGroupBinding) (elbv2model.TargetGroupBindingResourceStatus, error) {
+	k8sTGBSpec, err := buildK8sTargetGroupBindingSpec(ctx, resTGB)
+	if err != nil {
+		return elbv2model.TargetGroupBindingResourceStatus{}, err
+	}
+	if !equality.Semantic.DeepEqual(k8sTGB.Spec, k8sTGBSpec) {
+		m.changes.Record(plan.Change{
+			Action:       plan.ActionUpdate,
+			ResourceType: resTGB.Type(),
+			ResourceID:   resTGB.ID(),
+			Identifier:   k8s.NamespacedName(k8sTGB).String(),
+			Diffs:        []string{"spec"},
+		})
+	}
+	return buildResTargetGroupBindingStatus(k8sTGB), nil
+}
+
+func (m *planTargetGroupBindingManager) Delete(_ context.Context, k8sTGB *elbv2api.TargetGroupBinding) error {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionDelete,
+		ResourceType: resourceTypeTargetGroupBinding,
+		Identifier:   k8s.NamespacedName(k8sTGB).String(),
+	})
+	return nil
+}
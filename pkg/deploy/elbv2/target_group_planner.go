@@ -0,0 +1,94 @@
+package elbv2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// NewPlanTargetGroupManager constructs new planTargetGroupManager.
+func NewPlanTargetGroupManager(elbv2Client services.ELBV2, trackingProvider tracking.Provider,
+	externalManagedTags []string, changes *plan.Plan, logger logr.Logger) *planTargetGroupManager {
+	return &planTargetGroupManager{
+		trackingProvider:     trackingProvider,
+		attributesReconciler: NewDefaultTargetGroupAttributesReconciler(elbv2Client, logger),
+		externalManagedTags:  externalManagedTags,
+		changes:              changes,
+		logger:               logger,
+	}
+}
+
+var _ TargetGroupManager = &planTargetGroupManager{}
+
+// planTargetGroupManager implements TargetGroupManager by recording the changes into plan instead of applying them.
+type planTargetGroupManager struct {
+	trackingProvider     tracking.Provider
+	attributesReconciler *defaultTargetGroupAttributeReconciler
+	externalManagedTags  []string
+	changes              *plan.Plan
+
+	logger logr.Logger
+}
+
+func (m *planTargetGroupManager) Create(_ context.Context, resTG *elbv2model.TargetGroup) (elbv2model.TargetGroupStatus, error) {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionCreate,
+		ResourceType: resTG.Type(),
+		ResourceID:   resTG.ID(),
+	})
+	return elbv2model.TargetGroupStatus{
+		TargetGroupARN: plan.UnknownValue(resTG.Type(), resTG.ID()),
+	}, nil
+}
+
+func (m *planTargetGroupManager) Update(ctx context.Context, resTG *elbv2model.TargetGroup, sdkTG TargetGroupWithTags) (elbv2model.TargetGroupStatus, error) {
+	diffs, err := m.buildTargetGroupDiffs(ctx, resTG, sdkTG)
+	if err != nil {
+		return elbv2model.TargetGroupStatus{}, err
+	}
+	if len(diffs) != 0 {
+		m.changes.Record(plan.Change{
+			Action:       plan.ActionUpdate,
+			ResourceType: resTG.Type(),
+			ResourceID:   resTG.ID(),
+			Identifier:   awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn),
+			Diffs:        diffs,
+		})
+	}
+	return buildResTargetGroupStatus(sdkTG), nil
+}
+
+func (m *planTargetGroupManager) Delete(_ context.Context, sdkTG TargetGroupWithTags) error {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionDelete,
+		ResourceType: resourceTypeTargetGroup,
+		Identifier:   awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn),
+	})
+	return nil
+}
+
+// buildTargetGroupDiffs returns the settings of sdkTG that would be modified by defaultTargetGroupManager.
+func (m *planTargetGroupManager) buildTargetGroupDiffs(ctx context.Context, resTG *elbv2model.TargetGroup, sdkTG TargetGroupWithTags) ([]string, error) {
+	var diffs []string
+	desiredTGTags := m.trackingProvider.ResourceTags(resTG.Stack(), resTG, resTG.Spec.Tags)
+	ignoredTagKeys := append(m.trackingProvider.LegacyTagKeys(), m.externalManagedTags...)
+	if isSDKTagsDrifted(desiredTGTags, sdkTG.Tags, ignoredTagKeys) {
+		diffs = append(diffs, "tags")
+	}
+	if isSDKTargetGroupHealthCheckDrifted(resTG.Spec, sdkTG) {
+		diffs = append(diffs, "healthCheck")
+	}
+
+	desiredAttrs := m.attributesReconciler.getDesiredTargetGroupAttributes(ctx, resTG)
+	currentAttrs, err := m.attributesReconciler.getCurrentTargetGroupAttributes(ctx, sdkTG)
+	if err != nil {
+		return nil, err
+	}
+	diffs = append(diffs, buildAttributesDiffs(desiredAttrs, currentAttrs)...)
+	return diffs, nil
+}
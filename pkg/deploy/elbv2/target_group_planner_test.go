@@ -0,0 +1,170 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_planTargetGroupManager_Update(t *testing.T) {
+	port9090 := intstr.FromInt(9090)
+	protocolHTTP := elbv2model.ProtocolHTTP
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	trackingProvider := tracking.NewDefaultProvider("elbv2.k8s.aws", "cluster-name")
+	resTG := &elbv2model.TargetGroup{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::TargetGroup", "id-1"),
+		Spec: elbv2model.TargetGroupSpec{
+			Name:       "my-tg",
+			TargetType: elbv2model.TargetTypeIP,
+			Port:       8080,
+			Protocol:   elbv2model.ProtocolHTTP,
+			HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+				Port:                    &port9090,
+				Protocol:                &protocolHTTP,
+				Path:                    awssdk.String("/healthcheck"),
+				Matcher:                 &elbv2model.HealthCheckMatcher{HTTPCode: awssdk.String("200")},
+				IntervalSeconds:         awssdk.Int64(10),
+				TimeoutSeconds:          awssdk.Int64(5),
+				HealthyThresholdCount:   awssdk.Int64(3),
+				UnhealthyThresholdCount: awssdk.Int64(2),
+			},
+			TargetGroupAttributes: []elbv2model.TargetGroupAttribute{
+				{
+					Key:   "deregistration_delay.timeout_seconds",
+					Value: "60",
+				},
+			},
+		},
+	}
+	sdkTargetGroup := &elbv2sdk.TargetGroup{
+		TargetGroupArn:             awssdk.String("my-arn"),
+		HealthCheckEnabled:         awssdk.Bool(true),
+		HealthCheckIntervalSeconds: awssdk.Int64(10),
+		HealthCheckPath:            awssdk.String("/healthcheck"),
+		HealthCheckPort:            awssdk.String("9090"),
+		HealthCheckProtocol:        awssdk.String("HTTP"),
+		HealthCheckTimeoutSeconds:  awssdk.Int64(5),
+		HealthyThresholdCount:      awssdk.Int64(3),
+		Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
+		UnhealthyThresholdCount:    awssdk.Int64(2),
+	}
+	desiredTags := trackingProvider.ResourceTags(stack, resTG, nil)
+
+	tests := []struct {
+		name          string
+		sdkTG         TargetGroupWithTags
+		currentAttrs  []*elbv2sdk.TargetGroupAttribute
+		wantChanges   []plan.Change
+		wantStatusARN string
+	}{
+		{
+			name: "targetGroup isn't drifted",
+			sdkTG: TargetGroupWithTags{
+				TargetGroup: sdkTargetGroup,
+				Tags: algorithm.MergeStringMap(desiredTags, map[string]string{
+					"kubernetes.io/cluster/cluster-name": "owned",
+					"external-tag":                       "value",
+				}),
+			},
+			currentAttrs: []*elbv2sdk.TargetGroupAttribute{
+				{
+					Key:   awssdk.String("deregistration_delay.timeout_seconds"),
+					Value: awssdk.String("60"),
+				},
+			},
+			wantChanges:   []plan.Change{},
+			wantStatusARN: "my-arn",
+		},
+		{
+			name: "tags and attributes drifted",
+			sdkTG: TargetGroupWithTags{
+				TargetGroup: sdkTargetGroup,
+				Tags: map[string]string{
+					"elbv2.k8s.aws/cluster": "cluster-name",
+				},
+			},
+			currentAttrs: []*elbv2sdk.TargetGroupAttribute{
+				{
+					Key:   awssdk.String("deregistration_delay.timeout_seconds"),
+					Value: awssdk.String("300"),
+				},
+			},
+			wantChanges: []plan.Change{
+				{
+					Action:       plan.ActionUpdate,
+					ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+					ResourceID:   "id-1",
+					Identifier:   "my-arn",
+					Diffs:        []string{"tags", "attributes.deregistration_delay.timeout_seconds"},
+				},
+			},
+			wantStatusARN: "my-arn",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().DescribeTargetGroupAttributesWithContext(gomock.Any(), &elbv2sdk.DescribeTargetGroupAttributesInput{
+				TargetGroupArn: awssdk.String("my-arn"),
+			}).Return(&elbv2sdk.DescribeTargetGroupAttributesOutput{Attributes: tt.currentAttrs}, nil)
+
+			changes := plan.NewPlan(stack.StackID().String())
+			m := NewPlanTargetGroupManager(elbv2Client, trackingProvider, []string{"external-tag"}, changes, logr.New(&log.NullLogSink{}))
+			got, err := m.Update(context.Background(), resTG, tt.sdkTG)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatusARN, got.TargetGroupARN)
+			assert.Equal(t, tt.wantChanges, changes.Changes)
+		})
+	}
+}
+
+func Test_planTargetGroupManager_CreateAndDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resTG := &elbv2model.TargetGroup{
+		ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::TargetGroup", "id-1"),
+	}
+	// no calls are expected against elbv2Client.
+	elbv2Client := services.NewMockELBV2(ctrl)
+	changes := plan.NewPlan(stack.StackID().String())
+	m := NewPlanTargetGroupManager(elbv2Client, tracking.NewDefaultProvider("elbv2.k8s.aws", "cluster-name"), nil, changes, logr.New(&log.NullLogSink{}))
+
+	status, err := m.Create(context.Background(), resTG)
+	assert.NoError(t, err)
+	assert.True(t, plan.IsUnknownValue(status.TargetGroupARN))
+
+	err = m.Delete(context.Background(), TargetGroupWithTags{
+		TargetGroup: &elbv2sdk.TargetGroup{TargetGroupArn: awssdk.String("stale-arn")},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []plan.Change{
+		{
+			Action:       plan.ActionCreate,
+			ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+			ResourceID:   "id-1",
+		},
+		{
+			Action:       plan.ActionDelete,
+			ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+			Identifier:   "stale-arn",
+		},
+	}, changes.Changes)
+}
@@ -0,0 +1,174 @@
+package elbv2
+
+import (
+	"context"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	s3sdk "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"time"
+)
+
+const (
+	defaultWaitTSDeletionPollInterval = 2 * time.Second
+	defaultWaitTSDeletionTimeout      = 20 * time.Second
+)
+
+// TrustStoreManager is responsible for create/update/delete TrustStore resources.
+type TrustStoreManager interface {
+	Create(ctx context.Context, resTS *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error)
+
+	Update(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) (elbv2model.TrustStoreStatus, error)
+
+	Delete(ctx context.Context, sdkTS TrustStoreWithTags) error
+}
+
+// NewDefaultTrustStoreManager constructs new defaultTrustStoreManager.
+func NewDefaultTrustStoreManager(elbv2Client services.ELBV2, s3Client services.S3, trackingProvider tracking.Provider,
+	taggingManager TaggingManager, externalManagedTags []string, logger logr.Logger) *defaultTrustStoreManager {
+	return &defaultTrustStoreManager{
+		elbv2Client:         elbv2Client,
+		s3Client:            s3Client,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+
+		waitTSDeletionPollInterval: defaultWaitTSDeletionPollInterval,
+		waitTSDeletionTimeout:      defaultWaitTSDeletionTimeout,
+	}
+}
+
+var _ TrustStoreManager = &defaultTrustStoreManager{}
+
+// default implementation for TrustStoreManager
+type defaultTrustStoreManager struct {
+	elbv2Client         services.ELBV2
+	s3Client            services.S3
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+
+	logger logr.Logger
+
+	waitTSDeletionPollInterval time.Duration
+	waitTSDeletionTimeout      time.Duration
+}
+
+func (m *defaultTrustStoreManager) Create(ctx context.Context, resTS *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error) {
+	req := buildSDKCreateTrustStoreInput(resTS.Spec)
+	if resTS.Spec.CACertificatesBundle != nil {
+		bundleVersion, err := m.uploadCACertificatesBundle(ctx, resTS)
+		if err != nil {
+			return elbv2model.TrustStoreStatus{}, err
+		}
+		req.CaCertificatesBundleS3ObjectVersion = bundleVersion
+	}
+	tsTags := m.trackingProvider.ResourceTags(resTS.Stack(), resTS, resTS.Spec.Tags)
+	req.Tags = convertTagsToSDKTags(tsTags)
+
+	m.logger.Info("creating trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID())
+	resp, err := m.elbv2Client.CreateTrustStoreWithContext(ctx, req)
+	if err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	sdkTS := TrustStoreWithTags{
+		TrustStore: resp.TrustStores[0],
+		Tags:       tsTags,
+	}
+	m.logger.Info("created trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"arn", awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn))
+	return buildResTrustStoreStatus(sdkTS), nil
+}
+
+func (m *defaultTrustStoreManager) Update(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) (elbv2model.TrustStoreStatus, error) {
+	if err := m.updateSDKTrustStoreWithTags(ctx, resTS, sdkTS); err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	return buildResTrustStoreStatus(sdkTS), nil
+}
+
+func (m *defaultTrustStoreManager) Delete(ctx context.Context, sdkTS TrustStoreWithTags) error {
+	req := &elbv2sdk.DeleteTrustStoreInput{
+		TrustStoreArn: sdkTS.TrustStore.TrustStoreArn,
+	}
+
+	m.logger.Info("deleting trustStore",
+		"arn", awssdk.StringValue(req.TrustStoreArn))
+	if err := runtime.RetryImmediateOnError(m.waitTSDeletionPollInterval, m.waitTSDeletionTimeout, isTrustStoreInUseError, func() error {
+		_, err := m.elbv2Client.DeleteTrustStoreWithContext(ctx, req)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete trustStore")
+	}
+	m.logger.Info("deleted trustStore",
+		"arn", awssdk.StringValue(req.TrustStoreArn))
+
+	return nil
+}
+
+// uploadCACertificatesBundle uploads the ca certificates bundle of trust store to Amazon S3,
+// and returns the version of uploaded object if versioning is enabled on the bucket.
+func (m *defaultTrustStoreManager) uploadCACertificatesBundle(ctx context.Context, resTS *elbv2model.TrustStore) (*string, error) {
+	req := &s3sdk.PutObjectInput{
+		Bucket: awssdk.String(resTS.Spec.CACertificatesBundleS3Bucket),
+		Key:    awssdk.String(resTS.Spec.CACertificatesBundleS3Key),
+		Body:   strings.NewReader(awssdk.StringValue(resTS.Spec.CACertificatesBundle)),
+	}
+	m.logger.Info("uploading caCertificatesBundle",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"bucket", resTS.Spec.CACertificatesBundleS3Bucket,
+		"key", resTS.Spec.CACertificatesBundleS3Key)
+	resp, err := m.s3Client.PutObjectWithContext(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to upload caCertificatesBundle")
+	}
+	m.logger.Info("uploaded caCertificatesBundle",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"versionID", awssdk.StringValue(resp.VersionId))
+	return resp.VersionId, nil
+}
+
+func (m *defaultTrustStoreManager) updateSDKTrustStoreWithTags(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) error {
+	desiredTSTags := m.trackingProvider.ResourceTags(resTS.Stack(), resTS, resTS.Spec.Tags)
+	return m.taggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn), desiredTSTags,
+		WithCurrentTags(sdkTS.Tags),
+		WithIgnoredTagKeys(m.externalManagedTags))
+}
+
+func buildSDKCreateTrustStoreInput(tsSpec elbv2model.TrustStoreSpec) *elbv2sdk.CreateTrustStoreInput {
+	return &elbv2sdk.CreateTrustStoreInput{
+		Name:                                awssdk.String(tsSpec.Name),
+		CaCertificatesBundleS3Bucket:        awssdk.String(tsSpec.CACertificatesBundleS3Bucket),
+		CaCertificatesBundleS3Key:           awssdk.String(tsSpec.CACertificatesBundleS3Key),
+		CaCertificatesBundleS3ObjectVersion: tsSpec.CACertificatesBundleS3ObjectVersion,
+	}
+}
+
+func buildResTrustStoreStatus(sdkTS TrustStoreWithTags) elbv2model.TrustStoreStatus {
+	return elbv2model.TrustStoreStatus{
+		TrustStoreARN: awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn),
+	}
+}
+
+func isTrustStoreInUseError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == elbv2sdk.ErrCodeTrustStoreInUseException
+	}
+	return false
+}
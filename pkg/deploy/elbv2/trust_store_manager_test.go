@@ -0,0 +1,60 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/fake"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultTrustStoreManager_Create(t *testing.T) {
+	tests := []struct {
+		name         string
+		tsSpec       elbv2model.TrustStoreSpec
+		wantUploaded []byte
+	}{
+		{
+			name: "bundle in S3",
+			tsSpec: elbv2model.TrustStoreSpec{
+				Name:                         "k8s-awesomen-ing1-0123456789",
+				CACertificatesBundleS3Bucket: "bucket",
+				CACertificatesBundleS3Key:    "ca.pem",
+			},
+			wantUploaded: nil,
+		},
+		{
+			name: "bundle from secret is uploaded to S3",
+			tsSpec: elbv2model.TrustStoreSpec{
+				Name:                         "k8s-awesomen-ing1-0123456789",
+				CACertificatesBundleS3Bucket: "bucket",
+				CACertificatesBundleS3Key:    "ca.pem",
+				CACertificatesBundle:         awssdk.String("-----BEGIN CERTIFICATE-----"),
+			},
+			wantUploaded: []byte("-----BEGIN CERTIFICATE-----"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cloud := fake.NewCloud(fake.Config{})
+			m := NewDefaultTrustStoreManager(cloud.ELBV2(), cloud.S3(), tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+				nil, nil, logr.Discard())
+			stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "ing-1"})
+			resTS := elbv2model.NewTrustStore(stack, "443", tt.tsSpec)
+
+			got, err := m.Create(context.Background(), resTS)
+			require.NoError(t, err)
+			assert.Contains(t, got.TrustStoreARN, "truststore/k8s-awesomen-ing1-0123456789/")
+
+			uploaded, exists := cloud.S3Object("bucket", "ca.pem")
+			assert.Equal(t, tt.wantUploaded != nil, exists)
+			assert.Equal(t, tt.wantUploaded, uploaded)
+		})
+	}
+}
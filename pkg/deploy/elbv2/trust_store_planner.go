@@ -0,0 +1,67 @@
+package elbv2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// NewPlanTrustStoreManager constructs new planTrustStoreManager.
+func NewPlanTrustStoreManager(trackingProvider tracking.Provider, externalManagedTags []string,
+	changes *plan.Plan, logger logr.Logger) *planTrustStoreManager {
+	return &planTrustStoreManager{
+		trackingProvider:    trackingProvider,
+		externalManagedTags: externalManagedTags,
+		changes:             changes,
+		logger:              logger,
+	}
+}
+
+var _ TrustStoreManager = &planTrustStoreManager{}
+
+// planTrustStoreManager implements TrustStoreManager by recording the changes into plan instead of applying them.
+type planTrustStoreManager struct {
+	trackingProvider    tracking.Provider
+	externalManagedTags []string
+	changes             *plan.Plan
+
+	logger logr.Logger
+}
+
+func (m *planTrustStoreManager) Create(_ context.Context, resTS *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error) {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionCreate,
+		ResourceType: resTS.Type(),
+		ResourceID:   resTS.ID(),
+	})
+	return elbv2model.TrustStoreStatus{
+		TrustStoreARN: plan.UnknownValue(resTS.Type(), resTS.ID()),
+	}, nil
+}
+
+func (m *planTrustStoreManager) Update(_ context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) (elbv2model.TrustStoreStatus, error) {
+	desiredTSTags := m.trackingProvider.ResourceTags(resTS.Stack(), resTS, resTS.Spec.Tags)
+	if isSDKTagsDrifted(desiredTSTags, sdkTS.Tags, m.externalManagedTags) {
+		m.changes.Record(plan.Change{
+			Action:       plan.ActionUpdate,
+			ResourceType: resTS.Type(),
+			ResourceID:   resTS.ID(),
+			Identifier:   awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn),
+			Diffs:        []string{"tags"},
+		})
+	}
+	return buildResTrustStoreStatus(sdkTS), nil
+}
+
+func (m *planTrustStoreManager) Delete(_ context.Context, sdkTS TrustStoreWithTags) error {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionDelete,
+		ResourceType: resourceTypeTrustStore,
+		Identifier:   awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn),
+	})
+	return nil
+}
@@ -0,0 +1,108 @@
+package plan
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Action is the kind of change to a resource.
+type Action string
+
+const (
+	ActionCreate Action = "Create"
+	ActionUpdate Action = "Update"
+	ActionDelete Action = "Delete"
+)
+
+const unknownValuePrefix = "(known after apply"
+
+// UnknownValue returns the placeholder for values of resource that are only known after the changes are applied,
+// e.g. the ARN of a LoadBalancer to be created.
+func UnknownValue(resType string, resID string) string {
+	return fmt.Sprintf("%v: %v %v)", unknownValuePrefix, resType, resID)
+}
+
+// IsUnknownValue checks whether value is a placeholder returned by UnknownValue.
+func IsUnknownValue(value string) bool {
+	return strings.HasPrefix(value, unknownValuePrefix)
+}
+
+// Change is a change to be made to a resource.
+type Change struct {
+	// Action is the kind of change.
+	Action Action `json:"action"`
+
+	// ResourceType is the type of resource.
+	ResourceType string `json:"resourceType"`
+
+	// ResourceID is the ID of resource within stack, it's empty for resources to be deleted.
+	ResourceID string `json:"resourceID,omitempty"`
+
+	// Identifier is the ARN or ID of the existing resource, it's empty for resources to be created.
+	// For associations like WebACLAssociation and Protection, it's the ARN of the associated resource instead.
+	Identifier string `json:"identifier,omitempty"`
+
+	// Diffs are the settings of existing resource that differ from the desired state, it's only set for updates.
+	Diffs []string `json:"diffs,omitempty"`
+}
+
+// String returns the diff representation of change.
+func (c Change) String() string {
+	var symbol string
+	switch c.Action {
+	case ActionCreate:
+		symbol = "+"
+	case ActionUpdate:
+		symbol = "~"
+	case ActionDelete:
+		symbol = "-"
+	}
+	var parts []string
+	parts = append(parts, symbol, c.ResourceType)
+	if c.ResourceID != "" {
+		parts = append(parts, c.ResourceID)
+	}
+	if c.Identifier != "" {
+		parts = append(parts, fmt.Sprintf("(%v)", c.Identifier))
+	}
+	if len(c.Diffs) != 0 {
+		parts = append(parts, fmt.Sprintf("[%v]", strings.Join(c.Diffs, ", ")))
+	}
+	return strings.Join(parts, " ")
+}
+
+// NewPlan constructs new Plan for stack.
+func NewPlan(stackID string) *Plan {
+	return &Plan{
+		StackID: stackID,
+		Changes: []Change{},
+	}
+}
+
+// Plan contains the changes a deployment of resource stack would make.
+type Plan struct {
+	// StackID is the ID of the planned stack.
+	StackID string `json:"stackID"`
+
+	// Changes are the changes in the order they would be applied.
+	Changes []Change `json:"changes"`
+}
+
+// Record records a change into plan.
+func (p *Plan) Record(change Change) {
+	p.Changes = append(p.Changes, change)
+}
+
+// Empty checks whether the plan contains no change.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns the diff representation of plan, one change per line.
+func (p *Plan) String() string {
+	lines := make([]string, 0, len(p.Changes))
+	for _, change := range p.Changes {
+		lines = append(lines, change.String())
+	}
+	return strings.Join(lines, "\n")
+}
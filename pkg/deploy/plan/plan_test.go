@@ -0,0 +1,62 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsUnknownValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  bool
+	}{
+		{
+			name:  "unknown value",
+			value: UnknownValue("AWS::ElasticLoadBalancingV2::LoadBalancer", "LoadBalancer"),
+			want:  true,
+		},
+		{
+			name:  "ARN",
+			value: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/1234567890abcdef",
+			want:  false,
+		},
+		{
+			name:  "empty value",
+			value: "",
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsUnknownValue(tt.value))
+		})
+	}
+}
+
+func TestPlan_String(t *testing.T) {
+	p := NewPlan("awesome-ns/awesome-ing")
+	assert.True(t, p.Empty())
+	p.Record(Change{
+		Action:       ActionDelete,
+		ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+		Identifier:   "tg-arn",
+	})
+	p.Record(Change{
+		Action:       ActionCreate,
+		ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+		ResourceID:   "awesome-ns/awesome-svc:80",
+	})
+	p.Record(Change{
+		Action:       ActionUpdate,
+		ResourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+		ResourceID:   "LoadBalancer",
+		Identifier:   "lb-arn",
+		Diffs:        []string{"tags", "attributes.idle_timeout.timeout_seconds"},
+	})
+	assert.False(t, p.Empty())
+	assert.Equal(t, `- AWS::ElasticLoadBalancingV2::TargetGroup (tg-arn)
++ AWS::ElasticLoadBalancingV2::TargetGroup awesome-ns/awesome-svc:80
+~ AWS::ElasticLoadBalancingV2::LoadBalancer LoadBalancer (lb-arn) [tags, attributes.idle_timeout.timeout_seconds]`, p.String())
+}
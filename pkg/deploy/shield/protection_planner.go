@@ -0,0 +1,61 @@
+package shield
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+)
+
+const (
+	resourceTypeProtection = "AWS::Shield::Protection"
+)
+
+// NewPlanProtectionManager constructs new planProtectionManager.
+func NewPlanProtectionManager(protectionManager ProtectionManager, changes *plan.Plan, logger logr.Logger) *planProtectionManager {
+	return &planProtectionManager{
+		protectionManager: protectionManager,
+		changes:           changes,
+		logger:            logger,
+	}
+}
+
+var _ ProtectionManager = &planProtectionManager{}
+
+// planProtectionManager implements ProtectionManager by recording the changes into plan instead of applying them.
+// the current protections are still read from AWS, except for resources to be created.
+type planProtectionManager struct {
+	protectionManager ProtectionManager
+	changes           *plan.Plan
+
+	logger logr.Logger
+}
+
+func (m *planProtectionManager) CreateProtection(_ context.Context, resourceARN string, _ string) (string, error) {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionCreate,
+		ResourceType: resourceTypeProtection,
+		Identifier:   resourceARN,
+	})
+	return plan.UnknownValue(resourceTypeProtection, resourceARN), nil
+}
+
+func (m *planProtectionManager) DeleteProtection(_ context.Context, resourceARN string, _ string) error {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionDelete,
+		ResourceType: resourceTypeProtection,
+		Identifier:   resourceARN,
+	})
+	return nil
+}
+
+func (m *planProtectionManager) GetProtection(ctx context.Context, resourceARN string) (*ProtectionInfo, error) {
+	if plan.IsUnknownValue(resourceARN) {
+		return nil, nil
+	}
+	return m.protectionManager.GetProtection(ctx, resourceARN)
+}
+
+func (m *planProtectionManager) IsSubscribed(ctx context.Context) (bool, error) {
+	return m.protectionManager.IsSubscribed(ctx)
+}
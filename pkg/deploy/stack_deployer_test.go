@@ -0,0 +1,160 @@
+package deploy
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/fake"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultStackDeployer_Deploy_withFakeCloud(t *testing.T) {
+	stackID := core.StackID{Namespace: "awesome-ns", Name: "awesome-ing"}
+	buildStack := func(withResources bool) core.Stack {
+		stack := core.NewDefaultStack(stackID)
+		if !withResources {
+			return stack
+		}
+		sg := ec2model.NewSecurityGroup(stack, "ManagedLBSecurityGroup", ec2model.SecurityGroupSpec{
+			GroupName:   "k8s-awesomen-awesomei-0123456789",
+			Description: "[k8s] Managed SecurityGroup for LoadBalancer",
+			Ingress: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "0.0.0.0/0"}},
+				},
+			},
+		})
+		lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+			Name: "k8s-awesomen-awesomei-0123456789",
+			Type: elbv2model.LoadBalancerTypeApplication,
+			SubnetMappings: []elbv2model.SubnetMapping{
+				{SubnetID: "subnet-a"},
+				{SubnetID: "subnet-b"},
+			},
+			SecurityGroups: []core.StringToken{sg.GroupID()},
+		})
+		tg := elbv2model.NewTargetGroup(stack, "awesome-ns/awesome-ing-svc:80", elbv2model.TargetGroupSpec{
+			Name:       "k8s-awesomen-svc-0123456789",
+			TargetType: elbv2model.TargetTypeIP,
+			Port:       8080,
+			Protocol:   elbv2model.ProtocolHTTP,
+		})
+		ls := elbv2model.NewListener(stack, "80", elbv2model.ListenerSpec{
+			LoadBalancerARN: lb.LoadBalancerARN(),
+			Port:            80,
+			Protocol:        elbv2model.ProtocolHTTP,
+			DefaultActions: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeFixedResponse,
+					FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+						StatusCode: "404",
+					},
+				},
+			},
+		})
+		elbv2model.NewListenerRule(stack, "80:1", elbv2model.ListenerRuleSpec{
+			ListenerARN: ls.ListenerARN(),
+			Priority:    1,
+			Actions: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeForward,
+					ForwardConfig: &elbv2model.ForwardActionConfig{
+						TargetGroups: []elbv2model.TargetGroupTuple{{TargetGroupARN: tg.TargetGroupARN()}},
+					},
+				},
+			},
+			Conditions: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+						Values: []string{"/api/*"},
+					},
+				},
+			},
+		})
+		return stack
+	}
+
+	cloud := fake.NewCloud(fake.Config{})
+	for _, subnet := range []*ec2sdk.Subnet{
+		{SubnetId: awssdk.String("subnet-a"), CidrBlock: awssdk.String("192.168.0.0/19"), AvailabilityZone: awssdk.String("us-west-2a"), AvailabilityZoneId: awssdk.String("usw2-az1")},
+		{SubnetId: awssdk.String("subnet-b"), CidrBlock: awssdk.String("192.168.32.0/19"), AvailabilityZone: awssdk.String("us-west-2b"), AvailabilityZoneId: awssdk.String("usw2-az2")},
+	} {
+		require.NoError(t, cloud.AddSubnet(subnet))
+	}
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	elbv2api.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	networkingSGManager := networking.NewDefaultSecurityGroupManager(cloud.EC2(), logr.Discard())
+	networkingSGReconciler := networking.NewDefaultSecurityGroupReconciler(networkingSGManager, logr.Discard())
+	controllerConfig := config.ControllerConfig{
+		ClusterName:  "awesome-cluster",
+		FeatureGates: config.NewFeatureGates(),
+	}
+	deployer := NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, controllerConfig, "elbv2.k8s.aws", logr.Discard())
+	ctx := context.Background()
+
+	// deploy twice, the second deployment should converge to the same AWS resources.
+	var lbARN string
+	for i := 0; i < 2; i++ {
+		require.NoError(t, deployer.Deploy(ctx, buildStack(true)))
+
+		lbs, err := cloud.ELBV2().DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{})
+		require.NoError(t, err)
+		require.Len(t, lbs, 1)
+		if lbARN == "" {
+			lbARN = awssdk.StringValue(lbs[0].LoadBalancerArn)
+		}
+		assert.Equal(t, lbARN, awssdk.StringValue(lbs[0].LoadBalancerArn))
+		assert.Len(t, lbs[0].SecurityGroups, 1)
+		assert.Len(t, lbs[0].AvailabilityZones, 2)
+
+		listeners, err := cloud.ELBV2().DescribeListenersAsList(ctx, &elbv2sdk.DescribeListenersInput{LoadBalancerArn: awssdk.String(lbARN)})
+		require.NoError(t, err)
+		require.Len(t, listeners, 1)
+		assert.Equal(t, int64(80), awssdk.Int64Value(listeners[0].Port))
+
+		rules, err := cloud.ELBV2().DescribeRulesAsList(ctx, &elbv2sdk.DescribeRulesInput{ListenerArn: listeners[0].ListenerArn})
+		require.NoError(t, err)
+		require.Len(t, rules, 2)
+		assert.Equal(t, "100", awssdk.StringValue(rules[0].Priority))
+		assert.True(t, awssdk.BoolValue(rules[1].IsDefault))
+
+		tgs, err := cloud.ELBV2().DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{})
+		require.NoError(t, err)
+		require.Len(t, tgs, 1)
+		assert.Equal(t, []string{lbARN}, awssdk.StringValueSlice(tgs[0].LoadBalancerArns))
+	}
+
+	// deploying an empty stack should clean up all AWS resources.
+	require.NoError(t, deployer.Deploy(ctx, buildStack(false)))
+	lbs, err := cloud.ELBV2().DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{})
+	require.NoError(t, err)
+	assert.Empty(t, lbs)
+	tgs, err := cloud.ELBV2().DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{})
+	require.NoError(t, err)
+	assert.Empty(t, tgs)
+	sgs, err := cloud.EC2().DescribeSecurityGroupsAsList(ctx, &ec2sdk.DescribeSecurityGroupsInput{
+		Filters: []*ec2sdk.Filter{{Name: awssdk.String("tag:elbv2.k8s.aws/cluster"), Values: awssdk.StringSlice([]string{"awesome-cluster"})}},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, sgs)
+}
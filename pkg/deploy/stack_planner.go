@@ -0,0 +1,119 @@
+package deploy
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/shield"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafregional"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StackPlanner will compute the changes that deploying a resource stack would make into AWS and K8S, without applying them.
+type StackPlanner interface {
+	// Plan the deployment of a resource stack.
+	// the resources of stack are fulfilled with the status of existing resources or placeholders for resources to be created,
+	// so the stack shouldn't be deployed afterwards.
+	Plan(ctx context.Context, stack core.Stack) (*plan.Plan, error)
+}
+
+// NewDefaultStackPlanner constructs new defaultStackPlanner.
+func NewDefaultStackPlanner(cloud aws.Cloud, k8sClient client.Client, networkingSGManager networking.SecurityGroupManager,
+	config config.ControllerConfig, tagPrefix string, logger logr.Logger) *defaultStackPlanner {
+
+	trackingProvider := tracking.NewDefaultProvider(tagPrefix, config.ClusterName)
+	ec2TaggingManager := ec2.NewDefaultTaggingManager(cloud.EC2(), networkingSGManager, cloud.VpcID(), logger)
+	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.RGT(), cloud.VpcID(), config.FeatureGates, logger)
+
+	return &defaultStackPlanner{
+		cloud:                               cloud,
+		k8sClient:                           k8sClient,
+		addonsConfig:                        config.AddonsConfig,
+		trackingProvider:                    trackingProvider,
+		ec2TaggingManager:                   ec2TaggingManager,
+		elbv2TaggingManager:                 elbv2.NewPlanTaggingManager(elbv2TaggingManager),
+		wafv2WebACLAssociationManager:       wafv2.NewDefaultWebACLAssociationManager(cloud.WAFv2(), logger),
+		wafRegionalWebACLAssociationManager: wafregional.NewDefaultWebACLAssociationManager(cloud.WAFRegional(), logger),
+		shieldProtectionManager:             shield.NewDefaultProtectionManager(cloud.Shield(), logger),
+		externalManagedTags:                 config.ExternalManagedTags,
+		featureGates:                        config.FeatureGates,
+		vpcID:                               cloud.VpcID(),
+		logger:                              logger,
+	}
+}
+
+var _ StackPlanner = &defaultStackPlanner{}
+
+// defaultStackPlanner is the default implementation for StackPlanner.
+// It runs the same synthesizers as defaultStackDeployer, with managers that record the changes into plan instead of applying them.
+// Only read-only calls are made to AWS and K8S.
+type defaultStackPlanner struct {
+	cloud                               aws.Cloud
+	k8sClient                           client.Client
+	addonsConfig                        config.AddonsConfig
+	trackingProvider                    tracking.Provider
+	ec2TaggingManager                   ec2.TaggingManager
+	elbv2TaggingManager                 elbv2.TaggingManager
+	wafv2WebACLAssociationManager       wafv2.WebACLAssociationManager
+	wafRegionalWebACLAssociationManager wafregional.WebACLAssociationManager
+	shieldProtectionManager             shield.ProtectionManager
+	externalManagedTags                 []string
+	featureGates                        config.FeatureGates
+	vpcID                               string
+
+	logger logr.Logger
+}
+
+// Plan the deployment of a resource stack.
+func (p *defaultStackPlanner) Plan(ctx context.Context, stack core.Stack) (*plan.Plan, error) {
+	changes := plan.NewPlan(stack.StackID().String())
+	ec2SGManager := ec2.NewPlanSecurityGroupManager(p.trackingProvider, p.externalManagedTags, changes, p.logger)
+	elbv2LBManager := elbv2.NewPlanLoadBalancerManager(p.cloud.ELBV2(), p.trackingProvider, p.externalManagedTags, changes, p.logger)
+	elbv2LSManager := elbv2.NewPlanListenerManager(p.cloud.ELBV2(), p.trackingProvider, p.externalManagedTags, p.featureGates, changes, p.logger)
+	elbv2LRManager := elbv2.NewPlanListenerRuleManager(p.trackingProvider, p.externalManagedTags, p.featureGates, changes, p.logger)
+	elbv2TGManager := elbv2.NewPlanTargetGroupManager(p.cloud.ELBV2(), p.trackingProvider, p.externalManagedTags, changes, p.logger)
+	elbv2TGBManager := elbv2.NewPlanTargetGroupBindingManager(changes, p.logger)
+	elbv2TSManager := elbv2.NewPlanTrustStoreManager(p.trackingProvider, p.externalManagedTags, changes, p.logger)
+
+	synthesizers := []ResourceSynthesizer{
+		ec2.NewSecurityGroupSynthesizer(p.cloud.EC2(), p.trackingProvider, p.ec2TaggingManager, ec2SGManager, p.vpcID, p.logger, stack),
+		elbv2.NewTargetGroupSynthesizer(p.cloud.ELBV2(), p.trackingProvider, p.elbv2TaggingManager, elbv2TGManager, p.logger, p.featureGates, stack),
+		elbv2.NewTrustStoreSynthesizer(p.trackingProvider, p.elbv2TaggingManager, elbv2TSManager, p.logger, stack),
+		elbv2.NewLoadBalancerSynthesizer(p.cloud.ELBV2(), p.trackingProvider, p.elbv2TaggingManager, elbv2LBManager, p.logger, stack),
+		elbv2.NewListenerSynthesizer(p.cloud.ELBV2(), p.elbv2TaggingManager, elbv2LSManager, p.logger, stack),
+		elbv2.NewListenerRuleSynthesizer(p.cloud.ELBV2(), p.elbv2TaggingManager, elbv2LRManager, p.logger, stack),
+		elbv2.NewTargetGroupBindingSynthesizer(p.k8sClient, p.trackingProvider, elbv2TGBManager, p.logger, stack),
+	}
+
+	if p.addonsConfig.WAFV2Enabled {
+		associationManager := wafv2.NewPlanWebACLAssociationManager(p.wafv2WebACLAssociationManager, changes, p.logger)
+		synthesizers = append(synthesizers, wafv2.NewWebACLAssociationSynthesizer(associationManager, p.logger, stack))
+	}
+	if p.addonsConfig.WAFEnabled && p.cloud.WAFRegional().Available() {
+		associationManager := wafregional.NewPlanWebACLAssociationManager(p.wafRegionalWebACLAssociationManager, changes, p.logger)
+		synthesizers = append(synthesizers, wafregional.NewWebACLAssociationSynthesizer(associationManager, p.logger, stack))
+	}
+	if p.addonsConfig.ShieldEnabled {
+		shieldSubscribed, err := p.shieldProtectionManager.IsSubscribed(ctx)
+		if err != nil {
+			p.logger.Error(err, "unable to determine AWS Shield subscription state, skipping AWS shield planning")
+		} else if shieldSubscribed {
+			protectionManager := shield.NewPlanProtectionManager(p.shieldProtectionManager, changes, p.logger)
+			synthesizers = append(synthesizers, shield.NewProtectionSynthesizer(protectionManager, p.logger, stack))
+		}
+	}
+
+	if err := runSynthesizers(ctx, synthesizers); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
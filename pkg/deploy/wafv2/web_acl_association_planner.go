@@ -0,0 +1,70 @@
+package wafv2
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+)
+
+const (
+	resourceTypeWebACLAssociation = "AWS::WAFv2::WebACLAssociation"
+)
+
+// NewPlanWebACLAssociationManager constructs new planWebACLAssociationManager.
+func NewPlanWebACLAssociationManager(associationManager WebACLAssociationManager, changes *plan.Plan, logger logr.Logger) *planWebACLAssociationManager {
+	return &planWebACLAssociationManager{
+		associationManager: associationManager,
+		changes:            changes,
+		logger:             logger,
+	}
+}
+
+var _ WebACLAssociationManager = &planWebACLAssociationManager{}
+
+// planWebACLAssociationManager implements WebACLAssociationManager by recording the changes into plan instead of applying them.
+// the current associations are still read from AWS, except for resources to be created.
+type planWebACLAssociationManager struct {
+	associationManager WebACLAssociationManager
+	changes            *plan.Plan
+
+	logger logr.Logger
+}
+
+func (m *planWebACLAssociationManager) AssociateWebACL(ctx context.Context, resourceARN string, webACLARN string) error {
+	currentWebACLARN, err := m.GetAssociatedWebACL(ctx, resourceARN)
+	if err != nil {
+		return err
+	}
+	if currentWebACLARN == "" {
+		m.changes.Record(plan.Change{
+			Action:       plan.ActionCreate,
+			ResourceType: resourceTypeWebACLAssociation,
+			Identifier:   resourceARN,
+		})
+		return nil
+	}
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionUpdate,
+		ResourceType: resourceTypeWebACLAssociation,
+		Identifier:   resourceARN,
+		Diffs:        []string{"webACLARN"},
+	})
+	return nil
+}
+
+func (m *planWebACLAssociationManager) DisassociateWebACL(_ context.Context, resourceARN string) error {
+	m.changes.Record(plan.Change{
+		Action:       plan.ActionDelete,
+		ResourceType: resourceTypeWebACLAssociation,
+		Identifier:   resourceARN,
+	})
+	return nil
+}
+
+func (m *planWebACLAssociationManager) GetAssociatedWebACL(ctx context.Context, resourceARN string) (string, error) {
+	if plan.IsUnknownValue(resourceARN) {
+		return "", nil
+	}
+	return m.associationManager.GetAssociatedWebACL(ctx, resourceARN)
+}
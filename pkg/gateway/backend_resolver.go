@@ -0,0 +1,135 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// serviceBackend is a backendRef resolved to a Service port.
+type serviceBackend struct {
+	Service *corev1.Service
+	Port    corev1.ServicePort
+}
+
+// unresolvedBackend describes why a backendRef cannot be resolved.
+type unresolvedBackend struct {
+	Reason  gwv1beta1.RouteConditionReason
+	Message string
+}
+
+// newServiceBackendResolver constructs new serviceBackendResolver.
+func newServiceBackendResolver(k8sClient client.Client) *serviceBackendResolver {
+	return &serviceBackendResolver{
+		k8sClient: k8sClient,
+		services:  make(map[types.NamespacedName]*corev1.Service),
+	}
+}
+
+// serviceBackendResolver resolves backendRefs on routes into Service ports.
+// fetched services are cached, so it should only be used within a single translation.
+type serviceBackendResolver struct {
+	k8sClient client.Client
+	services  map[types.NamespacedName]*corev1.Service
+}
+
+// resolve resolves the backendRef on route within routeNamespace.
+// a non-nil unresolvedBackend will be returned if the backendRef doesn't reference an existing Service port.
+func (r *serviceBackendResolver) resolve(ctx context.Context, routeNamespace string, backendRef gwv1beta1.BackendRef) (serviceBackend, *unresolvedBackend, error) {
+	if (backendRef.Group != nil && *backendRef.Group != "") || (backendRef.Kind != nil && *backendRef.Kind != "Service") {
+		return serviceBackend{}, &unresolvedBackend{
+			Reason:  gwv1beta1.RouteReasonInvalidKind,
+			Message: fmt.Sprintf("unsupported backendRef kind: %v", backendRef.Name),
+		}, nil
+	}
+	if backendRef.Namespace != nil && string(*backendRef.Namespace) != routeNamespace {
+		return serviceBackend{}, &unresolvedBackend{
+			Reason:  gwv1beta1.RouteReasonRefNotPermitted,
+			Message: fmt.Sprintf("cross-namespace backendRef is not permitted: %v/%v", *backendRef.Namespace, backendRef.Name),
+		}, nil
+	}
+	if backendRef.Port == nil {
+		return serviceBackend{}, &unresolvedBackend{
+			Reason:  gwv1beta1.RouteReasonBackendNotFound,
+			Message: fmt.Sprintf("missing port for backendRef: %v", backendRef.Name),
+		}, nil
+	}
+
+	svcKey := types.NamespacedName{Namespace: routeNamespace, Name: string(backendRef.Name)}
+	svc, ok := r.services[svcKey]
+	if !ok {
+		svc = &corev1.Service{}
+		if err := r.k8sClient.Get(ctx, svcKey, svc); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return serviceBackend{}, nil, err
+			}
+			svc = nil
+		}
+		r.services[svcKey] = svc
+	}
+	if svc == nil {
+		return serviceBackend{}, &unresolvedBackend{
+			Reason:  gwv1beta1.RouteReasonBackendNotFound,
+			Message: fmt.Sprintf("service not found: %v", svcKey.String()),
+		}, nil
+	}
+	svcPort, err := k8s.LookupServicePort(svc, intstr.FromInt(int(*backendRef.Port)))
+	if err != nil {
+		return serviceBackend{}, &unresolvedBackend{
+			Reason:  gwv1beta1.RouteReasonBackendNotFound,
+			Message: fmt.Sprintf("service port not found: %v:%v", svcKey.String(), *backendRef.Port),
+		}, nil
+	}
+	return serviceBackend{
+		Service: svc,
+		Port:    svcPort,
+	}, nil, nil
+}
+
+// newRouteBackendResolver constructs new routeBackendResolver for route within routeNamespace.
+func newRouteBackendResolver(resolver *serviceBackendResolver, routeNamespace string) *routeBackendResolver {
+	return &routeBackendResolver{
+		resolver:       resolver,
+		routeNamespace: routeNamespace,
+	}
+}
+
+// routeBackendResolver resolves the backendRefs of a single route, and records the first backendRef that cannot be resolved.
+// both HTTP and L4 routes resolve backends via it, so that they report the ResolvedRefs condition consistently.
+type routeBackendResolver struct {
+	resolver       *serviceBackendResolver
+	routeNamespace string
+
+	// the first backendRef that cannot be resolved.
+	unresolved *unresolvedBackend
+}
+
+// resolve resolves the backendRef into a Service port, nil will be returned if it cannot be resolved.
+func (r *routeBackendResolver) resolve(ctx context.Context, backendRef gwv1beta1.BackendRef) (*serviceBackend, error) {
+	backend, unresolved, err := r.resolver.resolve(ctx, r.routeNamespace, backendRef)
+	if err != nil {
+		return nil, err
+	}
+	if unresolved != nil {
+		if r.unresolved == nil {
+			r.unresolved = unresolved
+		}
+		return nil, nil
+	}
+	return &backend, nil
+}
+
+// resolvedRefsCondition returns the status, reason and message for the ResolvedRefs condition of the route.
+func (r *routeBackendResolver) resolvedRefsCondition() (bool, gwv1beta1.RouteConditionReason, string) {
+	if r.unresolved != nil {
+		return false, r.unresolved.Reason, r.unresolved.Message
+	}
+	return true, gwv1beta1.RouteReasonResolvedRefs, "all references resolved"
+}
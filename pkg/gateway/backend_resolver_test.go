@@ -0,0 +1,77 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func Test_routeBackendResolver_resolve(t *testing.T) {
+	port80 := gwv1beta1.PortNumber(80)
+	port8080 := gwv1beta1.PortNumber(8080)
+	otherNamespace := gwv1beta1.Namespace("other-ns")
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc-1"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080)}},
+		},
+	}
+	tests := []struct {
+		name             string
+		backendRefs      []gwv1beta1.BackendRef
+		wantResolved     []bool
+		wantResolvedRefs bool
+		wantReason       gwv1beta1.RouteConditionReason
+	}{
+		{
+			name: "all backendRefs are resolved",
+			backendRefs: []gwv1beta1.BackendRef{
+				{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "svc-1", Port: &port80}},
+			},
+			wantResolved:     []bool{true},
+			wantResolvedRefs: true,
+			wantReason:       gwv1beta1.RouteReasonResolvedRefs,
+		},
+		{
+			name: "the first unresolved backendRef is reported",
+			backendRefs: []gwv1beta1.BackendRef{
+				{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "svc-1", Port: &port80, Namespace: &otherNamespace}},
+				{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "svc-1", Port: &port8080}},
+				{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "svc-1", Port: &port80}},
+			},
+			wantResolved:     []bool{false, false, true},
+			wantResolvedRefs: false,
+			wantReason:       gwv1beta1.RouteReasonRefNotPermitted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(svc.DeepCopy()).Build()
+			r := newRouteBackendResolver(newServiceBackendResolver(k8sClient), "awesome-ns")
+
+			for i, backendRef := range tt.backendRefs {
+				backend, err := r.resolve(context.Background(), backendRef)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantResolved[i], backend != nil)
+				if backend != nil {
+					assert.Equal(t, "svc-1", backend.Service.Name)
+					assert.Equal(t, int32(80), backend.Port.Port)
+				}
+			}
+			resolvedRefs, reason, _ := r.resolvedRefsCondition()
+			assert.Equal(t, tt.wantResolvedRefs, resolvedRefs)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
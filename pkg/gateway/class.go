@@ -0,0 +1,56 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// GatewayClassControllerALB is the controller name used in GatewayClass for ALB.
+	GatewayClassControllerALB gwv1beta1.GatewayController = "gateway.k8s.aws/alb"
+	// GatewayClassControllerNLB is the controller name used in GatewayClass for NLB.
+	GatewayClassControllerNLB gwv1beta1.GatewayController = "gateway.k8s.aws/nlb"
+)
+
+// ClassLoader loads GatewayClass for Gateway.
+type ClassLoader interface {
+	// Load returns the GatewayClass for Gateway if it's managed by any of specified controllers.
+	// nil will be returned if the GatewayClass doesn't exist or isn't managed by specified controllers.
+	Load(ctx context.Context, gw *gwv1beta1.Gateway, controllerNames ...gwv1beta1.GatewayController) (*gwv1beta1.GatewayClass, error)
+}
+
+// NewDefaultClassLoader constructs new defaultClassLoader instance.
+func NewDefaultClassLoader(k8sClient client.Client) *defaultClassLoader {
+	return &defaultClassLoader{
+		k8sClient: k8sClient,
+	}
+}
+
+var _ ClassLoader = &defaultClassLoader{}
+
+// default implementation for ClassLoader
+type defaultClassLoader struct {
+	k8sClient client.Client
+}
+
+func (l *defaultClassLoader) Load(ctx context.Context, gw *gwv1beta1.Gateway, controllerNames ...gwv1beta1.GatewayController) (*gwv1beta1.GatewayClass, error) {
+	gwClass := &gwv1beta1.GatewayClass{}
+	gwClassKey := types.NamespacedName{Name: string(gw.Spec.GatewayClassName)}
+	if err := l.k8sClient.Get(ctx, gwClassKey, gwClass); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to fetch gatewayClass: %v", gwClassKey.Name)
+	}
+	for _, controllerName := range controllerNames {
+		if gwClass.Spec.ControllerName == controllerName {
+			return gwClass, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,663 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// the magic service port that instructs the ingress model builder to load actions from annotation.
+	magicServicePortUseAnnotation = "use-annotation"
+
+	// the message body of fixed 500 response used when none of the backendRefs on a route rule is valid.
+	invalidBackendRefsMessageBody = "Backend is not valid"
+	// the message body of fixed 404 response used when a route rule has neither backendRefs nor redirect filter.
+	noBackendRefsMessageBody = "Not found"
+)
+
+var invalidLoadBalancerNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+// HTTPRouteTranslation contains the translation result of a HTTPRoute attached to the Gateway.
+type HTTPRouteTranslation struct {
+	Route *gwv1beta1.HTTPRoute
+
+	// Attachments are the attachments of the route to each parentRef that references the Gateway.
+	Attachments []RouteAttachment
+
+	// ResolvedRefs denotes whether all backendRefs on the route are resolved.
+	ResolvedRefs bool
+	// ResolvedRefsReason is the reason for ResolvedRefs condition.
+	ResolvedRefsReason gwv1beta1.RouteConditionReason
+	// ResolvedRefsMessage is a human-readable message for ResolvedRefs condition.
+	ResolvedRefsMessage string
+}
+
+// ListenerTranslation contains the translation result of a Gateway listener.
+type ListenerTranslation struct {
+	Listener gwv1beta1.Listener
+
+	// Accepted denotes whether the listener can be supported by ALB.
+	Accepted bool
+	// Reason is the reason for the Accepted condition.
+	Reason gwv1beta1.ListenerConditionReason
+	// Message is a human-readable message for the Accepted condition.
+	Message string
+	// AttachedRoutes is the number of routes successfully attached to this listener.
+	AttachedRoutes int32
+}
+
+// GatewayTranslation contains the translation result of a Gateway along with its attached routes.
+type GatewayTranslation struct {
+	// IngGroup is the IngressGroup equivalent of the Gateway, which can be consumed by ingress ModelBuilder.
+	IngGroup ingress.Group
+	// Listeners are the translation results of Gateway listeners.
+	Listeners []ListenerTranslation
+	// HTTPRoutes are the translation results of HTTPRoutes that references the Gateway.
+	HTTPRoutes []HTTPRouteTranslation
+}
+
+// IngressTranslator translates Gateway and its attached HTTPRoutes into an IngressGroup.
+// Each attached HTTPRoute is translated as a member Ingress of the group, with routing rules expressed in
+// `alb.ingress.kubernetes.io/actions.${name}` and `alb.ingress.kubernetes.io/conditions.${name}` annotations.
+type IngressTranslator interface {
+	// Translate translates the Gateway along with its attached HTTPRoutes.
+	Translate(ctx context.Context, gw *gwv1beta1.Gateway) (GatewayTranslation, error)
+}
+
+// NewDefaultIngressTranslator constructs new defaultIngressTranslator.
+func NewDefaultIngressTranslator(k8sClient client.Client, routeAttacher RouteAttacher) *defaultIngressTranslator {
+	return &defaultIngressTranslator{
+		k8sClient:     k8sClient,
+		routeAttacher: routeAttacher,
+	}
+}
+
+var _ IngressTranslator = &defaultIngressTranslator{}
+
+// default implementation for IngressTranslator
+type defaultIngressTranslator struct {
+	k8sClient     client.Client
+	routeAttacher RouteAttacher
+}
+
+func (t *defaultIngressTranslator) Translate(ctx context.Context, gw *gwv1beta1.Gateway) (GatewayTranslation, error) {
+	groupID := ingress.GroupID(k8s.NamespacedName(gw))
+	if !gw.DeletionTimestamp.IsZero() {
+		return GatewayTranslation{
+			IngGroup: ingress.Group{ID: groupID},
+		}, nil
+	}
+
+	listenerTranslations := t.translateListeners(gw)
+	acceptedListeners := make([]gwv1beta1.Listener, 0, len(listenerTranslations))
+	for _, listenerTranslation := range listenerTranslations {
+		if listenerTranslation.Accepted {
+			acceptedListeners = append(acceptedListeners, listenerTranslation.Listener)
+		}
+	}
+	gwWithAcceptedListeners := gw.DeepCopy()
+	gwWithAcceptedListeners.Spec.Listeners = acceptedListeners
+
+	routes, err := t.loadHTTPRoutes(ctx, gw)
+	if err != nil {
+		return GatewayTranslation{}, err
+	}
+	attachedRoutesByListener := make(map[gwv1beta1.SectionName]int32)
+	var members []ingress.ClassifiedIngress
+	var routeTranslations []HTTPRouteTranslation
+	for _, route := range routes {
+		routeTranslation, ing, err := t.translateHTTPRoute(ctx, gw, gwWithAcceptedListeners, route)
+		if err != nil {
+			return GatewayTranslation{}, errors.Wrapf(err, "httpRoute: %v", k8s.NamespacedName(route).String())
+		}
+		routeTranslations = append(routeTranslations, routeTranslation)
+		if ing == nil {
+			continue
+		}
+		members = append(members, ingress.ClassifiedIngress{Ing: ing})
+		for _, listenerName := range acceptedListenerNamesForRoute(routeTranslation) {
+			attachedRoutesByListener[listenerName]++
+		}
+	}
+	for i := range listenerTranslations {
+		listenerTranslations[i].AttachedRoutes = attachedRoutesByListener[listenerTranslations[i].Listener.Name]
+	}
+
+	return GatewayTranslation{
+		IngGroup: ingress.Group{
+			ID:      groupID,
+			Members: members,
+		},
+		Listeners:  listenerTranslations,
+		HTTPRoutes: routeTranslations,
+	}, nil
+}
+
+// translateListeners checks whether each Gateway listener can be supported by ALB.
+func (t *defaultIngressTranslator) translateListeners(gw *gwv1beta1.Gateway) []ListenerTranslation {
+	protocolsByPort := make(map[gwv1beta1.PortNumber]map[gwv1beta1.ProtocolType]struct{})
+	for _, listener := range gw.Spec.Listeners {
+		if _, ok := protocolsByPort[listener.Port]; !ok {
+			protocolsByPort[listener.Port] = make(map[gwv1beta1.ProtocolType]struct{})
+		}
+		protocolsByPort[listener.Port][listener.Protocol] = struct{}{}
+	}
+
+	listenerTranslations := make([]ListenerTranslation, 0, len(gw.Spec.Listeners))
+	for _, listener := range gw.Spec.Listeners {
+		listenerTranslation := ListenerTranslation{
+			Listener: listener,
+			Accepted: true,
+			Reason:   gwv1beta1.ListenerReasonAccepted,
+			Message:  "listener accepted",
+		}
+		switch {
+		case listener.Protocol != gwv1beta1.HTTPProtocolType && listener.Protocol != gwv1beta1.HTTPSProtocolType:
+			listenerTranslation.Accepted = false
+			listenerTranslation.Reason = gwv1beta1.ListenerReasonUnsupportedProtocol
+			listenerTranslation.Message = fmt.Sprintf("unsupported protocol: %v", listener.Protocol)
+		case listener.Protocol == gwv1beta1.HTTPSProtocolType && listener.TLS != nil &&
+			listener.TLS.Mode != nil && *listener.TLS.Mode != gwv1beta1.TLSModeTerminate:
+			listenerTranslation.Accepted = false
+			listenerTranslation.Reason = gwv1beta1.ListenerReasonUnsupportedProtocol
+			listenerTranslation.Message = fmt.Sprintf("unsupported TLS mode: %v", *listener.TLS.Mode)
+		case len(protocolsByPort[listener.Port]) > 1:
+			listenerTranslation.Accepted = false
+			listenerTranslation.Reason = gwv1beta1.ListenerReasonProtocolConflict
+			listenerTranslation.Message = fmt.Sprintf("conflicting protocols on port: %v", listener.Port)
+		}
+		listenerTranslations = append(listenerTranslations, listenerTranslation)
+	}
+	return listenerTranslations
+}
+
+// loadHTTPRoutes loads HTTPRoutes that references the Gateway, sorted by creationTimestamp and then namespace/name.
+func (t *defaultIngressTranslator) loadHTTPRoutes(ctx context.Context, gw *gwv1beta1.Gateway) ([]*gwv1beta1.HTTPRoute, error) {
+	routeList := &gwv1beta1.HTTPRouteList{}
+	if err := t.k8sClient.List(ctx, routeList); err != nil {
+		return nil, errors.Wrap(err, "failed to list httpRoutes")
+	}
+	var routes []*gwv1beta1.HTTPRoute
+	for i := range routeList.Items {
+		route := &routeList.Items[i]
+		if !route.DeletionTimestamp.IsZero() {
+			continue
+		}
+		for _, parentRef := range route.Spec.ParentRefs {
+			if IsParentRefToGateway(parentRef, route.Namespace, gw) {
+				routes = append(routes, route)
+				break
+			}
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if !routes[i].CreationTimestamp.Equal(&routes[j].CreationTimestamp) {
+			return routes[i].CreationTimestamp.Before(&routes[j].CreationTimestamp)
+		}
+		return k8s.NamespacedName(routes[i]).String() < k8s.NamespacedName(routes[j]).String()
+	})
+	return routes, nil
+}
+
+// translateHTTPRoute translates HTTPRoute into an Ingress.
+// nil Ingress will be returned if the route is not accepted by any listener.
+func (t *defaultIngressTranslator) translateHTTPRoute(ctx context.Context, gw *gwv1beta1.Gateway,
+	gwWithAcceptedListeners *gwv1beta1.Gateway, route *gwv1beta1.HTTPRoute) (HTTPRouteTranslation, *networking.Ingress, error) {
+	attachments, err := t.routeAttacher.Attach(ctx, gwWithAcceptedListeners, RouteDescriptor{
+		Kind:       RouteKindHTTPRoute,
+		Meta:       route,
+		ParentRefs: route.Spec.ParentRefs,
+		Hostnames:  route.Spec.Hostnames,
+	})
+	if err != nil {
+		return HTTPRouteTranslation{}, nil, err
+	}
+	backendResolver := newRouteBackendResolver(newServiceBackendResolver(t.k8sClient), route.Namespace)
+	routeTranslation := HTTPRouteTranslation{
+		Route:       route,
+		Attachments: attachments,
+	}
+	routeTranslation.ResolvedRefs, routeTranslation.ResolvedRefsReason, routeTranslation.ResolvedRefsMessage = backendResolver.resolvedRefsCondition()
+
+	var acceptedAttachments []RouteAttachment
+	for _, attachment := range attachments {
+		if attachment.Accepted {
+			acceptedAttachments = append(acceptedAttachments, attachment)
+		}
+	}
+	if len(acceptedAttachments) == 0 {
+		return routeTranslation, nil, nil
+	}
+
+	builder := &httpRouteIngressBuilder{
+		backendResolver: backendResolver,
+		route:           route,
+	}
+	ing, err := builder.build(ctx, gw, acceptedAttachments)
+	if err != nil {
+		return HTTPRouteTranslation{}, nil, err
+	}
+	if builder.unsupportedReason != "" {
+		for i := range routeTranslation.Attachments {
+			if routeTranslation.Attachments[i].Accepted {
+				routeTranslation.Attachments[i].Accepted = false
+				routeTranslation.Attachments[i].Reason = gwv1beta1.RouteReasonUnsupportedValue
+				routeTranslation.Attachments[i].Message = builder.unsupportedReason
+			}
+		}
+		ing = nil
+	}
+	routeTranslation.ResolvedRefs, routeTranslation.ResolvedRefsReason, routeTranslation.ResolvedRefsMessage = backendResolver.resolvedRefsCondition()
+	return routeTranslation, ing, nil
+}
+
+// acceptedListenerNamesForRoute returns names of listeners that accepted the route.
+func acceptedListenerNamesForRoute(routeTranslation HTTPRouteTranslation) []gwv1beta1.SectionName {
+	listenerNames := make(map[gwv1beta1.SectionName]struct{})
+	for _, attachment := range routeTranslation.Attachments {
+		if !attachment.Accepted {
+			continue
+		}
+		for _, listener := range attachment.Listeners {
+			listenerNames[listener.Name] = struct{}{}
+		}
+	}
+	result := make([]gwv1beta1.SectionName, 0, len(listenerNames))
+	for listenerName := range listenerNames {
+		result = append(result, listenerName)
+	}
+	return result
+}
+
+// httpRouteIngressBuilder builds the Ingress equivalent for a single HTTPRoute.
+type httpRouteIngressBuilder struct {
+	backendResolver *routeBackendResolver
+	route           *gwv1beta1.HTTPRoute
+
+	// non-empty if the route uses features that cannot be supported.
+	unsupportedReason string
+}
+
+func (b *httpRouteIngressBuilder) build(ctx context.Context, gw *gwv1beta1.Gateway, attachments []RouteAttachment) (*networking.Ingress, error) {
+	ingAnnotations := b.buildBaseAnnotations(gw)
+	if _, exists := ingAnnotations[annotationKey(annotations.IngressSuffixLoadBalancerName)]; !exists {
+		ingAnnotations[annotationKey(annotations.IngressSuffixLoadBalancerName)] = buildDefaultLoadBalancerName(gw)
+	}
+	listenPorts, hostnames, hasHTTPS := b.buildListenPortsAndHostnames(attachments)
+	rawListenPorts, err := json.Marshal(listenPorts)
+	if err != nil {
+		return nil, err
+	}
+	ingAnnotations[annotationKey(annotations.IngressSuffixListenPorts)] = string(rawListenPorts)
+
+	var paths []networking.HTTPIngressPath
+	for ruleIdx, rule := range b.route.Spec.Rules {
+		action, err := b.buildRuleAction(ctx, rule)
+		if err != nil {
+			return nil, err
+		}
+		rawAction, err := json.Marshal(action)
+		if err != nil {
+			return nil, err
+		}
+		matches := rule.Matches
+		if len(matches) == 0 {
+			matches = []gwv1beta1.HTTPRouteMatch{{}}
+		}
+		for matchIdx, match := range sortHTTPRouteMatches(matches) {
+			backendName := fmt.Sprintf("rule-%d-%d", ruleIdx, matchIdx)
+			path, err := b.buildIngressPath(match, backendName)
+			if err != nil {
+				return nil, err
+			}
+			conditions := b.buildRuleConditions(match, hostnames)
+			if len(conditions) != 0 {
+				rawConditions, err := json.Marshal(conditions)
+				if err != nil {
+					return nil, err
+				}
+				ingAnnotations[annotationKey(fmt.Sprintf("conditions.%v", backendName))] = string(rawConditions)
+			}
+			ingAnnotations[annotationKey(fmt.Sprintf("actions.%v", backendName))] = string(rawAction)
+			paths = append(paths, path)
+		}
+	}
+
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         b.route.Namespace,
+			Name:              b.route.Name,
+			UID:               b.route.UID,
+			CreationTimestamp: b.route.CreationTimestamp,
+			Annotations:       ingAnnotations,
+		},
+	}
+	if len(paths) != 0 {
+		ing.Spec.Rules = []networking.IngressRule{
+			{
+				IngressRuleValue: networking.IngressRuleValue{
+					HTTP: &networking.HTTPIngressRuleValue{
+						Paths: paths,
+					},
+				},
+			},
+		}
+	}
+	// hostnames are exposed as TLS hosts so that certificates can be discovered for HTTPS listeners.
+	if hasHTTPS && len(hostnames) != 0 {
+		ing.Spec.TLS = []networking.IngressTLS{
+			{
+				Hosts: hostnames,
+			},
+		}
+	}
+	return ing, nil
+}
+
+// buildBaseAnnotations builds the ingress annotations from Gateway and HTTPRoute.
+// annotations on HTTPRoute take precedence over annotations on Gateway, except the ones computed by us.
+func (b *httpRouteIngressBuilder) buildBaseAnnotations(gw *gwv1beta1.Gateway) map[string]string {
+	ingAnnotations := make(map[string]string)
+	for _, objAnnotations := range []map[string]string{gw.Annotations, b.route.Annotations} {
+		for key, value := range objAnnotations {
+			if !strings.HasPrefix(key, annotations.AnnotationPrefixIngress+"/") || isComputedAnnotation(key) {
+				continue
+			}
+			ingAnnotations[key] = value
+		}
+	}
+	return ingAnnotations
+}
+
+// buildListenPortsAndHostnames computes the listen-ports and effective hostnames for the route.
+func (b *httpRouteIngressBuilder) buildListenPortsAndHostnames(attachments []RouteAttachment) ([]map[string]int64, []string, bool) {
+	matchAnyHostname := false
+	hostnameSet := make(map[string]struct{})
+	portSet := make(map[string]struct{})
+	hasHTTPS := false
+	var listenPorts []map[string]int64
+	for _, attachment := range attachments {
+		if len(attachment.Hostnames) == 0 {
+			matchAnyHostname = true
+		}
+		for _, hostname := range attachment.Hostnames {
+			hostnameSet[hostname] = struct{}{}
+		}
+		for _, listener := range attachment.Listeners {
+			protocol := string(elbv2model.ProtocolHTTP)
+			if listener.Protocol == gwv1beta1.HTTPSProtocolType {
+				protocol = string(elbv2model.ProtocolHTTPS)
+				hasHTTPS = true
+			}
+			portKey := fmt.Sprintf("%v:%v", protocol, listener.Port)
+			if _, ok := portSet[portKey]; ok {
+				continue
+			}
+			portSet[portKey] = struct{}{}
+			listenPorts = append(listenPorts, map[string]int64{protocol: int64(listener.Port)})
+		}
+	}
+	if matchAnyHostname {
+		return listenPorts, nil, hasHTTPS
+	}
+	hostnames := make([]string, 0, len(hostnameSet))
+	for hostname := range hostnameSet {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+	return listenPorts, hostnames, hasHTTPS
+}
+
+// buildRuleAction builds the ingress Action for a route rule.
+func (b *httpRouteIngressBuilder) buildRuleAction(ctx context.Context, rule gwv1beta1.HTTPRouteRule) (ingress.Action, error) {
+	var redirectFilter *gwv1beta1.HTTPRequestRedirectFilter
+	for _, filter := range rule.Filters {
+		if filter.Type == gwv1beta1.HTTPRouteFilterRequestRedirect && filter.RequestRedirect != nil {
+			redirectFilter = filter.RequestRedirect
+			continue
+		}
+		b.markUnsupported(fmt.Sprintf("unsupported filter type: %v", filter.Type))
+	}
+	if redirectFilter != nil {
+		return b.buildRedirectAction(*redirectFilter), nil
+	}
+	if len(rule.BackendRefs) == 0 {
+		return buildFixedResponseAction("404", noBackendRefsMessageBody), nil
+	}
+
+	var targetGroups []ingress.TargetGroupTuple
+	totalWeight := int64(0)
+	for _, backendRef := range rule.BackendRefs {
+		if len(backendRef.Filters) != 0 {
+			b.markUnsupported("backendRef filters are not supported")
+		}
+		backend, err := b.backendResolver.resolve(ctx, backendRef.BackendRef)
+		if err != nil {
+			return ingress.Action{}, err
+		}
+		if backend == nil {
+			continue
+		}
+		weight := int64(1)
+		if backendRef.Weight != nil {
+			weight = int64(*backendRef.Weight)
+		}
+		svcPort := intstr.FromInt(int(backend.Port.Port))
+		targetGroups = append(targetGroups, ingress.TargetGroupTuple{
+			ServiceName: awssdk.String(backend.Service.Name),
+			ServicePort: &svcPort,
+			Weight:      awssdk.Int64(weight),
+		})
+		totalWeight += weight
+	}
+	if len(targetGroups) == 0 || totalWeight == 0 {
+		return buildFixedResponseAction("500", invalidBackendRefsMessageBody), nil
+	}
+	return ingress.Action{
+		Type: ingress.ActionTypeForward,
+		ForwardConfig: &ingress.ForwardActionConfig{
+			TargetGroups: targetGroups,
+		},
+	}, nil
+}
+
+// buildRedirectAction builds the ingress redirect Action for RequestRedirect filter.
+func (b *httpRouteIngressBuilder) buildRedirectAction(filter gwv1beta1.HTTPRequestRedirectFilter) ingress.Action {
+	statusCode := "HTTP_302"
+	if filter.StatusCode != nil && *filter.StatusCode == 301 {
+		statusCode = "HTTP_301"
+	}
+	redirectConfig := &ingress.RedirectActionConfig{
+		StatusCode: statusCode,
+	}
+	if filter.Scheme != nil {
+		redirectConfig.Protocol = awssdk.String(strings.ToUpper(*filter.Scheme))
+	}
+	if filter.Hostname != nil {
+		redirectConfig.Host = awssdk.String(string(*filter.Hostname))
+	}
+	if filter.Port != nil {
+		redirectConfig.Port = awssdk.String(fmt.Sprintf("%d", *filter.Port))
+	}
+	if filter.Path != nil {
+		if filter.Path.Type == gwv1beta1.FullPathHTTPPathModifier && filter.Path.ReplaceFullPath != nil {
+			redirectConfig.Path = filter.Path.ReplaceFullPath
+		} else {
+			b.markUnsupported(fmt.Sprintf("unsupported redirect path modifier: %v", filter.Path.Type))
+		}
+	}
+	return ingress.Action{
+		Type:           ingress.ActionTypeRedirect,
+		RedirectConfig: redirectConfig,
+	}
+}
+
+// buildIngressPath builds the ingress path for a route match.
+func (b *httpRouteIngressBuilder) buildIngressPath(match gwv1beta1.HTTPRouteMatch, backendName string) (networking.HTTPIngressPath, error) {
+	pathValue := "/"
+	pathType := networking.PathTypePrefix
+	if match.Path != nil {
+		if match.Path.Value != nil {
+			pathValue = *match.Path.Value
+		}
+		if match.Path.Type != nil {
+			switch *match.Path.Type {
+			case gwv1beta1.PathMatchPathPrefix:
+				pathType = networking.PathTypePrefix
+			case gwv1beta1.PathMatchExact:
+				pathType = networking.PathTypeExact
+			default:
+				b.markUnsupported(fmt.Sprintf("unsupported path match type: %v", *match.Path.Type))
+			}
+		}
+	}
+	return networking.HTTPIngressPath{
+		Path:     pathValue,
+		PathType: &pathType,
+		Backend: networking.IngressBackend{
+			Service: &networking.IngressServiceBackend{
+				Name: backendName,
+				Port: networking.ServiceBackendPort{
+					Name: magicServicePortUseAnnotation,
+				},
+			},
+		},
+	}, nil
+}
+
+// buildRuleConditions builds the ingress rule conditions for a route match.
+func (b *httpRouteIngressBuilder) buildRuleConditions(match gwv1beta1.HTTPRouteMatch, hostnames []string) []ingress.RuleCondition {
+	var conditions []ingress.RuleCondition
+	if len(hostnames) != 0 {
+		conditions = append(conditions, ingress.RuleCondition{
+			Field: ingress.RuleConditionFieldHostHeader,
+			HostHeaderConfig: &ingress.HostHeaderConditionConfig{
+				Values: hostnames,
+			},
+		})
+	}
+	for _, header := range match.Headers {
+		if header.Type != nil && *header.Type != gwv1beta1.HeaderMatchExact {
+			b.markUnsupported(fmt.Sprintf("unsupported header match type: %v", *header.Type))
+			continue
+		}
+		conditions = append(conditions, ingress.RuleCondition{
+			Field: ingress.RuleConditionFieldHTTPHeader,
+			HTTPHeaderConfig: &ingress.HTTPHeaderConditionConfig{
+				HTTPHeaderName: string(header.Name),
+				Values:         []string{header.Value},
+			},
+		})
+	}
+	if len(match.QueryParams) != 0 {
+		var keyValuePairs []ingress.QueryStringKeyValuePair
+		for _, queryParam := range match.QueryParams {
+			if queryParam.Type != nil && *queryParam.Type != gwv1beta1.QueryParamMatchExact {
+				b.markUnsupported(fmt.Sprintf("unsupported queryParam match type: %v", *queryParam.Type))
+				continue
+			}
+			keyValuePairs = append(keyValuePairs, ingress.QueryStringKeyValuePair{
+				Key:   awssdk.String(queryParam.Name),
+				Value: queryParam.Value,
+			})
+		}
+		if len(keyValuePairs) != 0 {
+			conditions = append(conditions, ingress.RuleCondition{
+				Field: ingress.RuleConditionFieldQueryString,
+				QueryStringConfig: &ingress.QueryStringConditionConfig{
+					Values: keyValuePairs,
+				},
+			})
+		}
+	}
+	if match.Method != nil {
+		conditions = append(conditions, ingress.RuleCondition{
+			Field: ingress.RuleConditionFieldHTTPRequestMethod,
+			HTTPRequestMethodConfig: &ingress.HTTPRequestMethodConditionConfig{
+				Values: []string{string(*match.Method)},
+			},
+		})
+	}
+	return conditions
+}
+
+func (b *httpRouteIngressBuilder) markUnsupported(reason string) {
+	if b.unsupportedReason == "" {
+		b.unsupportedReason = reason
+	}
+}
+
+// sortHTTPRouteMatches sorts matches within a rule so that more specific matches come first.
+// path precedence is handled by the ingress model builder, here we only order by method, headers and queryParams.
+func sortHTTPRouteMatches(matches []gwv1beta1.HTTPRouteMatch) []gwv1beta1.HTTPRouteMatch {
+	sortedMatches := append([]gwv1beta1.HTTPRouteMatch(nil), matches...)
+	sort.SliceStable(sortedMatches, func(i, j int) bool {
+		if (sortedMatches[i].Method != nil) != (sortedMatches[j].Method != nil) {
+			return sortedMatches[i].Method != nil
+		}
+		if len(sortedMatches[i].Headers) != len(sortedMatches[j].Headers) {
+			return len(sortedMatches[i].Headers) > len(sortedMatches[j].Headers)
+		}
+		return len(sortedMatches[i].QueryParams) > len(sortedMatches[j].QueryParams)
+	})
+	return sortedMatches
+}
+
+func buildFixedResponseAction(statusCode string, messageBody string) ingress.Action {
+	return ingress.Action{
+		Type: ingress.ActionTypeFixedResponse,
+		FixedResponseConfig: &ingress.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  statusCode,
+			MessageBody: awssdk.String(messageBody),
+		},
+	}
+}
+
+// buildDefaultLoadBalancerName computes the default load balancer name for Gateway.
+// The name is derived from the Gateway's UID and scheme so that it never conflicts with load balancers for Ingresses
+// with the same namespace/name, and changes when the scheme changes so that a new load balancer can be provisioned.
+func buildDefaultLoadBalancerName(gw *gwv1beta1.Gateway) string {
+	scheme := gw.Annotations[annotationKey(annotations.IngressSuffixScheme)]
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(gw.UID))
+	_, _ = uuidHash.Write([]byte(scheme))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(gw.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(gw.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+// annotationKey returns the full ingress annotation key for suffix.
+func annotationKey(suffix string) string {
+	return fmt.Sprintf("%v/%v", annotations.AnnotationPrefixIngress, suffix)
+}
+
+// isComputedAnnotation checks whether the annotation is computed from Gateway and HTTPRoute specs.
+func isComputedAnnotation(key string) bool {
+	suffix := strings.TrimPrefix(key, annotations.AnnotationPrefixIngress+"/")
+	return suffix == annotations.IngressSuffixListenPorts ||
+		suffix == annotations.IngressSuffixGroupName ||
+		suffix == annotations.IngressSuffixGroupOrder ||
+		strings.HasPrefix(suffix, "actions.") ||
+		strings.HasPrefix(suffix, "conditions.")
+}
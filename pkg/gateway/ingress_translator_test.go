@@ -0,0 +1,273 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func Test_defaultIngressTranslator_Translate(t *testing.T) {
+	pathPrefix := gwv1beta1.PathMatchPathPrefix
+	pathRegex := gwv1beta1.PathMatchRegularExpression
+	port80 := gwv1beta1.PortNumber(80)
+	weight90 := int32(90)
+	weight10 := int32(10)
+	statusCode301 := 301
+	schemeHTTPS := "https"
+	methodGet := gwv1beta1.HTTPMethodGet
+	pathTypePrefix := networking.PathTypePrefix
+	tlsModePassthrough := gwv1beta1.TLSModePassthrough
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc-1"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Port: 80}},
+		},
+	}
+	gw := &gwv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-gw",
+			UID:       "gw-uid",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/scheme":       "internet-facing",
+				"alb.ingress.kubernetes.io/listen-ports": `[{"HTTP": 8080}]`,
+				"some-other-annotation":                  "value",
+			},
+		},
+		Spec: gwv1beta1.GatewaySpec{
+			GatewayClassName: "aws-alb",
+			Listeners: []gwv1beta1.Listener{
+				{Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80},
+				{Name: "tcp", Protocol: gwv1beta1.TCPProtocolType, Port: 9090},
+				{Name: "tls", Protocol: gwv1beta1.HTTPSProtocolType, Port: 8443, TLS: &gwv1beta1.GatewayTLSConfig{Mode: &tlsModePassthrough}},
+			},
+		},
+	}
+
+	type env struct {
+		svcList   []*corev1.Service
+		routeList []*gwv1beta1.HTTPRoute
+	}
+	type wantRoute struct {
+		accepted             bool
+		acceptedReason       gwv1beta1.RouteConditionReason
+		resolvedRefs         bool
+		resolvedRefsReason   gwv1beta1.RouteConditionReason
+		ingressAnnotations   map[string]string
+		ingressRules         []networking.IngressRule
+		ingressNotTranslated bool
+	}
+	tests := []struct {
+		name          string
+		env           env
+		wantListeners map[gwv1beta1.SectionName]bool
+		wantAttached  int32
+		wantRoutes    []wantRoute
+	}{
+		{
+			name: "route with weighted backends, conditions and redirect",
+			env: env{
+				svcList: []*corev1.Service{svc},
+				routeList: []*gwv1beta1.HTTPRoute{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "route-1",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/target-type": "ip",
+							},
+						},
+						Spec: gwv1beta1.HTTPRouteSpec{
+							CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+								ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw"}},
+							},
+							Hostnames: []gwv1beta1.Hostname{"www.example.com"},
+							Rules: []gwv1beta1.HTTPRouteRule{
+								{
+									Matches: []gwv1beta1.HTTPRouteMatch{
+										{
+											Path:   &gwv1beta1.HTTPPathMatch{Type: &pathPrefix, Value: &[]string{"/api"}[0]},
+											Method: &methodGet,
+										},
+									},
+									BackendRefs: []gwv1beta1.HTTPBackendRef{
+										{
+											BackendRef: gwv1beta1.BackendRef{
+												BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "svc-1", Port: &port80},
+												Weight:                 &weight90,
+											},
+										},
+										{
+											BackendRef: gwv1beta1.BackendRef{
+												BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "svc-missing", Port: &port80},
+												Weight:                 &weight10,
+											},
+										},
+									},
+								},
+								{
+									Filters: []gwv1beta1.HTTPRouteFilter{
+										{
+											Type: gwv1beta1.HTTPRouteFilterRequestRedirect,
+											RequestRedirect: &gwv1beta1.HTTPRequestRedirectFilter{
+												Scheme:     &schemeHTTPS,
+												StatusCode: &statusCode301,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantListeners: map[gwv1beta1.SectionName]bool{"http": true, "tcp": false, "tls": false},
+			wantAttached:  1,
+			wantRoutes: []wantRoute{
+				{
+					accepted:           true,
+					acceptedReason:     gwv1beta1.RouteReasonAccepted,
+					resolvedRefs:       false,
+					resolvedRefsReason: gwv1beta1.RouteReasonBackendNotFound,
+					ingressAnnotations: map[string]string{
+						"alb.ingress.kubernetes.io/scheme":             "internet-facing",
+						"alb.ingress.kubernetes.io/target-type":        "ip",
+						"alb.ingress.kubernetes.io/load-balancer-name": buildDefaultLoadBalancerName(gw),
+						"alb.ingress.kubernetes.io/listen-ports":       `[{"HTTP":80}]`,
+						"alb.ingress.kubernetes.io/actions.rule-0-0":   `{"type":"forward","targetGroupARN":null,"forwardConfig":{"targetGroups":[{"targetGroupARN":null,"serviceName":"svc-1","servicePort":80,"weight":90}]}}`,
+						"alb.ingress.kubernetes.io/conditions.rule-0-0": `[{"field":"host-header","hostHeaderConfig":{"values":["www.example.com"]},"httpHeaderConfig":null,"httpRequestMethodConfig":null,"pathPatternConfig":null,"queryStringConfig":null,"sourceIPConfig":null},` +
+							`{"field":"http-request-method","hostHeaderConfig":null,"httpHeaderConfig":null,"httpRequestMethodConfig":{"values":["GET"]},"pathPatternConfig":null,"queryStringConfig":null,"sourceIPConfig":null}]`,
+						"alb.ingress.kubernetes.io/actions.rule-1-0":    `{"type":"redirect","targetGroupARN":null,"redirectConfig":{"protocol":"HTTPS","statusCode":"HTTP_301"}}`,
+						"alb.ingress.kubernetes.io/conditions.rule-1-0": `[{"field":"host-header","hostHeaderConfig":{"values":["www.example.com"]},"httpHeaderConfig":null,"httpRequestMethodConfig":null,"pathPatternConfig":null,"queryStringConfig":null,"sourceIPConfig":null}]`,
+					},
+					ingressRules: []networking.IngressRule{
+						{
+							IngressRuleValue: networking.IngressRuleValue{
+								HTTP: &networking.HTTPIngressRuleValue{
+									Paths: []networking.HTTPIngressPath{
+										{
+											Path:     "/api",
+											PathType: &pathTypePrefix,
+											Backend: networking.IngressBackend{
+												Service: &networking.IngressServiceBackend{
+													Name: "rule-0-0",
+													Port: networking.ServiceBackendPort{Name: "use-annotation"},
+												},
+											},
+										},
+										{
+											Path:     "/",
+											PathType: &pathTypePrefix,
+											Backend: networking.IngressBackend{
+												Service: &networking.IngressServiceBackend{
+													Name: "rule-1-0",
+													Port: networking.ServiceBackendPort{Name: "use-annotation"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "route with unsupported path match type",
+			env: env{
+				svcList: []*corev1.Service{svc},
+				routeList: []*gwv1beta1.HTTPRoute{
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "route-1"},
+						Spec: gwv1beta1.HTTPRouteSpec{
+							CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+								ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw"}},
+							},
+							Rules: []gwv1beta1.HTTPRouteRule{
+								{
+									Matches: []gwv1beta1.HTTPRouteMatch{
+										{
+											Path: &gwv1beta1.HTTPPathMatch{Type: &pathRegex, Value: &[]string{"/api/.*"}[0]},
+										},
+									},
+									BackendRefs: []gwv1beta1.HTTPBackendRef{
+										{
+											BackendRef: gwv1beta1.BackendRef{
+												BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "svc-1", Port: &port80},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantListeners: map[gwv1beta1.SectionName]bool{"http": true, "tcp": false, "tls": false},
+			wantAttached:  0,
+			wantRoutes: []wantRoute{
+				{
+					accepted:             false,
+					acceptedReason:       gwv1beta1.RouteReasonUnsupportedValue,
+					resolvedRefs:         true,
+					resolvedRefsReason:   gwv1beta1.RouteReasonResolvedRefs,
+					ingressNotTranslated: true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			gwv1beta1.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, svc := range tt.env.svcList {
+				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
+			}
+			for _, route := range tt.env.routeList {
+				assert.NoError(t, k8sClient.Create(ctx, route.DeepCopy()))
+			}
+
+			translator := NewDefaultIngressTranslator(k8sClient, NewDefaultRouteAttacher(k8sClient))
+			got, err := translator.Translate(ctx, gw)
+			assert.NoError(t, err)
+
+			for _, listener := range got.Listeners {
+				assert.Equal(t, tt.wantListeners[listener.Listener.Name], listener.Accepted, "listener %v", listener.Listener.Name)
+				if listener.Listener.Name == "http" {
+					assert.Equal(t, tt.wantAttached, listener.AttachedRoutes)
+				}
+			}
+			assert.Equal(t, len(tt.wantRoutes), len(got.HTTPRoutes))
+			memberIdx := 0
+			for i, want := range tt.wantRoutes {
+				routeTranslation := got.HTTPRoutes[i]
+				assert.Equal(t, 1, len(routeTranslation.Attachments))
+				assert.Equal(t, want.accepted, routeTranslation.Attachments[0].Accepted)
+				assert.Equal(t, want.acceptedReason, routeTranslation.Attachments[0].Reason)
+				assert.Equal(t, want.resolvedRefs, routeTranslation.ResolvedRefs)
+				assert.Equal(t, want.resolvedRefsReason, routeTranslation.ResolvedRefsReason)
+				if want.ingressNotTranslated {
+					continue
+				}
+				ing := got.IngGroup.Members[memberIdx].Ing
+				memberIdx++
+				assert.Equal(t, want.ingressAnnotations, ing.Annotations)
+				assert.Equal(t, want.ingressRules, ing.Spec.Rules)
+			}
+			assert.Equal(t, memberIdx, len(got.IngGroup.Members))
+		})
+	}
+}
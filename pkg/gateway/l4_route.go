@@ -0,0 +1,107 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// L4Route is the common representation of TCPRoute, UDPRoute and TLSRoute.
+// These routes share the same structure of parentRefs and backendRefs based rules, and can be fulfilled by NLB.
+type L4Route struct {
+	Kind gwv1beta1.Kind
+	// Object is the underlying route object.
+	Object client.Object
+
+	ParentRefs []gwv1beta1.ParentReference
+	// Hostnames is only available for TLSRoute.
+	Hostnames []gwv1beta1.Hostname
+	// RuleBackendRefs are the backendRefs of each route rule.
+	RuleBackendRefs [][]gwv1beta1.BackendRef
+	// Status points to the status of the underlying route object.
+	Status *gwv1beta1.RouteStatus
+}
+
+// NewL4Route constructs L4Route for TCPRoute, UDPRoute or TLSRoute object.
+func NewL4Route(obj client.Object) (L4Route, error) {
+	switch route := obj.(type) {
+	case *gwv1alpha2.TCPRoute:
+		ruleBackendRefs := make([][]gwv1beta1.BackendRef, 0, len(route.Spec.Rules))
+		for _, rule := range route.Spec.Rules {
+			ruleBackendRefs = append(ruleBackendRefs, rule.BackendRefs)
+		}
+		return L4Route{
+			Kind:            RouteKindTCPRoute,
+			Object:          route,
+			ParentRefs:      route.Spec.ParentRefs,
+			RuleBackendRefs: ruleBackendRefs,
+			Status:          &route.Status.RouteStatus,
+		}, nil
+	case *gwv1alpha2.UDPRoute:
+		ruleBackendRefs := make([][]gwv1beta1.BackendRef, 0, len(route.Spec.Rules))
+		for _, rule := range route.Spec.Rules {
+			ruleBackendRefs = append(ruleBackendRefs, rule.BackendRefs)
+		}
+		return L4Route{
+			Kind:            RouteKindUDPRoute,
+			Object:          route,
+			ParentRefs:      route.Spec.ParentRefs,
+			RuleBackendRefs: ruleBackendRefs,
+			Status:          &route.Status.RouteStatus,
+		}, nil
+	case *gwv1alpha2.TLSRoute:
+		ruleBackendRefs := make([][]gwv1beta1.BackendRef, 0, len(route.Spec.Rules))
+		for _, rule := range route.Spec.Rules {
+			ruleBackendRefs = append(ruleBackendRefs, rule.BackendRefs)
+		}
+		return L4Route{
+			Kind:            RouteKindTLSRoute,
+			Object:          route,
+			ParentRefs:      route.Spec.ParentRefs,
+			Hostnames:       route.Spec.Hostnames,
+			RuleBackendRefs: ruleBackendRefs,
+			Status:          &route.Status.RouteStatus,
+		}, nil
+	default:
+		return L4Route{}, errors.Errorf("unsupported L4 route type: %T", obj)
+	}
+}
+
+// ListL4Routes lists all TCPRoutes, UDPRoutes and TLSRoutes.
+func ListL4Routes(ctx context.Context, k8sClient client.Client, opts ...client.ListOption) ([]L4Route, error) {
+	tcpRouteList := &gwv1alpha2.TCPRouteList{}
+	if err := k8sClient.List(ctx, tcpRouteList, opts...); err != nil {
+		return nil, errors.Wrap(err, "failed to list tcpRoutes")
+	}
+	udpRouteList := &gwv1alpha2.UDPRouteList{}
+	if err := k8sClient.List(ctx, udpRouteList, opts...); err != nil {
+		return nil, errors.Wrap(err, "failed to list udpRoutes")
+	}
+	tlsRouteList := &gwv1alpha2.TLSRouteList{}
+	if err := k8sClient.List(ctx, tlsRouteList, opts...); err != nil {
+		return nil, errors.Wrap(err, "failed to list tlsRoutes")
+	}
+
+	routeObjs := make([]client.Object, 0, len(tcpRouteList.Items)+len(udpRouteList.Items)+len(tlsRouteList.Items))
+	for i := range tcpRouteList.Items {
+		routeObjs = append(routeObjs, &tcpRouteList.Items[i])
+	}
+	for i := range udpRouteList.Items {
+		routeObjs = append(routeObjs, &udpRouteList.Items[i])
+	}
+	for i := range tlsRouteList.Items {
+		routeObjs = append(routeObjs, &tlsRouteList.Items[i])
+	}
+	routes := make([]L4Route, 0, len(routeObjs))
+	for _, routeObj := range routeObjs {
+		route, err := NewL4Route(routeObj)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
@@ -0,0 +1,285 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// RouteKindHTTPRoute is the kind for HTTPRoute.
+	RouteKindHTTPRoute gwv1beta1.Kind = "HTTPRoute"
+	// RouteKindTCPRoute is the kind for TCPRoute.
+	RouteKindTCPRoute gwv1beta1.Kind = "TCPRoute"
+	// RouteKindUDPRoute is the kind for UDPRoute.
+	RouteKindUDPRoute gwv1beta1.Kind = "UDPRoute"
+	// RouteKindTLSRoute is the kind for TLSRoute.
+	RouteKindTLSRoute gwv1beta1.Kind = "TLSRoute"
+
+	gatewayKind gwv1beta1.Kind = "Gateway"
+)
+
+// RouteDescriptor describes the parts of a route that matters for attachment.
+type RouteDescriptor struct {
+	Kind       gwv1beta1.Kind
+	Meta       metav1.Object
+	ParentRefs []gwv1beta1.ParentReference
+	Hostnames  []gwv1beta1.Hostname
+}
+
+// RouteAttachment describes the attachment of a route to a specific parentRef of a Gateway.
+type RouteAttachment struct {
+	// ParentRef is the parentRef on route that references the Gateway.
+	ParentRef gwv1beta1.ParentReference
+
+	// Accepted denotes whether the route is accepted by at least one listener of the Gateway.
+	Accepted bool
+	// Reason is the reason for the Accepted condition.
+	Reason gwv1beta1.RouteConditionReason
+	// Message is a human-readable message for the Accepted condition.
+	Message string
+
+	// Listeners are the Gateway listeners that accepted the route.
+	Listeners []gwv1beta1.Listener
+	// Hostnames are the effective hostnames of the route after intersecting with listener hostnames.
+	// empty Hostnames means the route matches any hostname.
+	Hostnames []string
+}
+
+// RouteAttacher computes the attachments of a route to a Gateway.
+type RouteAttacher interface {
+	// Attach returns the attachments of route to the Gateway, one for each parentRef that references the Gateway.
+	Attach(ctx context.Context, gw *gwv1beta1.Gateway, route RouteDescriptor) ([]RouteAttachment, error)
+}
+
+// NewDefaultRouteAttacher constructs new defaultRouteAttacher.
+func NewDefaultRouteAttacher(k8sClient client.Client) *defaultRouteAttacher {
+	return &defaultRouteAttacher{
+		k8sClient: k8sClient,
+	}
+}
+
+var _ RouteAttacher = &defaultRouteAttacher{}
+
+// default implementation for RouteAttacher
+type defaultRouteAttacher struct {
+	k8sClient client.Client
+}
+
+func (a *defaultRouteAttacher) Attach(ctx context.Context, gw *gwv1beta1.Gateway, route RouteDescriptor) ([]RouteAttachment, error) {
+	var attachments []RouteAttachment
+	for _, parentRef := range route.ParentRefs {
+		if !IsParentRefToGateway(parentRef, route.Meta.GetNamespace(), gw) {
+			continue
+		}
+		attachment, err := a.attachToParentRef(ctx, gw, route, parentRef)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+	return attachments, nil
+}
+
+func (a *defaultRouteAttacher) attachToParentRef(ctx context.Context, gw *gwv1beta1.Gateway, route RouteDescriptor, parentRef gwv1beta1.ParentReference) (RouteAttachment, error) {
+	attachment := RouteAttachment{
+		ParentRef: parentRef,
+	}
+	var candidateListeners []gwv1beta1.Listener
+	for _, listener := range gw.Spec.Listeners {
+		if parentRef.SectionName != nil && *parentRef.SectionName != listener.Name {
+			continue
+		}
+		if parentRef.Port != nil && *parentRef.Port != listener.Port {
+			continue
+		}
+		candidateListeners = append(candidateListeners, listener)
+	}
+	if len(candidateListeners) == 0 {
+		attachment.Reason = gwv1beta1.RouteReasonNoMatchingParent
+		attachment.Message = "no listener matches the parentRef"
+		return attachment, nil
+	}
+
+	var allowedListeners []gwv1beta1.Listener
+	for _, listener := range candidateListeners {
+		allowed, err := a.isRouteAllowedByListener(ctx, gw, route, listener)
+		if err != nil {
+			return RouteAttachment{}, err
+		}
+		if allowed {
+			allowedListeners = append(allowedListeners, listener)
+		}
+	}
+	if len(allowedListeners) == 0 {
+		attachment.Reason = gwv1beta1.RouteReasonNotAllowedByListeners
+		attachment.Message = fmt.Sprintf("%v is not allowed by listeners", route.Kind)
+		return attachment, nil
+	}
+
+	matchAnyHostname := false
+	hostnames := sets.NewString()
+	for _, listener := range allowedListeners {
+		listenerHostnames, matches := computeListenerHostnames(listener.Hostname, route.Hostnames)
+		if !matches {
+			continue
+		}
+		attachment.Listeners = append(attachment.Listeners, listener)
+		if len(listenerHostnames) == 0 {
+			matchAnyHostname = true
+		}
+		hostnames.Insert(listenerHostnames...)
+	}
+	if len(attachment.Listeners) == 0 {
+		attachment.Reason = gwv1beta1.RouteReasonNoMatchingListenerHostname
+		attachment.Message = "no listener hostname matches the route hostnames"
+		return attachment, nil
+	}
+	if !matchAnyHostname {
+		attachment.Hostnames = hostnames.List()
+	}
+	attachment.Accepted = true
+	attachment.Reason = gwv1beta1.RouteReasonAccepted
+	attachment.Message = "route accepted"
+	return attachment, nil
+}
+
+// isRouteAllowedByListener checks whether the route kind and route namespace is allowed by listener.
+func (a *defaultRouteAttacher) isRouteAllowedByListener(ctx context.Context, gw *gwv1beta1.Gateway, route RouteDescriptor, listener gwv1beta1.Listener) (bool, error) {
+	if !isRouteKindAllowedByListener(route.Kind, listener) {
+		return false, nil
+	}
+	from := gwv1beta1.NamespacesFromSame
+	var selector *metav1.LabelSelector
+	if listener.AllowedRoutes != nil && listener.AllowedRoutes.Namespaces != nil {
+		if listener.AllowedRoutes.Namespaces.From != nil {
+			from = *listener.AllowedRoutes.Namespaces.From
+		}
+		selector = listener.AllowedRoutes.Namespaces.Selector
+	}
+	switch from {
+	case gwv1beta1.NamespacesFromAll:
+		return true, nil
+	case gwv1beta1.NamespacesFromSame:
+		return route.Meta.GetNamespace() == gw.Namespace, nil
+	case gwv1beta1.NamespacesFromSelector:
+		if selector == nil {
+			return false, nil
+		}
+		labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return false, errors.Wrapf(err, "invalid namespace selector on listener %v", listener.Name)
+		}
+		ns := &corev1.Namespace{}
+		if err := a.k8sClient.Get(ctx, types.NamespacedName{Name: route.Meta.GetNamespace()}, ns); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return labelSelector.Matches(labels.Set(ns.Labels)), nil
+	default:
+		return false, nil
+	}
+}
+
+// IsParentRefToGateway checks whether the parentRef on route within routeNamespace references the Gateway.
+func IsParentRefToGateway(parentRef gwv1beta1.ParentReference, routeNamespace string, gw *gwv1beta1.Gateway) bool {
+	if parentRef.Group != nil && *parentRef.Group != gwv1beta1.GroupName {
+		return false
+	}
+	if parentRef.Kind != nil && *parentRef.Kind != gatewayKind {
+		return false
+	}
+	parentNamespace := routeNamespace
+	if parentRef.Namespace != nil {
+		parentNamespace = string(*parentRef.Namespace)
+	}
+	return parentNamespace == gw.Namespace && string(parentRef.Name) == gw.Name
+}
+
+// isRouteKindAllowedByListener checks whether route kind is allowed by listener.
+// when allowedRoutes.kinds is unspecified, the kinds are inferred from listener protocol.
+func isRouteKindAllowedByListener(routeKind gwv1beta1.Kind, listener gwv1beta1.Listener) bool {
+	if listener.AllowedRoutes != nil && len(listener.AllowedRoutes.Kinds) != 0 {
+		for _, kind := range listener.AllowedRoutes.Kinds {
+			if kind.Group != nil && *kind.Group != gwv1beta1.GroupName {
+				continue
+			}
+			if kind.Kind == routeKind {
+				return true
+			}
+		}
+		return false
+	}
+	return defaultRouteKindForProtocol(listener.Protocol) == routeKind
+}
+
+// defaultRouteKindForProtocol returns the route kind supported by listener protocol by default.
+func defaultRouteKindForProtocol(protocol gwv1beta1.ProtocolType) gwv1beta1.Kind {
+	switch protocol {
+	case gwv1beta1.HTTPProtocolType, gwv1beta1.HTTPSProtocolType:
+		return RouteKindHTTPRoute
+	case gwv1beta1.TCPProtocolType:
+		return RouteKindTCPRoute
+	case gwv1beta1.UDPProtocolType:
+		return RouteKindUDPRoute
+	case gwv1beta1.TLSProtocolType:
+		return RouteKindTLSRoute
+	default:
+		return ""
+	}
+}
+
+// computeListenerHostnames computes the effective hostnames for route hostnames on listener.
+// returns the effective hostnames and whether there is any match.
+// empty effective hostnames with match means the route matches any hostname.
+func computeListenerHostnames(listenerHostname *gwv1beta1.Hostname, routeHostnames []gwv1beta1.Hostname) ([]string, bool) {
+	if listenerHostname == nil || *listenerHostname == "" {
+		hostnames := make([]string, 0, len(routeHostnames))
+		for _, routeHostname := range routeHostnames {
+			hostnames = append(hostnames, string(routeHostname))
+		}
+		return hostnames, true
+	}
+	if len(routeHostnames) == 0 {
+		return []string{string(*listenerHostname)}, true
+	}
+	var hostnames []string
+	for _, routeHostname := range routeHostnames {
+		if hostname, ok := intersectHostname(string(*listenerHostname), string(routeHostname)); ok {
+			hostnames = append(hostnames, hostname)
+		}
+	}
+	return hostnames, len(hostnames) != 0
+}
+
+// intersectHostname computes the intersection between two hostnames which can contain wildcard prefix.
+// the most specific hostname will be returned if they intersect.
+func intersectHostname(hostnameA string, hostnameB string) (string, bool) {
+	if hostnameA == hostnameB {
+		return hostnameA, true
+	}
+	if wildcardHostnameMatches(hostnameA, hostnameB) {
+		return hostnameB, true
+	}
+	if wildcardHostnameMatches(hostnameB, hostnameA) {
+		return hostnameA, true
+	}
+	return "", false
+}
+
+// wildcardHostnameMatches checks whether wildcard hostname like "*.example.com" matches hostname.
+func wildcardHostnameMatches(wildcardHostname string, hostname string) bool {
+	if !strings.HasPrefix(wildcardHostname, "*.") {
+		return false
+	}
+	suffix := wildcardHostname[1:]
+	return strings.HasSuffix(hostname, suffix) && len(hostname) > len(suffix)
+}
@@ -0,0 +1,366 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func Test_defaultRouteAttacher_Attach(t *testing.T) {
+	fromAll := gwv1beta1.NamespacesFromAll
+	fromSelector := gwv1beta1.NamespacesFromSelector
+	sectionHTTPS := gwv1beta1.SectionName("https")
+	gwNS := gwv1beta1.Namespace("awesome-ns")
+	otherNS := gwv1beta1.Namespace("other-ns")
+	hostnameWildcard := gwv1beta1.Hostname("*.example.com")
+
+	type env struct {
+		nsList []*corev1.Namespace
+	}
+	type args struct {
+		gw    *gwv1beta1.Gateway
+		route RouteDescriptor
+	}
+	tests := []struct {
+		name    string
+		env     env
+		args    args
+		want    []RouteAttachment
+		wantErr error
+	}{
+		{
+			name: "route attached to all listeners in same namespace",
+			args: args{
+				gw: &gwv1beta1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-gw"},
+					Spec: gwv1beta1.GatewaySpec{
+						Listeners: []gwv1beta1.Listener{
+							{Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80},
+							{Name: "https", Protocol: gwv1beta1.HTTPSProtocolType, Port: 443},
+						},
+					},
+				},
+				route: RouteDescriptor{
+					Kind:       RouteKindHTTPRoute,
+					Meta:       &metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-route"},
+					ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw"}},
+				},
+			},
+			want: []RouteAttachment{
+				{
+					ParentRef: gwv1beta1.ParentReference{Name: "awesome-gw"},
+					Accepted:  true,
+					Reason:    gwv1beta1.RouteReasonAccepted,
+					Message:   "route accepted",
+					Listeners: []gwv1beta1.Listener{
+						{Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80},
+						{Name: "https", Protocol: gwv1beta1.HTTPSProtocolType, Port: 443},
+					},
+				},
+			},
+		},
+		{
+			name: "route attached to listener by sectionName",
+			args: args{
+				gw: &gwv1beta1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-gw"},
+					Spec: gwv1beta1.GatewaySpec{
+						Listeners: []gwv1beta1.Listener{
+							{Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80},
+							{Name: "https", Protocol: gwv1beta1.HTTPSProtocolType, Port: 443},
+						},
+					},
+				},
+				route: RouteDescriptor{
+					Kind:       RouteKindHTTPRoute,
+					Meta:       &metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-route"},
+					ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw", SectionName: &sectionHTTPS}},
+				},
+			},
+			want: []RouteAttachment{
+				{
+					ParentRef: gwv1beta1.ParentReference{Name: "awesome-gw", SectionName: &sectionHTTPS},
+					Accepted:  true,
+					Reason:    gwv1beta1.RouteReasonAccepted,
+					Message:   "route accepted",
+					Listeners: []gwv1beta1.Listener{
+						{Name: "https", Protocol: gwv1beta1.HTTPSProtocolType, Port: 443},
+					},
+				},
+			},
+		},
+		{
+			name: "parentRefs to other gateways are ignored",
+			args: args{
+				gw: &gwv1beta1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-gw"},
+					Spec: gwv1beta1.GatewaySpec{
+						Listeners: []gwv1beta1.Listener{
+							{Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80},
+						},
+					},
+				},
+				route: RouteDescriptor{
+					Kind:       RouteKindHTTPRoute,
+					Meta:       &metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-route"},
+					ParentRefs: []gwv1beta1.ParentReference{{Name: "other-gw"}, {Name: "awesome-gw", Namespace: &otherNS}},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "route in other namespace is not allowed by default",
+			args: args{
+				gw: &gwv1beta1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-gw"},
+					Spec: gwv1beta1.GatewaySpec{
+						Listeners: []gwv1beta1.Listener{
+							{Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80},
+						},
+					},
+				},
+				route: RouteDescriptor{
+					Kind:       RouteKindHTTPRoute,
+					Meta:       &metav1.ObjectMeta{Namespace: "other-ns", Name: "awesome-route"},
+					ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw", Namespace: &gwNS}},
+				},
+			},
+			want: []RouteAttachment{
+				{
+					ParentRef: gwv1beta1.ParentReference{Name: "awesome-gw", Namespace: &gwNS},
+					Accepted:  false,
+					Reason:    gwv1beta1.RouteReasonNotAllowedByListeners,
+					Message:   "HTTPRoute is not allowed by listeners",
+				},
+			},
+		},
+		{
+			name: "route in other namespace is allowed by namespace selector",
+			env: env{
+				nsList: []*corev1.Namespace{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "other-ns",
+							Labels: map[string]string{"team": "awesome"},
+						},
+					},
+				},
+			},
+			args: args{
+				gw: &gwv1beta1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-gw"},
+					Spec: gwv1beta1.GatewaySpec{
+						Listeners: []gwv1beta1.Listener{
+							{
+								Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80,
+								AllowedRoutes: &gwv1beta1.AllowedRoutes{
+									Namespaces: &gwv1beta1.RouteNamespaces{
+										From:     &fromSelector,
+										Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "awesome"}},
+									},
+								},
+							},
+							{
+								Name: "http-all", Protocol: gwv1beta1.HTTPProtocolType, Port: 8080,
+								AllowedRoutes: &gwv1beta1.AllowedRoutes{
+									Namespaces: &gwv1beta1.RouteNamespaces{
+										From: &fromAll,
+									},
+									Kinds: []gwv1beta1.RouteGroupKind{{Kind: "TCPRoute"}},
+								},
+							},
+						},
+					},
+				},
+				route: RouteDescriptor{
+					Kind:       RouteKindHTTPRoute,
+					Meta:       &metav1.ObjectMeta{Namespace: "other-ns", Name: "awesome-route"},
+					ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw", Namespace: &gwNS}},
+				},
+			},
+			want: []RouteAttachment{
+				{
+					ParentRef: gwv1beta1.ParentReference{Name: "awesome-gw", Namespace: &gwNS},
+					Accepted:  true,
+					Reason:    gwv1beta1.RouteReasonAccepted,
+					Message:   "route accepted",
+					Listeners: []gwv1beta1.Listener{
+						{
+							Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80,
+							AllowedRoutes: &gwv1beta1.AllowedRoutes{
+								Namespaces: &gwv1beta1.RouteNamespaces{
+									From:     &fromSelector,
+									Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "awesome"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "route hostnames intersect with listener hostname",
+			args: args{
+				gw: &gwv1beta1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-gw"},
+					Spec: gwv1beta1.GatewaySpec{
+						Listeners: []gwv1beta1.Listener{
+							{Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80, Hostname: &hostnameWildcard},
+						},
+					},
+				},
+				route: RouteDescriptor{
+					Kind:       RouteKindHTTPRoute,
+					Meta:       &metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-route"},
+					ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw"}},
+					Hostnames:  []gwv1beta1.Hostname{"www.example.com", "www.example.org"},
+				},
+			},
+			want: []RouteAttachment{
+				{
+					ParentRef: gwv1beta1.ParentReference{Name: "awesome-gw"},
+					Accepted:  true,
+					Reason:    gwv1beta1.RouteReasonAccepted,
+					Message:   "route accepted",
+					Listeners: []gwv1beta1.Listener{
+						{Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80, Hostname: &hostnameWildcard},
+					},
+					Hostnames: []string{"www.example.com"},
+				},
+			},
+		},
+		{
+			name: "route hostnames doesn't intersect with listener hostname",
+			args: args{
+				gw: &gwv1beta1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-gw"},
+					Spec: gwv1beta1.GatewaySpec{
+						Listeners: []gwv1beta1.Listener{
+							{Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80, Hostname: &hostnameWildcard},
+						},
+					},
+				},
+				route: RouteDescriptor{
+					Kind:       RouteKindHTTPRoute,
+					Meta:       &metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-route"},
+					ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw"}},
+					Hostnames:  []gwv1beta1.Hostname{"www.example.org"},
+				},
+			},
+			want: []RouteAttachment{
+				{
+					ParentRef: gwv1beta1.ParentReference{Name: "awesome-gw"},
+					Accepted:  false,
+					Reason:    gwv1beta1.RouteReasonNoMatchingListenerHostname,
+					Message:   "no listener hostname matches the route hostnames",
+				},
+			},
+		},
+		{
+			name: "no listener matches the sectionName",
+			args: args{
+				gw: &gwv1beta1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-gw"},
+					Spec: gwv1beta1.GatewaySpec{
+						Listeners: []gwv1beta1.Listener{
+							{Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80},
+						},
+					},
+				},
+				route: RouteDescriptor{
+					Kind:       RouteKindHTTPRoute,
+					Meta:       &metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-route"},
+					ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw", SectionName: &sectionHTTPS}},
+				},
+			},
+			want: []RouteAttachment{
+				{
+					ParentRef: gwv1beta1.ParentReference{Name: "awesome-gw", SectionName: &sectionHTTPS},
+					Accepted:  false,
+					Reason:    gwv1beta1.RouteReasonNoMatchingParent,
+					Message:   "no listener matches the parentRef",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, ns := range tt.env.nsList {
+				assert.NoError(t, k8sClient.Create(ctx, ns.DeepCopy()))
+			}
+
+			a := NewDefaultRouteAttacher(k8sClient)
+			got, err := a.Attach(ctx, tt.args.gw, tt.args.route)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_intersectHostname(t *testing.T) {
+	tests := []struct {
+		name      string
+		hostnameA string
+		hostnameB string
+		want      string
+		wantMatch bool
+	}{
+		{
+			name:      "same hostnames",
+			hostnameA: "www.example.com",
+			hostnameB: "www.example.com",
+			want:      "www.example.com",
+			wantMatch: true,
+		},
+		{
+			name:      "wildcard hostname matches specific hostname",
+			hostnameA: "*.example.com",
+			hostnameB: "www.example.com",
+			want:      "www.example.com",
+			wantMatch: true,
+		},
+		{
+			name:      "specific hostname matches wildcard hostname",
+			hostnameA: "www.example.com",
+			hostnameB: "*.example.com",
+			want:      "www.example.com",
+			wantMatch: true,
+		},
+		{
+			name:      "wildcard hostname doesn't match its own suffix",
+			hostnameA: "*.example.com",
+			hostnameB: "example.com",
+			want:      "",
+			wantMatch: false,
+		},
+		{
+			name:      "different hostnames",
+			hostnameA: "www.example.com",
+			hostnameB: "www.example.org",
+			want:      "",
+			wantMatch: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotMatch := intersectHostname(tt.hostnameA, tt.hostnameB)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantMatch, gotMatch)
+		})
+	}
+}
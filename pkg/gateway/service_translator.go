@@ -0,0 +1,372 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// the annotation prefix used by service model builder.
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+	// the load balancer type that instructs the service model builder to provision NLB.
+	serviceLoadBalancerTypeExternal = "external"
+)
+
+// L4RouteTranslation contains the translation result of a TCPRoute, UDPRoute or TLSRoute attached to the Gateway.
+type L4RouteTranslation struct {
+	Route L4Route
+
+	// Attachments are the attachments of the route to each parentRef that references the Gateway.
+	Attachments []RouteAttachment
+
+	// ResolvedRefs denotes whether all backendRefs on the route are resolved.
+	ResolvedRefs bool
+	// ResolvedRefsReason is the reason for ResolvedRefs condition.
+	ResolvedRefsReason gwv1beta1.RouteConditionReason
+	// ResolvedRefsMessage is a human-readable message for ResolvedRefs condition.
+	ResolvedRefsMessage string
+}
+
+// ServiceGatewayTranslation contains the translation result of a Gateway along with its attached L4 routes.
+type ServiceGatewayTranslation struct {
+	// Service is the frontend Service equivalent of the Gateway, which can be consumed by service ModelBuilder.
+	// each port of the Service corresponds to a Gateway listener with route attached.
+	Service *corev1.Service
+	// BackendByPort are the backend Service ports that traffic received on each frontend Service port will be forwarded to.
+	BackendByPort map[int32]service.ServiceBackend
+	// Listeners are the translation results of Gateway listeners.
+	Listeners []ListenerTranslation
+	// L4Routes are the translation results of L4 routes that references the Gateway.
+	L4Routes []L4RouteTranslation
+}
+
+// ServiceTranslator translates Gateway and its attached TCPRoutes, UDPRoutes and TLSRoutes into a frontend Service.
+// Each Gateway listener with route attached is translated into a port of the frontend Service,
+// with traffic forwarded to the backend Service port referenced by the route.
+type ServiceTranslator interface {
+	// Translate translates the Gateway along with its attached L4 routes.
+	Translate(ctx context.Context, gw *gwv1beta1.Gateway) (ServiceGatewayTranslation, error)
+}
+
+// NewDefaultServiceTranslator constructs new defaultServiceTranslator.
+func NewDefaultServiceTranslator(k8sClient client.Client, routeAttacher RouteAttacher) *defaultServiceTranslator {
+	return &defaultServiceTranslator{
+		k8sClient:     k8sClient,
+		routeAttacher: routeAttacher,
+	}
+}
+
+var _ ServiceTranslator = &defaultServiceTranslator{}
+
+// default implementation for ServiceTranslator
+type defaultServiceTranslator struct {
+	k8sClient     client.Client
+	routeAttacher RouteAttacher
+}
+
+func (t *defaultServiceTranslator) Translate(ctx context.Context, gw *gwv1beta1.Gateway) (ServiceGatewayTranslation, error) {
+	if !gw.DeletionTimestamp.IsZero() {
+		return ServiceGatewayTranslation{
+			Service: t.buildFrontendService(gw, nil),
+		}, nil
+	}
+
+	listenerTranslations := t.translateListeners(gw)
+	acceptedListeners := make([]gwv1beta1.Listener, 0, len(listenerTranslations))
+	for _, listenerTranslation := range listenerTranslations {
+		if listenerTranslation.Accepted {
+			acceptedListeners = append(acceptedListeners, listenerTranslation.Listener)
+		}
+	}
+	gwWithAcceptedListeners := gw.DeepCopy()
+	gwWithAcceptedListeners.Spec.Listeners = acceptedListeners
+
+	routes, err := t.loadL4Routes(ctx, gw)
+	if err != nil {
+		return ServiceGatewayTranslation{}, err
+	}
+	backendResolver := newServiceBackendResolver(t.k8sClient)
+	claimedListeners := make(map[gwv1beta1.SectionName]struct{})
+	var frontendPorts []corev1.ServicePort
+	backendByPort := make(map[int32]service.ServiceBackend)
+	var routeTranslations []L4RouteTranslation
+	for _, route := range routes {
+		routeTranslation, backend, err := t.translateL4Route(ctx, gwWithAcceptedListeners, route, backendResolver)
+		if err != nil {
+			return ServiceGatewayTranslation{}, errors.Wrapf(err, "%v: %v", route.Kind, k8s.NamespacedName(route.Object).String())
+		}
+		// NLB listener can only forward to a single target group, so each listener can only be claimed by the oldest route.
+		for i := range routeTranslation.Attachments {
+			attachment := &routeTranslation.Attachments[i]
+			if !attachment.Accepted {
+				continue
+			}
+			var claimableListeners []gwv1beta1.Listener
+			for _, listener := range attachment.Listeners {
+				if _, claimed := claimedListeners[listener.Name]; !claimed {
+					claimableListeners = append(claimableListeners, listener)
+				}
+			}
+			if len(claimableListeners) == 0 {
+				attachment.Accepted = false
+				attachment.Reason = gwv1beta1.RouteReasonNotAllowedByListeners
+				attachment.Message = "listeners are already in use by other routes"
+				continue
+			}
+			for _, listener := range claimableListeners {
+				claimedListeners[listener.Name] = struct{}{}
+				if backend == nil {
+					continue
+				}
+				frontendPorts = append(frontendPorts, buildFrontendServicePort(listener))
+				backendByPort[int32(listener.Port)] = service.ServiceBackend{
+					Service: backend.Service,
+					Port:    backend.Port,
+				}
+			}
+		}
+		routeTranslations = append(routeTranslations, routeTranslation)
+	}
+	for i := range listenerTranslations {
+		if _, claimed := claimedListeners[listenerTranslations[i].Listener.Name]; claimed {
+			listenerTranslations[i].AttachedRoutes = 1
+		}
+	}
+
+	sort.Slice(frontendPorts, func(i, j int) bool {
+		return frontendPorts[i].Port < frontendPorts[j].Port
+	})
+	return ServiceGatewayTranslation{
+		Service:       t.buildFrontendService(gw, frontendPorts),
+		BackendByPort: backendByPort,
+		Listeners:     listenerTranslations,
+		L4Routes:      routeTranslations,
+	}, nil
+}
+
+// translateListeners checks whether each Gateway listener can be supported by NLB.
+func (t *defaultServiceTranslator) translateListeners(gw *gwv1beta1.Gateway) []ListenerTranslation {
+	protocolsByPort := make(map[gwv1beta1.PortNumber]map[gwv1beta1.ProtocolType]struct{})
+	listenerCountByPort := make(map[gwv1beta1.PortNumber]int)
+	for _, listener := range gw.Spec.Listeners {
+		if _, ok := protocolsByPort[listener.Port]; !ok {
+			protocolsByPort[listener.Port] = make(map[gwv1beta1.ProtocolType]struct{})
+		}
+		protocolsByPort[listener.Port][listener.Protocol] = struct{}{}
+		listenerCountByPort[listener.Port]++
+	}
+	hasCertificate := gw.Annotations[serviceAnnotationKey(annotations.SvcLBSuffixSSLCertificate)] != ""
+
+	listenerTranslations := make([]ListenerTranslation, 0, len(gw.Spec.Listeners))
+	for _, listener := range gw.Spec.Listeners {
+		listenerTranslation := ListenerTranslation{
+			Listener: listener,
+			Accepted: true,
+			Reason:   gwv1beta1.ListenerReasonAccepted,
+			Message:  "listener accepted",
+		}
+		switch {
+		case listener.Protocol != gwv1beta1.TCPProtocolType && listener.Protocol != gwv1beta1.UDPProtocolType &&
+			listener.Protocol != gwv1beta1.TLSProtocolType:
+			listenerTranslation.Accepted = false
+			listenerTranslation.Reason = gwv1beta1.ListenerReasonUnsupportedProtocol
+			listenerTranslation.Message = fmt.Sprintf("unsupported protocol: %v", listener.Protocol)
+		case isTLSTerminateListener(listener) && !hasCertificate:
+			listenerTranslation.Accepted = false
+			listenerTranslation.Reason = gwv1beta1.ListenerReasonInvalidCertificateRef
+			listenerTranslation.Message = fmt.Sprintf("certificate must be specified via annotation %v", serviceAnnotationKey(annotations.SvcLBSuffixSSLCertificate))
+		case len(protocolsByPort[listener.Port]) > 1:
+			listenerTranslation.Accepted = false
+			listenerTranslation.Reason = gwv1beta1.ListenerReasonProtocolConflict
+			listenerTranslation.Message = fmt.Sprintf("conflicting protocols on port: %v", listener.Port)
+		case listenerCountByPort[listener.Port] > 1:
+			listenerTranslation.Accepted = false
+			listenerTranslation.Reason = gwv1beta1.ListenerReasonHostnameConflict
+			listenerTranslation.Message = fmt.Sprintf("multiple listeners on port: %v", listener.Port)
+		}
+		listenerTranslations = append(listenerTranslations, listenerTranslation)
+	}
+	return listenerTranslations
+}
+
+// loadL4Routes loads L4 routes that references the Gateway, sorted by creationTimestamp and then kind/namespace/name.
+func (t *defaultServiceTranslator) loadL4Routes(ctx context.Context, gw *gwv1beta1.Gateway) ([]L4Route, error) {
+	allRoutes, err := ListL4Routes(ctx, t.k8sClient)
+	if err != nil {
+		return nil, err
+	}
+	var routes []L4Route
+	for _, route := range allRoutes {
+		if !route.Object.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		for _, parentRef := range route.ParentRefs {
+			if IsParentRefToGateway(parentRef, route.Object.GetNamespace(), gw) {
+				routes = append(routes, route)
+				break
+			}
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		tsI, tsJ := routes[i].Object.GetCreationTimestamp(), routes[j].Object.GetCreationTimestamp()
+		if !tsI.Equal(&tsJ) {
+			return tsI.Before(&tsJ)
+		}
+		if routes[i].Kind != routes[j].Kind {
+			return routes[i].Kind < routes[j].Kind
+		}
+		return k8s.NamespacedName(routes[i].Object).String() < k8s.NamespacedName(routes[j].Object).String()
+	})
+	return routes, nil
+}
+
+// translateL4Route computes the attachments of L4 route and resolves its backend.
+// nil backend will be returned if the route is not accepted by any listener or its backend cannot be resolved.
+func (t *defaultServiceTranslator) translateL4Route(ctx context.Context, gwWithAcceptedListeners *gwv1beta1.Gateway,
+	route L4Route, backendResolver *serviceBackendResolver) (L4RouteTranslation, *serviceBackend, error) {
+	attachments, err := t.routeAttacher.Attach(ctx, gwWithAcceptedListeners, RouteDescriptor{
+		Kind:       route.Kind,
+		Meta:       route.Object,
+		ParentRefs: route.ParentRefs,
+		Hostnames:  route.Hostnames,
+	})
+	if err != nil {
+		return L4RouteTranslation{}, nil, err
+	}
+	routeBackendResolver := newRouteBackendResolver(backendResolver, route.Object.GetNamespace())
+	routeTranslation := L4RouteTranslation{
+		Route:       route,
+		Attachments: attachments,
+	}
+	routeTranslation.ResolvedRefs, routeTranslation.ResolvedRefsReason, routeTranslation.ResolvedRefsMessage = routeBackendResolver.resolvedRefsCondition()
+
+	hasAcceptedAttachment := false
+	for _, attachment := range attachments {
+		if attachment.Accepted {
+			hasAcceptedAttachment = true
+			break
+		}
+	}
+	if !hasAcceptedAttachment {
+		return routeTranslation, nil, nil
+	}
+
+	if len(route.RuleBackendRefs) != 1 || len(route.RuleBackendRefs[0]) != 1 {
+		for i := range routeTranslation.Attachments {
+			if routeTranslation.Attachments[i].Accepted {
+				routeTranslation.Attachments[i].Accepted = false
+				routeTranslation.Attachments[i].Reason = gwv1beta1.RouteReasonUnsupportedValue
+				routeTranslation.Attachments[i].Message = "only a single rule with a single backendRef is supported"
+			}
+		}
+		return routeTranslation, nil, nil
+	}
+
+	backend, err := routeBackendResolver.resolve(ctx, route.RuleBackendRefs[0][0])
+	if err != nil {
+		return L4RouteTranslation{}, nil, err
+	}
+	routeTranslation.ResolvedRefs, routeTranslation.ResolvedRefsReason, routeTranslation.ResolvedRefsMessage = routeBackendResolver.resolvedRefsCondition()
+	return routeTranslation, backend, nil
+}
+
+// buildFrontendService builds the frontend Service equivalent of Gateway.
+func (t *defaultServiceTranslator) buildFrontendService(gw *gwv1beta1.Gateway, ports []corev1.ServicePort) *corev1.Service {
+	frontendPortNames := make(map[string]struct{}, len(ports))
+	for _, port := range ports {
+		frontendPortNames[port.Name] = struct{}{}
+	}
+	var tlsPorts []string
+	for _, listener := range gw.Spec.Listeners {
+		if _, ok := frontendPortNames[string(listener.Name)]; ok && isTLSTerminateListener(listener) {
+			tlsPorts = append(tlsPorts, strconv.Itoa(int(listener.Port)))
+		}
+	}
+	svcAnnotations := make(map[string]string)
+	for key, value := range gw.Annotations {
+		if strings.HasPrefix(key, serviceAnnotationPrefix+"/") {
+			svcAnnotations[key] = value
+		}
+	}
+	svcAnnotations[serviceAnnotationKey(annotations.SvcLBSuffixLoadBalancerType)] = serviceLoadBalancerTypeExternal
+	if _, exists := svcAnnotations[serviceAnnotationKey(annotations.SvcLBSuffixTargetType)]; !exists {
+		svcAnnotations[serviceAnnotationKey(annotations.SvcLBSuffixTargetType)] = service.LoadBalancerTargetTypeIP
+	}
+	if len(tlsPorts) == 0 {
+		delete(svcAnnotations, serviceAnnotationKey(annotations.SvcLBSuffixSSLCertificate))
+		delete(svcAnnotations, serviceAnnotationKey(annotations.SvcLBSuffixSSLPorts))
+	} else {
+		svcAnnotations[serviceAnnotationKey(annotations.SvcLBSuffixSSLPorts)] = strings.Join(tlsPorts, ",")
+	}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         gw.Namespace,
+			Name:              gw.Name,
+			UID:               gw.UID,
+			Annotations:       svcAnnotations,
+			DeletionTimestamp: gw.DeletionTimestamp,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:  corev1.ServiceTypeLoadBalancer,
+			Ports: ports,
+		},
+	}
+}
+
+// buildFrontendServicePort builds the frontend Service port for listener.
+func buildFrontendServicePort(listener gwv1beta1.Listener) corev1.ServicePort {
+	protocol := corev1.ProtocolTCP
+	if listener.Protocol == gwv1beta1.UDPProtocolType {
+		protocol = corev1.ProtocolUDP
+	}
+	return corev1.ServicePort{
+		Name:     string(listener.Name),
+		Protocol: protocol,
+		Port:     int32(listener.Port),
+	}
+}
+
+// isTLSTerminateListener checks whether listener terminates TLS traffic.
+func isTLSTerminateListener(listener gwv1beta1.Listener) bool {
+	if listener.Protocol != gwv1beta1.TLSProtocolType {
+		return false
+	}
+	return listener.TLS == nil || listener.TLS.Mode == nil || *listener.TLS.Mode == gwv1beta1.TLSModeTerminate
+}
+
+// serviceAnnotationKey returns the full service annotation key for suffix.
+func serviceAnnotationKey(suffix string) string {
+	return fmt.Sprintf("%v/%v", serviceAnnotationPrefix, suffix)
+}
+
+// NewFrontendServiceUtils constructs new frontendServiceUtils.
+func NewFrontendServiceUtils() *frontendServiceUtils {
+	return &frontendServiceUtils{}
+}
+
+var _ service.ServiceUtils = &frontendServiceUtils{}
+
+// frontendServiceUtils is the ServiceUtils for frontend Services translated from Gateway.
+// the frontend Service is supported as long as the Gateway isn't deleting and it has any port.
+type frontendServiceUtils struct{}
+
+func (u *frontendServiceUtils) IsServiceSupported(svc *corev1.Service) bool {
+	return svc.DeletionTimestamp.IsZero() && len(svc.Spec.Ports) != 0
+}
+
+func (u *frontendServiceUtils) IsServicePendingFinalization(_ *corev1.Service) bool {
+	return false
+}
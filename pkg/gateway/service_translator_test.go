@@ -0,0 +1,358 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func Test_defaultServiceTranslator_Translate(t *testing.T) {
+	port5432 := gwv1beta1.PortNumber(5432)
+	port53 := gwv1beta1.PortNumber(53)
+	tlsModeTerminate := gwv1beta1.TLSModeTerminate
+	createdAt := metav1.NewTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	createdLater := metav1.NewTime(createdAt.Add(time.Hour))
+
+	dbSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "db"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Port: 5432, TargetPort: intstr.FromInt(15432), Protocol: corev1.ProtocolTCP}},
+		},
+	}
+	dnsSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "dns"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Port: 53, TargetPort: intstr.FromInt(1053), Protocol: corev1.ProtocolUDP}},
+		},
+	}
+	listeners := []gwv1beta1.Listener{
+		{Name: "tcp", Protocol: gwv1beta1.TCPProtocolType, Port: 5432},
+		{Name: "udp", Protocol: gwv1beta1.UDPProtocolType, Port: 53},
+		{Name: "http", Protocol: gwv1beta1.HTTPProtocolType, Port: 80},
+		{Name: "tls", Protocol: gwv1beta1.TLSProtocolType, Port: 443, TLS: &gwv1beta1.GatewayTLSConfig{Mode: &tlsModeTerminate}},
+	}
+
+	type env struct {
+		svcList   []*corev1.Service
+		routeList []client.Object
+	}
+	type wantRoute struct {
+		name               string
+		accepted           bool
+		acceptedReason     gwv1beta1.RouteConditionReason
+		resolvedRefs       bool
+		resolvedRefsReason gwv1beta1.RouteConditionReason
+	}
+	tests := []struct {
+		name              string
+		gwAnnotations     map[string]string
+		env               env
+		wantListeners     map[gwv1beta1.SectionName]gwv1beta1.ListenerConditionReason
+		wantAttached      map[gwv1beta1.SectionName]int32
+		wantRoutes        []wantRoute
+		wantPorts         []corev1.ServicePort
+		wantBackendByPort map[int32]string
+		wantAnnotations   map[string]string
+	}{
+		{
+			name: "routes attached to TCP and UDP listeners",
+			gwAnnotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+				"alb.ingress.kubernetes.io/scheme":                    "internal",
+			},
+			env: env{
+				svcList: []*corev1.Service{dbSvc, dnsSvc},
+				routeList: []client.Object{
+					&gwv1alpha2.TCPRoute{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "db-route", CreationTimestamp: createdAt},
+						Spec: gwv1alpha2.TCPRouteSpec{
+							CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+								ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw"}},
+							},
+							Rules: []gwv1alpha2.TCPRouteRule{
+								{
+									BackendRefs: []gwv1beta1.BackendRef{
+										{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "db", Port: &port5432}},
+									},
+								},
+							},
+						},
+					},
+					&gwv1alpha2.UDPRoute{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "dns-route", CreationTimestamp: createdAt},
+						Spec: gwv1alpha2.UDPRouteSpec{
+							CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+								ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw"}},
+							},
+							Rules: []gwv1alpha2.UDPRouteRule{
+								{
+									BackendRefs: []gwv1beta1.BackendRef{
+										{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "dns", Port: &port53}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantListeners: map[gwv1beta1.SectionName]gwv1beta1.ListenerConditionReason{
+				"tcp":  gwv1beta1.ListenerReasonAccepted,
+				"udp":  gwv1beta1.ListenerReasonAccepted,
+				"http": gwv1beta1.ListenerReasonUnsupportedProtocol,
+				"tls":  gwv1beta1.ListenerReasonInvalidCertificateRef,
+			},
+			wantAttached: map[gwv1beta1.SectionName]int32{"tcp": 1, "udp": 1},
+			wantRoutes: []wantRoute{
+				{
+					name:               "db-route",
+					accepted:           true,
+					acceptedReason:     gwv1beta1.RouteReasonAccepted,
+					resolvedRefs:       true,
+					resolvedRefsReason: gwv1beta1.RouteReasonResolvedRefs,
+				},
+				{
+					name:               "dns-route",
+					accepted:           true,
+					acceptedReason:     gwv1beta1.RouteReasonAccepted,
+					resolvedRefs:       true,
+					resolvedRefsReason: gwv1beta1.RouteReasonResolvedRefs,
+				},
+			},
+			wantPorts: []corev1.ServicePort{
+				{Name: "udp", Protocol: corev1.ProtocolUDP, Port: 53},
+				{Name: "tcp", Protocol: corev1.ProtocolTCP, Port: 5432},
+			},
+			wantBackendByPort: map[int32]string{
+				53:   "awesome-ns/dns:53",
+				5432: "awesome-ns/db:5432",
+			},
+			wantAnnotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+				"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+			},
+		},
+		{
+			name: "routes competing for same listener and route with missing backend",
+			gwAnnotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-cert":        "arn:aws:acm:us-west-2:xxxxx:certificate/xxxxxxx",
+				"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "instance",
+			},
+			env: env{
+				svcList: []*corev1.Service{dbSvc},
+				routeList: []client.Object{
+					&gwv1alpha2.TCPRoute{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "db-route-new", CreationTimestamp: createdLater},
+						Spec: gwv1alpha2.TCPRouteSpec{
+							CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+								ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw", SectionName: &[]gwv1beta1.SectionName{"tcp"}[0]}},
+							},
+							Rules: []gwv1alpha2.TCPRouteRule{
+								{
+									BackendRefs: []gwv1beta1.BackendRef{
+										{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "db", Port: &port5432}},
+									},
+								},
+							},
+						},
+					},
+					&gwv1alpha2.TCPRoute{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "db-route-old", CreationTimestamp: createdAt},
+						Spec: gwv1alpha2.TCPRouteSpec{
+							CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+								ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw", SectionName: &[]gwv1beta1.SectionName{"tcp"}[0]}},
+							},
+							Rules: []gwv1alpha2.TCPRouteRule{
+								{
+									BackendRefs: []gwv1beta1.BackendRef{
+										{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "db", Port: &port5432}},
+									},
+								},
+							},
+						},
+					},
+					&gwv1alpha2.TLSRoute{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tls-route", CreationTimestamp: createdAt},
+						Spec: gwv1alpha2.TLSRouteSpec{
+							CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+								ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw"}},
+							},
+							Rules: []gwv1alpha2.TLSRouteRule{
+								{
+									BackendRefs: []gwv1beta1.BackendRef{
+										{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "svc-missing", Port: &port5432}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantListeners: map[gwv1beta1.SectionName]gwv1beta1.ListenerConditionReason{
+				"tcp":  gwv1beta1.ListenerReasonAccepted,
+				"udp":  gwv1beta1.ListenerReasonAccepted,
+				"http": gwv1beta1.ListenerReasonUnsupportedProtocol,
+				"tls":  gwv1beta1.ListenerReasonAccepted,
+			},
+			wantAttached: map[gwv1beta1.SectionName]int32{"tcp": 1, "tls": 1},
+			wantRoutes: []wantRoute{
+				{
+					name:               "db-route-old",
+					accepted:           true,
+					acceptedReason:     gwv1beta1.RouteReasonAccepted,
+					resolvedRefs:       true,
+					resolvedRefsReason: gwv1beta1.RouteReasonResolvedRefs,
+				},
+				{
+					name:               "tls-route",
+					accepted:           true,
+					acceptedReason:     gwv1beta1.RouteReasonAccepted,
+					resolvedRefs:       false,
+					resolvedRefsReason: gwv1beta1.RouteReasonBackendNotFound,
+				},
+				{
+					name:               "db-route-new",
+					accepted:           false,
+					acceptedReason:     gwv1beta1.RouteReasonNotAllowedByListeners,
+					resolvedRefs:       true,
+					resolvedRefsReason: gwv1beta1.RouteReasonResolvedRefs,
+				},
+			},
+			wantPorts: []corev1.ServicePort{
+				{Name: "tcp", Protocol: corev1.ProtocolTCP, Port: 5432},
+			},
+			wantBackendByPort: map[int32]string{
+				5432: "awesome-ns/db:5432",
+			},
+			wantAnnotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+				"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "instance",
+			},
+		},
+		{
+			name: "route attached to TLS listener with certificate",
+			gwAnnotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-cert": "arn:aws:acm:us-west-2:xxxxx:certificate/xxxxxxx",
+			},
+			env: env{
+				svcList: []*corev1.Service{dbSvc},
+				routeList: []client.Object{
+					&gwv1alpha2.TLSRoute{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tls-route", CreationTimestamp: createdAt},
+						Spec: gwv1alpha2.TLSRouteSpec{
+							CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+								ParentRefs: []gwv1beta1.ParentReference{{Name: "awesome-gw"}},
+							},
+							Rules: []gwv1alpha2.TLSRouteRule{
+								{
+									BackendRefs: []gwv1beta1.BackendRef{
+										{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "db", Port: &port5432}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantListeners: map[gwv1beta1.SectionName]gwv1beta1.ListenerConditionReason{
+				"tcp":  gwv1beta1.ListenerReasonAccepted,
+				"udp":  gwv1beta1.ListenerReasonAccepted,
+				"http": gwv1beta1.ListenerReasonUnsupportedProtocol,
+				"tls":  gwv1beta1.ListenerReasonAccepted,
+			},
+			wantAttached: map[gwv1beta1.SectionName]int32{"tls": 1},
+			wantRoutes: []wantRoute{
+				{
+					name:               "tls-route",
+					accepted:           true,
+					acceptedReason:     gwv1beta1.RouteReasonAccepted,
+					resolvedRefs:       true,
+					resolvedRefsReason: gwv1beta1.RouteReasonResolvedRefs,
+				},
+			},
+			wantPorts: []corev1.ServicePort{
+				{Name: "tls", Protocol: corev1.ProtocolTCP, Port: 443},
+			},
+			wantBackendByPort: map[int32]string{
+				443: "awesome-ns/db:5432",
+			},
+			wantAnnotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-cert":        "arn:aws:acm:us-west-2:xxxxx:certificate/xxxxxxx",
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports":       "443",
+				"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+				"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			gwv1beta1.AddToScheme(k8sSchema)
+			gwv1alpha2.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, svc := range tt.env.svcList {
+				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
+			}
+			for _, route := range tt.env.routeList {
+				assert.NoError(t, k8sClient.Create(ctx, route.DeepCopyObject().(client.Object)))
+			}
+			gw := &gwv1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "awesome-gw",
+					UID:         "gw-uid",
+					Annotations: tt.gwAnnotations,
+				},
+				Spec: gwv1beta1.GatewaySpec{
+					GatewayClassName: "aws-nlb",
+					Listeners:        listeners,
+				},
+			}
+
+			translator := NewDefaultServiceTranslator(k8sClient, NewDefaultRouteAttacher(k8sClient))
+			got, err := translator.Translate(ctx, gw)
+			assert.NoError(t, err)
+
+			assert.Equal(t, len(tt.wantListeners), len(got.Listeners))
+			for _, listener := range got.Listeners {
+				assert.Equal(t, tt.wantListeners[listener.Listener.Name], listener.Reason, "listener %v", listener.Listener.Name)
+				assert.Equal(t, tt.wantAttached[listener.Listener.Name], listener.AttachedRoutes, "listener %v", listener.Listener.Name)
+			}
+			assert.Equal(t, len(tt.wantRoutes), len(got.L4Routes))
+			for i, want := range tt.wantRoutes {
+				routeTranslation := got.L4Routes[i]
+				assert.Equal(t, want.name, routeTranslation.Route.Object.GetName())
+				assert.Equal(t, 1, len(routeTranslation.Attachments))
+				assert.Equal(t, want.accepted, routeTranslation.Attachments[0].Accepted)
+				assert.Equal(t, want.acceptedReason, routeTranslation.Attachments[0].Reason)
+				assert.Equal(t, want.resolvedRefs, routeTranslation.ResolvedRefs)
+				assert.Equal(t, want.resolvedRefsReason, routeTranslation.ResolvedRefsReason)
+			}
+
+			assert.Equal(t, "awesome-ns", got.Service.Namespace)
+			assert.Equal(t, "awesome-gw", got.Service.Name)
+			assert.Equal(t, tt.wantAnnotations, got.Service.Annotations)
+			assert.Equal(t, tt.wantPorts, got.Service.Spec.Ports)
+			gotBackendByPort := make(map[int32]string)
+			for port, backend := range got.BackendByPort {
+				gotBackendByPort[port] = fmt.Sprintf("%v:%v", k8s.NamespacedName(backend.Service), backend.Port.Port)
+			}
+			assert.Equal(t, tt.wantBackendByPort, gotBackendByPort)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package gateway
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// BuildGatewayClassStatus computes the status for GatewayClass accepted by us.
+func BuildGatewayClassStatus(gwClass *gwv1beta1.GatewayClass) gwv1beta1.GatewayClassStatus {
+	status := *gwClass.Status.DeepCopy()
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               string(gwv1beta1.GatewayClassConditionStatusAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1beta1.GatewayClassReasonAccepted),
+		Message:            "gatewayClass accepted",
+		ObservedGeneration: gwClass.Generation,
+	})
+	return status
+}
+
+// BuildGatewayStatus computes the status for Gateway based on listener translation results.
+// lbDNS is the DNS name of provisioned load balancer, and programmedErrMessage is non-empty if we failed to provision it.
+func BuildGatewayStatus(gw *gwv1beta1.Gateway, listenerTranslations []ListenerTranslation, lbDNS string, programmedErrMessage string) gwv1beta1.GatewayStatus {
+	status := *gw.Status.DeepCopy()
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               string(gwv1beta1.GatewayConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1beta1.GatewayReasonAccepted),
+		Message:            "gateway accepted",
+		ObservedGeneration: gw.Generation,
+	})
+	switch {
+	case programmedErrMessage != "":
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               string(gwv1beta1.GatewayConditionProgrammed),
+			Status:             metav1.ConditionFalse,
+			Reason:             string(gwv1beta1.GatewayReasonInvalid),
+			Message:            programmedErrMessage,
+			ObservedGeneration: gw.Generation,
+		})
+	case lbDNS == "":
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               string(gwv1beta1.GatewayConditionProgrammed),
+			Status:             metav1.ConditionFalse,
+			Reason:             string(gwv1beta1.GatewayReasonAddressNotAssigned),
+			Message:            "no route attached to gateway",
+			ObservedGeneration: gw.Generation,
+		})
+	default:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               string(gwv1beta1.GatewayConditionProgrammed),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gwv1beta1.GatewayReasonProgrammed),
+			Message:            "load balancer provisioned",
+			ObservedGeneration: gw.Generation,
+		})
+	}
+	if programmedErrMessage == "" {
+		status.Addresses = nil
+		if lbDNS != "" {
+			addressType := gwv1beta1.HostnameAddressType
+			status.Addresses = []gwv1beta1.GatewayAddress{
+				{
+					Type:  &addressType,
+					Value: lbDNS,
+				},
+			}
+		}
+	}
+	status.Listeners = buildListenerStatuses(gw, listenerTranslations)
+	return status
+}
+
+// buildListenerStatuses computes the listener statuses for Gateway.
+func buildListenerStatuses(gw *gwv1beta1.Gateway, listenerTranslations []ListenerTranslation) []gwv1beta1.ListenerStatus {
+	existingListenerStatusByName := make(map[gwv1beta1.SectionName]gwv1beta1.ListenerStatus, len(gw.Status.Listeners))
+	for _, listenerStatus := range gw.Status.Listeners {
+		existingListenerStatusByName[listenerStatus.Name] = listenerStatus
+	}
+	listenerStatuses := make([]gwv1beta1.ListenerStatus, 0, len(listenerTranslations))
+	for _, listenerTranslation := range listenerTranslations {
+		listener := listenerTranslation.Listener
+		listenerStatus := gwv1beta1.ListenerStatus{
+			Name:           listener.Name,
+			SupportedKinds: []gwv1beta1.RouteGroupKind{},
+			AttachedRoutes: listenerTranslation.AttachedRoutes,
+		}
+		if existingListenerStatus, ok := existingListenerStatusByName[listener.Name]; ok {
+			listenerStatus.Conditions = existingListenerStatus.Conditions
+		}
+		if routeKind := defaultRouteKindForProtocol(listener.Protocol); listenerTranslation.Accepted && routeKind != "" {
+			routeGroup := gwv1beta1.Group(gwv1beta1.GroupName)
+			listenerStatus.SupportedKinds = append(listenerStatus.SupportedKinds, gwv1beta1.RouteGroupKind{
+				Group: &routeGroup,
+				Kind:  routeKind,
+			})
+		}
+
+		conflicted := listenerTranslation.Reason == gwv1beta1.ListenerReasonProtocolConflict ||
+			listenerTranslation.Reason == gwv1beta1.ListenerReasonHostnameConflict
+		acceptedCondition := metav1.Condition{
+			Type:               string(gwv1beta1.ListenerConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gwv1beta1.ListenerReasonAccepted),
+			Message:            listenerTranslation.Message,
+			ObservedGeneration: gw.Generation,
+		}
+		conflictedCondition := metav1.Condition{
+			Type:               string(gwv1beta1.ListenerConditionConflicted),
+			Status:             metav1.ConditionFalse,
+			Reason:             string(gwv1beta1.ListenerReasonNoConflicts),
+			Message:            "no conflicts",
+			ObservedGeneration: gw.Generation,
+		}
+		if !listenerTranslation.Accepted {
+			acceptedCondition.Status = metav1.ConditionFalse
+			acceptedCondition.Reason = string(listenerTranslation.Reason)
+			if conflicted {
+				acceptedCondition.Reason = string(gwv1beta1.ListenerReasonInvalid)
+				conflictedCondition.Status = metav1.ConditionTrue
+				conflictedCondition.Reason = string(listenerTranslation.Reason)
+				conflictedCondition.Message = listenerTranslation.Message
+			}
+		}
+		meta.SetStatusCondition(&listenerStatus.Conditions, acceptedCondition)
+		meta.SetStatusCondition(&listenerStatus.Conditions, conflictedCondition)
+		listenerStatuses = append(listenerStatuses, listenerStatus)
+	}
+	return listenerStatuses
+}
+
+// BuildHTTPRouteStatus computes the status for HTTPRoute based on translation result.
+// only parent statuses managed by controllerName for the translated Gateway will be updated.
+func BuildHTTPRouteStatus(gw *gwv1beta1.Gateway, routeTranslation HTTPRouteTranslation, controllerName gwv1beta1.GatewayController) gwv1beta1.HTTPRouteStatus {
+	route := routeTranslation.Route
+	return gwv1beta1.HTTPRouteStatus{
+		RouteStatus: buildRouteStatus(gw, route, route.Status.RouteStatus, routeTranslation.Attachments, routeTranslation.ResolvedRefs,
+			routeTranslation.ResolvedRefsReason, routeTranslation.ResolvedRefsMessage, controllerName),
+	}
+}
+
+// BuildL4RouteStatus computes the status for TCPRoute, UDPRoute or TLSRoute based on translation result.
+// only parent statuses managed by controllerName for the translated Gateway will be updated.
+func BuildL4RouteStatus(gw *gwv1beta1.Gateway, routeTranslation L4RouteTranslation, controllerName gwv1beta1.GatewayController) gwv1beta1.RouteStatus {
+	route := routeTranslation.Route
+	return buildRouteStatus(gw, route.Object, *route.Status, routeTranslation.Attachments, routeTranslation.ResolvedRefs,
+		routeTranslation.ResolvedRefsReason, routeTranslation.ResolvedRefsMessage, controllerName)
+}
+
+// buildRouteStatus computes the status for route based on its attachments and backendRefs resolution.
+func buildRouteStatus(gw *gwv1beta1.Gateway, route metav1.Object, routeStatus gwv1beta1.RouteStatus, attachments []RouteAttachment,
+	resolvedRefs bool, resolvedRefsReason gwv1beta1.RouteConditionReason, resolvedRefsMessage string,
+	controllerName gwv1beta1.GatewayController) gwv1beta1.RouteStatus {
+	status := *routeStatus.DeepCopy()
+	// parent statuses for parentRefs that no longer exists on route should be removed.
+	parents := make([]gwv1beta1.RouteParentStatus, 0, len(status.Parents))
+	for _, parentStatus := range status.Parents {
+		if parentStatus.ControllerName == controllerName &&
+			IsParentRefToGateway(parentStatus.ParentRef, route.GetNamespace(), gw) &&
+			!containsParentRef(attachments, parentStatus.ParentRef) {
+			continue
+		}
+		parents = append(parents, parentStatus)
+	}
+	status.Parents = parents
+	for _, attachment := range attachments {
+		parentStatus := findOrAppendRouteParentStatus(&status, attachment.ParentRef, controllerName)
+		acceptedCondition := metav1.Condition{
+			Type:               string(gwv1beta1.RouteConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(attachment.Reason),
+			Message:            attachment.Message,
+			ObservedGeneration: route.GetGeneration(),
+		}
+		if !attachment.Accepted {
+			acceptedCondition.Status = metav1.ConditionFalse
+		}
+		resolvedRefsCondition := metav1.Condition{
+			Type:               string(gwv1beta1.RouteConditionResolvedRefs),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(resolvedRefsReason),
+			Message:            resolvedRefsMessage,
+			ObservedGeneration: route.GetGeneration(),
+		}
+		if !resolvedRefs {
+			resolvedRefsCondition.Status = metav1.ConditionFalse
+		}
+		meta.SetStatusCondition(&parentStatus.Conditions, acceptedCondition)
+		meta.SetStatusCondition(&parentStatus.Conditions, resolvedRefsCondition)
+	}
+	return status
+}
+
+// containsParentRef checks whether any attachment is for parentRef.
+func containsParentRef(attachments []RouteAttachment, parentRef gwv1beta1.ParentReference) bool {
+	for _, attachment := range attachments {
+		if reflect.DeepEqual(attachment.ParentRef, parentRef) {
+			return true
+		}
+	}
+	return false
+}
+
+// findOrAppendRouteParentStatus finds the parent status for parentRef managed by controllerName, or append a new one if not found.
+func findOrAppendRouteParentStatus(routeStatus *gwv1beta1.RouteStatus, parentRef gwv1beta1.ParentReference, controllerName gwv1beta1.GatewayController) *gwv1beta1.RouteParentStatus {
+	for i := range routeStatus.Parents {
+		if routeStatus.Parents[i].ControllerName == controllerName &&
+			reflect.DeepEqual(routeStatus.Parents[i].ParentRef, parentRef) {
+			return &routeStatus.Parents[i]
+		}
+	}
+	routeStatus.Parents = append(routeStatus.Parents, gwv1beta1.RouteParentStatus{
+		ParentRef:      parentRef,
+		ControllerName: controllerName,
+	})
+	return &routeStatus.Parents[len(routeStatus.Parents)-1]
+}
@@ -1,6 +1,9 @@
 package ingress
 
 import (
+	"net"
+	"regexp"
+
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -230,12 +233,22 @@ func (c *HTTPHeaderConditionConfig) validate() error {
 	return nil
 }
 
+func (c *HTTPHeaderConditionConfig) validateFormat() error {
+	if len(c.HTTPHeaderName) == 0 {
+		return errors.New("httpHeaderName cannot be empty")
+	}
+	return nil
+}
+
 // Information for an HTTP method condition.
 type HTTPRequestMethodConditionConfig struct {
 	// The name of the request method.
 	Values []string `json:"values"`
 }
 
+// httpRequestMethodPattern is the allowed characters for request methods.
+var httpRequestMethodPattern = regexp.MustCompile(`^[A-Z_-]{1,40}$`)
+
 func (c *HTTPRequestMethodConditionConfig) validate() error {
 	if len(c.Values) == 0 {
 		return errors.New("values cannot be empty")
@@ -243,6 +256,15 @@ func (c *HTTPRequestMethodConditionConfig) validate() error {
 	return nil
 }
 
+func (c *HTTPRequestMethodConditionConfig) validateFormat() error {
+	for _, method := range c.Values {
+		if !httpRequestMethodPattern.MatchString(method) {
+			return errors.Errorf("invalid request method: %v", method)
+		}
+	}
+	return nil
+}
+
 // Information about a path pattern condition.
 type PathPatternConditionConfig struct {
 	// One or more path patterns to compare against the request URL.
@@ -291,6 +313,15 @@ func (c *QueryStringConditionConfig) validate() error {
 	return nil
 }
 
+func (c *QueryStringConditionConfig) validateFormat() error {
+	for _, pair := range c.Values {
+		if pair.Key != nil && len(*pair.Key) == 0 {
+			return errors.New("key cannot be empty when specified")
+		}
+	}
+	return nil
+}
+
 // Information about a source IP condition.
 type SourceIPConditionConfig struct {
 	// One or more source IP addresses, in CIDR format.
@@ -304,7 +335,18 @@ func (c *SourceIPConditionConfig) validate() error {
 	return nil
 }
 
+func (c *SourceIPConditionConfig) validateFormat() error {
+	for _, cidr := range c.Values {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errors.Errorf("invalid CIDR: %v", cidr)
+		}
+	}
+	return nil
+}
+
 // Information about a condition for a rule.
+// http-header, query-string, http-request-method and source-ip conditions are evaluated individually, i.e. all of them must match.
+// values of host-header and path-pattern conditions are merged into a single condition, i.e. any of the values must match.
 type RuleCondition struct {
 	// The field in the HTTP request.
 	Field RuleConditionField `json:"field"`
@@ -370,6 +412,56 @@ func (c *RuleCondition) Validate() error {
 	return nil
 }
 
+// validateFormat validates the condition against the formats accepted by ALB.
+// it must be invoked after Validate, which ensures the config of condition is present.
+func (c *RuleCondition) validateFormat() error {
+	switch c.Field {
+	case RuleConditionFieldHostHeader, RuleConditionFieldPathPattern:
+	case RuleConditionFieldHTTPHeader:
+		if err := c.HTTPHeaderConfig.validateFormat(); err != nil {
+			return errors.Wrap(err, "invalid httpHeaderConfig")
+		}
+	case RuleConditionFieldHTTPRequestMethod:
+		if err := c.HTTPRequestMethodConfig.validateFormat(); err != nil {
+			return errors.Wrap(err, "invalid httpRequestMethodConfig")
+		}
+	case RuleConditionFieldQueryString:
+		if err := c.QueryStringConfig.validateFormat(); err != nil {
+			return errors.Wrap(err, "invalid queryStringConfig")
+		}
+	case RuleConditionFieldSourceIP:
+		if err := c.SourceIPConfig.validateFormat(); err != nil {
+			return errors.Wrap(err, "invalid sourceIPConfig")
+		}
+	default:
+		return errors.Errorf("unknown field: %v", c.Field)
+	}
+	return nil
+}
+
+// ValidateRuleConditions validates a list of conditions for a rule, which is stricter than RuleCondition.Validate.
+// At most one http-request-method and one source-ip condition is allowed, since merging them would turn AND into OR.
+// It's only enforced by the Ingress admission webhook, so that Ingresses admitted before these checks are still reconciled.
+func ValidateRuleConditions(conditions []RuleCondition) error {
+	seenFields := make(map[RuleConditionField]bool)
+	for _, condition := range conditions {
+		if err := condition.Validate(); err != nil {
+			return err
+		}
+		if err := condition.validateFormat(); err != nil {
+			return err
+		}
+		switch condition.Field {
+		case RuleConditionFieldHTTPRequestMethod, RuleConditionFieldSourceIP:
+			if seenFields[condition.Field] {
+				return errors.Errorf("duplicate %v condition, specify all values in a single condition instead", condition.Field)
+			}
+			seenFields[condition.Field] = true
+		}
+	}
+	return nil
+}
+
 type AuthType string
 
 const (
@@ -414,3 +506,89 @@ type AuthIDPConfigOIDC struct {
 	// +optional
 	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
 }
+
+type MutualAuthenticationMode string
+
+const (
+	MutualAuthenticationModeOff         MutualAuthenticationMode = "off"
+	MutualAuthenticationModePassthrough MutualAuthenticationMode = "passthrough"
+	MutualAuthenticationModeVerify      MutualAuthenticationMode = "verify"
+)
+
+// Information about the CA certificates bundle in Amazon S3 that a trust store is created from.
+// When SecretName is specified, the bundle within the Kubernetes Secret is uploaded to the Amazon S3 bucket and path first.
+type CACertificatesBundleConfig struct {
+	// The Amazon S3 bucket for the ca certificates bundle.
+	S3Bucket string `json:"s3Bucket"`
+
+	// The Amazon S3 path for the ca certificates bundle.
+	S3Key string `json:"s3Key"`
+
+	// The Amazon S3 object version for the ca certificates bundle.
+	// +optional
+	S3ObjectVersion *string `json:"s3ObjectVersion,omitempty"`
+
+	// The name of Kubernetes Secret in the Ingress namespace, whose ca.crt contains the ca certificates bundle.
+	// +optional
+	SecretName *string `json:"secretName,omitempty"`
+}
+
+func (c *CACertificatesBundleConfig) validate() error {
+	if len(c.S3Bucket) == 0 {
+		return errors.New("s3Bucket is required")
+	}
+	if len(c.S3Key) == 0 {
+		return errors.New("s3Key is required")
+	}
+	if c.SecretName != nil {
+		if len(*c.SecretName) == 0 {
+			return errors.New("secretName must be non-empty")
+		}
+		if c.S3ObjectVersion != nil {
+			return errors.New("s3ObjectVersion cannot be specified along with secretName")
+		}
+	}
+	return nil
+}
+
+// Information about the mutual authentication settings of a HTTPS listener.
+type MutualAuthenticationConfig struct {
+	// The listener port.
+	Port int64 `json:"port"`
+
+	// The client certificate handling method.
+	Mode MutualAuthenticationMode `json:"mode"`
+
+	// [verify mode] The Amazon Resource Name (ARN) of an existing trust store.
+	// +optional
+	TrustStoreARN *string `json:"trustStoreARN,omitempty"`
+
+	// [verify mode] The CA certificates bundle to create a trust store from.
+	// +optional
+	CACertificatesBundle *CACertificatesBundleConfig `json:"caCertificatesBundle,omitempty"`
+
+	// [verify mode] Indicates whether expired client certificates are ignored.
+	// +optional
+	IgnoreClientCertificateExpiry *bool `json:"ignoreClientCertificateExpiry,omitempty"`
+}
+
+func (c *MutualAuthenticationConfig) validate() error {
+	switch c.Mode {
+	case MutualAuthenticationModeVerify:
+		if (c.TrustStoreARN != nil) == (c.CACertificatesBundle != nil) {
+			return errors.New("precisely one of trustStoreARN and caCertificatesBundle can be specified in verify mode")
+		}
+		if c.CACertificatesBundle != nil {
+			if err := c.CACertificatesBundle.validate(); err != nil {
+				return errors.Wrap(err, "invalid caCertificatesBundle")
+			}
+		}
+	case MutualAuthenticationModeOff, MutualAuthenticationModePassthrough:
+		if c.TrustStoreARN != nil || c.CACertificatesBundle != nil || c.IgnoreClientCertificateExpiry != nil {
+			return errors.Errorf("trustStoreARN, caCertificatesBundle and ignoreClientCertificateExpiry are only supported in %v mode", MutualAuthenticationModeVerify)
+		}
+	default:
+		return errors.Errorf("unknown mode: %v", c.Mode)
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -80,11 +81,13 @@ type EnhancedBackendBuilder interface {
 }
 
 // NewDefaultEnhancedBackendBuilder constructs new defaultEnhancedBackendBuilder.
-func NewDefaultEnhancedBackendBuilder(k8sClient client.Client, annotationParser annotations.Parser, authConfigBuilder AuthConfigBuilder) *defaultEnhancedBackendBuilder {
+func NewDefaultEnhancedBackendBuilder(k8sClient client.Client, annotationParser annotations.Parser, authConfigBuilder AuthConfigBuilder,
+	enableTrafficSplit bool) *defaultEnhancedBackendBuilder {
 	return &defaultEnhancedBackendBuilder{
-		k8sClient:         k8sClient,
-		annotationParser:  annotationParser,
-		authConfigBuilder: authConfigBuilder,
+		k8sClient:          k8sClient,
+		annotationParser:   annotationParser,
+		authConfigBuilder:  authConfigBuilder,
+		enableTrafficSplit: enableTrafficSplit,
 
 		tolerateNonExistentBackendService: defaultTolerateNonExistentBackendAction,
 		tolerateNonExistentBackendAction:  defaultTolerateNonExistentBackendService,
@@ -98,6 +101,8 @@ type defaultEnhancedBackendBuilder struct {
 	k8sClient         client.Client
 	annotationParser  annotations.Parser
 	authConfigBuilder AuthConfigBuilder
+	// whether to split traffic of backend services according to TrafficSplit.
+	enableTrafficSplit bool
 
 	// whether to tolerate misconfiguration that used a non-existent backend service.
 	// when tolerate, If a single backend service is used and it's non-existent, a fixed 503 response will be used instead.
@@ -148,6 +153,12 @@ func (b *defaultEnhancedBackendBuilder) Build(ctx context.Context, ing *networki
 				return EnhancedBackend{}, err
 			}
 		}
+
+		if b.enableTrafficSplit {
+			if err := b.applyTrafficSplit(ctx, &action, ing, buildOpts.BackendServices); err != nil {
+				return EnhancedBackend{}, err
+			}
+		}
 	}
 
 	return EnhancedBackend{
@@ -273,6 +284,71 @@ func (b *defaultEnhancedBackendBuilder) loadBackendServices(ctx context.Context,
 	return nil
 }
 
+// applyTrafficSplit will split the traffic between stable and canary Service when forward to a single Service that's split by TrafficSplit.
+// the weight of canary Service is the weight observed in TrafficSplit's status, and the action is kept if either Service is non-existent.
+func (b *defaultEnhancedBackendBuilder) applyTrafficSplit(ctx context.Context, action *Action, ing *networking.Ingress,
+	backendServices map[types.NamespacedName]*corev1.Service) error {
+	if action.Type != ActionTypeForward ||
+		action.ForwardConfig == nil ||
+		len(action.ForwardConfig.TargetGroups) != 1 ||
+		action.ForwardConfig.TargetGroups[0].ServiceName == nil {
+		return nil
+	}
+	backendTGT := action.ForwardConfig.TargetGroups[0]
+	svcName := awssdk.StringValue(backendTGT.ServiceName)
+	tsList := &elbv2api.TrafficSplitList{}
+	if err := b.k8sClient.List(ctx, tsList, client.InNamespace(ing.Namespace)); err != nil {
+		return err
+	}
+	var ts *elbv2api.TrafficSplit
+	for i := range tsList.Items {
+		backend := tsList.Items[i].Spec.Backend
+		if backend.IngressName == ing.Name && backend.ServiceName == svcName {
+			ts = &tsList.Items[i]
+			break
+		}
+	}
+	if ts == nil || !ts.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	for _, splitSvcName := range []string{ts.Spec.StableServiceName, ts.Spec.CanaryServiceName} {
+		svcKey := types.NamespacedName{Namespace: ing.Namespace, Name: splitSvcName}
+		if _, ok := backendServices[svcKey]; ok {
+			continue
+		}
+		svc := &corev1.Service{}
+		if err := b.k8sClient.Get(ctx, svcKey, svc); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		backendServices[svcKey] = svc
+	}
+
+	canaryWeight := int64(ts.Status.Weight)
+	*action = Action{
+		Type: ActionTypeForward,
+		ForwardConfig: &ForwardActionConfig{
+			TargetGroups: []TargetGroupTuple{
+				{
+					ServiceName: awssdk.String(ts.Spec.StableServiceName),
+					ServicePort: backendTGT.ServicePort,
+					Weight:      awssdk.Int64(100 - canaryWeight),
+				},
+				{
+					ServiceName: awssdk.String(ts.Spec.CanaryServiceName),
+					ServicePort: backendTGT.ServicePort,
+					Weight:      awssdk.Int64(canaryWeight),
+				},
+			},
+			TargetGroupStickinessConfig: action.ForwardConfig.TargetGroupStickinessConfig,
+		},
+	}
+	return nil
+}
+
 func (b *defaultEnhancedBackendBuilder) buildAuthConfig(ctx context.Context, action Action, namespace string, ingAnnotation map[string]string, backendServices map[types.NamespacedName]*corev1.Service) (AuthConfig, error) {
 	svcAndIngAnnotations := ingAnnotation
 	// when forward to a single Service, the auth annotations on that Service will be merged in.
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -810,6 +811,35 @@ func Test_defaultEnhancedBackendBuilder_buildConditions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "conditions admitted before stricter validation are still built",
+			args: args{
+				ingAnnotation: map[string]string{
+					"alb.ingress.kubernetes.io/conditions.rule-path4": `[{"field":"http-request-method","httpRequestMethodConfig":{"values":["get"]}},{"field":"http-request-method","httpRequestMethodConfig":{"values":["HEAD"]}},{"field":"source-ip","sourceIPConfig":{"values":["192.168.0.0"]}}]`,
+				},
+				svcName: "rule-path4",
+			},
+			want: []RuleCondition{
+				{
+					Field: RuleConditionFieldHTTPRequestMethod,
+					HTTPRequestMethodConfig: &HTTPRequestMethodConditionConfig{
+						Values: []string{"get"},
+					},
+				},
+				{
+					Field: RuleConditionFieldHTTPRequestMethod,
+					HTTPRequestMethodConfig: &HTTPRequestMethodConditionConfig{
+						Values: []string{"HEAD"},
+					},
+				},
+				{
+					Field: RuleConditionFieldSourceIP,
+					SourceIPConfig: &SourceIPConditionConfig{
+						Values: []string{"192.168.0.0"},
+					},
+				},
+			},
+		},
 		{
 			name: "query string condition",
 			args: args{
@@ -1621,6 +1651,181 @@ func Test_defaultEnhancedBackendBuilder_loadBackendServices(t *testing.T) {
 	}
 }
 
+func Test_defaultEnhancedBackendBuilder_applyTrafficSplit(t *testing.T) {
+	port80 := intstr.FromInt(80)
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-ing",
+		},
+	}
+	svcStable := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-1-stable",
+		},
+	}
+	svcCanary := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-1-canary",
+		},
+	}
+	newTrafficSplit := func(ingName string, svcName string, weight int32) *elbv2api.TrafficSplit {
+		return &elbv2api.TrafficSplit{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "awesome-split",
+			},
+			Spec: elbv2api.TrafficSplitSpec{
+				Backend: elbv2api.TrafficSplitIngressBackend{
+					IngressName: ingName,
+					ServiceName: svcName,
+				},
+				StableServiceName: "svc-1-stable",
+				CanaryServiceName: "svc-1-canary",
+				Steps:             []elbv2api.TrafficSplitStep{{Weight: 20}},
+			},
+			Status: elbv2api.TrafficSplitStatus{
+				Weight: weight,
+			},
+		}
+	}
+	forwardToSvc1 := Action{
+		Type: ActionTypeForward,
+		ForwardConfig: &ForwardActionConfig{
+			TargetGroups: []TargetGroupTuple{
+				{
+					ServiceName: awssdk.String("svc-1"),
+					ServicePort: &port80,
+				},
+			},
+		},
+	}
+
+	type env struct {
+		svcs          []*corev1.Service
+		trafficSplits []*elbv2api.TrafficSplit
+	}
+	tests := []struct {
+		name                string
+		env                 env
+		action              Action
+		wantAction          Action
+		wantBackendServices map[types.NamespacedName]*corev1.Service
+	}{
+		{
+			name: "forward to split service",
+			env: env{
+				svcs:          []*corev1.Service{svcStable, svcCanary},
+				trafficSplits: []*elbv2api.TrafficSplit{newTrafficSplit("awesome-ing", "svc-1", 20)},
+			},
+			action: forwardToSvc1,
+			wantAction: Action{
+				Type: ActionTypeForward,
+				ForwardConfig: &ForwardActionConfig{
+					TargetGroups: []TargetGroupTuple{
+						{
+							ServiceName: awssdk.String("svc-1-stable"),
+							ServicePort: &port80,
+							Weight:      awssdk.Int64(80),
+						},
+						{
+							ServiceName: awssdk.String("svc-1-canary"),
+							ServicePort: &port80,
+							Weight:      awssdk.Int64(20),
+						},
+					},
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{
+				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1-stable"}: svcStable,
+				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1-canary"}: svcCanary,
+			},
+		},
+		{
+			name: "forward to service split for another ingress",
+			env: env{
+				svcs:          []*corev1.Service{svcStable, svcCanary},
+				trafficSplits: []*elbv2api.TrafficSplit{newTrafficSplit("other-ing", "svc-1", 20)},
+			},
+			action:              forwardToSvc1,
+			wantAction:          forwardToSvc1,
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{},
+		},
+		{
+			name: "forward to service that isn't split",
+			env: env{
+				svcs:          []*corev1.Service{svcStable, svcCanary},
+				trafficSplits: []*elbv2api.TrafficSplit{newTrafficSplit("awesome-ing", "svc-2", 20)},
+			},
+			action:              forwardToSvc1,
+			wantAction:          forwardToSvc1,
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{},
+		},
+		{
+			name: "canary service is non-existent",
+			env: env{
+				svcs:          []*corev1.Service{svcStable},
+				trafficSplits: []*elbv2api.TrafficSplit{newTrafficSplit("awesome-ing", "svc-1", 20)},
+			},
+			action:     forwardToSvc1,
+			wantAction: forwardToSvc1,
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{
+				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1-stable"}: svcStable,
+			},
+		},
+		{
+			name: "fixed response action is noop",
+			env: env{
+				svcs:          []*corev1.Service{svcStable, svcCanary},
+				trafficSplits: []*elbv2api.TrafficSplit{newTrafficSplit("awesome-ing", "svc-1", 20)},
+			},
+			action: Action{
+				Type: ActionTypeFixedResponse,
+				FixedResponseConfig: &FixedResponseActionConfig{
+					StatusCode: "503",
+				},
+			},
+			wantAction: Action{
+				Type: ActionTypeFixedResponse,
+				FixedResponseConfig: &FixedResponseActionConfig{
+					StatusCode: "503",
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, svc := range tt.env.svcs {
+				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
+			}
+			for _, ts := range tt.env.trafficSplits {
+				assert.NoError(t, k8sClient.Create(ctx, ts.DeepCopy()))
+			}
+
+			b := &defaultEnhancedBackendBuilder{
+				k8sClient:          k8sClient,
+				enableTrafficSplit: true,
+			}
+			action := tt.action
+			backendServices := map[types.NamespacedName]*corev1.Service{}
+			err := b.applyTrafficSplit(ctx, &action, ing, backendServices)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAction, action)
+			opt := equality.IgnoreFakeClientPopulatedFields()
+			assert.True(t, cmp.Equal(tt.wantBackendServices, backendServices, opt),
+				"diff: %v", cmp.Diff(tt.wantBackendServices, backendServices, opt))
+		})
+	}
+}
+
 func Test_defaultEnhancedBackendBuilder_buildAuthConfig(t *testing.T) {
 	port80 := intstr.FromInt(80)
 	type args struct {
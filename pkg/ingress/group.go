@@ -40,6 +40,18 @@ func NewGroupIDForImplicitGroup(ingKey types.NamespacedName) GroupID {
 	return GroupID(ingKey)
 }
 
+// NewGroupIDForShard generates GroupID for a shard of an explicit group.
+// shard 0 shares the GroupID of the group itself, so that existing load balancers are kept when sharding is enabled.
+func NewGroupIDForShard(groupID GroupID, shard int) GroupID {
+	if shard == 0 {
+		return groupID
+	}
+	return GroupID{
+		Namespace: groupID.Namespace,
+		Name:      fmt.Sprintf("%s:%d", groupID.Name, shard),
+	}
+}
+
 // EncodeGroupIDToReconcileRequest encodes a GroupID into a controller-runtime reconcile request
 func EncodeGroupIDToReconcileRequest(gID GroupID) ctrl.Request {
 	return ctrl.Request{NamespacedName: types.NamespacedName(gID)}
@@ -64,4 +76,8 @@ type Group struct {
 
 	// InactiveMembers are Ingresses that no longer belong to this group, but still hold the finalizers.
 	InactiveMembers []*networking.Ingress
+
+	// Shard is the shard of IngressGroup that this group is built for.
+	// it's always 0 unless the IngressGroup is sharded across multiple load balancers.
+	Shard int
 }
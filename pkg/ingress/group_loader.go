@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
@@ -45,7 +46,7 @@ type GroupLoader interface {
 }
 
 // NewDefaultGroupLoader constructs new GroupLoader instance.
-func NewDefaultGroupLoader(client client.Client, eventRecorder record.EventRecorder, annotationParser annotations.Parser, classLoader ClassLoader, classAnnotationMatcher ClassAnnotationMatcher, manageIngressesWithoutIngressClass bool) *defaultGroupLoader {
+func NewDefaultGroupLoader(client client.Client, eventRecorder record.EventRecorder, annotationParser annotations.Parser, classLoader ClassLoader, classAnnotationMatcher ClassAnnotationMatcher, manageIngressesWithoutIngressClass bool, enableGroupPolicy bool) *defaultGroupLoader {
 	return &defaultGroupLoader{
 		client:           client,
 		eventRecorder:    eventRecorder,
@@ -54,6 +55,7 @@ func NewDefaultGroupLoader(client client.Client, eventRecorder record.EventRecor
 		classLoader:                        classLoader,
 		classAnnotationMatcher:             classAnnotationMatcher,
 		manageIngressesWithoutIngressClass: manageIngressesWithoutIngressClass,
+		enableGroupPolicy:                  enableGroupPolicy,
 	}
 }
 
@@ -74,6 +76,9 @@ type defaultGroupLoader struct {
 	// manageIngressesWithoutIngressClass specifies whether ingresses without "kubernetes.io/ingress.class" annotation
 	// and "spec.ingressClassName" should be managed or not.
 	manageIngressesWithoutIngressClass bool
+
+	// enableGroupPolicy specifies whether IngressGroupPolicy should be enforced on members of explicit IngressGroups.
+	enableGroupPolicy bool
 }
 
 func (m *defaultGroupLoader) Load(ctx context.Context, groupID GroupID) (Group, error) {
@@ -101,6 +106,28 @@ func (m *defaultGroupLoader) Load(ctx context.Context, groupID GroupID) (Group,
 	if err != nil {
 		return Group{}, err
 	}
+	if m.enableGroupPolicy {
+		policy, err := m.loadGroupPolicy(ctx, groupID)
+		if err != nil {
+			return Group{}, errors.Wrapf(err, "failed to load IngressGroupPolicy for IngressGroup: %v", groupID)
+		}
+		if policy != nil {
+			allowedMembers, deniedMembers, err := m.enforceGroupPolicy(ctx, policy, sortedMembers)
+			if err != nil {
+				return Group{}, errors.Wrapf(err, "failed to enforce IngressGroupPolicy: %v", policy.Name)
+			}
+			sortedMembers = allowedMembers
+			groupFinalizer := buildGroupFinalizer(groupID)
+			for _, deniedMember := range deniedMembers {
+				m.eventRecorder.Event(deniedMember.ing, corev1.EventTypeWarning, k8s.IngressEventReasonDeniedByGroupPolicy,
+					fmt.Sprintf("Ingress is denied by IngressGroupPolicy %v: %v", policy.Name, deniedMember.reason))
+				// denied Ingresses that were members of IngressGroup leave the IngressGroup.
+				if m.containsGroupFinalizer(groupID, groupFinalizer, deniedMember.ing) {
+					inactiveMembers = append(inactiveMembers, deniedMember.ing)
+				}
+			}
+		}
+	}
 
 	return Group{
 		ID:              groupID,
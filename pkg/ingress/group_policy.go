@@ -0,0 +1,167 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+// deniedGroupMember is an Ingress that is denied from joining IngressGroup by IngressGroupPolicy.
+type deniedGroupMember struct {
+	ing    *networking.Ingress
+	reason string
+}
+
+// loadGroupPolicy loads the IngressGroupPolicy for explicit IngressGroup, it returns nil if there is no IngressGroupPolicy.
+func (m *defaultGroupLoader) loadGroupPolicy(ctx context.Context, groupID GroupID) (*elbv2api.IngressGroupPolicy, error) {
+	if !groupID.IsExplicit() {
+		return nil, nil
+	}
+	policy := &elbv2api.IngressGroupPolicy{}
+	if err := m.client.Get(ctx, types.NamespacedName{Name: groupID.Name}, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+// enforceGroupPolicy enforces the IngressGroupPolicy on sorted members of IngressGroup.
+// namespace quotas are consumed in the order of members, so members with lower group order take precedence.
+func (m *defaultGroupLoader) enforceGroupPolicy(ctx context.Context, policy *elbv2api.IngressGroupPolicy, members []ClassifiedIngress) ([]ClassifiedIngress, []deniedGroupMember, error) {
+	var nsSelector labels.Selector
+	if policy.Spec.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector)
+		if err != nil {
+			return nil, nil, err
+		}
+		nsSelector = selector
+	}
+	reservedHostnames := make(map[string][]string, len(policy.Spec.ReservedHostnames))
+	for _, reservedHostname := range policy.Spec.ReservedHostnames {
+		hostname := strings.ToLower(reservedHostname.Hostname)
+		reservedHostnames[hostname] = append(reservedHostnames[hostname], reservedHostname.Namespaces...)
+	}
+
+	nsAllowedCache := make(map[string]bool)
+	rulesByNamespace := make(map[string]int32)
+	hostnamesByNamespace := make(map[string]map[string]bool)
+	var allowedMembers []ClassifiedIngress
+	var deniedMembers []deniedGroupMember
+	for _, member := range members {
+		ing := member.Ing
+		if nsSelector != nil {
+			nsAllowed, cached := nsAllowedCache[ing.Namespace]
+			if !cached {
+				ns := &corev1.Namespace{}
+				if err := m.client.Get(ctx, types.NamespacedName{Name: ing.Namespace}, ns); err != nil {
+					return nil, nil, err
+				}
+				nsAllowed = nsSelector.Matches(labels.Set(ns.Labels))
+				nsAllowedCache[ing.Namespace] = nsAllowed
+			}
+			if !nsAllowed {
+				deniedMembers = append(deniedMembers, deniedGroupMember{
+					ing:    ing,
+					reason: fmt.Sprintf("namespace %v is not allowed to join IngressGroup %v", ing.Namespace, policy.Name),
+				})
+				continue
+			}
+		}
+
+		ingRules, ingHostnames := computeIngressRulesAndHostnames(ing)
+		if reason := checkReservedHostnames(ing.Namespace, ingHostnames, reservedHostnames); reason != "" {
+			deniedMembers = append(deniedMembers, deniedGroupMember{ing: ing, reason: reason})
+			continue
+		}
+
+		nsHostnames := hostnamesByNamespace[ing.Namespace]
+		if nsHostnames == nil {
+			nsHostnames = make(map[string]bool)
+			hostnamesByNamespace[ing.Namespace] = nsHostnames
+		}
+		newHostnames := 0
+		for _, hostname := range ingHostnames {
+			if !nsHostnames[hostname] {
+				newHostnames++
+			}
+		}
+		if quota := policy.Spec.NamespaceQuota; quota != nil {
+			if quota.MaxRules != nil && rulesByNamespace[ing.Namespace]+ingRules > *quota.MaxRules {
+				deniedMembers = append(deniedMembers, deniedGroupMember{
+					ing: ing,
+					reason: fmt.Sprintf("namespace %v exceeds the quota of rules in IngressGroup %v: %v > %v",
+						ing.Namespace, policy.Name, rulesByNamespace[ing.Namespace]+ingRules, *quota.MaxRules),
+				})
+				continue
+			}
+			if quota.MaxHostnames != nil && int32(len(nsHostnames)+newHostnames) > *quota.MaxHostnames {
+				deniedMembers = append(deniedMembers, deniedGroupMember{
+					ing: ing,
+					reason: fmt.Sprintf("namespace %v exceeds the quota of hostnames in IngressGroup %v: %v > %v",
+						ing.Namespace, policy.Name, len(nsHostnames)+newHostnames, *quota.MaxHostnames),
+				})
+				continue
+			}
+		}
+
+		rulesByNamespace[ing.Namespace] += ingRules
+		for _, hostname := range ingHostnames {
+			nsHostnames[hostname] = true
+		}
+		allowedMembers = append(allowedMembers, member)
+	}
+	return allowedMembers, deniedMembers, nil
+}
+
+// computeIngressRulesAndHostnames computes the number of rules and the distinct hostnames of Ingress.
+func computeIngressRulesAndHostnames(ing *networking.Ingress) (int32, []string) {
+	var rules int32
+	hostnameSet := make(map[string]bool)
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP != nil {
+			rules += int32(len(rule.HTTP.Paths))
+		}
+		if rule.Host != "" {
+			hostnameSet[strings.ToLower(rule.Host)] = true
+		}
+	}
+	hostnames := make([]string, 0, len(hostnameSet))
+	for hostname := range hostnameSet {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+	return rules, hostnames
+}
+
+// checkReservedHostnames checks whether hostnames are reserved to other namespaces.
+// it returns the reason if any hostname is reserved to other namespaces, or an empty string otherwise.
+func checkReservedHostnames(namespace string, hostnames []string, reservedHostnames map[string][]string) string {
+	for _, hostname := range hostnames {
+		allowedNamespaces, reserved := reservedHostnames[hostname]
+		if !reserved {
+			continue
+		}
+		allowed := false
+		for _, allowedNamespace := range allowedNamespaces {
+			if allowedNamespace == namespace {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Sprintf("hostname %v is reserved to namespaces %v", hostname, allowedNamespaces)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,301 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultGroupLoader_loadGroupPolicy(t *testing.T) {
+	policy := &elbv2api.IngressGroupPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-group",
+		},
+		Spec: elbv2api.IngressGroupPolicySpec{
+			NamespaceQuota: &elbv2api.IngressGroupNamespaceQuota{
+				MaxRules: awssdk.Int32(10),
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		groupID  GroupID
+		wantName string
+	}{
+		{
+			name:     "explicit group with policy",
+			groupID:  NewGroupIDForExplicitGroup("awesome-group"),
+			wantName: "awesome-group",
+		},
+		{
+			name:    "explicit group without policy",
+			groupID: NewGroupIDForExplicitGroup("another-group"),
+		},
+		{
+			name:    "implicit group",
+			groupID: NewGroupIDForImplicitGroup(types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-group"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(context.Background(), policy.DeepCopy()))
+
+			m := &defaultGroupLoader{
+				client: k8sClient,
+			}
+			got, err := m.loadGroupPolicy(context.Background(), tt.groupID)
+			assert.NoError(t, err)
+			if tt.wantName == "" {
+				assert.Nil(t, got)
+			} else {
+				assert.Equal(t, tt.wantName, got.Name)
+			}
+		})
+	}
+}
+
+func Test_defaultGroupLoader_enforceGroupPolicy(t *testing.T) {
+	nsList := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "team-a",
+				Labels: map[string]string{"team": "a"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "team-b",
+				Labels: map[string]string{"team": "b"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "sandbox",
+			},
+		},
+	}
+	buildIngress := func(namespace string, name string, hostPaths map[string]int) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+		}
+		for _, host := range []string{"a.example.com", "b.example.com", "c.example.com", "shop.example.com"} {
+			paths, ok := hostPaths[host]
+			if !ok {
+				continue
+			}
+			rule := networking.IngressRule{
+				Host: host,
+				IngressRuleValue: networking.IngressRuleValue{
+					HTTP: &networking.HTTPIngressRuleValue{},
+				},
+			}
+			for i := 0; i < paths; i++ {
+				rule.HTTP.Paths = append(rule.HTTP.Paths, networking.HTTPIngressPath{Path: "/"})
+			}
+			ing.Spec.Rules = append(ing.Spec.Rules, rule)
+		}
+		return ing
+	}
+	teamAIng1 := buildIngress("team-a", "ing-1", map[string]int{"a.example.com": 2})
+	teamAIng2 := buildIngress("team-a", "ing-2", map[string]int{"a.example.com": 1, "b.example.com": 1})
+	teamAIng3 := buildIngress("team-a", "ing-3", map[string]int{"c.example.com": 1})
+	teamBIng1 := buildIngress("team-b", "ing-1", map[string]int{"shop.example.com": 1})
+	sandboxIng1 := buildIngress("sandbox", "ing-1", map[string]int{"a.example.com": 1})
+
+	tests := []struct {
+		name        string
+		policySpec  elbv2api.IngressGroupPolicySpec
+		members     []*networking.Ingress
+		wantAllowed []*networking.Ingress
+		wantDenied  map[types.NamespacedName]string
+	}{
+		{
+			name:        "empty policy allows all members",
+			policySpec:  elbv2api.IngressGroupPolicySpec{},
+			members:     []*networking.Ingress{teamAIng1, teamAIng2, teamBIng1, sandboxIng1},
+			wantAllowed: []*networking.Ingress{teamAIng1, teamAIng2, teamBIng1, sandboxIng1},
+		},
+		{
+			name: "namespaceSelector denies members from unselected namespaces",
+			policySpec: elbv2api.IngressGroupPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{
+							Key:      "team",
+							Operator: metav1.LabelSelectorOpExists,
+						},
+					},
+				},
+			},
+			members:     []*networking.Ingress{teamAIng1, teamBIng1, sandboxIng1},
+			wantAllowed: []*networking.Ingress{teamAIng1, teamBIng1},
+			wantDenied: map[types.NamespacedName]string{
+				k8s.NamespacedName(sandboxIng1): "namespace sandbox is not allowed to join IngressGroup awesome-group",
+			},
+		},
+		{
+			name: "reservedHostnames denies members from other namespaces",
+			policySpec: elbv2api.IngressGroupPolicySpec{
+				ReservedHostnames: []elbv2api.IngressGroupReservedHostname{
+					{
+						Hostname:   "A.example.com",
+						Namespaces: []string{"team-a"},
+					},
+				},
+			},
+			members:     []*networking.Ingress{teamAIng1, teamBIng1, sandboxIng1},
+			wantAllowed: []*networking.Ingress{teamAIng1, teamBIng1},
+			wantDenied: map[types.NamespacedName]string{
+				k8s.NamespacedName(sandboxIng1): "hostname a.example.com is reserved to namespaces [team-a]",
+			},
+		},
+		{
+			name: "maxRules denies members after namespace exceeds quota",
+			policySpec: elbv2api.IngressGroupPolicySpec{
+				NamespaceQuota: &elbv2api.IngressGroupNamespaceQuota{
+					MaxRules: awssdk.Int32(3),
+				},
+			},
+			members:     []*networking.Ingress{teamAIng1, teamAIng2, teamAIng3, teamBIng1},
+			wantAllowed: []*networking.Ingress{teamAIng1, teamAIng3, teamBIng1},
+			wantDenied: map[types.NamespacedName]string{
+				k8s.NamespacedName(teamAIng2): "namespace team-a exceeds the quota of rules in IngressGroup awesome-group: 4 > 3",
+			},
+		},
+		{
+			name: "maxHostnames counts distinct hostnames within namespace",
+			policySpec: elbv2api.IngressGroupPolicySpec{
+				NamespaceQuota: &elbv2api.IngressGroupNamespaceQuota{
+					MaxHostnames: awssdk.Int32(2),
+				},
+			},
+			members:     []*networking.Ingress{teamAIng1, teamAIng2, teamAIng3, teamBIng1},
+			wantAllowed: []*networking.Ingress{teamAIng1, teamAIng2, teamBIng1},
+			wantDenied: map[types.NamespacedName]string{
+				k8s.NamespacedName(teamAIng3): "namespace team-a exceeds the quota of hostnames in IngressGroup awesome-group: 3 > 2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, ns := range nsList {
+				assert.NoError(t, k8sClient.Create(context.Background(), ns.DeepCopy()))
+			}
+
+			m := &defaultGroupLoader{
+				client: k8sClient,
+			}
+			policy := &elbv2api.IngressGroupPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "awesome-group",
+				},
+				Spec: tt.policySpec,
+			}
+			var members []ClassifiedIngress
+			for _, ing := range tt.members {
+				members = append(members, ClassifiedIngress{Ing: ing})
+			}
+			gotAllowed, gotDenied, err := m.enforceGroupPolicy(context.Background(), policy, members)
+			assert.NoError(t, err)
+			var gotAllowedIngs []*networking.Ingress
+			for _, member := range gotAllowed {
+				gotAllowedIngs = append(gotAllowedIngs, member.Ing)
+			}
+			assert.Equal(t, tt.wantAllowed, gotAllowedIngs)
+			var gotDeniedReasons map[types.NamespacedName]string
+			for _, deniedMember := range gotDenied {
+				if gotDeniedReasons == nil {
+					gotDeniedReasons = make(map[types.NamespacedName]string)
+				}
+				gotDeniedReasons[k8s.NamespacedName(deniedMember.ing)] = deniedMember.reason
+			}
+			assert.Equal(t, tt.wantDenied, gotDeniedReasons)
+		})
+	}
+}
+
+func Test_computeIngressRulesAndHostnames(t *testing.T) {
+	tests := []struct {
+		name          string
+		ing           *networking.Ingress
+		wantRules     int32
+		wantHostnames []string
+	}{
+		{
+			name: "ingress with default backend only",
+			ing: &networking.Ingress{
+				Spec: networking.IngressSpec{
+					DefaultBackend: &networking.IngressBackend{},
+				},
+			},
+			wantRules:     0,
+			wantHostnames: []string{},
+		},
+		{
+			name: "ingress with rules",
+			ing: &networking.Ingress{
+				Spec: networking.IngressSpec{
+					Rules: []networking.IngressRule{
+						{
+							Host: "B.example.com",
+							IngressRuleValue: networking.IngressRuleValue{
+								HTTP: &networking.HTTPIngressRuleValue{
+									Paths: []networking.HTTPIngressPath{{Path: "/a"}, {Path: "/b"}},
+								},
+							},
+						},
+						{
+							Host: "a.example.com",
+							IngressRuleValue: networking.IngressRuleValue{
+								HTTP: &networking.HTTPIngressRuleValue{
+									Paths: []networking.HTTPIngressPath{{Path: "/"}},
+								},
+							},
+						},
+						{
+							Host: "b.example.com",
+						},
+						{
+							IngressRuleValue: networking.IngressRuleValue{
+								HTTP: &networking.HTTPIngressRuleValue{
+									Paths: []networking.HTTPIngressPath{{Path: "/"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantRules:     4,
+			wantHostnames: []string{"a.example.com", "b.example.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRules, gotHostnames := computeIngressRulesAndHostnames(tt.ing)
+			assert.Equal(t, tt.wantRules, gotRules)
+			assert.Equal(t, tt.wantHostnames, gotHostnames)
+		})
+	}
+}
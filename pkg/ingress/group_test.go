@@ -119,6 +119,40 @@ func TestNewGroupIDForImplicitGroup(t *testing.T) {
 	}
 }
 
+func TestNewGroupIDForShard(t *testing.T) {
+	tests := []struct {
+		name    string
+		groupID GroupID
+		shard   int
+		want    GroupID
+	}{
+		{
+			name:    "shard 0",
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			shard:   0,
+			want: GroupID{
+				Namespace: "",
+				Name:      "awesome-group",
+			},
+		},
+		{
+			name:    "shard 2",
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			shard:   2,
+			want: GroupID{
+				Namespace: "",
+				Name:      "awesome-group:2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewGroupIDForShard(tt.groupID, tt.shard)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestEncodeGroupIDToReconcileRequest(t *testing.T) {
 	tests := []struct {
 		name    string
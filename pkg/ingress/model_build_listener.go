@@ -38,6 +38,14 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, lbARN cor
 	if err != nil {
 		return elbv2model.ListenerSpec{}, err
 	}
+	mutualAuthentication, err := t.buildListenerMutualAuthentication(ctx, port, config.mutualAuthentication, config.mutualAuthenticationNamespace)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+	lsAttributes, err := t.buildListenerAttributes(ctx, port, config.protocol, ingList)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
 	certs := make([]elbv2model.Certificate, 0, len(config.tlsCerts))
 	for _, certARN := range config.tlsCerts {
 		certs = append(certs, elbv2model.Certificate{
@@ -45,13 +53,15 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, lbARN cor
 		})
 	}
 	return elbv2model.ListenerSpec{
-		LoadBalancerARN: lbARN,
-		Port:            port,
-		Protocol:        config.protocol,
-		DefaultActions:  defaultActions,
-		Certificates:    certs,
-		SSLPolicy:       config.sslPolicy,
-		Tags:            tags,
+		LoadBalancerARN:      lbARN,
+		Port:                 port,
+		Protocol:             config.protocol,
+		DefaultActions:       defaultActions,
+		Certificates:         certs,
+		SSLPolicy:            config.sslPolicy,
+		MutualAuthentication: mutualAuthentication,
+		ListenerAttributes:   lsAttributes,
+		Tags:                 tags,
 	}, nil
 }
 
@@ -95,6 +105,37 @@ func (t *defaultModelBuildTask) buildListenerTags(_ context.Context, ingList []C
 	return algorithm.MergeStringMap(t.defaultTags, ingGroupTags), nil
 }
 
+// buildListenerAttributes builds the listener attributes from annotation in the format of `${IngressSuffixListenerAttributes}.${Protocol}-${Port}`.
+// the attributes are merged across Ingresses that share the listener, conflicting values are rejected.
+func (t *defaultModelBuildTask) buildListenerAttributes(_ context.Context, port int64, protocol elbv2model.Protocol, ingList []ClassifiedIngress) ([]elbv2model.ListenerAttribute, error) {
+	annotationKey := fmt.Sprintf("%v.%v-%v", annotations.IngressSuffixListenerAttributes, protocol, port)
+	mergedAttributes := make(map[string]string)
+	mergedAttributesProvider := make(map[string]types.NamespacedName)
+	for _, ing := range ingList {
+		ingKey := k8s.NamespacedName(ing.Ing)
+		var rawAttributes map[string]string
+		if _, err := t.annotationParser.ParseStringMapAnnotation(annotationKey, &rawAttributes, ing.Ing.Annotations); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse listener attributes for ingress: %v", ingKey)
+		}
+		for attrKey, attrValue := range rawAttributes {
+			if existingValue, ok := mergedAttributes[attrKey]; ok && existingValue != attrValue {
+				return nil, errors.Errorf("conflicting listener attribute %v, %v: %v | %v: %v",
+					attrKey, mergedAttributesProvider[attrKey], existingValue, ingKey, attrValue)
+			}
+			mergedAttributes[attrKey] = attrValue
+			mergedAttributesProvider[attrKey] = ingKey
+		}
+	}
+	attributes := make([]elbv2model.ListenerAttribute, 0, len(mergedAttributes))
+	for _, attrKey := range sets.StringKeySet(mergedAttributes).List() {
+		attributes = append(attributes, elbv2model.ListenerAttribute{
+			Key:   attrKey,
+			Value: mergedAttributes[attrKey],
+		})
+	}
+	return attributes, nil
+}
+
 // the listen port config for specific listener port.
 type listenPortConfig struct {
 	protocol       elbv2model.Protocol
@@ -102,17 +143,25 @@ type listenPortConfig struct {
 	inboundCIDRv6s []string
 	sslPolicy      *string
 	tlsCerts       []string
+
+	mutualAuthentication *MutualAuthenticationConfig
+	// mutualAuthenticationNamespace is the namespace of Ingress that configured mutualAuthentication.
+	mutualAuthenticationNamespace string
 }
 
 func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context.Context, ing *ClassifiedIngress) (map[int64]listenPortConfig, error) {
-	explicitTLSCertARNs := t.computeIngressExplicitTLSCertARNs(ctx, ing.Ing)
+	explicitTLSCertARNs := t.computeIngressExplicitTLSCertARNs(ctx, ing)
 	explicitSSLPolicy := t.computeIngressExplicitSSLPolicy(ctx, ing)
-	inboundCIDRv4s, inboundCIDRV6s, err := t.computeIngressExplicitInboundCIDRs(ctx, ing.Ing)
+	inboundCIDRv4s, inboundCIDRV6s, err := t.computeIngressExplicitInboundCIDRs(ctx, ing)
+	if err != nil {
+		return nil, err
+	}
+	mutualAuthenticationByPort, err := t.computeIngressMutualAuthenticationByPort(ctx, ing)
 	if err != nil {
 		return nil, err
 	}
 	preferTLS := len(explicitTLSCertARNs) != 0
-	listenPorts, err := t.computeIngressListenPorts(ctx, ing.Ing, preferTLS)
+	listenPorts, err := t.computeIngressListenPorts(ctx, ing, preferTLS)
 	if err != nil {
 		return nil, err
 	}
@@ -146,16 +195,25 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 				cfg.tlsCerts = explicitTLSCertARNs
 			}
 			cfg.sslPolicy = explicitSSLPolicy
+			cfg.mutualAuthentication = mutualAuthenticationByPort[port]
 		}
 		listenPortConfigByPort[port] = cfg
 	}
+	for port := range mutualAuthenticationByPort {
+		if listenPorts[port] != elbv2model.ProtocolHTTPS {
+			return nil, errors.Errorf("mutual-authentication is only supported on HTTPS listener ports: %v", port)
+		}
+	}
 
 	return listenPortConfigByPort, nil
 }
 
-func (t *defaultModelBuildTask) computeIngressExplicitTLSCertARNs(_ context.Context, ing *networking.Ingress) []string {
+func (t *defaultModelBuildTask) computeIngressExplicitTLSCertARNs(_ context.Context, ing *ClassifiedIngress) []string {
+	if ing.IngClassConfig.IngClassParams != nil && len(ing.IngClassConfig.IngClassParams.Spec.CertificateARNs) != 0 {
+		return ing.IngClassConfig.IngClassParams.Spec.CertificateARNs
+	}
 	var rawTLSCertARNs []string
-	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &rawTLSCertARNs, ing.Annotations)
+	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &rawTLSCertARNs, ing.Ing.Annotations)
 	return rawTLSCertARNs
 }
 
@@ -172,21 +230,26 @@ func (t *defaultModelBuildTask) computeIngressInferredTLSCertARNs(ctx context.Co
 	return t.certDiscovery.Discover(ctx, hosts.List())
 }
 
-func (t *defaultModelBuildTask) computeIngressListenPorts(_ context.Context, ing *networking.Ingress, preferTLS bool) (map[int64]elbv2model.Protocol, error) {
-	rawListenPorts := ""
-	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixListenPorts, &rawListenPorts, ing.Annotations); !exists {
-		if preferTLS {
-			return map[int64]elbv2model.Protocol{443: elbv2model.ProtocolHTTPS}, nil
-		}
-		return map[int64]elbv2model.Protocol{80: elbv2model.ProtocolHTTP}, nil
-	}
-
+func (t *defaultModelBuildTask) computeIngressListenPorts(_ context.Context, ing *ClassifiedIngress, preferTLS bool) (map[int64]elbv2model.Protocol, error) {
 	var entries []map[string]int64
-	if err := json.Unmarshal([]byte(rawListenPorts), &entries); err != nil {
-		return nil, errors.Wrapf(err, "failed to parse listen-ports configuration: `%s`", rawListenPorts)
-	}
-	if len(entries) == 0 {
-		return nil, errors.Errorf("empty listen-ports configuration: `%s`", rawListenPorts)
+	if ing.IngClassConfig.IngClassParams != nil && len(ing.IngClassConfig.IngClassParams.Spec.Listeners) != 0 {
+		for _, listener := range ing.IngClassConfig.IngClassParams.Spec.Listeners {
+			entries = append(entries, map[string]int64{string(listener.Protocol): int64(listener.Port)})
+		}
+	} else {
+		rawListenPorts := ""
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixListenPorts, &rawListenPorts, ing.Ing.Annotations); !exists {
+			if preferTLS {
+				return map[int64]elbv2model.Protocol{443: elbv2model.ProtocolHTTPS}, nil
+			}
+			return map[int64]elbv2model.Protocol{80: elbv2model.ProtocolHTTP}, nil
+		}
+		if err := json.Unmarshal([]byte(rawListenPorts), &entries); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse listen-ports configuration: `%s`", rawListenPorts)
+		}
+		if len(entries) == 0 {
+			return nil, errors.Errorf("empty listen-ports configuration: `%s`", rawListenPorts)
+		}
 	}
 
 	portAndProtocols := make(map[int64]elbv2model.Protocol, len(entries))
@@ -209,15 +272,24 @@ func (t *defaultModelBuildTask) computeIngressListenPorts(_ context.Context, ing
 	return portAndProtocols, nil
 }
 
-func (t *defaultModelBuildTask) computeIngressExplicitInboundCIDRs(_ context.Context, ing *networking.Ingress) ([]string, []string, error) {
+func (t *defaultModelBuildTask) computeIngressExplicitInboundCIDRs(_ context.Context, ing *ClassifiedIngress) ([]string, []string, error) {
 	var rawInboundCIDRs []string
-	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixInboundCIDRs, &rawInboundCIDRs, ing.Annotations)
+	fromIngClassParams := false
+	if ing.IngClassConfig.IngClassParams != nil && len(ing.IngClassConfig.IngClassParams.Spec.InboundCIDRs) != 0 {
+		rawInboundCIDRs = ing.IngClassConfig.IngClassParams.Spec.InboundCIDRs
+		fromIngClassParams = true
+	} else {
+		_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixInboundCIDRs, &rawInboundCIDRs, ing.Ing.Annotations)
+	}
 
 	var inboundCIDRv4s, inboundCIDRv6s []string
 	for _, cidr := range rawInboundCIDRs {
 		_, _, err := net.ParseCIDR(cidr)
 		if err != nil {
-			return nil, nil, errors.Wrapf(err, "invalid %v settings on Ingress: %v", annotations.IngressSuffixInboundCIDRs, k8s.NamespacedName(ing))
+			if fromIngClassParams {
+				return nil, nil, errors.Wrapf(err, "invalid inboundCIDRs settings on IngressClassParams: %v", ing.IngClassConfig.IngClassParams.Name)
+			}
+			return nil, nil, errors.Wrapf(err, "invalid %v settings on Ingress: %v", annotations.IngressSuffixInboundCIDRs, k8s.NamespacedName(ing.Ing))
 		}
 		if strings.Contains(cidr, ":") {
 			inboundCIDRv6s = append(inboundCIDRv6s, cidr)
@@ -238,3 +310,26 @@ func (t *defaultModelBuildTask) computeIngressExplicitSSLPolicy(_ context.Contex
 	}
 	return &rawSSLPolicy
 }
+
+func (t *defaultModelBuildTask) computeIngressMutualAuthenticationByPort(_ context.Context, ing *ClassifiedIngress) (map[int64]*MutualAuthenticationConfig, error) {
+	var rawConfigs []MutualAuthenticationConfig
+	exists, err := t.annotationParser.ParseJSONAnnotation(annotations.IngressSuffixMutualAuthentication, &rawConfigs, ing.Ing.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	configByPort := make(map[int64]*MutualAuthenticationConfig, len(rawConfigs))
+	for i := range rawConfigs {
+		cfg := rawConfigs[i]
+		if err := cfg.validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid %v settings for port: %v", annotations.IngressSuffixMutualAuthentication, cfg.Port)
+		}
+		if _, ok := configByPort[cfg.Port]; ok {
+			return nil, errors.Errorf("duplicate %v settings for port: %v", annotations.IngressSuffixMutualAuthentication, cfg.Port)
+		}
+		configByPort[cfg.Port] = &cfg
+	}
+	return configByPort, nil
+}
@@ -52,10 +52,15 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 					Conditions: conditions,
 					Actions:    actions,
 					Tags:       tags,
+					IngressKey: k8s.NamespacedName(ing.Ing),
 				})
 			}
 		}
 	}
+	rules, err := t.resolveRuleConflicts(port, rules)
+	if err != nil {
+		return err
+	}
 	optimizedRules, err := t.ruleOptimizer.Optimize(ctx, port, protocol, rules)
 	if err != nil {
 		return err
@@ -64,13 +69,15 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 	priority := int64(1)
 	for _, rule := range optimizedRules {
 		ruleResID := fmt.Sprintf("%v:%v", port, priority)
-		_ = elbv2model.NewListenerRule(t.stack, ruleResID, elbv2model.ListenerRuleSpec{
+		lr := elbv2model.NewListenerRule(t.stack, ruleResID, elbv2model.ListenerRuleSpec{
 			ListenerARN: lsARN,
 			Priority:    priority,
 			Conditions:  rule.Conditions,
 			Actions:     rule.Actions,
 			Tags:        rule.Tags,
 		})
+		ingResources := t.buildIngressResources(rule.IngressKey)
+		ingResources.ListenerRules = append(ingResources.ListenerRules, lr)
 		priority += 1
 	}
 
@@ -1,10 +1,12 @@
 package ingress
 
 import (
+	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
 )
 
@@ -675,3 +677,203 @@ func Test_defaultModelBuildTask_buildPathPatternsForPrefixPathType(t *testing.T)
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildRuleConditions(t *testing.T) {
+	pathTypeExact := networking.PathTypeExact
+	type args struct {
+		rule    networking.IngressRule
+		path    networking.HTTPIngressPath
+		backend EnhancedBackend
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []elbv2model.RuleCondition
+		wantErr error
+	}{
+		{
+			name: "host and path only",
+			args: args{
+				rule: networking.IngressRule{
+					Host: "www.example.com",
+				},
+				path: networking.HTTPIngressPath{
+					Path:     "/path",
+					PathType: &pathTypeExact,
+				},
+			},
+			want: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldHostHeader,
+					HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+						Values: []string{"www.example.com"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+						Values: []string{"/path"},
+					},
+				},
+			},
+		},
+		{
+			name: "no host and path",
+			args: args{},
+			want: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+						Values: []string{"/*"},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple http-header and query-string conditions are kept individually",
+			args: args{
+				path: networking.HTTPIngressPath{
+					Path:     "/path",
+					PathType: &pathTypeExact,
+				},
+				backend: EnhancedBackend{
+					Conditions: []RuleCondition{
+						{
+							Field: RuleConditionFieldHTTPHeader,
+							HTTPHeaderConfig: &HTTPHeaderConditionConfig{
+								HTTPHeaderName: "HeaderA",
+								Values:         []string{"valueA1", "valueA2"},
+							},
+						},
+						{
+							Field: RuleConditionFieldQueryString,
+							QueryStringConfig: &QueryStringConditionConfig{
+								Values: []QueryStringKeyValuePair{
+									{
+										Key:   awssdk.String("paramA"),
+										Value: "valueA",
+									},
+									{
+										Value: "valueB",
+									},
+								},
+							},
+						},
+						{
+							Field: RuleConditionFieldHTTPHeader,
+							HTTPHeaderConfig: &HTTPHeaderConditionConfig{
+								HTTPHeaderName: "HeaderB",
+								Values:         []string{"valueB"},
+							},
+						},
+					},
+				},
+			},
+			want: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldHTTPHeader,
+					HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+						HTTPHeaderName: "HeaderA",
+						Values:         []string{"valueA1", "valueA2"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldQueryString,
+					QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+						Values: []elbv2model.QueryStringKeyValuePair{
+							{
+								Key:   awssdk.String("paramA"),
+								Value: "valueA",
+							},
+							{
+								Value: "valueB",
+							},
+						},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldHTTPHeader,
+					HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+						HTTPHeaderName: "HeaderB",
+						Values:         []string{"valueB"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+						Values: []string{"/path"},
+					},
+				},
+			},
+		},
+		{
+			name: "http-request-method and source-ip conditions",
+			args: args{
+				rule: networking.IngressRule{
+					Host: "www.example.com",
+				},
+				backend: EnhancedBackend{
+					Conditions: []RuleCondition{
+						{
+							Field: RuleConditionFieldHTTPRequestMethod,
+							HTTPRequestMethodConfig: &HTTPRequestMethodConditionConfig{
+								Values: []string{"GET", "HEAD"},
+							},
+						},
+						{
+							Field: RuleConditionFieldSourceIP,
+							SourceIPConfig: &SourceIPConditionConfig{
+								Values: []string{"192.168.0.0/16", "2001:db8::/32"},
+							},
+						},
+					},
+				},
+			},
+			want: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+					HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+						Values: []string{"GET", "HEAD"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldSourceIP,
+					SourceIPConfig: &elbv2model.SourceIPConditionConfig{
+						Values: []string{"192.168.0.0/16", "2001:db8::/32"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldHostHeader,
+					HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+						Values: []string{"www.example.com"},
+					},
+				},
+			},
+		},
+		{
+			name: "missing sourceIPConfig",
+			args: args{
+				backend: EnhancedBackend{
+					Conditions: []RuleCondition{
+						{
+							Field: RuleConditionFieldSourceIP,
+						},
+					},
+				},
+			},
+			wantErr: errors.New("missing SourceIPConfig"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{}
+			got, err := task.buildRuleConditions(context.Background(), tt.args.rule, tt.args.path, tt.args.backend)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,549 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultModelBuildTask_computeIngressListenPortConfigByPort(t *testing.T) {
+	tests := []struct {
+		name    string
+		ing     ClassifiedIngress
+		want    map[int64]listenPortConfig
+		wantErr string
+	}{
+		{
+			name: "settings from annotations",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/listen-ports":    `[{"HTTP": 80}, {"HTTPS": 443}]`,
+							"alb.ingress.kubernetes.io/certificate-arn": "arn-1",
+							"alb.ingress.kubernetes.io/inbound-cidrs":   "10.0.0.0/16, 2001:db8::/32",
+						},
+					},
+				},
+			},
+			want: map[int64]listenPortConfig{
+				80: {
+					protocol:       elbv2model.ProtocolHTTP,
+					inboundCIDRv4s: []string{"10.0.0.0/16"},
+					inboundCIDRv6s: []string{"2001:db8::/32"},
+				},
+				443: {
+					protocol:       elbv2model.ProtocolHTTPS,
+					inboundCIDRv4s: []string{"10.0.0.0/16"},
+					inboundCIDRv6s: []string{"2001:db8::/32"},
+					tlsCerts:       []string{"arn-1"},
+				},
+			},
+		},
+		{
+			name: "settings from IngressClassParams take precedence over annotations",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/listen-ports":    `[{"HTTP": 80}, {"HTTPS": 443}]`,
+							"alb.ingress.kubernetes.io/certificate-arn": "arn-1",
+							"alb.ingress.kubernetes.io/inbound-cidrs":   "10.0.0.0/16",
+							"alb.ingress.kubernetes.io/ssl-policy":      "ELBSecurityPolicy-2016-08",
+						},
+					},
+				},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &v1beta1.IngressClassParams{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "awesome-class",
+						},
+						Spec: v1beta1.IngressClassParamsSpec{
+							Listeners: []v1beta1.Listener{
+								{Port: 8443, Protocol: v1beta1.ListenerProtocolHTTPS},
+							},
+							CertificateARNs: []string{"arn-2", "arn-3"},
+							InboundCIDRs:    []string{"192.168.0.0/16"},
+							SSLPolicy:       "ELBSecurityPolicy-TLS-1-2-2017-01",
+						},
+					},
+				},
+			},
+			want: map[int64]listenPortConfig{
+				8443: {
+					protocol:       elbv2model.ProtocolHTTPS,
+					inboundCIDRv4s: []string{"192.168.0.0/16"},
+					tlsCerts:       []string{"arn-2", "arn-3"},
+					sslPolicy:      awssdk.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+				},
+			},
+		},
+		{
+			name: "certificates from IngressClassParams infer HTTPS listener",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+					},
+				},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &v1beta1.IngressClassParams{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "awesome-class",
+						},
+						Spec: v1beta1.IngressClassParamsSpec{
+							CertificateARNs: []string{"arn-2"},
+						},
+					},
+				},
+			},
+			want: map[int64]listenPortConfig{
+				443: {
+					protocol: elbv2model.ProtocolHTTPS,
+					tlsCerts: []string{"arn-2"},
+				},
+			},
+		},
+		{
+			name: "unsupported listener protocol from IngressClassParams",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+					},
+				},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &v1beta1.IngressClassParams{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "awesome-class",
+						},
+						Spec: v1beta1.IngressClassParamsSpec{
+							Listeners: []v1beta1.Listener{
+								{Port: 443, Protocol: v1beta1.ListenerProtocolTLS},
+							},
+						},
+					},
+				},
+			},
+			wantErr: "listen protocol must be within [HTTP, HTTPS]: TLS",
+		},
+		{
+			name: "invalid inbound CIDRs from IngressClassParams",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+					},
+				},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &v1beta1.IngressClassParams{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "awesome-class",
+						},
+						Spec: v1beta1.IngressClassParamsSpec{
+							InboundCIDRs: []string{"10.0.0.1"},
+						},
+					},
+				},
+			},
+			wantErr: "invalid inboundCIDRs settings on IngressClassParams: awesome-class: invalid CIDR address: 10.0.0.1",
+		},
+		{
+			name: "mutual authentication from annotations",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/listen-ports":          `[{"HTTP": 80}, {"HTTPS": 443}, {"HTTPS": 8443}]`,
+							"alb.ingress.kubernetes.io/certificate-arn":       "arn-1",
+							"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "verify", "caCertificatesBundle": {"s3Bucket": "bucket", "s3Key": "ca.pem"}, "ignoreClientCertificateExpiry": true}, {"port": 8443, "mode": "passthrough"}]`,
+						},
+					},
+				},
+			},
+			want: map[int64]listenPortConfig{
+				80: {
+					protocol: elbv2model.ProtocolHTTP,
+				},
+				443: {
+					protocol: elbv2model.ProtocolHTTPS,
+					tlsCerts: []string{"arn-1"},
+					mutualAuthentication: &MutualAuthenticationConfig{
+						Port: 443,
+						Mode: MutualAuthenticationModeVerify,
+						CACertificatesBundle: &CACertificatesBundleConfig{
+							S3Bucket: "bucket",
+							S3Key:    "ca.pem",
+						},
+						IgnoreClientCertificateExpiry: awssdk.Bool(true),
+					},
+				},
+				8443: {
+					protocol: elbv2model.ProtocolHTTPS,
+					tlsCerts: []string{"arn-1"},
+					mutualAuthentication: &MutualAuthenticationConfig{
+						Port: 8443,
+						Mode: MutualAuthenticationModePassthrough,
+					},
+				},
+			},
+		},
+		{
+			name: "mutual authentication on HTTP listener",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/listen-ports":          `[{"HTTP": 80}]`,
+							"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 80, "mode": "passthrough"}]`,
+						},
+					},
+				},
+			},
+			wantErr: "mutual-authentication is only supported on HTTPS listener ports: 80",
+		},
+		{
+			name: "mutual authentication in verify mode without trust store",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/listen-ports":          `[{"HTTPS": 443}]`,
+							"alb.ingress.kubernetes.io/certificate-arn":       "arn-1",
+							"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "verify"}]`,
+						},
+					},
+				},
+			},
+			wantErr: "invalid mutual-authentication settings for port: 443: precisely one of trustStoreARN and caCertificatesBundle can be specified in verify mode",
+		},
+		{
+			name: "mutual authentication with trust store in passthrough mode",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/listen-ports":          `[{"HTTPS": 443}]`,
+							"alb.ingress.kubernetes.io/certificate-arn":       "arn-1",
+							"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "passthrough", "trustStoreARN": "ts-arn"}]`,
+						},
+					},
+				},
+			},
+			wantErr: "invalid mutual-authentication settings for port: 443: trustStoreARN, caCertificatesBundle and ignoreClientCertificateExpiry are only supported in verify mode",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.computeIngressListenPortConfigByPort(context.Background(), &tt.ing)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildListenerMutualAuthentication(t *testing.T) {
+	tests := []struct {
+		name           string
+		secrets        []*corev1.Secret
+		cfg            *MutualAuthenticationConfig
+		want           *elbv2model.MutualAuthenticationAttributes
+		wantTrustStore *elbv2model.TrustStoreSpec
+		wantSecretKeys []types.NamespacedName
+		wantErr        string
+	}{
+		{
+			name: "no mutual authentication",
+			cfg:  nil,
+			want: nil,
+		},
+		{
+			name: "passthrough mode",
+			cfg: &MutualAuthenticationConfig{
+				Port: 443,
+				Mode: MutualAuthenticationModePassthrough,
+			},
+			want: &elbv2model.MutualAuthenticationAttributes{
+				Mode: elbv2model.MutualAuthenticationModePassthrough,
+			},
+		},
+		{
+			name: "verify mode with existing trust store",
+			cfg: &MutualAuthenticationConfig{
+				Port:                          443,
+				Mode:                          MutualAuthenticationModeVerify,
+				TrustStoreARN:                 awssdk.String("ts-arn"),
+				IgnoreClientCertificateExpiry: awssdk.Bool(true),
+			},
+			want: &elbv2model.MutualAuthenticationAttributes{
+				Mode:                          elbv2model.MutualAuthenticationModeVerify,
+				TrustStoreARN:                 core.LiteralStringToken("ts-arn"),
+				IgnoreClientCertificateExpiry: awssdk.Bool(true),
+			},
+		},
+		{
+			name: "verify mode with CA certificates bundle",
+			cfg: &MutualAuthenticationConfig{
+				Port: 443,
+				Mode: MutualAuthenticationModeVerify,
+				CACertificatesBundle: &CACertificatesBundleConfig{
+					S3Bucket:        "bucket",
+					S3Key:           "ca.pem",
+					S3ObjectVersion: awssdk.String("v1"),
+				},
+			},
+			wantTrustStore: &elbv2model.TrustStoreSpec{
+				Name:                                "k8s-awesomen-ing1-2f809cffef",
+				CACertificatesBundleS3Bucket:        "bucket",
+				CACertificatesBundleS3Key:           "ca.pem",
+				CACertificatesBundleS3ObjectVersion: awssdk.String("v1"),
+				Tags:                                map[string]string{},
+			},
+		},
+		{
+			name: "verify mode with CA certificates bundle from secret",
+			secrets: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ca-bundle",
+					},
+					Data: map[string][]byte{
+						"ca.crt": []byte("-----BEGIN CERTIFICATE-----"),
+					},
+				},
+			},
+			cfg: &MutualAuthenticationConfig{
+				Port: 443,
+				Mode: MutualAuthenticationModeVerify,
+				CACertificatesBundle: &CACertificatesBundleConfig{
+					S3Bucket:   "bucket",
+					S3Key:      "ca.pem",
+					SecretName: awssdk.String("ca-bundle"),
+				},
+			},
+			wantTrustStore: &elbv2model.TrustStoreSpec{
+				Name:                         "k8s-awesomen-ing1-3fc706248e",
+				CACertificatesBundleS3Bucket: "bucket",
+				CACertificatesBundleS3Key:    "ca.pem",
+				CACertificatesBundle:         awssdk.String("-----BEGIN CERTIFICATE-----"),
+				Tags:                         map[string]string{},
+			},
+			wantSecretKeys: []types.NamespacedName{{Namespace: "awesome-ns", Name: "ca-bundle"}},
+		},
+		{
+			name: "verify mode with CA certificates bundle from secret without ca.crt",
+			secrets: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ca-bundle",
+					},
+					Data: map[string][]byte{
+						"tls.crt": []byte("-----BEGIN CERTIFICATE-----"),
+					},
+				},
+			},
+			cfg: &MutualAuthenticationConfig{
+				Port: 443,
+				Mode: MutualAuthenticationModeVerify,
+				CACertificatesBundle: &CACertificatesBundleConfig{
+					S3Bucket:   "bucket",
+					S3Key:      "ca.pem",
+					SecretName: awssdk.String("ca-bundle"),
+				},
+			},
+			wantErr: "missing ca.crt, secret: awesome-ns/ca-bundle",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingGroup := Group{
+				ID: NewGroupIDForImplicitGroup(types.NamespacedName{Namespace: "awesome-ns", Name: "ing-1"}),
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+							},
+						},
+					},
+				},
+			}
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, secret := range tt.secrets {
+				assert.NoError(t, k8sClient.Create(context.Background(), secret.DeepCopy()))
+			}
+			stack := core.NewDefaultStack(core.StackID(ingGroup.ID))
+			task := &defaultModelBuildTask{
+				k8sClient:        k8sClient,
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				clusterName:      "cluster-name",
+				ingGroup:         ingGroup,
+				stack:            stack,
+			}
+			got, err := task.buildListenerMutualAuthentication(context.Background(), 443, tt.cfg, "awesome-ns")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSecretKeys, task.secretKeys)
+
+			var resTSs []*elbv2model.TrustStore
+			stack.ListResources(&resTSs)
+			if tt.wantTrustStore == nil {
+				assert.Equal(t, tt.want, got)
+				assert.Empty(t, resTSs)
+				return
+			}
+			assert.Len(t, resTSs, 1)
+			assert.Equal(t, "443", resTSs[0].ID())
+			assert.Equal(t, *tt.wantTrustStore, resTSs[0].Spec)
+			assert.Equal(t, elbv2model.MutualAuthenticationModeVerify, got.Mode)
+			assert.Equal(t, []core.Resource{resTSs[0]}, got.TrustStoreARN.Dependencies())
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildListenerAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		ingList []ClassifiedIngress
+		want    []elbv2model.ListenerAttribute
+		wantErr string
+	}{
+		{
+			name: "no annotation",
+			ingList: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "ing-1",
+						},
+					},
+				},
+			},
+			want: []elbv2model.ListenerAttribute{},
+		},
+		{
+			name: "attributes merged across ingresses",
+			ingList: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "ing-1",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/listener-attributes.HTTPS-443": "routing.http.response.server.enabled=false",
+								"alb.ingress.kubernetes.io/listener-attributes.HTTP-80":   "routing.http.response.server.enabled=true",
+							},
+						},
+					},
+				},
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "ing-2",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/listener-attributes.HTTPS-443": "routing.http.response.server.enabled=false,routing.http.response.strict_transport_security.header_value=max-age=31536000",
+							},
+						},
+					},
+				},
+			},
+			want: []elbv2model.ListenerAttribute{
+				{
+					Key:   "routing.http.response.server.enabled",
+					Value: "false",
+				},
+				{
+					Key:   "routing.http.response.strict_transport_security.header_value",
+					Value: "max-age=31536000",
+				},
+			},
+		},
+		{
+			name: "conflicting attributes across ingresses",
+			ingList: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "ing-1",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/listener-attributes.HTTPS-443": "routing.http.response.server.enabled=false",
+							},
+						},
+					},
+				},
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "ing-2",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/listener-attributes.HTTPS-443": "routing.http.response.server.enabled=true",
+							},
+						},
+					},
+				},
+			},
+			wantErr: "conflicting listener attribute routing.http.response.server.enabled, awesome-ns/ing-1: false | awesome-ns/ing-2: true",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildListenerAttributes(context.Background(), 443, elbv2model.ProtocolHTTPS, tt.ingList)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+func Test_defaultModelBuildTask_buildWAFv2WebACLAssociation(t *testing.T) {
+	tests := []struct {
+		name          string
+		ingGroup      Group
+		wantWebACLARN string
+		wantErr       error
+	}{
+		{
+			name: "no WAFv2 WebACL",
+			ingGroup: Group{
+				ID: GroupID{Namespace: "awesome-ns", Name: "ing-1"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "WAFv2 WebACL from IngressClassParams takes precedence over annotation",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/wafv2-acl-arn": "arn:aws:wafv2:acl-1",
+								},
+							},
+						},
+						IngClassConfig: ClassConfiguration{
+							IngClassParams: &v1beta1.IngressClassParams{
+								Spec: v1beta1.IngressClassParamsSpec{
+									WAFv2ACLARN: "arn:aws:wafv2:acl-2",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantWebACLARN: "arn:aws:wafv2:acl-2",
+		},
+		{
+			name: "conflicting WAFv2 WebACL between IngressClassParams and annotation of different members",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/wafv2-acl-arn": "arn:aws:wafv2:acl-1",
+								},
+							},
+						},
+					},
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-2",
+							},
+						},
+						IngClassConfig: ClassConfiguration{
+							IngClassParams: &v1beta1.IngressClassParams{
+								Spec: v1beta1.IngressClassParamsSpec{
+									WAFv2ACLARN: "arn:aws:wafv2:acl-2",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting WAFv2 WebACL ARNs: [arn:aws:wafv2:acl-1 arn:aws:wafv2:acl-2]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup:         tt.ingGroup,
+				stack:            core.NewDefaultStack(core.StackID{Name: "awesome-group"}),
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildWAFv2WebACLAssociation(context.Background(), core.LiteralStringToken("lb-arn"))
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			if tt.wantWebACLARN == "" {
+				assert.Nil(t, got)
+			} else {
+				assert.Equal(t, tt.wantWebACLARN, got.Spec.WebACLARN)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildShieldProtection(t *testing.T) {
+	tests := []struct {
+		name           string
+		ingGroup       Group
+		wantProtection bool
+	}{
+		{
+			name: "shield protection enabled by annotation",
+			ingGroup: Group{
+				ID: GroupID{Namespace: "awesome-ns", Name: "ing-1"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/shield-advanced-protection": "true",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantProtection: true,
+		},
+		{
+			name: "shield protection from IngressClassParams takes precedence over annotation",
+			ingGroup: Group{
+				ID: GroupID{Namespace: "awesome-ns", Name: "ing-1"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/shield-advanced-protection": "true",
+								},
+							},
+						},
+						IngClassConfig: ClassConfiguration{
+							IngClassParams: &v1beta1.IngressClassParams{
+								Spec: v1beta1.IngressClassParamsSpec{
+									ShieldAdvancedProtection: awssdk.Bool(false),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantProtection: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup:         tt.ingGroup,
+				stack:            core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "ing-1"}),
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildShieldProtection(context.Background(), core.LiteralStringToken("lb-arn"))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantProtection, got != nil)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// LoadBalancerQuotas are the ALB quotas that an IngressGroup must fit in.
+// a non-positive quota means it's not checked.
+type LoadBalancerQuotas struct {
+	// MaxListenerRules is the maximum number of listener rules, excluding the default rules.
+	MaxListenerRules int
+
+	// MaxTargetGroups is the maximum number of target groups.
+	MaxTargetGroups int
+
+	// MaxCertificates is the maximum number of certificates, excluding the default certificates.
+	MaxCertificates int
+}
+
+// NewLoadBalancerQuotas constructs LoadBalancerQuotas from IngressConfig.
+func NewLoadBalancerQuotas(cfg config.IngressConfig) LoadBalancerQuotas {
+	return LoadBalancerQuotas{
+		MaxListenerRules: cfg.MaxListenerRules,
+		MaxTargetGroups:  cfg.MaxTargetGroups,
+		MaxCertificates:  cfg.MaxCertificates,
+	}
+}
+
+// QuotaExceededError is returned when an IngressGroup exceeds the ALB quotas.
+type QuotaExceededError struct {
+	// Quota is the name of exceeded quota.
+	Quota string
+
+	// Usage is the usage by the IngressGroup.
+	Usage int
+
+	// Limit is the limit of quota.
+	Limit int
+
+	// Ingresses are the Ingresses whose resources don't fit in the quota.
+	Ingresses []types.NamespacedName
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%v quota exceeded: %v > %v, offending ingresses: %v", e.Quota, e.Usage, e.Limit, e.Ingresses)
+}
+
+// quotaUsage tracks the resources used by an IngressGroup against a quota.
+type quotaUsage struct {
+	quota string
+	limit int
+	// count returns the usage after the resources of an Ingress are added.
+	count func(ingKey types.NamespacedName) int
+}
+
+// checkLoadBalancerQuotas checks whether the built resources fit in the load balancer quotas.
+// Ingresses already deployed to this load balancer are counted first, so that the Ingresses newly added to the
+// IngressGroup are reported as offending rather than the ones that are already serving traffic.
+func (t *defaultModelBuildTask) checkLoadBalancerQuotas(_ context.Context, listenPortConfigsByPort map[int64][]listenPortConfigWithIngress) error {
+	var deployedIngKeys, newIngKeys []types.NamespacedName
+	for _, member := range t.ingGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		if status, exists := ParseReconcileStatus(member.Ing); exists && status.LoadBalancerARN != "" && status.Shard == t.ingGroup.Shard {
+			deployedIngKeys = append(deployedIngKeys, ingKey)
+		} else {
+			newIngKeys = append(newIngKeys, ingKey)
+		}
+	}
+	ingKeys := append(deployedIngKeys, newIngKeys...)
+
+	listenerRules := 0
+	targetGroupIDs := sets.NewString()
+	certARNsByPort := make(map[int64]sets.String)
+	usages := []quotaUsage{
+		{
+			quota: "listener rules",
+			limit: t.quotas.MaxListenerRules,
+			count: func(ingKey types.NamespacedName) int {
+				if ingResources, exists := t.ingressResources[ingKey]; exists {
+					listenerRules += len(ingResources.ListenerRules)
+				}
+				return listenerRules
+			},
+		},
+		{
+			quota: "target groups",
+			limit: t.quotas.MaxTargetGroups,
+			count: func(ingKey types.NamespacedName) int {
+				if ingResources, exists := t.ingressResources[ingKey]; exists {
+					for _, tg := range ingResources.TargetGroups {
+						targetGroupIDs.Insert(tg.ID())
+					}
+				}
+				return targetGroupIDs.Len()
+			},
+		},
+		{
+			quota: "certificates",
+			limit: t.quotas.MaxCertificates,
+			count: func(ingKey types.NamespacedName) int {
+				for port, cfgs := range listenPortConfigsByPort {
+					for _, cfg := range cfgs {
+						if cfg.ingKey != ingKey || len(cfg.listenPortConfig.tlsCerts) == 0 {
+							continue
+						}
+						if _, exists := certARNsByPort[port]; !exists {
+							certARNsByPort[port] = sets.NewString()
+						}
+						certARNsByPort[port].Insert(cfg.listenPortConfig.tlsCerts...)
+					}
+				}
+				// the default certificate of each listener doesn't count towards the quota.
+				certificates := 0
+				for _, certARNs := range certARNsByPort {
+					certificates += certARNs.Len() - 1
+				}
+				return certificates
+			},
+		},
+	}
+	for _, usage := range usages {
+		if usage.limit <= 0 {
+			continue
+		}
+		var offendingIngKeys []types.NamespacedName
+		used := 0
+		for _, ingKey := range ingKeys {
+			newUsed := usage.count(ingKey)
+			if newUsed > usage.limit && newUsed > used {
+				offendingIngKeys = append(offendingIngKeys, ingKey)
+			}
+			used = newUsed
+		}
+		if len(offendingIngKeys) != 0 {
+			return &QuotaExceededError{
+				Quota:     usage.quota,
+				Usage:     used,
+				Limit:     usage.limit,
+				Ingresses: offendingIngKeys,
+			}
+		}
+	}
+	return nil
+}
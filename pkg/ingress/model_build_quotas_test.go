@@ -0,0 +1,216 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_checkLoadBalancerQuotas(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+	newListenerRules := func(ingName string, count int) []*elbv2model.ListenerRule {
+		var lrs []*elbv2model.ListenerRule
+		for i := 0; i < count; i++ {
+			lrs = append(lrs, elbv2model.NewListenerRule(stack, fmt.Sprintf("%v:%v", ingName, i), elbv2model.ListenerRuleSpec{
+				ListenerARN: core.LiteralStringToken("ls-arn"),
+			}))
+		}
+		return lrs
+	}
+	tg1 := elbv2model.NewTargetGroup(stack, "awesome-ns/svc-1:http", elbv2model.TargetGroupSpec{})
+	tg2 := elbv2model.NewTargetGroup(stack, "awesome-ns/svc-2:http", elbv2model.TargetGroupSpec{})
+	tg3 := elbv2model.NewTargetGroup(stack, "awesome-ns/svc-3:http", elbv2model.TargetGroupSpec{})
+	newIngress := func(name string, rawStatus string) ClassifiedIngress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+		}
+		if rawStatus != "" {
+			ing.Annotations = map[string]string{
+				"alb.ingress.kubernetes.io/status": rawStatus,
+			}
+		}
+		return ClassifiedIngress{Ing: ing}
+	}
+	ing1Key := types.NamespacedName{Namespace: "awesome-ns", Name: "ing-1"}
+	ing2Key := types.NamespacedName{Namespace: "awesome-ns", Name: "ing-2"}
+	ing3Key := types.NamespacedName{Namespace: "awesome-ns", Name: "ing-3"}
+
+	tests := []struct {
+		name                    string
+		ingGroup                Group
+		ingressResources        map[types.NamespacedName]*IngressResources
+		listenPortConfigsByPort map[int64][]listenPortConfigWithIngress
+		quotas                  LoadBalancerQuotas
+		wantErr                 error
+	}{
+		{
+			name: "quotas are not checked when unset",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{newIngress("ing-1", "")},
+			},
+			ingressResources: map[types.NamespacedName]*IngressResources{
+				ing1Key: {
+					ListenerRules: newListenerRules("ing-1", 3),
+					TargetGroups:  []*elbv2model.TargetGroup{tg1, tg2},
+				},
+			},
+			quotas: LoadBalancerQuotas{},
+		},
+		{
+			name: "within quotas",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{newIngress("ing-1", ""), newIngress("ing-2", "")},
+			},
+			ingressResources: map[types.NamespacedName]*IngressResources{
+				ing1Key: {
+					ListenerRules: newListenerRules("ing-1", 2),
+					TargetGroups:  []*elbv2model.TargetGroup{tg1},
+				},
+				ing2Key: {
+					ListenerRules: newListenerRules("ing-2", 1),
+					TargetGroups:  []*elbv2model.TargetGroup{tg1, tg2},
+				},
+			},
+			listenPortConfigsByPort: map[int64][]listenPortConfigWithIngress{
+				443: {
+					{ingKey: ing1Key, listenPortConfig: listenPortConfig{tlsCerts: []string{"cert-1", "cert-2"}}},
+					{ingKey: ing2Key, listenPortConfig: listenPortConfig{tlsCerts: []string{"cert-1"}}},
+				},
+			},
+			quotas: LoadBalancerQuotas{MaxListenerRules: 3, MaxTargetGroups: 2, MaxCertificates: 1},
+		},
+		{
+			name: "listener rules quota exceeded",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{newIngress("ing-1", ""), newIngress("ing-2", ""), newIngress("ing-3", "")},
+			},
+			ingressResources: map[types.NamespacedName]*IngressResources{
+				ing1Key: {ListenerRules: newListenerRules("ing-1", 2)},
+				ing2Key: {ListenerRules: newListenerRules("ing-2", 2)},
+				ing3Key: {ListenerRules: newListenerRules("ing-3", 1)},
+			},
+			quotas: LoadBalancerQuotas{MaxListenerRules: 3},
+			wantErr: &QuotaExceededError{
+				Quota:     "listener rules",
+				Usage:     5,
+				Limit:     3,
+				Ingresses: []types.NamespacedName{ing2Key, ing3Key},
+			},
+		},
+		{
+			name: "deployed ingresses are counted first",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					newIngress("ing-1", ""),
+					newIngress("ing-2", `{"groupName":"awesome-group","observedGeneration":1,"rulesAccepted":true,"loadBalancerARN":"lb-arn"}`),
+				},
+			},
+			ingressResources: map[types.NamespacedName]*IngressResources{
+				ing1Key: {ListenerRules: newListenerRules("ing-1", 2)},
+				ing2Key: {ListenerRules: newListenerRules("ing-2", 2)},
+			},
+			quotas: LoadBalancerQuotas{MaxListenerRules: 3},
+			wantErr: &QuotaExceededError{
+				Quota:     "listener rules",
+				Usage:     4,
+				Limit:     3,
+				Ingresses: []types.NamespacedName{ing1Key},
+			},
+		},
+		{
+			name: "ingresses deployed to another shard are not counted first",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					newIngress("ing-1", ""),
+					newIngress("ing-2", `{"groupName":"awesome-group","observedGeneration":1,"rulesAccepted":true,"shard":1,"loadBalancerARN":"lb-arn"}`),
+				},
+			},
+			ingressResources: map[types.NamespacedName]*IngressResources{
+				ing1Key: {ListenerRules: newListenerRules("ing-1", 2)},
+				ing2Key: {ListenerRules: newListenerRules("ing-2", 2)},
+			},
+			quotas: LoadBalancerQuotas{MaxListenerRules: 3},
+			wantErr: &QuotaExceededError{
+				Quota:     "listener rules",
+				Usage:     4,
+				Limit:     3,
+				Ingresses: []types.NamespacedName{ing2Key},
+			},
+		},
+		{
+			name: "target groups quota exceeded - shared target groups are counted once",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{newIngress("ing-1", ""), newIngress("ing-2", ""), newIngress("ing-3", "")},
+			},
+			ingressResources: map[types.NamespacedName]*IngressResources{
+				ing1Key: {TargetGroups: []*elbv2model.TargetGroup{tg1, tg2}},
+				ing2Key: {TargetGroups: []*elbv2model.TargetGroup{tg3}},
+				ing3Key: {TargetGroups: []*elbv2model.TargetGroup{tg1}},
+			},
+			quotas: LoadBalancerQuotas{MaxTargetGroups: 2},
+			wantErr: &QuotaExceededError{
+				Quota:     "target groups",
+				Usage:     3,
+				Limit:     2,
+				Ingresses: []types.NamespacedName{ing2Key},
+			},
+		},
+		{
+			name: "certificates quota exceeded - default certificates are not counted",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{newIngress("ing-1", ""), newIngress("ing-2", "")},
+			},
+			listenPortConfigsByPort: map[int64][]listenPortConfigWithIngress{
+				443: {
+					{ingKey: ing1Key, listenPortConfig: listenPortConfig{tlsCerts: []string{"cert-1", "cert-2"}}},
+					{ingKey: ing2Key, listenPortConfig: listenPortConfig{tlsCerts: []string{"cert-3"}}},
+				},
+				8443: {
+					{ingKey: ing1Key, listenPortConfig: listenPortConfig{tlsCerts: []string{"cert-1"}}},
+				},
+			},
+			quotas: LoadBalancerQuotas{MaxCertificates: 1},
+			wantErr: &QuotaExceededError{
+				Quota:     "certificates",
+				Usage:     2,
+				Limit:     1,
+				Ingresses: []types.NamespacedName{ing2Key},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup:         tt.ingGroup,
+				quotas:           tt.quotas,
+				ingressResources: tt.ingressResources,
+			}
+			err := task.checkLoadBalancerQuotas(context.Background(), tt.listenPortConfigsByPort)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestQuotaExceededError_Error(t *testing.T) {
+	err := &QuotaExceededError{
+		Quota:     "listener rules",
+		Usage:     101,
+		Limit:     100,
+		Ingresses: []types.NamespacedName{{Namespace: "awesome-ns", Name: "ing-1"}, {Namespace: "awesome-ns", Name: "ing-2"}},
+	}
+	assert.EqualError(t, err, "listener rules quota exceeded: 101 > 100, offending ingresses: [awesome-ns/ing-1 awesome-ns/ing-2]")
+}
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
@@ -195,6 +196,87 @@ func Test_defaultModelBuildTask_buildTargetGroupPort(t *testing.T) {
 	}
 }
 
+func Test_defaultModelBuildTask_buildTargetGroupTargetType(t *testing.T) {
+	targetTypeIP := v1beta1.TargetTypeIP
+	tests := []struct {
+		name                 string
+		ing                  ClassifiedIngress
+		svcAndIngAnnotations map[string]string
+		enableIPTargetType   bool
+		want                 elbv2model.TargetType
+		wantErr              error
+	}{
+		{
+			name: "default targetType",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{},
+			},
+			enableIPTargetType: true,
+			want:               elbv2model.TargetTypeInstance,
+		},
+		{
+			name: "targetType from annotation",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{},
+			},
+			svcAndIngAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-type": "ip",
+			},
+			enableIPTargetType: true,
+			want:               elbv2model.TargetTypeIP,
+		},
+		{
+			name: "targetType from IngressClassParams takes precedence over annotation",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &v1beta1.IngressClassParams{
+						Spec: v1beta1.IngressClassParamsSpec{
+							TargetType: &targetTypeIP,
+						},
+					},
+				},
+			},
+			svcAndIngAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-type": "instance",
+			},
+			enableIPTargetType: true,
+			want:               elbv2model.TargetTypeIP,
+		},
+		{
+			name: "ip targetType from IngressClassParams when ip targetType is disabled",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &v1beta1.IngressClassParams{
+						Spec: v1beta1.IngressClassParamsSpec{
+							TargetType: &targetTypeIP,
+						},
+					},
+				},
+			},
+			enableIPTargetType: false,
+			wantErr:            errors.New("unsupported targetType: ip when EnableIPTargetType is false"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser:   annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				defaultTargetType:  elbv2model.TargetTypeInstance,
+				enableIPTargetType: tt.enableIPTargetType,
+			}
+			got, err := task.buildTargetGroupTargetType(context.Background(), tt.ing, tt.svcAndIngAnnotations)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_defaultModelBuildTask_buildTargetGroupTags(t *testing.T) {
 	type fields struct {
 		defaultTags         map[string]string
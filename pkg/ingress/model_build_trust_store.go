@@ -0,0 +1,121 @@
+package ingress
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+var invalidTrustStoreNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+// secretKeyCACertificatesBundle is the key of the ca certificates bundle within Secrets.
+const secretKeyCACertificatesBundle = "ca.crt"
+
+func (t *defaultModelBuildTask) buildListenerMutualAuthentication(ctx context.Context, port int64, cfg *MutualAuthenticationConfig, namespace string) (*elbv2model.MutualAuthenticationAttributes, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	mutualAuthentication := &elbv2model.MutualAuthenticationAttributes{
+		Mode: elbv2model.MutualAuthenticationMode(cfg.Mode),
+	}
+	if cfg.Mode != MutualAuthenticationModeVerify {
+		return mutualAuthentication, nil
+	}
+	mutualAuthentication.IgnoreClientCertificateExpiry = cfg.IgnoreClientCertificateExpiry
+	if cfg.TrustStoreARN != nil {
+		mutualAuthentication.TrustStoreARN = core.LiteralStringToken(*cfg.TrustStoreARN)
+		return mutualAuthentication, nil
+	}
+	ts, err := t.buildTrustStore(ctx, port, *cfg.CACertificatesBundle, namespace)
+	if err != nil {
+		return nil, err
+	}
+	mutualAuthentication.TrustStoreARN = ts.TrustStoreARN()
+	return mutualAuthentication, nil
+}
+
+func (t *defaultModelBuildTask) buildTrustStore(ctx context.Context, port int64, bundle CACertificatesBundleConfig, namespace string) (*elbv2model.TrustStore, error) {
+	tags, err := t.buildTrustStoreTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var bundleContent *string
+	if bundle.SecretName != nil {
+		content, err := t.loadCACertificatesBundleFromSecret(ctx, types.NamespacedName{Namespace: namespace, Name: *bundle.SecretName})
+		if err != nil {
+			return nil, err
+		}
+		bundleContent = &content
+	}
+	tsSpec := elbv2model.TrustStoreSpec{
+		Name:                                t.buildTrustStoreName(ctx, port, bundle, bundleContent),
+		CACertificatesBundleS3Bucket:        bundle.S3Bucket,
+		CACertificatesBundleS3Key:           bundle.S3Key,
+		CACertificatesBundleS3ObjectVersion: bundle.S3ObjectVersion,
+		CACertificatesBundle:                bundleContent,
+		Tags:                                tags,
+	}
+	tsResID := fmt.Sprintf("%v", port)
+	return elbv2model.NewTrustStore(t.stack, tsResID, tsSpec), nil
+}
+
+// loadCACertificatesBundleFromSecret loads the ca certificates bundle from Secret.
+// the Secret is tracked like the OIDC Secrets, so that a change to it triggers reconcile.
+func (t *defaultModelBuildTask) loadCACertificatesBundleFromSecret(ctx context.Context, secretKey types.NamespacedName) (string, error) {
+	secret := &corev1.Secret{}
+	if err := t.k8sClient.Get(ctx, secretKey, secret); err != nil {
+		return "", err
+	}
+	rawBundle, ok := secret.Data[secretKeyCACertificatesBundle]
+	if !ok || len(rawBundle) == 0 {
+		return "", errors.Errorf("missing %v, secret: %v", secretKeyCACertificatesBundle, secretKey)
+	}
+	t.secretKeys = append(t.secretKeys, secretKey)
+	return string(rawBundle), nil
+}
+
+// buildTrustStoreName computes the name of trust store.
+// the CA certificates bundle location and content are part of the name, so that a change to them results in a new trust store.
+func (t *defaultModelBuildTask) buildTrustStoreName(_ context.Context, port int64, bundle CACertificatesBundleConfig, bundleContent *string) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	_, _ = uuidHash.Write([]byte(strconv.FormatInt(port, 10)))
+	_, _ = uuidHash.Write([]byte(bundle.S3Bucket))
+	_, _ = uuidHash.Write([]byte(bundle.S3Key))
+	_, _ = uuidHash.Write([]byte(awssdk.StringValue(bundle.S3ObjectVersion)))
+	_, _ = uuidHash.Write([]byte(awssdk.StringValue(bundleContent)))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	if t.ingGroup.ID.IsExplicit() {
+		payload := invalidTrustStoreNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid)
+	}
+	sanitizedNamespace := invalidTrustStoreNamePattern.ReplaceAllString(t.ingGroup.ID.Namespace, "")
+	sanitizedName := invalidTrustStoreNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildTrustStoreTags(_ context.Context) (map[string]string, error) {
+	ingGroupTags, err := t.buildIngressGroupResourceTags(t.ingGroup.Members)
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.MergeStringMap(t.defaultTags, ingGroupTags), nil
+}
+
+// isCACertificatesBundleFromSecret checks whether the trust store of mutual authentication is created from a Secret.
+func isCACertificatesBundleFromSecret(cfg *MutualAuthenticationConfig) bool {
+	return cfg.CACertificatesBundle != nil && cfg.CACertificatesBundle.SecretName != nil
+}
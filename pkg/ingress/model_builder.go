@@ -7,6 +7,7 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
@@ -19,6 +20,7 @@ import (
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/lbconfig"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
@@ -32,7 +34,8 @@ const (
 // ModelBuilder is responsible for build mode stack for a IngressGroup.
 type ModelBuilder interface {
 	// build mode stack for a IngressGroup.
-	Build(ctx context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, error)
+	// returns the stack, the LoadBalancer, the referenced secrets, and the resources built for each member Ingress.
+	Build(ctx context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, map[types.NamespacedName]*IngressResources, error)
 }
 
 // NewDefaultModelBuilder constructs new defaultModelBuilder.
@@ -42,19 +45,21 @@ func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventR
 	authConfigBuilder AuthConfigBuilder, enhancedBackendBuilder EnhancedBackendBuilder,
 	trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager, featureGates config.FeatureGates,
 	vpcID string, clusterName string, defaultTags map[string]string, externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string,
-	backendSGProvider networkingpkg.BackendSGProvider, enableBackendSG bool, disableRestrictedSGRules bool, enableIPTargetType bool, logger logr.Logger) *defaultModelBuilder {
+	backendSGProvider networkingpkg.BackendSGProvider, enableBackendSG bool, disableRestrictedSGRules bool, enableIPTargetType bool, quotas LoadBalancerQuotas,
+	ruleConflictPolicy config.RuleConflictPolicy, logger logr.Logger) *defaultModelBuilder {
 	certDiscovery := NewACMCertDiscovery(acmClient, logger)
 	ruleOptimizer := NewDefaultRuleOptimizer(logger)
 	return &defaultModelBuilder{
 		k8sClient:                k8sClient,
 		eventRecorder:            eventRecorder,
-		ec2Client:                ec2Client,
 		vpcID:                    vpcID,
 		clusterName:              clusterName,
 		annotationParser:         annotationParser,
 		subnetsResolver:          subnetsResolver,
+		sgResolver:               networkingpkg.NewDefaultSecurityGroupResolver(ec2Client, vpcID),
 		backendSGProvider:        backendSGProvider,
 		certDiscovery:            certDiscovery,
+		lbConfigResolver:         lbconfig.NewDefaultResolver(k8sClient),
 		authConfigBuilder:        authConfigBuilder,
 		enhancedBackendBuilder:   enhancedBackendBuilder,
 		ruleOptimizer:            ruleOptimizer,
@@ -68,6 +73,8 @@ func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventR
 		enableBackendSG:          enableBackendSG,
 		disableRestrictedSGRules: disableRestrictedSGRules,
 		enableIPTargetType:       enableIPTargetType,
+		quotas:                   quotas,
+		ruleConflictPolicy:       ruleConflictPolicy,
 		logger:                   logger,
 	}
 }
@@ -78,15 +85,16 @@ var _ ModelBuilder = &defaultModelBuilder{}
 type defaultModelBuilder struct {
 	k8sClient     client.Client
 	eventRecorder record.EventRecorder
-	ec2Client     services.EC2
 
 	vpcID       string
 	clusterName string
 
 	annotationParser         annotations.Parser
 	subnetsResolver          networkingpkg.SubnetsResolver
+	sgResolver               networkingpkg.SecurityGroupResolver
 	backendSGProvider        networkingpkg.BackendSGProvider
 	certDiscovery            CertDiscovery
+	lbConfigResolver         lbconfig.Resolver
 	authConfigBuilder        AuthConfigBuilder
 	enhancedBackendBuilder   EnhancedBackendBuilder
 	ruleOptimizer            RuleOptimizer
@@ -100,21 +108,28 @@ type defaultModelBuilder struct {
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
 	enableIPTargetType       bool
+	quotas                   LoadBalancerQuotas
+	ruleConflictPolicy       config.RuleConflictPolicy
 
 	logger logr.Logger
 }
 
 // build mode stack for a IngressGroup.
-func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, error) {
+func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, map[types.NamespacedName]*IngressResources, error) {
 	stack := core.NewDefaultStack(core.StackID(ingGroup.ID))
+	members, err := b.resolveLoadBalancerConfigurations(ctx, ingGroup.Members)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	ingGroup.Members = members
 	task := &defaultModelBuildTask{
 		k8sClient:                b.k8sClient,
 		eventRecorder:            b.eventRecorder,
-		ec2Client:                b.ec2Client,
 		vpcID:                    b.vpcID,
 		clusterName:              b.clusterName,
 		annotationParser:         b.annotationParser,
 		subnetsResolver:          b.subnetsResolver,
+		sgResolver:               b.sgResolver,
 		certDiscovery:            b.certDiscovery,
 		authConfigBuilder:        b.authConfigBuilder,
 		enhancedBackendBuilder:   b.enhancedBackendBuilder,
@@ -127,6 +142,8 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		enableBackendSG:          b.enableBackendSG,
 		disableRestrictedSGRules: b.disableRestrictedSGRules,
 		enableIPTargetType:       b.enableIPTargetType,
+		quotas:                   b.quotas,
+		ruleConflictPolicy:       b.ruleConflictPolicy,
 
 		ingGroup: ingGroup,
 		stack:    stack,
@@ -148,25 +165,46 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		defaultHealthCheckMatcherHTTPCode:         "200",
 		defaultHealthCheckMatcherGRPCCode:         "12",
 
-		loadBalancer:    nil,
-		tgByResID:       make(map[string]*elbv2model.TargetGroup),
-		backendServices: make(map[types.NamespacedName]*corev1.Service),
+		loadBalancer:     nil,
+		tgByResID:        make(map[string]*elbv2model.TargetGroup),
+		backendServices:  make(map[types.NamespacedName]*corev1.Service),
+		ingressResources: make(map[types.NamespacedName]*IngressResources),
 	}
 	if err := task.run(ctx); err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
+	}
+	return task.stack, task.loadBalancer, task.secretKeys, task.ingressResources, nil
+}
+
+// resolveLoadBalancerConfigurations merges the LoadBalancerConfiguration referenced by each Ingress into its annotations.
+// Ingresses are copied before their annotations are replaced, so that the original objects are kept intact.
+func (b *defaultModelBuilder) resolveLoadBalancerConfigurations(ctx context.Context, members []ClassifiedIngress) ([]ClassifiedIngress, error) {
+	resolvedMembers := make([]ClassifiedIngress, 0, len(members))
+	for _, member := range members {
+		ingAnnotations, err := b.lbConfigResolver.ResolveIngressAnnotations(ctx, member.Ing)
+		if err != nil {
+			return nil, errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(member.Ing))
+		}
+		// resolved annotations are a superset of the Ingress annotations, thus only differ when there are extra entries.
+		if len(ingAnnotations) != len(member.Ing.Annotations) {
+			ing := member.Ing.DeepCopy()
+			ing.Annotations = ingAnnotations
+			member.Ing = ing
+		}
+		resolvedMembers = append(resolvedMembers, member)
 	}
-	return task.stack, task.loadBalancer, task.secretKeys, nil
+	return resolvedMembers, nil
 }
 
 // the default model build task
 type defaultModelBuildTask struct {
 	k8sClient              client.Client
 	eventRecorder          record.EventRecorder
-	ec2Client              services.EC2
 	vpcID                  string
 	clusterName            string
 	annotationParser       annotations.Parser
 	subnetsResolver        networkingpkg.SubnetsResolver
+	sgResolver             networkingpkg.SecurityGroupResolver
 	backendSGProvider      networkingpkg.BackendSGProvider
 	certDiscovery          CertDiscovery
 	authConfigBuilder      AuthConfigBuilder
@@ -184,6 +222,8 @@ type defaultModelBuildTask struct {
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
 	enableIPTargetType       bool
+	quotas                   LoadBalancerQuotas
+	ruleConflictPolicy       config.RuleConflictPolicy
 
 	defaultTags                               map[string]string
 	externalManagedTags                       sets.String
@@ -202,10 +242,11 @@ type defaultModelBuildTask struct {
 	defaultHealthCheckMatcherHTTPCode         string
 	defaultHealthCheckMatcherGRPCCode         string
 
-	loadBalancer    *elbv2model.LoadBalancer
-	tgByResID       map[string]*elbv2model.TargetGroup
-	backendServices map[types.NamespacedName]*corev1.Service
-	secretKeys      []types.NamespacedName
+	loadBalancer     *elbv2model.LoadBalancer
+	tgByResID        map[string]*elbv2model.TargetGroup
+	backendServices  map[types.NamespacedName]*corev1.Service
+	secretKeys       []types.NamespacedName
+	ingressResources map[types.NamespacedName]*IngressResources
 }
 
 func (t *defaultModelBuildTask) run(ctx context.Context) error {
@@ -272,6 +313,9 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 			return err
 		}
 	}
+	if err := t.checkLoadBalancerQuotas(ctx, listenPortConfigsByPort); err != nil {
+		return err
+	}
 
 	if err := t.buildLoadBalancerAddOns(ctx, lb.LoadBalancerARN()); err != nil {
 		return err
@@ -293,6 +337,9 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 	var mergedTLSCerts []string
 	mergedTLSCertsSet := sets.NewString()
 
+	var mergedMutualAuthenticationProvider *types.NamespacedName
+	var mergedMutualAuthentication *MutualAuthenticationConfig
+
 	for _, cfg := range listenPortConfigs {
 		if mergedProtocolProvider == nil {
 			mergedProtocolProvider = &cfg.ingKey
@@ -332,6 +379,17 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 			mergedTLSCertsSet.Insert(cert)
 			mergedTLSCerts = append(mergedTLSCerts, cert)
 		}
+
+		if cfg.listenPortConfig.mutualAuthentication != nil {
+			if mergedMutualAuthenticationProvider == nil {
+				mergedMutualAuthenticationProvider = &cfg.ingKey
+				mergedMutualAuthentication = cfg.listenPortConfig.mutualAuthentication
+			} else if !cmp.Equal(*mergedMutualAuthentication, *cfg.listenPortConfig.mutualAuthentication) ||
+				(isCACertificatesBundleFromSecret(mergedMutualAuthentication) && mergedMutualAuthenticationProvider.Namespace != cfg.ingKey.Namespace) {
+				return listenPortConfig{}, errors.Errorf("conflicting mutual-authentication, %v | %v",
+					*mergedMutualAuthenticationProvider, cfg.ingKey)
+			}
+		}
 	}
 
 	if len(mergedInboundCIDRv4s) == 0 && len(mergedInboundCIDRv6s) == 0 {
@@ -342,12 +400,19 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 		mergedSSLPolicy = awssdk.String(t.defaultSSLPolicy)
 	}
 
+	var mergedMutualAuthenticationNamespace string
+	if mergedMutualAuthenticationProvider != nil {
+		mergedMutualAuthenticationNamespace = mergedMutualAuthenticationProvider.Namespace
+	}
+
 	return listenPortConfig{
-		protocol:       mergedProtocol,
-		inboundCIDRv4s: mergedInboundCIDRv4s.List(),
-		inboundCIDRv6s: mergedInboundCIDRv6s.List(),
-		sslPolicy:      mergedSSLPolicy,
-		tlsCerts:       mergedTLSCerts,
+		protocol:                      mergedProtocol,
+		inboundCIDRv4s:                mergedInboundCIDRv4s.List(),
+		inboundCIDRv6s:                mergedInboundCIDRv6s.List(),
+		sslPolicy:                     mergedSSLPolicy,
+		tlsCerts:                      mergedTLSCerts,
+		mutualAuthentication:          mergedMutualAuthentication,
+		mutualAuthenticationNamespace: mergedMutualAuthenticationNamespace,
 	}, nil
 }
 
@@ -0,0 +1,298 @@
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// resolveRuleConflicts resolves the rules from different Ingresses that claim identical or overlapping conditions on a listener.
+// Without resolution, the claim from the Ingress that comes first in the IngressGroup silently shadows the others.
+// rules must be in the order of IngressGroup members. The rules that lose are dropped,
+// and the conflicts are recorded in the IngressResources of the losing Ingresses.
+func (t *defaultModelBuildTask) resolveRuleConflicts(port int64, rules []Rule) ([]Rule, error) {
+	policy := t.ruleConflictPolicy
+	if policy == "" {
+		policy = config.RuleConflictPolicyGroupOrder
+	}
+	var claimKeys []string
+	claimsByKey := make(map[string][]int)
+	for idx, rule := range rules {
+		claimKey, err := buildRuleClaimKey(rule.Conditions)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := claimsByKey[claimKey]; !exists {
+			claimKeys = append(claimKeys, claimKey)
+		}
+		claimsByKey[claimKey] = append(claimsByKey[claimKey], idx)
+	}
+
+	droppedRules := make(map[int]bool)
+	for _, claimKey := range claimKeys {
+		claims := claimsByKey[claimKey]
+		var claimants []types.NamespacedName
+		seenClaimants := make(map[types.NamespacedName]bool)
+		for _, idx := range claims {
+			ingKey := rules[idx].IngressKey
+			if !seenClaimants[ingKey] {
+				seenClaimants[ingKey] = true
+				claimants = append(claimants, ingKey)
+			}
+		}
+		if len(claimants) < 2 {
+			continue
+		}
+
+		winner, hasWinner := t.selectRuleClaimWinner(policy, claimants)
+		ruleDescription := describeRuleConditions(rules[claims[0]].Conditions)
+		for _, claimant := range claimants {
+			if hasWinner && claimant == winner {
+				continue
+			}
+			var conflict string
+			if hasWinner {
+				conflict = fmt.Sprintf("rule for %v on port %v is claimed by Ingress %v, which takes precedence by %v policy",
+					ruleDescription, port, winner, policy)
+			} else {
+				var opponents []string
+				for _, opponent := range claimants {
+					if opponent != claimant {
+						opponents = append(opponents, opponent.String())
+					}
+				}
+				conflict = fmt.Sprintf("rule for %v on port %v conflicts with Ingress %v, and is rejected by %v policy",
+					ruleDescription, port, strings.Join(opponents, ", "), policy)
+			}
+			t.appendRuleConflict(claimant, conflict)
+		}
+		for _, idx := range claims {
+			if !hasWinner || rules[idx].IngressKey != winner {
+				droppedRules[idx] = true
+			}
+		}
+	}
+	resolvedRules := rules
+	if len(droppedRules) != 0 {
+		resolvedRules = make([]Rule, 0, len(rules)-len(droppedRules))
+		for idx, rule := range rules {
+			if !droppedRules[idx] {
+				resolvedRules = append(resolvedRules, rule)
+			}
+		}
+	}
+	return t.resolveRuleShadows(port, policy, resolvedRules), nil
+}
+
+// resolveRuleShadows resolves the rules that are shadowed by a rule from another Ingress that is evaluated first,
+// i.e. the earlier rule matches every request that the shadowed rule matches, such as path /api/* over /api/v1/*.
+// rules must be in the order of evaluation, and must not contain identical claims from different Ingresses.
+//   - GroupOrder: the shadowed rule is dropped.
+//   - FirstCreated: the shadowed rule is moved in front of the shadowing rule if its Ingress is created earlier, and dropped otherwise.
+//   - RejectAll: both rules are dropped.
+func (t *defaultModelBuildTask) resolveRuleShadows(port int64, policy config.RuleConflictPolicy, rules []Rule) []Rule {
+	creationTimestamps := t.buildIngressCreationTimestamps()
+	droppedRules := make(map[int]bool)
+	var orderedRuleIdxes []int
+	for idx, rule := range rules {
+		shadowingPos := -1
+		for pos, prevIdx := range orderedRuleIdxes {
+			prevRule := rules[prevIdx]
+			if prevRule.IngressKey != rule.IngressKey && isCoveringRuleConditions(prevRule.Conditions, rule.Conditions) {
+				shadowingPos = pos
+				break
+			}
+		}
+		if shadowingPos == -1 {
+			orderedRuleIdxes = append(orderedRuleIdxes, idx)
+			continue
+		}
+
+		shadowingIdx := orderedRuleIdxes[shadowingPos]
+		shadowingRule := rules[shadowingIdx]
+		ruleDescription := describeRuleConditions(rule.Conditions)
+		shadowingRuleDescription := describeRuleConditions(shadowingRule.Conditions)
+		switch {
+		case policy == config.RuleConflictPolicyRejectAll:
+			t.appendRuleConflict(rule.IngressKey, fmt.Sprintf("rule for %v on port %v is shadowed by rule for %v of Ingress %v, and is rejected by %v policy",
+				ruleDescription, port, shadowingRuleDescription, shadowingRule.IngressKey, policy))
+			t.appendRuleConflict(shadowingRule.IngressKey, fmt.Sprintf("rule for %v on port %v shadows rule for %v of Ingress %v, and is rejected by %v policy",
+				shadowingRuleDescription, port, ruleDescription, rule.IngressKey, policy))
+			droppedRules[idx] = true
+			droppedRules[shadowingIdx] = true
+			orderedRuleIdxes = append(orderedRuleIdxes, idx)
+		case policy == config.RuleConflictPolicyFirstCreated && creationTimestamps[rule.IngressKey] < creationTimestamps[shadowingRule.IngressKey]:
+			t.appendRuleConflict(shadowingRule.IngressKey, fmt.Sprintf("rule for %v on port %v no longer matches requests for %v, which are claimed by Ingress %v that takes precedence by %v policy",
+				shadowingRuleDescription, port, ruleDescription, rule.IngressKey, policy))
+			orderedRuleIdxes = append(orderedRuleIdxes[:shadowingPos], append([]int{idx}, orderedRuleIdxes[shadowingPos:]...)...)
+		default:
+			t.appendRuleConflict(rule.IngressKey, fmt.Sprintf("rule for %v on port %v is shadowed by rule for %v of Ingress %v, which takes precedence by %v policy",
+				ruleDescription, port, shadowingRuleDescription, shadowingRule.IngressKey, policy))
+			droppedRules[idx] = true
+		}
+	}
+
+	resolvedRules := make([]Rule, 0, len(rules))
+	for _, idx := range orderedRuleIdxes {
+		if !droppedRules[idx] {
+			resolvedRules = append(resolvedRules, rules[idx])
+		}
+	}
+	return resolvedRules
+}
+
+// appendRuleConflict records a rule conflict for the Ingress.
+func (t *defaultModelBuildTask) appendRuleConflict(ingKey types.NamespacedName, conflict string) {
+	ingResources := t.buildIngressResources(ingKey)
+	ingResources.RuleConflicts = append(ingResources.RuleConflicts, conflict)
+}
+
+// buildIngressCreationTimestamps builds the creation timestamps of IngressGroup members.
+func (t *defaultModelBuildTask) buildIngressCreationTimestamps() map[types.NamespacedName]int64 {
+	creationTimestamps := make(map[types.NamespacedName]int64, len(t.ingGroup.Members))
+	for _, member := range t.ingGroup.Members {
+		creationTimestamps[k8s.NamespacedName(member.Ing)] = member.Ing.CreationTimestamp.UnixNano()
+	}
+	return creationTimestamps
+}
+
+// selectRuleClaimWinner selects the Ingress whose claim takes precedence among claimants according to policy.
+// claimants must be in the order of IngressGroup members. It returns false if none of the claims takes precedence.
+func (t *defaultModelBuildTask) selectRuleClaimWinner(policy config.RuleConflictPolicy, claimants []types.NamespacedName) (types.NamespacedName, bool) {
+	switch policy {
+	case config.RuleConflictPolicyRejectAll:
+		return types.NamespacedName{}, false
+	case config.RuleConflictPolicyFirstCreated:
+		creationTimestamps := t.buildIngressCreationTimestamps()
+		winner := claimants[0]
+		for _, claimant := range claimants[1:] {
+			if creationTimestamps[claimant] < creationTimestamps[winner] {
+				winner = claimant
+			}
+		}
+		return winner, true
+	default:
+		return claimants[0], true
+	}
+}
+
+// buildRuleClaimKey builds the key that identifies the requests matched by rule conditions, regardless of the conditions' order.
+func buildRuleClaimKey(conditions []elbv2model.RuleCondition) (string, error) {
+	conditionKeys := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		payload, err := json.Marshal(condition)
+		if err != nil {
+			return "", err
+		}
+		conditionKeys = append(conditionKeys, string(payload))
+	}
+	sort.Strings(conditionKeys)
+	return strings.Join(conditionKeys, "\n"), nil
+}
+
+// isCoveringRuleConditions checks whether lhsConditions match every request that rhsConditions match.
+// host-header and path-pattern conditions are compared by their wildcard patterns, while other conditions must be identical.
+func isCoveringRuleConditions(lhsConditions []elbv2model.RuleCondition, rhsConditions []elbv2model.RuleCondition) bool {
+	for _, lhsCondition := range lhsConditions {
+		covered := false
+		for _, rhsCondition := range rhsConditions {
+			if lhsCondition.Field != rhsCondition.Field {
+				continue
+			}
+			switch {
+			case lhsCondition.HostHeaderConfig != nil && rhsCondition.HostHeaderConfig != nil:
+				covered = isCoveringPatterns(lowerStrings(lhsCondition.HostHeaderConfig.Values), lowerStrings(rhsCondition.HostHeaderConfig.Values))
+			case lhsCondition.PathPatternConfig != nil && rhsCondition.PathPatternConfig != nil:
+				covered = isCoveringPatterns(lhsCondition.PathPatternConfig.Values, rhsCondition.PathPatternConfig.Values)
+			default:
+				covered = reflect.DeepEqual(lhsCondition, rhsCondition)
+			}
+			if covered {
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// isCoveringPatterns checks whether any of lhsPatterns matches every value that any of rhsPatterns matches.
+func isCoveringPatterns(lhsPatterns []string, rhsPatterns []string) bool {
+	for _, rhsPattern := range rhsPatterns {
+		covered := false
+		for _, lhsPattern := range lhsPatterns {
+			if isCoveringPattern(lhsPattern, rhsPattern) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// isCoveringPattern checks whether lhsPattern matches every value that rhsPattern matches,
+// where "*" matches zero or more characters and "?" matches exactly one character.
+// It's conservative for patterns that only cover each other in combination, such as "a*" and "*b" over "a*b".
+func isCoveringPattern(lhsPattern string, rhsPattern string) bool {
+	lhs := []rune(lhsPattern)
+	rhs := []rune(rhsPattern)
+	// covers[i][j] is whether lhs[i:] covers rhs[j:].
+	covers := make([][]bool, len(lhs)+1)
+	for i := range covers {
+		covers[i] = make([]bool, len(rhs)+1)
+	}
+	covers[len(lhs)][len(rhs)] = true
+	for i := len(lhs) - 1; i >= 0; i-- {
+		for j := len(rhs); j >= 0; j-- {
+			switch lhs[i] {
+			case '*':
+				// "*" matches nothing, or anything that rhs[j] matches, including another wildcard.
+				covers[i][j] = covers[i+1][j] || (j < len(rhs) && covers[i][j+1])
+			case '?':
+				covers[i][j] = j < len(rhs) && rhs[j] != '*' && covers[i+1][j+1]
+			default:
+				covers[i][j] = j < len(rhs) && rhs[j] == lhs[i] && covers[i+1][j+1]
+			}
+		}
+	}
+	return covers[0][0]
+}
+
+func lowerStrings(values []string) []string {
+	lowered := make([]string, 0, len(values))
+	for _, value := range values {
+		lowered = append(lowered, strings.ToLower(value))
+	}
+	return lowered
+}
+
+// describeRuleConditions describes the rule conditions in a human-readable form.
+func describeRuleConditions(conditions []elbv2model.RuleCondition) string {
+	var descriptions []string
+	for _, condition := range conditions {
+		switch {
+		case condition.HostHeaderConfig != nil:
+			descriptions = append(descriptions, fmt.Sprintf("host %v", strings.Join(condition.HostHeaderConfig.Values, ",")))
+		case condition.PathPatternConfig != nil:
+			descriptions = append(descriptions, fmt.Sprintf("path %v", strings.Join(condition.PathPatternConfig.Values, ",")))
+		default:
+			descriptions = append(descriptions, string(condition.Field))
+		}
+	}
+	if len(descriptions) == 0 {
+		return "all requests"
+	}
+	return strings.Join(descriptions, " ")
+}
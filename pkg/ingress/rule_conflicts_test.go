@@ -0,0 +1,335 @@
+package ingress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_resolveRuleConflicts(t *testing.T) {
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	ingA := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "awesome-ns",
+			Name:              "ing-a",
+			CreationTimestamp: metav1.NewTime(now),
+		},
+	}
+	ingB := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "awesome-ns",
+			Name:              "ing-b",
+			CreationTimestamp: metav1.NewTime(now.Add(-1 * time.Hour)),
+		},
+	}
+	ingAKey := types.NamespacedName{Namespace: "awesome-ns", Name: "ing-a"}
+	ingBKey := types.NamespacedName{Namespace: "awesome-ns", Name: "ing-b"}
+	apiConditions := []elbv2model.RuleCondition{
+		{
+			Field: elbv2model.RuleConditionFieldHostHeader,
+			HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+				Values: []string{"api.example.com"},
+			},
+		},
+		{
+			Field: elbv2model.RuleConditionFieldPathPattern,
+			PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+				Values: []string{"/*"},
+			},
+		},
+	}
+	reorderedAPIConditions := []elbv2model.RuleCondition{apiConditions[1], apiConditions[0]}
+	webConditions := []elbv2model.RuleCondition{
+		{
+			Field: elbv2model.RuleConditionFieldHostHeader,
+			HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+				Values: []string{"www.example.com"},
+			},
+		},
+	}
+	ruleAAPI := Rule{Conditions: apiConditions, IngressKey: ingAKey, Tags: map[string]string{"rule": "a-api"}}
+	ruleAWeb := Rule{Conditions: webConditions, IngressKey: ingAKey, Tags: map[string]string{"rule": "a-web"}}
+	ruleBAPI := Rule{Conditions: reorderedAPIConditions, IngressKey: ingBKey, Tags: map[string]string{"rule": "b-api"}}
+	apiV1Conditions := []elbv2model.RuleCondition{
+		{
+			Field: elbv2model.RuleConditionFieldHostHeader,
+			HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+				Values: []string{"api.example.com"},
+			},
+		},
+		{
+			Field: elbv2model.RuleConditionFieldPathPattern,
+			PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+				Values: []string{"/v1", "/v1/*"},
+			},
+		},
+	}
+	ruleBAPIV1 := Rule{Conditions: apiV1Conditions, IngressKey: ingBKey, Tags: map[string]string{"rule": "b-api-v1"}}
+	ruleAAPIV1 := Rule{Conditions: apiV1Conditions, IngressKey: ingAKey, Tags: map[string]string{"rule": "a-api-v1"}}
+
+	tests := []struct {
+		name              string
+		policy            config.RuleConflictPolicy
+		rules             []Rule
+		want              []Rule
+		wantRuleConflicts map[types.NamespacedName][]string
+	}{
+		{
+			name:   "no conflicts",
+			policy: config.RuleConflictPolicyRejectAll,
+			rules:  []Rule{ruleAAPI, ruleAWeb},
+			want:   []Rule{ruleAAPI, ruleAWeb},
+		},
+		{
+			name:   "conflicts resolved by group order",
+			policy: config.RuleConflictPolicyGroupOrder,
+			rules:  []Rule{ruleAAPI, ruleAWeb, ruleBAPI},
+			want:   []Rule{ruleAAPI, ruleAWeb},
+			wantRuleConflicts: map[types.NamespacedName][]string{
+				ingBKey: {"rule for host api.example.com path /* on port 443 is claimed by Ingress awesome-ns/ing-a, which takes precedence by GroupOrder policy"},
+			},
+		},
+		{
+			name:   "conflicts resolved by group order when policy is unspecified",
+			policy: "",
+			rules:  []Rule{ruleAAPI, ruleBAPI},
+			want:   []Rule{ruleAAPI},
+			wantRuleConflicts: map[types.NamespacedName][]string{
+				ingBKey: {"rule for host api.example.com path /* on port 443 is claimed by Ingress awesome-ns/ing-a, which takes precedence by GroupOrder policy"},
+			},
+		},
+		{
+			name:   "conflicts resolved by creation time",
+			policy: config.RuleConflictPolicyFirstCreated,
+			rules:  []Rule{ruleAAPI, ruleAWeb, ruleBAPI},
+			want:   []Rule{ruleAWeb, ruleBAPI},
+			wantRuleConflicts: map[types.NamespacedName][]string{
+				ingAKey: {"rule for host api.example.com path /* on port 443 is claimed by Ingress awesome-ns/ing-b, which takes precedence by FirstCreated policy"},
+			},
+		},
+		{
+			name:   "conflicts rejected",
+			policy: config.RuleConflictPolicyRejectAll,
+			rules:  []Rule{ruleAAPI, ruleAWeb, ruleBAPI},
+			want:   []Rule{ruleAWeb},
+			wantRuleConflicts: map[types.NamespacedName][]string{
+				ingAKey: {"rule for host api.example.com path /* on port 443 conflicts with Ingress awesome-ns/ing-b, and is rejected by RejectAll policy"},
+				ingBKey: {"rule for host api.example.com path /* on port 443 conflicts with Ingress awesome-ns/ing-a, and is rejected by RejectAll policy"},
+			},
+		},
+		{
+			name:   "shadowed rules resolved by group order",
+			policy: config.RuleConflictPolicyGroupOrder,
+			rules:  []Rule{ruleAAPI, ruleAWeb, ruleBAPIV1},
+			want:   []Rule{ruleAAPI, ruleAWeb},
+			wantRuleConflicts: map[types.NamespacedName][]string{
+				ingBKey: {"rule for host api.example.com path /v1,/v1/* on port 443 is shadowed by rule for host api.example.com path /* of Ingress awesome-ns/ing-a, which takes precedence by GroupOrder policy"},
+			},
+		},
+		{
+			name:   "shadowed rules moved in front by creation time",
+			policy: config.RuleConflictPolicyFirstCreated,
+			rules:  []Rule{ruleAAPI, ruleAWeb, ruleBAPIV1},
+			want:   []Rule{ruleBAPIV1, ruleAAPI, ruleAWeb},
+			wantRuleConflicts: map[types.NamespacedName][]string{
+				ingAKey: {"rule for host api.example.com path /* on port 443 no longer matches requests for host api.example.com path /v1,/v1/*, which are claimed by Ingress awesome-ns/ing-b that takes precedence by FirstCreated policy"},
+			},
+		},
+		{
+			name:   "shadowed rules resolved by creation time",
+			policy: config.RuleConflictPolicyFirstCreated,
+			rules:  []Rule{ruleBAPI, ruleAAPIV1, ruleAWeb},
+			want:   []Rule{ruleBAPI, ruleAWeb},
+			wantRuleConflicts: map[types.NamespacedName][]string{
+				ingAKey: {"rule for host api.example.com path /v1,/v1/* on port 443 is shadowed by rule for path /* host api.example.com of Ingress awesome-ns/ing-b, which takes precedence by FirstCreated policy"},
+			},
+		},
+		{
+			name:   "shadowed rules rejected",
+			policy: config.RuleConflictPolicyRejectAll,
+			rules:  []Rule{ruleAAPI, ruleAWeb, ruleBAPIV1},
+			want:   []Rule{ruleAWeb},
+			wantRuleConflicts: map[types.NamespacedName][]string{
+				ingAKey: {"rule for host api.example.com path /* on port 443 shadows rule for host api.example.com path /v1,/v1/* of Ingress awesome-ns/ing-b, and is rejected by RejectAll policy"},
+				ingBKey: {"rule for host api.example.com path /v1,/v1/* on port 443 is shadowed by rule for host api.example.com path /* of Ingress awesome-ns/ing-a, and is rejected by RejectAll policy"},
+			},
+		},
+		{
+			name:   "more specific rules evaluated first are not conflicts",
+			policy: config.RuleConflictPolicyRejectAll,
+			rules:  []Rule{ruleBAPIV1, ruleAAPI, ruleAWeb},
+			want:   []Rule{ruleBAPIV1, ruleAAPI, ruleAWeb},
+		},
+		{
+			name:   "duplicated rules within same Ingress are not conflicts",
+			policy: config.RuleConflictPolicyRejectAll,
+			rules:  []Rule{ruleAAPI, ruleAAPI},
+			want:   []Rule{ruleAAPI, ruleAAPI},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ruleConflictPolicy: tt.policy,
+				ingGroup: Group{
+					Members: []ClassifiedIngress{{Ing: ingA}, {Ing: ingB}},
+				},
+			}
+			got, err := task.resolveRuleConflicts(443, tt.rules)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			var gotRuleConflicts map[types.NamespacedName][]string
+			for ingKey, ingResources := range task.ingressResources {
+				if len(ingResources.RuleConflicts) == 0 {
+					continue
+				}
+				if gotRuleConflicts == nil {
+					gotRuleConflicts = make(map[types.NamespacedName][]string)
+				}
+				gotRuleConflicts[ingKey] = ingResources.RuleConflicts
+			}
+			assert.Equal(t, tt.wantRuleConflicts, gotRuleConflicts)
+		})
+	}
+}
+
+func Test_isCoveringRuleConditions(t *testing.T) {
+	hostCondition := func(hosts ...string) elbv2model.RuleCondition {
+		return elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHostHeader,
+			HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+				Values: hosts,
+			},
+		}
+	}
+	pathCondition := func(paths ...string) elbv2model.RuleCondition {
+		return elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldPathPattern,
+			PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+				Values: paths,
+			},
+		}
+	}
+	methodCondition := func(methods ...string) elbv2model.RuleCondition {
+		return elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+			HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+				Values: methods,
+			},
+		}
+	}
+	tests := []struct {
+		name          string
+		lhsConditions []elbv2model.RuleCondition
+		rhsConditions []elbv2model.RuleCondition
+		want          bool
+	}{
+		{
+			name:          "prefix path covers nested prefix path",
+			lhsConditions: []elbv2model.RuleCondition{pathCondition("/api/*")},
+			rhsConditions: []elbv2model.RuleCondition{pathCondition("/api/v1", "/api/v1/*")},
+			want:          true,
+		},
+		{
+			name:          "nested prefix path doesn't cover prefix path",
+			lhsConditions: []elbv2model.RuleCondition{pathCondition("/api/v1/*")},
+			rhsConditions: []elbv2model.RuleCondition{pathCondition("/api/*")},
+			want:          false,
+		},
+		{
+			name:          "path covered by any of the patterns",
+			lhsConditions: []elbv2model.RuleCondition{pathCondition("/static/*", "/api/*")},
+			rhsConditions: []elbv2model.RuleCondition{pathCondition("/api/v?/users")},
+			want:          true,
+		},
+		{
+			name:          "single character wildcard doesn't cover multiple characters wildcard",
+			lhsConditions: []elbv2model.RuleCondition{pathCondition("/api/v?")},
+			rhsConditions: []elbv2model.RuleCondition{pathCondition("/api/v*")},
+			want:          false,
+		},
+		{
+			name:          "wildcard host covers subdomain case-insensitively",
+			lhsConditions: []elbv2model.RuleCondition{hostCondition("*.example.com")},
+			rhsConditions: []elbv2model.RuleCondition{hostCondition("API.example.com"), pathCondition("/*")},
+			want:          true,
+		},
+		{
+			name:          "any host covers a host",
+			lhsConditions: []elbv2model.RuleCondition{pathCondition("/api/*")},
+			rhsConditions: []elbv2model.RuleCondition{hostCondition("api.example.com"), pathCondition("/api/v1/*")},
+			want:          true,
+		},
+		{
+			name:          "a host doesn't cover any host",
+			lhsConditions: []elbv2model.RuleCondition{hostCondition("api.example.com"), pathCondition("/api/*")},
+			rhsConditions: []elbv2model.RuleCondition{pathCondition("/api/v1/*")},
+			want:          false,
+		},
+		{
+			name:          "different hosts don't cover each other",
+			lhsConditions: []elbv2model.RuleCondition{hostCondition("*.example.com"), pathCondition("/*")},
+			rhsConditions: []elbv2model.RuleCondition{hostCondition("example.com"), pathCondition("/api/*")},
+			want:          false,
+		},
+		{
+			name:          "identical other conditions are covered",
+			lhsConditions: []elbv2model.RuleCondition{methodCondition("GET"), pathCondition("/*")},
+			rhsConditions: []elbv2model.RuleCondition{pathCondition("/api/*"), methodCondition("GET")},
+			want:          true,
+		},
+		{
+			name:          "different other conditions are not covered",
+			lhsConditions: []elbv2model.RuleCondition{methodCondition("GET"), pathCondition("/*")},
+			rhsConditions: []elbv2model.RuleCondition{methodCondition("POST"), pathCondition("/api/*")},
+			want:          false,
+		},
+		{
+			name:          "other conditions narrow down covered requests",
+			lhsConditions: []elbv2model.RuleCondition{pathCondition("/*")},
+			rhsConditions: []elbv2model.RuleCondition{methodCondition("POST"), pathCondition("/api/*")},
+			want:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isCoveringRuleConditions(tt.lhsConditions, tt.rhsConditions)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_isCoveringPattern(t *testing.T) {
+	tests := []struct {
+		lhsPattern string
+		rhsPattern string
+		want       bool
+	}{
+		{lhsPattern: "/api", rhsPattern: "/api", want: true},
+		{lhsPattern: "/api", rhsPattern: "/api/", want: false},
+		{lhsPattern: "/*", rhsPattern: "/", want: true},
+		{lhsPattern: "/api/*", rhsPattern: "/api/v1/*", want: true},
+		{lhsPattern: "/api/v1/*", rhsPattern: "/api/*", want: false},
+		{lhsPattern: "/api*", rhsPattern: "/api/v1", want: true},
+		{lhsPattern: "/api/*", rhsPattern: "/api", want: false},
+		{lhsPattern: "/*/users", rhsPattern: "/api/v?/users", want: true},
+		{lhsPattern: "/api/v?", rhsPattern: "/api/v?", want: true},
+		{lhsPattern: "/api/v?", rhsPattern: "/api/v1", want: true},
+		{lhsPattern: "/api/v1", rhsPattern: "/api/v?", want: false},
+		{lhsPattern: "/api/v?", rhsPattern: "/api/v*", want: false},
+		{lhsPattern: "*.example.com", rhsPattern: "*.api.example.com", want: true},
+		{lhsPattern: "*.example.com", rhsPattern: "example.com", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lhsPattern+" over "+tt.rhsPattern, func(t *testing.T) {
+			got := isCoveringPattern(tt.lhsPattern, tt.rhsPattern)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
@@ -13,6 +14,9 @@ type Rule struct {
 	Conditions []elbv2model.RuleCondition
 	Actions    []elbv2model.Action
 	Tags       map[string]string
+
+	// the Ingress that this rule is built for.
+	IngressKey types.NamespacedName
 }
 
 // RuleOptimizer will optimize the listener Rules for a single Listener.
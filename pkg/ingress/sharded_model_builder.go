@@ -0,0 +1,165 @@
+package ingress
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// ShardModel is the model built for a shard of IngressGroup.
+type ShardModel struct {
+	// Group is the shard of IngressGroup, which has an unique GroupID per shard.
+	Group Group
+
+	// Stack is the model stack for this shard.
+	Stack core.Stack
+
+	// LoadBalancer is the load balancer for this shard, which is nil if this shard have no members.
+	LoadBalancer *elbv2model.LoadBalancer
+
+	// SecretKeys are the secrets referenced by this shard.
+	SecretKeys []types.NamespacedName
+
+	// IngressResources are the resources built for each member Ingress of this shard.
+	IngressResources map[types.NamespacedName]*IngressResources
+}
+
+// ShardedModelBuilder builds the model stacks for an IngressGroup, which might be sharded across multiple load balancers.
+type ShardedModelBuilder interface {
+	// Build builds the model stacks for IngressGroup, one per shard.
+	Build(ctx context.Context, ingGroup Group) ([]ShardModel, error)
+}
+
+// NewDefaultShardedModelBuilder constructs new defaultShardedModelBuilder.
+func NewDefaultShardedModelBuilder(modelBuilder ModelBuilder, enableSharding bool, logger logr.Logger) *defaultShardedModelBuilder {
+	return &defaultShardedModelBuilder{
+		modelBuilder:   modelBuilder,
+		enableSharding: enableSharding,
+		logger:         logger,
+	}
+}
+
+var _ ShardedModelBuilder = &defaultShardedModelBuilder{}
+
+// default implementation for ShardedModelBuilder.
+// Ingresses are assigned to shards deterministically:
+//  1. an Ingress stays on the shard recorded in its ReconcileStatus, so that its load balancer hostname is stable.
+//  2. when a shard exceeds the load balancer quotas, the offending Ingresses are moved to the next shard.
+//
+// Sharding only applies to explicit IngressGroups. When sharding is disabled, every Ingress is assigned to shard 0,
+// and the load balancers of other shards are deleted.
+type defaultShardedModelBuilder struct {
+	modelBuilder   ModelBuilder
+	enableSharding bool
+	logger         logr.Logger
+}
+
+func (b *defaultShardedModelBuilder) Build(ctx context.Context, ingGroup Group) ([]ShardModel, error) {
+	shardsWithResources := map[int]bool{0: true}
+	membersByShard := make(map[int][]ClassifiedIngress)
+	maxShard := 0
+	for _, member := range ingGroup.Members {
+		shard, deployedShard, deployed := b.assignShard(ingGroup, member.Ing)
+		if deployed {
+			shardsWithResources[deployedShard] = true
+			if deployedShard > maxShard {
+				maxShard = deployedShard
+			}
+		}
+		membersByShard[shard] = append(membersByShard[shard], member)
+		if shard > maxShard {
+			maxShard = shard
+		}
+	}
+	inactiveMembersByShard := make(map[int][]*networking.Ingress)
+	for _, inactiveMember := range ingGroup.InactiveMembers {
+		// inactive members are assigned to the shard they're deployed to, so that their resources are cleaned up.
+		_, deployedShard, deployed := b.assignShard(ingGroup, inactiveMember)
+		if deployed {
+			shardsWithResources[deployedShard] = true
+			if deployedShard > maxShard {
+				maxShard = deployedShard
+			}
+		}
+		inactiveMembersByShard[deployedShard] = append(inactiveMembersByShard[deployedShard], inactiveMember)
+	}
+
+	var shardModels []ShardModel
+	for shard := 0; shard <= maxShard; shard++ {
+		if len(membersByShard[shard]) == 0 && len(inactiveMembersByShard[shard]) == 0 && !shardsWithResources[shard] {
+			continue
+		}
+		for {
+			shardGroup := Group{
+				ID:              NewGroupIDForShard(ingGroup.ID, shard),
+				Members:         membersByShard[shard],
+				InactiveMembers: inactiveMembersByShard[shard],
+				Shard:           shard,
+			}
+			stack, lb, secrets, ingResources, err := b.modelBuilder.Build(ctx, shardGroup)
+			if err == nil {
+				shardModels = append(shardModels, ShardModel{
+					Group:            shardGroup,
+					Stack:            stack,
+					LoadBalancer:     lb,
+					SecretKeys:       secrets,
+					IngressResources: ingResources,
+				})
+				break
+			}
+			var quotaExceededErr *QuotaExceededError
+			if !b.shardingEnabled(ingGroup) || !errors.As(err, &quotaExceededErr) ||
+				len(quotaExceededErr.Ingresses) >= len(shardGroup.Members) {
+				return nil, err
+			}
+			b.logger.V(1).Info("moving ingresses to next shard",
+				"ingressGroup", ingGroup.ID, "shard", shard, "ingresses", quotaExceededErr.Ingresses, "reason", err.Error())
+			membersByShard[shard], membersByShard[shard+1] = moveShardMembers(membersByShard[shard], membersByShard[shard+1], quotaExceededErr.Ingresses)
+			if shard+1 > maxShard {
+				maxShard = shard + 1
+			}
+		}
+	}
+	return shardModels, nil
+}
+
+// shardingEnabled checks whether the IngressGroup can be sharded.
+func (b *defaultShardedModelBuilder) shardingEnabled(ingGroup Group) bool {
+	return b.enableSharding && ingGroup.ID.IsExplicit()
+}
+
+// assignShard returns the shard that Ingress is assigned to, along with the shard that it's currently deployed to if any.
+func (b *defaultShardedModelBuilder) assignShard(ingGroup Group, ing *networking.Ingress) (int, int, bool) {
+	status, exists := ParseReconcileStatus(ing)
+	if !exists || status.GroupName != ingGroup.ID.String() {
+		return 0, 0, false
+	}
+	deployed := status.LoadBalancerARN != ""
+	if !b.shardingEnabled(ingGroup) {
+		return 0, status.Shard, deployed
+	}
+	return status.Shard, status.Shard, deployed
+}
+
+// moveShardMembers moves the Ingresses with ingKeys from members to the end of nextMembers.
+func moveShardMembers(members []ClassifiedIngress, nextMembers []ClassifiedIngress, ingKeys []types.NamespacedName) ([]ClassifiedIngress, []ClassifiedIngress) {
+	ingKeySet := make(map[types.NamespacedName]bool, len(ingKeys))
+	for _, ingKey := range ingKeys {
+		ingKeySet[ingKey] = true
+	}
+	var remainingMembers []ClassifiedIngress
+	for _, member := range members {
+		if ingKeySet[k8s.NamespacedName(member.Ing)] {
+			nextMembers = append(nextMembers, member)
+		} else {
+			remainingMembers = append(remainingMembers, member)
+		}
+	}
+	return remainingMembers, nextMembers
+}
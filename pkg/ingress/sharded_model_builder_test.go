@@ -0,0 +1,258 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// stubQuotaModelBuilder is a ModelBuilder where each Ingress uses a fixed number of listener rules.
+type stubQuotaModelBuilder struct {
+	rulesByIngName   map[string]int
+	maxListenerRules int
+	buildErr         error
+}
+
+func (b *stubQuotaModelBuilder) Build(_ context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, map[types.NamespacedName]*IngressResources, error) {
+	if b.buildErr != nil {
+		return nil, nil, nil, nil, b.buildErr
+	}
+	var offendingIngKeys []types.NamespacedName
+	rules := 0
+	for _, member := range ingGroup.Members {
+		rules += b.rulesByIngName[member.Ing.Name]
+		if rules > b.maxListenerRules {
+			offendingIngKeys = append(offendingIngKeys, k8s.NamespacedName(member.Ing))
+		}
+	}
+	if len(offendingIngKeys) != 0 {
+		return nil, nil, nil, nil, &QuotaExceededError{Quota: "listener rules", Usage: rules, Limit: b.maxListenerRules, Ingresses: offendingIngKeys}
+	}
+	stack := core.NewDefaultStack(core.StackID(ingGroup.ID))
+	var lb *elbv2model.LoadBalancer
+	if len(ingGroup.Members) != 0 {
+		lb = elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+	}
+	return stack, lb, nil, nil, nil
+}
+
+func Test_defaultShardedModelBuilder_Build(t *testing.T) {
+	newIngress := func(name string, rawStatus string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+		}
+		if rawStatus != "" {
+			ing.Annotations = map[string]string{
+				"alb.ingress.kubernetes.io/status": rawStatus,
+			}
+		}
+		return ing
+	}
+	ing1 := newIngress("ing-1", "")
+	ing2 := newIngress("ing-2", "")
+	ing3 := newIngress("ing-3", "")
+	ing1OnShard1 := newIngress("ing-1", `{"groupName":"awesome-group","observedGeneration":1,"rulesAccepted":true,"shard":1,"loadBalancerARN":"lb-arn-1"}`)
+	ing2OnShard0 := newIngress("ing-2", `{"groupName":"awesome-group","observedGeneration":1,"rulesAccepted":true,"loadBalancerARN":"lb-arn-0"}`)
+	ing3OnShard2 := newIngress("ing-3", `{"groupName":"awesome-group","observedGeneration":1,"rulesAccepted":true,"shard":2,"loadBalancerARN":"lb-arn-2"}`)
+	explicitGroupID := NewGroupIDForExplicitGroup("awesome-group")
+
+	type shard struct {
+		groupID         GroupID
+		members         []*networking.Ingress
+		inactiveMembers []*networking.Ingress
+	}
+	tests := []struct {
+		name           string
+		enableSharding bool
+		ingGroup       Group
+		modelBuilder   *stubQuotaModelBuilder
+		wantShards     []shard
+		wantErr        error
+	}{
+		{
+			name:           "group within quotas",
+			enableSharding: true,
+			ingGroup: Group{
+				ID:      explicitGroupID,
+				Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}},
+			},
+			modelBuilder: &stubQuotaModelBuilder{
+				rulesByIngName:   map[string]int{"ing-1": 1, "ing-2": 1},
+				maxListenerRules: 2,
+			},
+			wantShards: []shard{
+				{groupID: explicitGroupID, members: []*networking.Ingress{ing1, ing2}},
+			},
+		},
+		{
+			name:           "group exceeds quotas with sharding disabled",
+			enableSharding: false,
+			ingGroup: Group{
+				ID:      explicitGroupID,
+				Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}},
+			},
+			modelBuilder: &stubQuotaModelBuilder{
+				rulesByIngName:   map[string]int{"ing-1": 2, "ing-2": 1},
+				maxListenerRules: 2,
+			},
+			wantErr: &QuotaExceededError{
+				Quota:     "listener rules",
+				Usage:     3,
+				Limit:     2,
+				Ingresses: []types.NamespacedName{{Namespace: "awesome-ns", Name: "ing-2"}},
+			},
+		},
+		{
+			name:           "group exceeds quotas with sharding enabled",
+			enableSharding: true,
+			ingGroup: Group{
+				ID:      explicitGroupID,
+				Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}, {Ing: ing3}},
+			},
+			modelBuilder: &stubQuotaModelBuilder{
+				rulesByIngName:   map[string]int{"ing-1": 2, "ing-2": 1, "ing-3": 2},
+				maxListenerRules: 2,
+			},
+			wantShards: []shard{
+				{groupID: explicitGroupID, members: []*networking.Ingress{ing1}},
+				{groupID: GroupID{Name: "awesome-group:1"}, members: []*networking.Ingress{ing2}},
+				{groupID: GroupID{Name: "awesome-group:2"}, members: []*networking.Ingress{ing3}},
+			},
+		},
+		{
+			name:           "ingresses stay on their shards",
+			enableSharding: true,
+			ingGroup: Group{
+				ID:      explicitGroupID,
+				Members: []ClassifiedIngress{{Ing: ing1OnShard1}, {Ing: ing2OnShard0}, {Ing: ing3}},
+			},
+			modelBuilder: &stubQuotaModelBuilder{
+				rulesByIngName:   map[string]int{"ing-1": 1, "ing-2": 1, "ing-3": 2},
+				maxListenerRules: 2,
+			},
+			wantShards: []shard{
+				{groupID: explicitGroupID, members: []*networking.Ingress{ing2OnShard0}},
+				{groupID: GroupID{Name: "awesome-group:1"}, members: []*networking.Ingress{ing1OnShard1}},
+				{groupID: GroupID{Name: "awesome-group:2"}, members: []*networking.Ingress{ing3}},
+			},
+		},
+		{
+			name:           "inactive members and stale shards are cleaned up",
+			enableSharding: true,
+			ingGroup: Group{
+				ID:              explicitGroupID,
+				Members:         []ClassifiedIngress{{Ing: ing2OnShard0}},
+				InactiveMembers: []*networking.Ingress{ing3OnShard2},
+			},
+			modelBuilder: &stubQuotaModelBuilder{
+				rulesByIngName:   map[string]int{"ing-2": 1},
+				maxListenerRules: 2,
+			},
+			wantShards: []shard{
+				{groupID: explicitGroupID, members: []*networking.Ingress{ing2OnShard0}},
+				{groupID: GroupID{Name: "awesome-group:2"}, inactiveMembers: []*networking.Ingress{ing3OnShard2}},
+			},
+		},
+		{
+			name:           "ingresses are moved back to shard 0 when sharding is disabled",
+			enableSharding: false,
+			ingGroup: Group{
+				ID:      explicitGroupID,
+				Members: []ClassifiedIngress{{Ing: ing1OnShard1}, {Ing: ing2OnShard0}},
+			},
+			modelBuilder: &stubQuotaModelBuilder{
+				rulesByIngName:   map[string]int{"ing-1": 1, "ing-2": 1},
+				maxListenerRules: 2,
+			},
+			wantShards: []shard{
+				{groupID: explicitGroupID, members: []*networking.Ingress{ing1OnShard1, ing2OnShard0}},
+				{groupID: GroupID{Name: "awesome-group:1"}},
+			},
+		},
+		{
+			name:           "implicit group is not sharded",
+			enableSharding: true,
+			ingGroup: Group{
+				ID:      NewGroupIDForImplicitGroup(k8s.NamespacedName(ing1)),
+				Members: []ClassifiedIngress{{Ing: ing1}},
+			},
+			modelBuilder: &stubQuotaModelBuilder{
+				rulesByIngName:   map[string]int{"ing-1": 3},
+				maxListenerRules: 2,
+			},
+			wantErr: &QuotaExceededError{
+				Quota:     "listener rules",
+				Usage:     3,
+				Limit:     2,
+				Ingresses: []types.NamespacedName{{Namespace: "awesome-ns", Name: "ing-1"}},
+			},
+		},
+		{
+			name:           "single ingress exceeds quotas with sharding enabled",
+			enableSharding: true,
+			ingGroup: Group{
+				ID:      explicitGroupID,
+				Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}},
+			},
+			modelBuilder: &stubQuotaModelBuilder{
+				rulesByIngName:   map[string]int{"ing-1": 1, "ing-2": 3},
+				maxListenerRules: 2,
+			},
+			wantErr: &QuotaExceededError{
+				Quota:     "listener rules",
+				Usage:     3,
+				Limit:     2,
+				Ingresses: []types.NamespacedName{{Namespace: "awesome-ns", Name: "ing-2"}},
+			},
+		},
+		{
+			name:           "other errors are not retried",
+			enableSharding: true,
+			ingGroup: Group{
+				ID:      explicitGroupID,
+				Members: []ClassifiedIngress{{Ing: ing1}},
+			},
+			modelBuilder: &stubQuotaModelBuilder{
+				buildErr: errors.New("some error"),
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewDefaultShardedModelBuilder(tt.modelBuilder, tt.enableSharding, log.Log)
+			got, err := b.Build(context.Background(), tt.ingGroup)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			var gotShards []shard
+			for _, shardModel := range got {
+				var members []*networking.Ingress
+				for _, member := range shardModel.Group.Members {
+					members = append(members, member.Ing)
+				}
+				gotShards = append(gotShards, shard{
+					groupID:         shardModel.Group.ID,
+					members:         members,
+					inactiveMembers: shardModel.Group.InactiveMembers,
+				})
+				assert.Equal(t, core.StackID(shardModel.Group.ID), shardModel.Stack.StackID())
+			}
+			assert.Equal(t, tt.wantShards, gotShards)
+		})
+	}
+}
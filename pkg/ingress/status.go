@@ -0,0 +1,162 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// StatusAnnotation is the annotation on Ingress that holds the ReconcileStatus.
+const StatusAnnotation = annotations.AnnotationPrefixIngress + "/" + annotations.IngressSuffixStatus
+
+// IngressResources contains the resources built for an Ingress within IngressGroup.
+type IngressResources struct {
+	// ListenerRules are the listener rules built from the Ingress's rules.
+	ListenerRules []*elbv2model.ListenerRule
+
+	// TargetGroups are the target groups for the Ingress's backends.
+	TargetGroups []*elbv2model.TargetGroup
+
+	// RuleConflicts describes the Ingress's rules that are dropped or shadowed due to conflicts with rules from other Ingresses.
+	RuleConflicts []string
+}
+
+// ReconcileStatus is the structured feedback of reconciling an Ingress, which is published via StatusAnnotation.
+type ReconcileStatus struct {
+	// GroupName is the IngressGroup that the Ingress belongs to.
+	GroupName string `json:"groupName"`
+
+	// ObservedGeneration is the Ingress's generation that this status is computed for.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// RulesAccepted is whether the Ingress's rules are successfully deployed to the load balancer.
+	RulesAccepted bool `json:"rulesAccepted"`
+
+	// Shard is the shard of IngressGroup that the Ingress is assigned to, see ShardedModelBuilder.
+	Shard int `json:"shard,omitempty"`
+
+	// LoadBalancerARN is the ARN of load balancer for the IngressGroup.
+	LoadBalancerARN string `json:"loadBalancerARN,omitempty"`
+
+	// ListenerRuleARNs are the ARNs of listener rules created for the Ingress.
+	ListenerRuleARNs []string `json:"listenerRuleARNs,omitempty"`
+
+	// TargetGroupARNs are the ARNs of target groups created for the Ingress.
+	TargetGroupARNs []string `json:"targetGroupARNs,omitempty"`
+
+	// LastError is the error from last failed reconcile, which is cleared once reconcile succeeds.
+	LastError string `json:"lastError,omitempty"`
+
+	// RuleConflicts describes the Ingress's rules that are not deployed or shadowed due to conflicts with rules from other Ingresses.
+	RuleConflicts []string `json:"ruleConflicts,omitempty"`
+}
+
+// BuildReconcileStatus computes the ReconcileStatus for Ingress based on the reconcile result of its IngressGroup.
+// lb and ingResources are the deployed resources if reconcileErr is nil,
+// otherwise the ARNs and shard from the Ingress's existing status are kept, since they are still in effect.
+func BuildReconcileStatus(ctx context.Context, ing *networking.Ingress, groupID GroupID, shard int, lb *elbv2model.LoadBalancer,
+	ingResources *IngressResources, reconcileErr error) (ReconcileStatus, error) {
+	status, _ := ParseReconcileStatus(ing)
+	status.GroupName = groupID.String()
+	status.ObservedGeneration = ing.Generation
+	if reconcileErr != nil {
+		status.RulesAccepted = false
+		status.LastError = reconcileErr.Error()
+		return status, nil
+	}
+
+	status.RulesAccepted = true
+	status.Shard = shard
+	status.LastError = ""
+	status.LoadBalancerARN = ""
+	if lb != nil {
+		lbARN, err := lb.LoadBalancerARN().Resolve(ctx)
+		if err != nil {
+			return ReconcileStatus{}, err
+		}
+		status.LoadBalancerARN = lbARN
+	}
+	ruleARNs := sets.NewString()
+	tgARNs := sets.NewString()
+	if ingResources != nil {
+		for _, lr := range ingResources.ListenerRules {
+			if lr.Status == nil {
+				return ReconcileStatus{}, errors.Errorf("ListenerRule is not fulfilled yet: %v", lr.ID())
+			}
+			ruleARNs.Insert(lr.Status.RuleARN)
+		}
+		for _, tg := range ingResources.TargetGroups {
+			tgARN, err := tg.TargetGroupARN().Resolve(ctx)
+			if err != nil {
+				return ReconcileStatus{}, err
+			}
+			tgARNs.Insert(tgARN)
+		}
+	}
+	status.ListenerRuleARNs = nil
+	if ruleARNs.Len() != 0 {
+		status.ListenerRuleARNs = ruleARNs.List()
+	}
+	status.TargetGroupARNs = nil
+	if tgARNs.Len() != 0 {
+		status.TargetGroupARNs = tgARNs.List()
+	}
+	status.RuleConflicts = nil
+	if ingResources != nil && len(ingResources.RuleConflicts) != 0 {
+		status.RuleConflicts = ingResources.RuleConflicts
+	}
+	return status, nil
+}
+
+// ParseReconcileStatus parses the ReconcileStatus from Ingress's StatusAnnotation.
+// returns whether the status exists.
+func ParseReconcileStatus(ing *networking.Ingress) (ReconcileStatus, bool) {
+	rawStatus, exists := ing.Annotations[StatusAnnotation]
+	if !exists {
+		return ReconcileStatus{}, false
+	}
+	var status ReconcileStatus
+	if err := json.Unmarshal([]byte(rawStatus), &status); err != nil {
+		return ReconcileStatus{}, false
+	}
+	return status, true
+}
+
+// EncodeReconcileStatus encodes the ReconcileStatus as value of StatusAnnotation.
+func EncodeReconcileStatus(status ReconcileStatus) (string, error) {
+	payload, err := json.Marshal(status)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+// buildIngressResources returns the resources built for Ingress.
+func (t *defaultModelBuildTask) buildIngressResources(ingKey types.NamespacedName) *IngressResources {
+	if ingResources, exists := t.ingressResources[ingKey]; exists {
+		return ingResources
+	}
+	if t.ingressResources == nil {
+		t.ingressResources = make(map[types.NamespacedName]*IngressResources)
+	}
+	ingResources := &IngressResources{}
+	t.ingressResources[ingKey] = ingResources
+	return ingResources
+}
+
+// recordIngressTargetGroup records the target group used by Ingress.
+func (t *defaultModelBuildTask) recordIngressTargetGroup(ingKey types.NamespacedName, tg *elbv2model.TargetGroup) {
+	ingResources := t.buildIngressResources(ingKey)
+	for _, existingTG := range ingResources.TargetGroups {
+		if existingTG == tg {
+			return
+		}
+	}
+	ingResources.TargetGroups = append(ingResources.TargetGroups, tg)
+}
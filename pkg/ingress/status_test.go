@@ -0,0 +1,292 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func TestBuildReconcileStatus(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+	lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+	lb.SetStatus(elbv2model.LoadBalancerStatus{LoadBalancerARN: "lb-arn"})
+	lr1 := elbv2model.NewListenerRule(stack, "80:1", elbv2model.ListenerRuleSpec{ListenerARN: core.LiteralStringToken("ls-arn")})
+	lr1.SetStatus(elbv2model.ListenerRuleStatus{RuleARN: "rule-arn-1"})
+	lr2 := elbv2model.NewListenerRule(stack, "80:2", elbv2model.ListenerRuleSpec{ListenerARN: core.LiteralStringToken("ls-arn")})
+	lr2.SetStatus(elbv2model.ListenerRuleStatus{RuleARN: "rule-arn-2"})
+	tg := elbv2model.NewTargetGroup(stack, "awesome-ns/ing-1-svc-1:http", elbv2model.TargetGroupSpec{})
+	tg.SetStatus(elbv2model.TargetGroupStatus{TargetGroupARN: "tg-arn"})
+	unfulfilledLR := elbv2model.NewListenerRule(stack, "80:3", elbv2model.ListenerRuleSpec{ListenerARN: core.LiteralStringToken("ls-arn")})
+
+	tests := []struct {
+		name         string
+		ing          *networking.Ingress
+		groupID      GroupID
+		shard        int
+		ingResources *IngressResources
+		reconcileErr error
+		want         ReconcileStatus
+		wantErr      error
+	}{
+		{
+			name: "reconcile succeeded",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-1",
+					Generation: 3,
+				},
+			},
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			ingResources: &IngressResources{
+				ListenerRules: []*elbv2model.ListenerRule{lr2, lr1},
+				TargetGroups:  []*elbv2model.TargetGroup{tg},
+			},
+			want: ReconcileStatus{
+				GroupName:          "awesome-group",
+				ObservedGeneration: 3,
+				RulesAccepted:      true,
+				LoadBalancerARN:    "lb-arn",
+				ListenerRuleARNs:   []string{"rule-arn-1", "rule-arn-2"},
+				TargetGroupARNs:    []string{"tg-arn"},
+			},
+		},
+		{
+			name: "reconcile succeeded after failure",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-1",
+					Generation: 3,
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/status": `{"groupName":"awesome-group","observedGeneration":2,"rulesAccepted":false,"loadBalancerARN":"lb-arn","listenerRuleARNs":["rule-arn-0"],"lastError":"some error"}`,
+					},
+				},
+			},
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			ingResources: &IngressResources{
+				TargetGroups: []*elbv2model.TargetGroup{tg},
+			},
+			want: ReconcileStatus{
+				GroupName:          "awesome-group",
+				ObservedGeneration: 3,
+				RulesAccepted:      true,
+				LoadBalancerARN:    "lb-arn",
+				TargetGroupARNs:    []string{"tg-arn"},
+			},
+		},
+		{
+			name: "reconcile failed keeps existing ARNs",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-1",
+					Generation: 4,
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/status": `{"groupName":"awesome-ns/ing-1","observedGeneration":3,"rulesAccepted":true,"loadBalancerARN":"lb-arn","listenerRuleARNs":["rule-arn-1"]}`,
+					},
+				},
+			},
+			groupID:      NewGroupIDForImplicitGroup(types.NamespacedName{Namespace: "awesome-ns", Name: "ing-1"}),
+			reconcileErr: errors.New("ingress: awesome-ns/ing-1: unable to find service"),
+			want: ReconcileStatus{
+				GroupName:          "awesome-ns/ing-1",
+				ObservedGeneration: 4,
+				RulesAccepted:      false,
+				LoadBalancerARN:    "lb-arn",
+				ListenerRuleARNs:   []string{"rule-arn-1"},
+				LastError:          "ingress: awesome-ns/ing-1: unable to find service",
+			},
+		},
+		{
+			name: "reconcile succeeded on another shard",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-1",
+					Generation: 2,
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/status": `{"groupName":"awesome-group","observedGeneration":1,"rulesAccepted":false,"lastError":"listener rules quota exceeded: 101 > 100"}`,
+					},
+				},
+			},
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			shard:   1,
+			ingResources: &IngressResources{
+				TargetGroups: []*elbv2model.TargetGroup{tg},
+			},
+			want: ReconcileStatus{
+				GroupName:          "awesome-group",
+				ObservedGeneration: 2,
+				RulesAccepted:      true,
+				Shard:              1,
+				LoadBalancerARN:    "lb-arn",
+				TargetGroupARNs:    []string{"tg-arn"},
+			},
+		},
+		{
+			name: "reconcile succeeded with rule conflicts",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-2",
+					Generation: 1,
+				},
+			},
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			ingResources: &IngressResources{
+				RuleConflicts: []string{"rule for host api.example.com on port 80 is claimed by Ingress awesome-ns/ing-1, which takes precedence by GroupOrder policy"},
+			},
+			want: ReconcileStatus{
+				GroupName:          "awesome-group",
+				ObservedGeneration: 1,
+				RulesAccepted:      true,
+				LoadBalancerARN:    "lb-arn",
+				RuleConflicts:      []string{"rule for host api.example.com on port 80 is claimed by Ingress awesome-ns/ing-1, which takes precedence by GroupOrder policy"},
+			},
+		},
+		{
+			name: "reconcile succeeded clears resolved rule conflicts",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-2",
+					Generation: 2,
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/status": `{"groupName":"awesome-group","observedGeneration":1,"rulesAccepted":true,"loadBalancerARN":"lb-arn","ruleConflicts":["some conflict"]}`,
+					},
+				},
+			},
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			ingResources: &IngressResources{
+				ListenerRules: []*elbv2model.ListenerRule{lr1},
+			},
+			want: ReconcileStatus{
+				GroupName:          "awesome-group",
+				ObservedGeneration: 2,
+				RulesAccepted:      true,
+				LoadBalancerARN:    "lb-arn",
+				ListenerRuleARNs:   []string{"rule-arn-1"},
+			},
+		},
+		{
+			name: "reconcile failed keeps existing shard",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-1",
+					Generation: 3,
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/status": `{"groupName":"awesome-group","observedGeneration":2,"rulesAccepted":true,"shard":1,"loadBalancerARN":"lb-arn"}`,
+					},
+				},
+			},
+			groupID:      NewGroupIDForExplicitGroup("awesome-group"),
+			reconcileErr: errors.New("some error"),
+			want: ReconcileStatus{
+				GroupName:          "awesome-group",
+				ObservedGeneration: 3,
+				RulesAccepted:      false,
+				Shard:              1,
+				LoadBalancerARN:    "lb-arn",
+				LastError:          "some error",
+			},
+		},
+		{
+			name: "reconcile failed with malformed existing status",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ing-1",
+					Generation: 1,
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/status": `{malformed`,
+					},
+				},
+			},
+			groupID:      NewGroupIDForExplicitGroup("awesome-group"),
+			reconcileErr: errors.New("some error"),
+			want: ReconcileStatus{
+				GroupName:          "awesome-group",
+				ObservedGeneration: 1,
+				RulesAccepted:      false,
+				LastError:          "some error",
+			},
+		},
+		{
+			name: "listener rule not fulfilled",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "ing-1",
+				},
+			},
+			groupID: NewGroupIDForExplicitGroup("awesome-group"),
+			ingResources: &IngressResources{
+				ListenerRules: []*elbv2model.ListenerRule{unfulfilledLR},
+			},
+			wantErr: errors.New("ListenerRule is not fulfilled yet: 80:3"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildReconcileStatus(context.Background(), tt.ing, tt.groupID, tt.shard, lb, tt.ingResources, tt.reconcileErr)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEncodeReconcileStatus(t *testing.T) {
+	status := ReconcileStatus{
+		GroupName:          "awesome-group",
+		ObservedGeneration: 2,
+		RulesAccepted:      true,
+		LoadBalancerARN:    "lb-arn",
+		TargetGroupARNs:    []string{"tg-arn"},
+	}
+	got, err := EncodeReconcileStatus(status)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"groupName":"awesome-group","observedGeneration":2,"rulesAccepted":true,"loadBalancerARN":"lb-arn","targetGroupARNs":["tg-arn"]}`, got)
+
+	parsedStatus, exists := ParseReconcileStatus(&networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/status": got,
+			},
+		},
+	})
+	assert.True(t, exists)
+	assert.Equal(t, status, parsedStatus)
+}
+
+func Test_defaultModelBuildTask_recordIngressTargetGroup(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+	tg1 := elbv2model.NewTargetGroup(stack, "tg-1", elbv2model.TargetGroupSpec{})
+	tg2 := elbv2model.NewTargetGroup(stack, "tg-2", elbv2model.TargetGroupSpec{})
+	ingKey1 := types.NamespacedName{Namespace: "awesome-ns", Name: "ing-1"}
+	ingKey2 := types.NamespacedName{Namespace: "awesome-ns", Name: "ing-2"}
+
+	task := &defaultModelBuildTask{
+		ingressResources: make(map[types.NamespacedName]*IngressResources),
+	}
+	task.recordIngressTargetGroup(ingKey1, tg1)
+	task.recordIngressTargetGroup(ingKey1, tg2)
+	task.recordIngressTargetGroup(ingKey1, tg1)
+	task.recordIngressTargetGroup(ingKey2, tg2)
+	assert.Equal(t, map[types.NamespacedName]*IngressResources{
+		ingKey1: {TargetGroups: []*elbv2model.TargetGroup{tg1, tg2}},
+		ingKey2: {TargetGroups: []*elbv2model.TargetGroup{tg2}},
+	}, task.ingressResources)
+}
@@ -9,6 +9,9 @@ const (
 	IngressEventReasonFailedUpdateStatus      = "FailedUpdateStatus"
 	IngressEventReasonFailedBuildModel        = "FailedBuildModel"
 	IngressEventReasonFailedDeployModel       = "FailedDeployModel"
+	IngressEventReasonQuotaExceeded           = "QuotaExceeded"
+	IngressEventReasonDeniedByGroupPolicy     = "DeniedByGroupPolicy"
+	IngressEventReasonConflictingRule         = "ConflictingRule"
 	IngressEventReasonSuccessfullyReconciled  = "SuccessfullyReconciled"
 
 	// Service events
@@ -19,6 +22,16 @@ const (
 	ServiceEventReasonFailedBuildModel       = "FailedBuildModel"
 	ServiceEventReasonFailedDeployModel      = "FailedDeployModel"
 	ServiceEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+	ServiceEventReasonPortConflict           = "PortConflict"
+
+	// Gateway events
+	GatewayEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
+	GatewayEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
+	GatewayEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
+	GatewayEventReasonFailedTranslateRoutes  = "FailedTranslateRoutes"
+	GatewayEventReasonFailedBuildModel       = "FailedBuildModel"
+	GatewayEventReasonFailedDeployModel      = "FailedDeployModel"
+	GatewayEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
 
 	// TargetGroupBinding events
 	TargetGroupBindingEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
@@ -26,5 +39,13 @@ const (
 	TargetGroupBindingEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
 	TargetGroupBindingEventReasonFailedCleanup          = "FailedCleanup"
 	TargetGroupBindingEventReasonBackendNotFound        = "BackendNotFound"
+	TargetGroupBindingEventReasonSwapStarted            = "SwapStarted"
+	TargetGroupBindingEventReasonSwapCompleted          = "SwapCompleted"
 	TargetGroupBindingEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+
+	// TrafficSplit events
+	TrafficSplitEventReasonFailedUpdateStatus = "FailedUpdateStatus"
+	TrafficSplitEventReasonStepAdvanced       = "StepAdvanced"
+	TrafficSplitEventReasonCanaryDegraded     = "CanaryDegraded"
+	TrafficSplitEventReasonSucceeded          = "Succeeded"
 )
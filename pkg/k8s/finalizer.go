@@ -9,6 +9,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
+const (
+	// ServiceFinalizer is the finalizer of Services reconciled by service controller.
+	ServiceFinalizer = "service.k8s.aws/resources"
+	// ServiceGroupFinalizerPrefix is the prefix of finalizers of service group members, which is followed by the group name.
+	ServiceGroupFinalizerPrefix = "group.service.k8s.aws/"
+)
+
 type FinalizerManager interface {
 	AddFinalizers(ctx context.Context, object client.Object, finalizers ...string) error
 	RemoveFinalizers(ctx context.Context, object client.Object, finalizers ...string) error
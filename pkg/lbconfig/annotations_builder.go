@@ -0,0 +1,222 @@
+package lbconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+const (
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+)
+
+// BuildIngressAnnotations builds the Ingress annotations equivalent to LoadBalancerConfiguration spec.
+func BuildIngressAnnotations(spec elbv2api.LoadBalancerConfigurationSpec) (map[string]string, error) {
+	b := newAnnotationsBuilder(annotations.AnnotationPrefixIngress)
+	b.setString(annotations.IngressSuffixLoadBalancerName, spec.LoadBalancerName)
+	if spec.Scheme != nil {
+		b.set(annotations.IngressSuffixScheme, string(*spec.Scheme))
+	}
+	if spec.IPAddressType != nil {
+		b.set(annotations.IngressSuffixIPAddressType, string(*spec.IPAddressType))
+	}
+	b.setStringSlice(annotations.IngressSuffixSubnets, spec.Subnets)
+	b.setTags(annotations.IngressSuffixTags, spec.Tags)
+	b.setAttributes(annotations.IngressSuffixLoadBalancerAttributes, spec.LoadBalancerAttributes)
+	if len(spec.Listeners) != 0 {
+		listenPorts := make([]map[string]int32, 0, len(spec.Listeners))
+		for _, listener := range spec.Listeners {
+			if listener.Protocol != elbv2api.ListenerProtocolHTTP && listener.Protocol != elbv2api.ListenerProtocolHTTPS {
+				return nil, errors.Errorf("listener protocol %v is not supported by Ingress", listener.Protocol)
+			}
+			listenPorts = append(listenPorts, map[string]int32{string(listener.Protocol): listener.Port})
+		}
+		if err := b.setJSON(annotations.IngressSuffixListenPorts, listenPorts); err != nil {
+			return nil, err
+		}
+	}
+	b.setStringSlice(annotations.IngressSuffixCertificateARN, spec.CertificateARNs)
+	b.setString(annotations.IngressSuffixSSLPolicy, spec.SSLPolicy)
+	if hc := spec.HealthCheck; hc != nil {
+		if hc.Protocol != nil && *hc.Protocol == elbv2api.HealthCheckProtocolTCP {
+			return nil, errors.Errorf("health check protocol %v is not supported by Ingress", *hc.Protocol)
+		}
+		b.setHealthCheck(hc, healthCheckSuffixes{
+			port:                    annotations.IngressSuffixHealthCheckPort,
+			protocol:                annotations.IngressSuffixHealthCheckProtocol,
+			path:                    annotations.IngressSuffixHealthCheckPath,
+			intervalSeconds:         annotations.IngressSuffixHealthCheckIntervalSeconds,
+			timeoutSeconds:          annotations.IngressSuffixHealthCheckTimeoutSeconds,
+			healthyThresholdCount:   annotations.IngressSuffixHealthyThresholdCount,
+			unhealthyThresholdCount: annotations.IngressSuffixUnhealthyThresholdCount,
+			successCodes:            annotations.IngressSuffixSuccessCodes,
+		})
+	}
+	b.setAttributes(annotations.IngressSuffixTargetGroupAttributes, spec.TargetGroupAttributes)
+	if auth := spec.Authentication; auth != nil {
+		b.set(annotations.IngressSuffixAuthType, string(auth.Type))
+		if auth.IDPCognito != nil {
+			if err := b.setJSON(annotations.IngressSuffixAuthIDPCognito, auth.IDPCognito); err != nil {
+				return nil, err
+			}
+		}
+		if auth.IDPOIDC != nil {
+			if err := b.setJSON(annotations.IngressSuffixAuthIDPOIDC, auth.IDPOIDC); err != nil {
+				return nil, err
+			}
+		}
+		if auth.OnUnauthenticatedRequest != nil {
+			b.set(annotations.IngressSuffixAuthOnUnauthenticatedRequest, string(*auth.OnUnauthenticatedRequest))
+		}
+		b.setString(annotations.IngressSuffixAuthScope, auth.Scope)
+		b.setString(annotations.IngressSuffixAuthSessionCookie, auth.SessionCookieName)
+		b.setInt64(annotations.IngressSuffixAuthSessionTimeout, auth.SessionTimeout)
+	}
+	if waf := spec.WAF; waf != nil {
+		b.setString(annotations.IngressSuffixWAFv2ACLARN, waf.WAFv2ACLARN)
+		b.setString(annotations.IngressSuffixWAFACLID, waf.WAFACLID)
+		if waf.ShieldAdvancedProtection != nil {
+			b.set(annotations.IngressSuffixShieldAdvancedProtection, strconv.FormatBool(*waf.ShieldAdvancedProtection))
+		}
+	}
+	return b.annotations, nil
+}
+
+// BuildServiceAnnotations builds the Service annotations equivalent to LoadBalancerConfiguration spec.
+func BuildServiceAnnotations(spec elbv2api.LoadBalancerConfigurationSpec) (map[string]string, error) {
+	if spec.Authentication != nil {
+		return nil, errors.New("authentication is not supported by Service")
+	}
+	if spec.WAF != nil {
+		return nil, errors.New("waf is not supported by Service")
+	}
+	b := newAnnotationsBuilder(serviceAnnotationPrefix)
+	b.setString(annotations.SvcLBSuffixLoadBalancerName, spec.LoadBalancerName)
+	if spec.Scheme != nil {
+		b.set(annotations.SvcLBSuffixScheme, string(*spec.Scheme))
+	}
+	if spec.IPAddressType != nil {
+		b.set(annotations.SvcLBSuffixIPAddressType, string(*spec.IPAddressType))
+	}
+	b.setStringSlice(annotations.SvcLBSuffixSubnets, spec.Subnets)
+	b.setTags(annotations.SvcLBSuffixAdditionalTags, spec.Tags)
+	b.setAttributes(annotations.SvcLBSuffixLoadBalancerAttributes, spec.LoadBalancerAttributes)
+	if len(spec.Listeners) != 0 {
+		sslPorts := make([]string, 0, len(spec.Listeners))
+		for _, listener := range spec.Listeners {
+			if listener.Protocol != elbv2api.ListenerProtocolTLS {
+				return nil, errors.Errorf("listener protocol %v is not supported by Service", listener.Protocol)
+			}
+			sslPorts = append(sslPorts, strconv.Itoa(int(listener.Port)))
+		}
+		b.setStringSlice(annotations.SvcLBSuffixSSLPorts, sslPorts)
+	}
+	b.setStringSlice(annotations.SvcLBSuffixSSLCertificate, spec.CertificateARNs)
+	b.setString(annotations.SvcLBSuffixSSLNegotiationPolicy, spec.SSLPolicy)
+	if hc := spec.HealthCheck; hc != nil {
+		b.setHealthCheck(hc, healthCheckSuffixes{
+			port:                    annotations.SvcLBSuffixHCPort,
+			protocol:                annotations.SvcLBSuffixHCProtocol,
+			path:                    annotations.SvcLBSuffixHCPath,
+			intervalSeconds:         annotations.SvcLBSuffixHCInterval,
+			timeoutSeconds:          annotations.SvcLBSuffixHCTimeout,
+			healthyThresholdCount:   annotations.SvcLBSuffixHCHealthyThreshold,
+			unhealthyThresholdCount: annotations.SvcLBSuffixHCUnhealthyThreshold,
+			successCodes:            annotations.SvcLBSuffixHCSuccessCodes,
+		})
+	}
+	b.setAttributes(annotations.SvcLBSuffixTargetGroupAttributes, spec.TargetGroupAttributes)
+	return b.annotations, nil
+}
+
+// healthCheckSuffixes are the annotation suffixes for health check settings.
+type healthCheckSuffixes struct {
+	port                    string
+	protocol                string
+	path                    string
+	intervalSeconds         string
+	timeoutSeconds          string
+	healthyThresholdCount   string
+	unhealthyThresholdCount string
+	successCodes            string
+}
+
+// annotationsBuilder builds annotations under specific annotation prefix.
+type annotationsBuilder struct {
+	annotationPrefix string
+	annotations      map[string]string
+}
+
+func newAnnotationsBuilder(annotationPrefix string) *annotationsBuilder {
+	return &annotationsBuilder{
+		annotationPrefix: annotationPrefix,
+		annotations:      make(map[string]string),
+	}
+}
+
+func (b *annotationsBuilder) set(suffix string, value string) {
+	b.annotations[fmt.Sprintf("%v/%v", b.annotationPrefix, suffix)] = value
+}
+
+func (b *annotationsBuilder) setString(suffix string, value *string) {
+	if value != nil {
+		b.set(suffix, *value)
+	}
+}
+
+func (b *annotationsBuilder) setInt64(suffix string, value *int64) {
+	if value != nil {
+		b.set(suffix, strconv.FormatInt(*value, 10))
+	}
+}
+
+func (b *annotationsBuilder) setStringSlice(suffix string, values []string) {
+	if len(values) != 0 {
+		b.set(suffix, strings.Join(values, ","))
+	}
+}
+
+func (b *annotationsBuilder) setTags(suffix string, tags []elbv2api.Tag) {
+	kvPairs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		kvPairs = append(kvPairs, fmt.Sprintf("%v=%v", tag.Key, tag.Value))
+	}
+	b.setStringSlice(suffix, kvPairs)
+}
+
+func (b *annotationsBuilder) setAttributes(suffix string, attributes []elbv2api.Attribute) {
+	kvPairs := make([]string, 0, len(attributes))
+	for _, attr := range attributes {
+		kvPairs = append(kvPairs, fmt.Sprintf("%v=%v", attr.Key, attr.Value))
+	}
+	b.setStringSlice(suffix, kvPairs)
+}
+
+func (b *annotationsBuilder) setJSON(suffix string, value interface{}) error {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode %v", suffix)
+	}
+	b.set(suffix, string(payload))
+	return nil
+}
+
+func (b *annotationsBuilder) setHealthCheck(hc *elbv2api.HealthCheckConfiguration, suffixes healthCheckSuffixes) {
+	if hc.Port != nil {
+		b.set(suffixes.port, hc.Port.String())
+	}
+	if hc.Protocol != nil {
+		b.set(suffixes.protocol, string(*hc.Protocol))
+	}
+	b.setString(suffixes.path, hc.Path)
+	b.setInt64(suffixes.intervalSeconds, hc.IntervalSeconds)
+	b.setInt64(suffixes.timeoutSeconds, hc.TimeoutSeconds)
+	b.setInt64(suffixes.healthyThresholdCount, hc.HealthyThresholdCount)
+	b.setInt64(suffixes.unhealthyThresholdCount, hc.UnhealthyThresholdCount)
+	b.setString(suffixes.successCodes, hc.SuccessCodes)
+}
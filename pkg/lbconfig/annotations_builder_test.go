@@ -0,0 +1,237 @@
+package lbconfig
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+func TestBuildIngressAnnotations(t *testing.T) {
+	schemeInternetFacing := elbv2api.LoadBalancerSchemeInternetFacing
+	ipAddressTypeDualStack := elbv2api.IPAddressTypeDualStack
+	hcProtocolHTTPS := elbv2api.HealthCheckProtocolHTTPS
+	hcProtocolTCP := elbv2api.HealthCheckProtocolTCP
+	hcPortTrafficPort := intstr.FromString("traffic-port")
+	onUnauthenticatedRequestDeny := elbv2api.AuthOnUnauthenticatedRequestDeny
+	tests := []struct {
+		name    string
+		spec    elbv2api.LoadBalancerConfigurationSpec
+		want    map[string]string
+		wantErr error
+	}{
+		{
+			name: "empty spec",
+			spec: elbv2api.LoadBalancerConfigurationSpec{},
+			want: map[string]string{},
+		},
+		{
+			name: "full spec",
+			spec: elbv2api.LoadBalancerConfigurationSpec{
+				LoadBalancerName: awssdk.String("my-alb"),
+				Scheme:           &schemeInternetFacing,
+				IPAddressType:    &ipAddressTypeDualStack,
+				Subnets:          []string{"subnet-a", "subnet-b"},
+				Tags: []elbv2api.Tag{
+					{Key: "team", Value: "awesome"},
+					{Key: "env", Value: "prod"},
+				},
+				LoadBalancerAttributes: []elbv2api.Attribute{
+					{Key: "idle_timeout.timeout_seconds", Value: "120"},
+				},
+				Listeners: []elbv2api.Listener{
+					{Port: 80, Protocol: elbv2api.ListenerProtocolHTTP},
+					{Port: 443, Protocol: elbv2api.ListenerProtocolHTTPS},
+				},
+				CertificateARNs: []string{"arn-1", "arn-2"},
+				SSLPolicy:       awssdk.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+				HealthCheck: &elbv2api.HealthCheckConfiguration{
+					Port:                    &hcPortTrafficPort,
+					Protocol:                &hcProtocolHTTPS,
+					Path:                    awssdk.String("/healthz"),
+					IntervalSeconds:         awssdk.Int64(10),
+					TimeoutSeconds:          awssdk.Int64(8),
+					HealthyThresholdCount:   awssdk.Int64(3),
+					UnhealthyThresholdCount: awssdk.Int64(4),
+					SuccessCodes:            awssdk.String("200-299"),
+				},
+				TargetGroupAttributes: []elbv2api.Attribute{
+					{Key: "stickiness.enabled", Value: "true"},
+				},
+				Authentication: &elbv2api.AuthConfiguration{
+					Type: elbv2api.AuthTypeOIDC,
+					IDPOIDC: &elbv2api.AuthIDPOIDC{
+						Issuer:                "https://example.com",
+						AuthorizationEndpoint: "https://example.com/authorize",
+						TokenEndpoint:         "https://example.com/token",
+						UserInfoEndpoint:      "https://example.com/userinfo",
+						SecretName:            "my-secret",
+					},
+					OnUnauthenticatedRequest: &onUnauthenticatedRequestDeny,
+					Scope:                    awssdk.String("email"),
+					SessionCookieName:        awssdk.String("my-cookie"),
+					SessionTimeout:           awssdk.Int64(3600),
+				},
+				WAF: &elbv2api.WAFConfiguration{
+					WAFv2ACLARN:              awssdk.String("arn:aws:wafv2:acl"),
+					ShieldAdvancedProtection: awssdk.Bool(true),
+				},
+			},
+			want: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-name":              "my-alb",
+				"alb.ingress.kubernetes.io/scheme":                          "internet-facing",
+				"alb.ingress.kubernetes.io/ip-address-type":                 "dualstack",
+				"alb.ingress.kubernetes.io/subnets":                         "subnet-a,subnet-b",
+				"alb.ingress.kubernetes.io/tags":                            "team=awesome,env=prod",
+				"alb.ingress.kubernetes.io/load-balancer-attributes":        "idle_timeout.timeout_seconds=120",
+				"alb.ingress.kubernetes.io/listen-ports":                    `[{"HTTP":80},{"HTTPS":443}]`,
+				"alb.ingress.kubernetes.io/certificate-arn":                 "arn-1,arn-2",
+				"alb.ingress.kubernetes.io/ssl-policy":                      "ELBSecurityPolicy-TLS-1-2-2017-01",
+				"alb.ingress.kubernetes.io/healthcheck-port":                "traffic-port",
+				"alb.ingress.kubernetes.io/healthcheck-protocol":            "HTTPS",
+				"alb.ingress.kubernetes.io/healthcheck-path":                "/healthz",
+				"alb.ingress.kubernetes.io/healthcheck-interval-seconds":    "10",
+				"alb.ingress.kubernetes.io/healthcheck-timeout-seconds":     "8",
+				"alb.ingress.kubernetes.io/healthy-threshold-count":         "3",
+				"alb.ingress.kubernetes.io/unhealthy-threshold-count":       "4",
+				"alb.ingress.kubernetes.io/success-codes":                   "200-299",
+				"alb.ingress.kubernetes.io/target-group-attributes":         "stickiness.enabled=true",
+				"alb.ingress.kubernetes.io/auth-type":                       "oidc",
+				"alb.ingress.kubernetes.io/auth-idp-oidc":                   `{"issuer":"https://example.com","authorizationEndpoint":"https://example.com/authorize","tokenEndpoint":"https://example.com/token","userInfoEndpoint":"https://example.com/userinfo","secretName":"my-secret"}`,
+				"alb.ingress.kubernetes.io/auth-on-unauthenticated-request": "deny",
+				"alb.ingress.kubernetes.io/auth-scope":                      "email",
+				"alb.ingress.kubernetes.io/auth-session-cookie":             "my-cookie",
+				"alb.ingress.kubernetes.io/auth-session-timeout":            "3600",
+				"alb.ingress.kubernetes.io/wafv2-acl-arn":                   "arn:aws:wafv2:acl",
+				"alb.ingress.kubernetes.io/shield-advanced-protection":      "true",
+			},
+		},
+		{
+			name: "TLS listener is not supported",
+			spec: elbv2api.LoadBalancerConfigurationSpec{
+				Listeners: []elbv2api.Listener{
+					{Port: 443, Protocol: elbv2api.ListenerProtocolTLS},
+				},
+			},
+			wantErr: errors.New("listener protocol TLS is not supported by Ingress"),
+		},
+		{
+			name: "TCP health check is not supported",
+			spec: elbv2api.LoadBalancerConfigurationSpec{
+				HealthCheck: &elbv2api.HealthCheckConfiguration{
+					Protocol: &hcProtocolTCP,
+				},
+			},
+			wantErr: errors.New("health check protocol TCP is not supported by Ingress"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildIngressAnnotations(tt.spec)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBuildServiceAnnotations(t *testing.T) {
+	schemeInternal := elbv2api.LoadBalancerSchemeInternal
+	hcProtocolTCP := elbv2api.HealthCheckProtocolTCP
+	hcPort := intstr.FromInt(8080)
+	tests := []struct {
+		name    string
+		spec    elbv2api.LoadBalancerConfigurationSpec
+		want    map[string]string
+		wantErr error
+	}{
+		{
+			name: "full spec",
+			spec: elbv2api.LoadBalancerConfigurationSpec{
+				LoadBalancerName: awssdk.String("my-nlb"),
+				Scheme:           &schemeInternal,
+				Subnets:          []string{"subnet-a"},
+				Tags: []elbv2api.Tag{
+					{Key: "team", Value: "awesome"},
+				},
+				LoadBalancerAttributes: []elbv2api.Attribute{
+					{Key: "load_balancing.cross_zone.enabled", Value: "true"},
+				},
+				Listeners: []elbv2api.Listener{
+					{Port: 443, Protocol: elbv2api.ListenerProtocolTLS},
+					{Port: 8443, Protocol: elbv2api.ListenerProtocolTLS},
+				},
+				CertificateARNs: []string{"arn-1"},
+				SSLPolicy:       awssdk.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+				HealthCheck: &elbv2api.HealthCheckConfiguration{
+					Port:                  &hcPort,
+					Protocol:              &hcProtocolTCP,
+					IntervalSeconds:       awssdk.Int64(10),
+					HealthyThresholdCount: awssdk.Int64(3),
+				},
+				TargetGroupAttributes: []elbv2api.Attribute{
+					{Key: "preserve_client_ip.enabled", Value: "false"},
+				},
+			},
+			want: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-name":                          "my-nlb",
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                        "internal",
+				"service.beta.kubernetes.io/aws-load-balancer-subnets":                       "subnet-a",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags":      "team=awesome",
+				"service.beta.kubernetes.io/aws-load-balancer-attributes":                    "load_balancing.cross_zone.enabled=true",
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports":                     "443,8443",
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-cert":                      "arn-1",
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-negotiation-policy":        "ELBSecurityPolicy-TLS13-1-2-2021-06",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-port":              "8080",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-protocol":          "TCP",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-interval":          "10",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-healthy-threshold": "3",
+				"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes":       "preserve_client_ip.enabled=false",
+			},
+		},
+		{
+			name: "HTTP listener is not supported",
+			spec: elbv2api.LoadBalancerConfigurationSpec{
+				Listeners: []elbv2api.Listener{
+					{Port: 80, Protocol: elbv2api.ListenerProtocolHTTP},
+				},
+			},
+			wantErr: errors.New("listener protocol HTTP is not supported by Service"),
+		},
+		{
+			name: "authentication is not supported",
+			spec: elbv2api.LoadBalancerConfigurationSpec{
+				Authentication: &elbv2api.AuthConfiguration{
+					Type: elbv2api.AuthTypeNone,
+				},
+			},
+			wantErr: errors.New("authentication is not supported by Service"),
+		},
+		{
+			name: "waf is not supported",
+			spec: elbv2api.LoadBalancerConfigurationSpec{
+				WAF: &elbv2api.WAFConfiguration{
+					WAFv2ACLARN: awssdk.String("arn:aws:wafv2:acl"),
+				},
+			},
+			wantErr: errors.New("waf is not supported by Service"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildServiceAnnotations(tt.spec)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
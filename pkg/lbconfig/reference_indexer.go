@@ -0,0 +1,32 @@
+package lbconfig
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+const (
+	// IndexKeyLoadBalancerConfigurationRefName is index key for loadBalancerConfiguration referenced by Ingress or Service.
+	IndexKeyLoadBalancerConfigurationRefName = "loadBalancerConfigurationRef.name"
+)
+
+// BuildIngressRefIndexes returns the name of LoadBalancerConfiguration referenced by Ingress.
+func BuildIngressRefIndexes(ing *networking.Ingress) []string {
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	var lbConfigName string
+	if exists := annotationParser.ParseStringAnnotation(annotations.IngressSuffixLoadBalancerConfiguration, &lbConfigName, ing.Annotations); !exists {
+		return nil
+	}
+	return []string{lbConfigName}
+}
+
+// BuildServiceRefIndexes returns the name of LoadBalancerConfiguration referenced by Service.
+func BuildServiceRefIndexes(svc *corev1.Service) []string {
+	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
+	var lbConfigName string
+	if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerConfiguration, &lbConfigName, svc.Annotations); !exists {
+		return nil
+	}
+	return []string{lbConfigName}
+}
@@ -0,0 +1,98 @@
+package lbconfig
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Resolver resolves the effective annotations of Ingresses and Services that reference a LoadBalancerConfiguration.
+// The settings from annotations on the Ingress or Service take precedence over settings from the LoadBalancerConfiguration.
+type Resolver interface {
+	// ResolveIngressAnnotations returns the effective annotations for Ingress.
+	ResolveIngressAnnotations(ctx context.Context, ing *networking.Ingress) (map[string]string, error)
+
+	// ResolveServiceAnnotations returns the effective annotations for Service.
+	ResolveServiceAnnotations(ctx context.Context, svc *corev1.Service) (map[string]string, error)
+}
+
+// NewDefaultResolver constructs new defaultResolver.
+func NewDefaultResolver(k8sClient client.Client) *defaultResolver {
+	return &defaultResolver{
+		k8sClient:               k8sClient,
+		ingressAnnotationParser: annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
+		serviceAnnotationParser: annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix),
+	}
+}
+
+var _ Resolver = &defaultResolver{}
+
+// default implementation for Resolver
+type defaultResolver struct {
+	k8sClient               client.Client
+	ingressAnnotationParser annotations.Parser
+	serviceAnnotationParser annotations.Parser
+}
+
+func (r *defaultResolver) ResolveIngressAnnotations(ctx context.Context, ing *networking.Ingress) (map[string]string, error) {
+	var lbConfigName string
+	if exists := r.ingressAnnotationParser.ParseStringAnnotation(annotations.IngressSuffixLoadBalancerConfiguration, &lbConfigName, ing.Annotations); !exists {
+		return ing.Annotations, nil
+	}
+	lbConfig, err := r.loadLoadBalancerConfiguration(ctx, ing.Namespace, lbConfigName)
+	if err != nil {
+		return nil, err
+	}
+	lbConfigAnnotations, err := BuildIngressAnnotations(lbConfig.Spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid LoadBalancerConfiguration %v", lbConfigName)
+	}
+	return mergeAnnotations(lbConfigAnnotations, ing.Annotations), nil
+}
+
+func (r *defaultResolver) ResolveServiceAnnotations(ctx context.Context, svc *corev1.Service) (map[string]string, error) {
+	var lbConfigName string
+	if exists := r.serviceAnnotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerConfiguration, &lbConfigName, svc.Annotations); !exists {
+		return svc.Annotations, nil
+	}
+	lbConfig, err := r.loadLoadBalancerConfiguration(ctx, svc.Namespace, lbConfigName)
+	if err != nil {
+		return nil, err
+	}
+	lbConfigAnnotations, err := BuildServiceAnnotations(lbConfig.Spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid LoadBalancerConfiguration %v", lbConfigName)
+	}
+	return mergeAnnotations(lbConfigAnnotations, svc.Annotations), nil
+}
+
+func (r *defaultResolver) loadLoadBalancerConfiguration(ctx context.Context, namespace string, name string) (*elbv2api.LoadBalancerConfiguration, error) {
+	lbConfig := &elbv2api.LoadBalancerConfiguration{}
+	if err := r.k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, lbConfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errors.Errorf("LoadBalancerConfiguration %v not found", name)
+		}
+		return nil, errors.Wrapf(err, "failed to get LoadBalancerConfiguration %v", name)
+	}
+	return lbConfig, nil
+}
+
+// mergeAnnotations merges the annotations from LoadBalancerConfiguration with the object annotations,
+// where the object annotations take precedence.
+func mergeAnnotations(lbConfigAnnotations map[string]string, objAnnotations map[string]string) map[string]string {
+	merged := make(map[string]string, len(lbConfigAnnotations)+len(objAnnotations))
+	for k, v := range lbConfigAnnotations {
+		merged[k] = v
+	}
+	for k, v := range objAnnotations {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,195 @@
+package lbconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultResolver_ResolveIngressAnnotations(t *testing.T) {
+	schemeInternetFacing := elbv2api.LoadBalancerSchemeInternetFacing
+	ipAddressTypeDualStack := elbv2api.IPAddressTypeDualStack
+	lbConfig := &elbv2api.LoadBalancerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-config",
+		},
+		Spec: elbv2api.LoadBalancerConfigurationSpec{
+			Scheme:        &schemeInternetFacing,
+			IPAddressType: &ipAddressTypeDualStack,
+		},
+	}
+	tests := []struct {
+		name      string
+		lbConfigs []*elbv2api.LoadBalancerConfiguration
+		ing       *networking.Ingress
+		want      map[string]string
+		wantErr   error
+	}{
+		{
+			name: "ingress without LoadBalancerConfiguration",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/scheme": "internal",
+					},
+				},
+			},
+			want: map[string]string{
+				"alb.ingress.kubernetes.io/scheme": "internal",
+			},
+		},
+		{
+			name:      "ingress annotations take precedence over LoadBalancerConfiguration",
+			lbConfigs: []*elbv2api.LoadBalancerConfiguration{lbConfig},
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/load-balancer-configuration": "awesome-config",
+						"alb.ingress.kubernetes.io/scheme":                      "internal",
+					},
+				},
+			},
+			want: map[string]string{
+				"alb.ingress.kubernetes.io/load-balancer-configuration": "awesome-config",
+				"alb.ingress.kubernetes.io/scheme":                      "internal",
+				"alb.ingress.kubernetes.io/ip-address-type":             "dualstack",
+			},
+		},
+		{
+			name:      "LoadBalancerConfiguration in another namespace",
+			lbConfigs: []*elbv2api.LoadBalancerConfiguration{lbConfig},
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "another-ns",
+					Name:      "ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/load-balancer-configuration": "awesome-config",
+					},
+				},
+			},
+			wantErr: errors.New("LoadBalancerConfiguration awesome-config not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sClient := newFakeClient(t, tt.lbConfigs)
+			r := NewDefaultResolver(k8sClient)
+			got, err := r.ResolveIngressAnnotations(ctx, tt.ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultResolver_ResolveServiceAnnotations(t *testing.T) {
+	schemeInternetFacing := elbv2api.LoadBalancerSchemeInternetFacing
+	tests := []struct {
+		name      string
+		lbConfigs []*elbv2api.LoadBalancerConfiguration
+		svc       *corev1.Service
+		want      map[string]string
+		wantErr   error
+	}{
+		{
+			name: "service annotations take precedence over LoadBalancerConfiguration",
+			lbConfigs: []*elbv2api.LoadBalancerConfiguration{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "awesome-config",
+					},
+					Spec: elbv2api.LoadBalancerConfigurationSpec{
+						Scheme:  &schemeInternetFacing,
+						Subnets: []string{"subnet-a", "subnet-b"},
+					},
+				},
+			},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-configuration": "awesome-config",
+						"service.beta.kubernetes.io/aws-load-balancer-subnets":       "subnet-c",
+					},
+				},
+			},
+			want: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-configuration": "awesome-config",
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":        "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-subnets":       "subnet-c",
+			},
+		},
+		{
+			name: "LoadBalancerConfiguration with settings unsupported by Service",
+			lbConfigs: []*elbv2api.LoadBalancerConfiguration{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "awesome-config",
+					},
+					Spec: elbv2api.LoadBalancerConfigurationSpec{
+						Authentication: &elbv2api.AuthConfiguration{
+							Type: elbv2api.AuthTypeCognito,
+						},
+					},
+				},
+			},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-configuration": "awesome-config",
+					},
+				},
+			},
+			wantErr: errors.New("invalid LoadBalancerConfiguration awesome-config: authentication is not supported by Service"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sClient := newFakeClient(t, tt.lbConfigs)
+			r := NewDefaultResolver(k8sClient)
+			got, err := r.ResolveServiceAnnotations(ctx, tt.svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func newFakeClient(t *testing.T, lbConfigs []*elbv2api.LoadBalancerConfiguration) client.Client {
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	elbv2api.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	for _, lbConfig := range lbConfigs {
+		assert.NoError(t, k8sClient.Create(context.Background(), lbConfig.DeepCopy()))
+	}
+	return k8sClient
+}
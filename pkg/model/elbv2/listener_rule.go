@@ -130,6 +130,7 @@ type ListenerRuleSpec struct {
 	// The Amazon Resource Name (ARN) of the listener.
 	ListenerARN core.StringToken `json:"listenerARN"`
 	// The rule priority.
+	// rules are deployed in the order of priority, while existing rules may keep their actual priorities.
 	Priority int64 `json:"priority"`
 	// The actions.
 	Actions []Action `json:"actions"`
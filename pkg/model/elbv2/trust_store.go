@@ -0,0 +1,80 @@
+package elbv2
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &TrustStore{}
+
+// TrustStore represents a ELBV2 TrustStore
+type TrustStore struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of TrustStore
+	Spec TrustStoreSpec `json:"spec"`
+
+	// observed state of TrustStore
+	// +optional
+	Status *TrustStoreStatus `json:"status,omitempty"`
+}
+
+// NewTrustStore constructs new TrustStore resource.
+func NewTrustStore(stack core.Stack, id string, spec TrustStoreSpec) *TrustStore {
+	ts := &TrustStore{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::TrustStore", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(ts)
+	return ts
+}
+
+// SetStatus sets the TrustStore's status
+func (ts *TrustStore) SetStatus(status TrustStoreStatus) {
+	ts.Status = &status
+}
+
+// TrustStoreARN returns The Amazon Resource Name (ARN) of the trust store.
+func (ts *TrustStore) TrustStoreARN() core.StringToken {
+	return core.NewResourceFieldStringToken(ts, "status/trustStoreARN",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			ts := res.(*TrustStore)
+			if ts.Status == nil {
+				return "", errors.Errorf("TrustStore is not fulfilled yet: %v", ts.ID())
+			}
+			return ts.Status.TrustStoreARN, nil
+		},
+	)
+}
+
+// TrustStoreSpec defines the desired state of TrustStore
+type TrustStoreSpec struct {
+	// The name of the trust store.
+	Name string `json:"name"`
+
+	// The Amazon S3 bucket for the ca certificates bundle.
+	CACertificatesBundleS3Bucket string `json:"caCertificatesBundleS3Bucket"`
+
+	// The Amazon S3 path for the ca certificates bundle.
+	CACertificatesBundleS3Key string `json:"caCertificatesBundleS3Key"`
+
+	// The Amazon S3 object version for the ca certificates bundle.
+	// +optional
+	CACertificatesBundleS3ObjectVersion *string `json:"caCertificatesBundleS3ObjectVersion,omitempty"`
+
+	// The ca certificates bundle to upload to the Amazon S3 bucket and path before the trust store is created.
+	// +optional
+	CACertificatesBundle *string `json:"caCertificatesBundle,omitempty"`
+
+	// The tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// TrustStoreStatus defines the observed state of TrustStore
+type TrustStoreStatus struct {
+	// The Amazon Resource Name (ARN) of the trust store.
+	TrustStoreARN string `json:"trustStoreARN"`
+}
@@ -16,8 +16,10 @@ import (
 	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -103,6 +105,49 @@ func (p *defaultBackendSGProvider) Release(ctx context.Context) error {
 	return p.releaseSG(ctx)
 }
 
+// releaseUnused releases the auto-generated backend SG if it's no longer required, even if it's allocated by other replicas.
+func (p *defaultBackendSGProvider) releaseUnused(ctx context.Context) error {
+	if len(p.backendSG) > 0 {
+		return nil
+	}
+	if required, err := p.isBackendSGRequired(ctx); required || err != nil {
+		return err
+	}
+	if err := p.loadAutoGeneratedSG(ctx); err != nil {
+		return err
+	}
+	return p.releaseSG(ctx)
+}
+
+// forgetUnused forgets the auto-generated backend SG if it's no longer required, since it might be released by other replicas.
+func (p *defaultBackendSGProvider) forgetUnused(ctx context.Context) error {
+	if len(p.backendSG) > 0 {
+		return nil
+	}
+	if required, err := p.isBackendSGRequired(ctx); required || err != nil {
+		return err
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.autoGeneratedSG = ""
+	return nil
+}
+
+// loadAutoGeneratedSG looks up the auto-generated backend SG from EC2 if it isn't known yet.
+func (p *defaultBackendSGProvider) loadAutoGeneratedSG(ctx context.Context) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if len(p.autoGeneratedSG) > 0 {
+		return nil
+	}
+	sgID, err := p.getBackendSGFromEC2(ctx, p.getBackendSGName(), p.vpcID)
+	if err != nil {
+		return err
+	}
+	p.autoGeneratedSG = sgID
+	return nil
+}
+
 func (p *defaultBackendSGProvider) allocateBackendSG(ctx context.Context) error {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
@@ -210,7 +255,23 @@ func (p *defaultBackendSGProvider) isBackendSGRequired(ctx context.Context) (boo
 			}
 		}
 	}
-	p.logger.Info("No ingress found, backend SG can be deleted", "SG ID", p.autoGeneratedSG)
+	// NLBs of Services may use the backend SG as well, so it's kept as long as there are Services with load balancer provisioned.
+	svcList := &corev1.ServiceList{}
+	if err := p.k8sClient.List(ctx, svcList); err != nil {
+		p.logger.Error(err, "Unable to list services")
+		return true, errors.Wrapf(err, "unable to list services")
+	}
+	for _, svc := range svcList.Items {
+		if !svc.DeletionTimestamp.IsZero() {
+			continue
+		}
+		for _, fin := range svc.GetFinalizers() {
+			if fin == k8s.ServiceFinalizer || strings.HasPrefix(fin, k8s.ServiceGroupFinalizerPrefix) {
+				return true, nil
+			}
+		}
+	}
+	p.logger.V(1).Info("No ingress or service found, backend SG can be deleted", "SG ID", p.autoGeneratedSG)
 	return false, nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	mock_client "sigs.k8s.io/aws-load-balancer-controller/mocks/controller-runtime/client"
@@ -275,6 +276,10 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 		ingresses []*networking.Ingress
 		err       error
 	}
+	type listServiceCall struct {
+		services []*corev1.Service
+		err      error
+	}
 	type deleteSecurityGroupWithContextCall struct {
 		req  *ec2sdk.DeleteSecurityGroupInput
 		resp *ec2sdk.DeleteSecurityGroupOutput
@@ -285,6 +290,7 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 		backendSG        string
 		defaultTags      map[string]string
 		listIngressCalls []listIngressCall
+		listServiceCalls []listServiceCall
 		deleteSGCalls    []deleteSecurityGroupWithContextCall
 	}
 	tests := []struct {
@@ -308,6 +314,11 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 						ingresses: []*networking.Ingress{},
 					},
 				},
+				listServiceCalls: []listServiceCall{
+					{
+						services: []*corev1.Service{},
+					},
+				},
 				deleteSGCalls: []deleteSecurityGroupWithContextCall{
 					{
 						req: &ec2sdk.DeleteSecurityGroupInput{
@@ -362,6 +373,60 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "backend sg required for service",
+			fields: fields{
+				autogenSG: "sg-autogen",
+				listIngressCalls: []listIngressCall{
+					{
+						ingresses: []*networking.Ingress{},
+					},
+				},
+				listServiceCalls: []listServiceCall{
+					{
+						services: []*corev1.Service{
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "regular-ns",
+									Name:      "svc-nofinalizer",
+								},
+							},
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace:  "awesome-ns",
+									Name:       "svc-1",
+									Finalizers: []string{"service.k8s.aws/resources"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "backend sg required for service group",
+			fields: fields{
+				autogenSG: "sg-autogen",
+				listIngressCalls: []listIngressCall{
+					{
+						ingresses: []*networking.Ingress{},
+					},
+				},
+				listServiceCalls: []listServiceCall{
+					{
+						services: []*corev1.Service{
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace:  "awesome-ns",
+									Name:       "svc-1",
+									Finalizers: []string{"group.service.k8s.aws/awesome-group"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "First SG delete attempt fails",
 			fields: fields{
@@ -371,6 +436,11 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 						ingresses: []*networking.Ingress{},
 					},
 				},
+				listServiceCalls: []listServiceCall{
+					{
+						services: []*corev1.Service{},
+					},
+				},
 				deleteSGCalls: []deleteSecurityGroupWithContextCall{
 					{
 						req: &ec2sdk.DeleteSecurityGroupInput{
@@ -395,6 +465,10 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 					{},
 					{},
 				},
+				listServiceCalls: []listServiceCall{
+					{},
+					{},
+				},
 				deleteSGCalls: []deleteSecurityGroupWithContextCall{
 					{
 						req: &ec2sdk.DeleteSecurityGroupInput{
@@ -449,6 +523,16 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 					},
 				).AnyTimes()
 			}
+			for _, call := range tt.fields.listServiceCalls {
+				k8sClient.EXPECT().List(gomock.Any(), &corev1.ServiceList{}, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, svcList *corev1.ServiceList, opts ...client.ListOption) error {
+						for _, svc := range call.services {
+							svcList.Items = append(svcList.Items, *(svc.DeepCopy()))
+						}
+						return call.err
+					},
+				).AnyTimes()
+			}
 			for _, ing := range tt.env.ingresses {
 				assert.NoError(t, k8sClient.Create(context.Background(), ing.DeepCopy()))
 			}
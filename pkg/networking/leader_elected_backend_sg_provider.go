@@ -0,0 +1,69 @@
+package networking
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	defaultSGReleaseInterval = 5 * time.Minute
+)
+
+// NewLeaderElectedBackendSGProvider constructs new leaderElectedBackendSGProvider.
+// elected should be closed once this replica is elected as leader.
+func NewLeaderElectedBackendSGProvider(provider *defaultBackendSGProvider, elected <-chan struct{}, logger logr.Logger) *leaderElectedBackendSGProvider {
+	return &leaderElectedBackendSGProvider{
+		provider:        provider,
+		elected:         elected,
+		releaseInterval: defaultSGReleaseInterval,
+		logger:          logger,
+	}
+}
+
+var _ BackendSGProvider = &leaderElectedBackendSGProvider{}
+var _ manager.Runnable = &leaderElectedBackendSGProvider{}
+var _ manager.LeaderElectionRunnable = &leaderElectedBackendSGProvider{}
+
+// leaderElectedBackendSGProvider is the BackendSGProvider used when Ingresses and Services are reconciled by every replica.
+// The auto-generated backend SG is only released by the leader replica. Since the last Ingress or Service that requires it
+// might be deleted on any replica, the leader replica checks periodically whether it can be released as well.
+type leaderElectedBackendSGProvider struct {
+	provider        *defaultBackendSGProvider
+	elected         <-chan struct{}
+	releaseInterval time.Duration
+	logger          logr.Logger
+}
+
+func (p *leaderElectedBackendSGProvider) Get(ctx context.Context) (string, error) {
+	return p.provider.Get(ctx)
+}
+
+// Release releases the auto-generated backend SG on the leader replica.
+// other replicas forget about the auto-generated backend SG instead, so that it's looked up again after the leader released it.
+func (p *leaderElectedBackendSGProvider) Release(ctx context.Context) error {
+	select {
+	case <-p.elected:
+		return p.provider.Release(ctx)
+	default:
+		return p.provider.forgetUnused(ctx)
+	}
+}
+
+// Start will release the auto-generated backend SG periodically until ctx is done.
+func (p *leaderElectedBackendSGProvider) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := p.provider.releaseUnused(ctx); err != nil {
+			p.logger.Error(err, "failed to release backend SG")
+		}
+	}, p.releaseInterval)
+	return nil
+}
+
+// NeedLeaderElection returns true, only the leader replica releases the auto-generated backend SG.
+func (p *leaderElectedBackendSGProvider) NeedLeaderElection() bool {
+	return true
+}
@@ -0,0 +1,147 @@
+package networking
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_leaderElectedBackendSGProvider_Release(t *testing.T) {
+	ingWithFinalizer := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "awesome-ns",
+			Name:       "ing-1",
+			Finalizers: []string{"ingress.k8s.aws/resources"},
+		},
+	}
+	tests := []struct {
+		name          string
+		elected       bool
+		ingresses     []*networking.Ingress
+		wantDeleteSG  bool
+		wantAutogenSG string
+	}{
+		{
+			name:          "leader releases unused backend SG",
+			elected:       true,
+			wantDeleteSG:  true,
+			wantAutogenSG: "",
+		},
+		{
+			name:          "leader keeps required backend SG",
+			elected:       true,
+			ingresses:     []*networking.Ingress{ingWithFinalizer},
+			wantAutogenSG: "sg-autogen",
+		},
+		{
+			name:          "non-leader forgets unused backend SG without releasing it",
+			elected:       false,
+			wantAutogenSG: "",
+		},
+		{
+			name:          "non-leader keeps required backend SG",
+			elected:       false,
+			ingresses:     []*networking.Ingress{ingWithFinalizer},
+			wantAutogenSG: "sg-autogen",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			k8sClient := newBackendSGTestClient(t, tt.ingresses)
+			if tt.wantDeleteSG {
+				ec2Client.EXPECT().DeleteSecurityGroupWithContext(gomock.Any(), &ec2sdk.DeleteSecurityGroupInput{
+					GroupId: awssdk.String("sg-autogen"),
+				}).Return(&ec2sdk.DeleteSecurityGroupOutput{}, nil)
+			}
+			sgProvider := NewBackendSGProvider(defaultClusterName, "", defaultVPCID, ec2Client, k8sClient, nil, logr.Discard())
+			sgProvider.autoGeneratedSG = "sg-autogen"
+			elected := make(chan struct{})
+			if tt.elected {
+				close(elected)
+			}
+			p := NewLeaderElectedBackendSGProvider(sgProvider, elected, logr.Discard())
+
+			assert.NoError(t, p.Release(context.Background()))
+			assert.Equal(t, tt.wantAutogenSG, sgProvider.autoGeneratedSG)
+		})
+	}
+}
+
+func Test_defaultBackendSGProvider_releaseUnused(t *testing.T) {
+	tests := []struct {
+		name         string
+		backendSG    string
+		autogenSG    string
+		describeResp []*ec2sdk.SecurityGroup
+		wantDescribe bool
+		wantDeleteSG string
+	}{
+		{
+			name:         "backend SG allocated by other replica is looked up and released",
+			describeResp: []*ec2sdk.SecurityGroup{{GroupId: awssdk.String("sg-autogen")}},
+			wantDescribe: true,
+			wantDeleteSG: "sg-autogen",
+		},
+		{
+			name:         "known backend SG is released",
+			autogenSG:    "sg-autogen",
+			wantDeleteSG: "sg-autogen",
+		},
+		{
+			name:         "no backend SG exists",
+			wantDescribe: true,
+		},
+		{
+			name:      "backend SG is specified explicitly",
+			backendSG: "sg-explicit",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			k8sClient := newBackendSGTestClient(t, nil)
+			if tt.wantDescribe {
+				ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), gomock.Any()).Return(tt.describeResp, nil)
+			}
+			if tt.wantDeleteSG != "" {
+				ec2Client.EXPECT().DeleteSecurityGroupWithContext(gomock.Any(), &ec2sdk.DeleteSecurityGroupInput{
+					GroupId: awssdk.String(tt.wantDeleteSG),
+				}).Return(&ec2sdk.DeleteSecurityGroupOutput{}, nil)
+			}
+			sgProvider := NewBackendSGProvider(defaultClusterName, tt.backendSG, defaultVPCID, ec2Client, k8sClient, nil, logr.Discard())
+			sgProvider.autoGeneratedSG = tt.autogenSG
+
+			assert.NoError(t, sgProvider.releaseUnused(context.Background()))
+			assert.Equal(t, "", sgProvider.autoGeneratedSG)
+		})
+	}
+}
+
+func newBackendSGTestClient(t *testing.T, ingresses []*networking.Ingress) client.Client {
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	for _, ing := range ingresses {
+		assert.NoError(t, k8sClient.Create(context.Background(), ing.DeepCopy()))
+	}
+	return k8sClient
+}
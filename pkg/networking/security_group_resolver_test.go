@@ -0,0 +1,154 @@
+package networking
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
+	type describeSecurityGroupsAsListCall struct {
+		req  *ec2sdk.DescribeSecurityGroupsInput
+		resp []*ec2sdk.SecurityGroup
+		err  error
+	}
+	type fields struct {
+		describeSGCalls []describeSecurityGroupsAsListCall
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		sgNameOrIDs []string
+		want        []string
+		wantErr     error
+	}{
+		{
+			name: "resolve via IDs",
+			fields: fields{
+				describeSGCalls: []describeSecurityGroupsAsListCall{
+					{
+						req: &ec2sdk.DescribeSecurityGroupsInput{
+							GroupIds: awssdk.StringSlice([]string{"sg-1", "sg-2"}),
+						},
+						resp: []*ec2sdk.SecurityGroup{
+							{
+								GroupId: awssdk.String("sg-1"),
+							},
+							{
+								GroupId: awssdk.String("sg-2"),
+							},
+						},
+					},
+				},
+			},
+			sgNameOrIDs: []string{"sg-1", "sg-2"},
+			want:        []string{"sg-1", "sg-2"},
+		},
+		{
+			name: "resolve via names and IDs",
+			fields: fields{
+				describeSGCalls: []describeSecurityGroupsAsListCall{
+					{
+						req: &ec2sdk.DescribeSecurityGroupsInput{
+							GroupIds: awssdk.StringSlice([]string{"sg-1"}),
+						},
+						resp: []*ec2sdk.SecurityGroup{
+							{
+								GroupId: awssdk.String("sg-1"),
+							},
+						},
+					},
+					{
+						req: &ec2sdk.DescribeSecurityGroupsInput{
+							Filters: []*ec2sdk.Filter{
+								{
+									Name:   awssdk.String("tag:Name"),
+									Values: awssdk.StringSlice([]string{"my-sg"}),
+								},
+								{
+									Name:   awssdk.String("vpc-id"),
+									Values: awssdk.StringSlice([]string{defaultVPCID}),
+								},
+							},
+						},
+						resp: []*ec2sdk.SecurityGroup{
+							{
+								GroupId: awssdk.String("sg-2"),
+							},
+						},
+					},
+				},
+			},
+			sgNameOrIDs: []string{"my-sg", "sg-1"},
+			want:        []string{"sg-1", "sg-2"},
+		},
+		{
+			name: "couldn't find all securityGroups",
+			fields: fields{
+				describeSGCalls: []describeSecurityGroupsAsListCall{
+					{
+						req: &ec2sdk.DescribeSecurityGroupsInput{
+							Filters: []*ec2sdk.Filter{
+								{
+									Name:   awssdk.String("tag:Name"),
+									Values: awssdk.StringSlice([]string{"my-sg", "other-sg"}),
+								},
+								{
+									Name:   awssdk.String("vpc-id"),
+									Values: awssdk.StringSlice([]string{defaultVPCID}),
+								},
+							},
+						},
+						resp: []*ec2sdk.SecurityGroup{
+							{
+								GroupId: awssdk.String("sg-2"),
+							},
+						},
+					},
+				},
+			},
+			sgNameOrIDs: []string{"my-sg", "other-sg"},
+			wantErr:     errors.New("couldn't find all securityGroups, nameOrIDs: [my-sg other-sg], found: [sg-2]"),
+		},
+		{
+			name: "describe securityGroups failed",
+			fields: fields{
+				describeSGCalls: []describeSecurityGroupsAsListCall{
+					{
+						req: &ec2sdk.DescribeSecurityGroupsInput{
+							GroupIds: awssdk.StringSlice([]string{"sg-1"}),
+						},
+						err: errors.New("some error"),
+					},
+				},
+			},
+			sgNameOrIDs: []string{"sg-1"},
+			wantErr:     errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.fields.describeSGCalls {
+				ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			r := NewDefaultSecurityGroupResolver(ec2Client, defaultVPCID)
+			got, err := r.ResolveViaNameOrID(context.Background(), tt.sgNameOrIDs)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
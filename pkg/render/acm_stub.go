@@ -0,0 +1,61 @@
+package render
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	acmsdk "github.com/aws/aws-sdk-go/service/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+// newStubACM constructs new stubACM for environment.
+func newStubACM(env Environment) *stubACM {
+	stub := &stubACM{}
+	for _, cert := range env.Certificates {
+		certDetail := &acmsdk.CertificateDetail{
+			CertificateArn:          awssdk.String(cert.ARN),
+			Status:                  awssdk.String(acmsdk.CertificateStatusIssued),
+			Type:                    awssdk.String(acmsdk.CertificateTypeAmazonIssued),
+			SubjectAlternativeNames: awssdk.StringSlice(cert.Domains),
+		}
+		if len(cert.Domains) != 0 {
+			certDetail.DomainName = awssdk.String(cert.Domains[0])
+		}
+		stub.certificates = append(stub.certificates, certDetail)
+	}
+	return stub
+}
+
+var _ services.ACM = &stubACM{}
+
+// stubACM serves the ACM lookups made by model builders from environment.
+// Only the lookups used by model builders are implemented, other calls will panic.
+type stubACM struct {
+	services.ACM
+
+	certificates []*acmsdk.CertificateDetail
+}
+
+func (c *stubACM) ListCertificatesAsList(_ context.Context, _ *acmsdk.ListCertificatesInput) ([]*acmsdk.CertificateSummary, error) {
+	certSummaries := make([]*acmsdk.CertificateSummary, 0, len(c.certificates))
+	for _, cert := range c.certificates {
+		certSummaries = append(certSummaries, &acmsdk.CertificateSummary{
+			CertificateArn: cert.CertificateArn,
+			DomainName:     cert.DomainName,
+		})
+	}
+	return certSummaries, nil
+}
+
+func (c *stubACM) DescribeCertificateWithContext(_ context.Context, input *acmsdk.DescribeCertificateInput, _ ...request.Option) (*acmsdk.DescribeCertificateOutput, error) {
+	for _, cert := range c.certificates {
+		if awssdk.StringValue(cert.CertificateArn) == awssdk.StringValue(input.CertificateArn) {
+			return &acmsdk.DescribeCertificateOutput{
+				Certificate: cert,
+			}, nil
+		}
+	}
+	return nil, awserr.New(acmsdk.ErrCodeResourceNotFoundException, "Could not find certificate "+awssdk.StringValue(input.CertificateArn), nil)
+}
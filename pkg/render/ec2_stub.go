@@ -0,0 +1,143 @@
+package render
+
+import (
+	"context"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+// newStubEC2 constructs new stubEC2 for environment.
+func newStubEC2(env Environment) *stubEC2 {
+	stub := &stubEC2{
+		vpc: env.buildSDKVPC(),
+	}
+	for _, subnet := range env.Subnets {
+		stub.subnets = append(stub.subnets, env.buildSDKSubnet(subnet))
+		zoneType := subnet.ZoneType
+		if zoneType == "" {
+			zoneType = zoneTypeAvailabilityZone
+		}
+		stub.availabilityZones = append(stub.availabilityZones, &ec2sdk.AvailabilityZone{
+			ZoneId:   awssdk.String(subnet.AvailabilityZoneID),
+			ZoneName: awssdk.String(subnet.AvailabilityZone),
+			ZoneType: awssdk.String(zoneType),
+		})
+	}
+	for _, sg := range env.SecurityGroups {
+		stub.securityGroups = append(stub.securityGroups, env.buildSDKSecurityGroup(sg))
+	}
+	return stub
+}
+
+var _ services.EC2 = &stubEC2{}
+
+// stubEC2 serves the EC2 lookups made by model builders from environment.
+// Only the lookups used by model builders are implemented, other calls will panic.
+type stubEC2 struct {
+	services.EC2
+
+	vpc               *ec2sdk.Vpc
+	subnets           []*ec2sdk.Subnet
+	securityGroups    []*ec2sdk.SecurityGroup
+	availabilityZones []*ec2sdk.AvailabilityZone
+}
+
+func (c *stubEC2) DescribeVpcsWithContext(_ context.Context, input *ec2sdk.DescribeVpcsInput, _ ...request.Option) (*ec2sdk.DescribeVpcsOutput, error) {
+	for _, vpcID := range input.VpcIds {
+		if awssdk.StringValue(vpcID) != awssdk.StringValue(c.vpc.VpcId) {
+			return nil, awserr.New("InvalidVpcID.NotFound", "The vpc ID '"+awssdk.StringValue(vpcID)+"' does not exist", nil)
+		}
+	}
+	return &ec2sdk.DescribeVpcsOutput{
+		Vpcs: []*ec2sdk.Vpc{c.vpc},
+	}, nil
+}
+
+func (c *stubEC2) DescribeAvailabilityZonesWithContext(_ context.Context, input *ec2sdk.DescribeAvailabilityZonesInput, _ ...request.Option) (*ec2sdk.DescribeAvailabilityZonesOutput, error) {
+	zoneIDs := sets.NewString(awssdk.StringValueSlice(input.ZoneIds)...)
+	var availabilityZones []*ec2sdk.AvailabilityZone
+	for _, az := range c.availabilityZones {
+		if zoneIDs.Len() != 0 && !zoneIDs.Has(awssdk.StringValue(az.ZoneId)) {
+			continue
+		}
+		availabilityZones = append(availabilityZones, az)
+	}
+	return &ec2sdk.DescribeAvailabilityZonesOutput{
+		AvailabilityZones: availabilityZones,
+	}, nil
+}
+
+func (c *stubEC2) DescribeSubnetsAsList(_ context.Context, input *ec2sdk.DescribeSubnetsInput) ([]*ec2sdk.Subnet, error) {
+	subnetIDs := sets.NewString(awssdk.StringValueSlice(input.SubnetIds)...)
+	var subnets []*ec2sdk.Subnet
+	for _, subnet := range c.subnets {
+		if subnetIDs.Len() != 0 && !subnetIDs.Has(awssdk.StringValue(subnet.SubnetId)) {
+			continue
+		}
+		matches, err := matchesFilters(input.Filters, awssdk.StringValue(subnet.VpcId), subnet.Tags)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			subnets = append(subnets, subnet)
+		}
+	}
+	return subnets, nil
+}
+
+func (c *stubEC2) DescribeSecurityGroupsAsList(_ context.Context, input *ec2sdk.DescribeSecurityGroupsInput) ([]*ec2sdk.SecurityGroup, error) {
+	groupIDs := sets.NewString(awssdk.StringValueSlice(input.GroupIds)...)
+	var securityGroups []*ec2sdk.SecurityGroup
+	for _, sg := range c.securityGroups {
+		if groupIDs.Len() != 0 && !groupIDs.Has(awssdk.StringValue(sg.GroupId)) {
+			continue
+		}
+		matches, err := matchesFilters(input.Filters, awssdk.StringValue(sg.VpcId), sg.Tags)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			securityGroups = append(securityGroups, sg)
+		}
+	}
+	return securityGroups, nil
+}
+
+// matchesFilters checks whether resource with vpcID and tags matches all filters.
+// Only the vpc-id and tag:<key> filters are supported.
+func matchesFilters(filters []*ec2sdk.Filter, vpcID string, tags []*ec2sdk.Tag) (bool, error) {
+	for _, filter := range filters {
+		filterName := awssdk.StringValue(filter.Name)
+		filterValues := sets.NewString(awssdk.StringValueSlice(filter.Values)...)
+		switch {
+		case filterName == "vpc-id":
+			if !filterValues.Has(vpcID) {
+				return false, nil
+			}
+		case strings.HasPrefix(filterName, "tag:"):
+			tagKey := strings.TrimPrefix(filterName, "tag:")
+			if !matchesTagFilter(tags, tagKey, filterValues) {
+				return false, nil
+			}
+		default:
+			return false, errors.Errorf("unsupported filter: %v", filterName)
+		}
+	}
+	return true, nil
+}
+
+func matchesTagFilter(tags []*ec2sdk.Tag, tagKey string, tagValues sets.String) bool {
+	for _, tag := range tags {
+		if awssdk.StringValue(tag.Key) == tagKey && tagValues.Has(awssdk.StringValue(tag.Value)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,213 @@
+package render
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// the zone type of AvailabilityZones.
+	zoneTypeAvailabilityZone = "availability-zone"
+	// the available IP address count of subnets if not specified, which is the count for a /24 subnet.
+	defaultSubnetAvailableIPAddressCount = 251
+)
+
+// Environment describes the AWS resources that model builders are rendered against.
+type Environment struct {
+	// VPCID is the ID of VPC that cluster runs in.
+	VPCID string `json:"vpcID"`
+
+	// CIDRBlocks are the IPv4 CIDR blocks of VPC.
+	// +optional
+	CIDRBlocks []string `json:"cidrBlocks,omitempty"`
+
+	// IPv6CIDRBlocks are the IPv6 CIDR blocks of VPC.
+	// +optional
+	IPv6CIDRBlocks []string `json:"ipv6CIDRBlocks,omitempty"`
+
+	// Subnets are the subnets within VPC.
+	// +optional
+	Subnets []Subnet `json:"subnets,omitempty"`
+
+	// SecurityGroups are the securityGroups within VPC.
+	// +optional
+	SecurityGroups []SecurityGroup `json:"securityGroups,omitempty"`
+
+	// Certificates are the ACM certificates available for auto-discovery.
+	// +optional
+	Certificates []Certificate `json:"certificates,omitempty"`
+}
+
+// Subnet describes a subnet within VPC.
+type Subnet struct {
+	// ID is the subnet ID.
+	ID string `json:"id"`
+
+	// AvailabilityZone is the name of AvailabilityZone.
+	AvailabilityZone string `json:"availabilityZone"`
+
+	// AvailabilityZoneID is the ID of AvailabilityZone.
+	AvailabilityZoneID string `json:"availabilityZoneID"`
+
+	// ZoneType is the type of zone, defaults to availability-zone.
+	// +optional
+	ZoneType string `json:"zoneType,omitempty"`
+
+	// CIDRBlock is the IPv4 CIDR block of subnet.
+	CIDRBlock string `json:"cidrBlock"`
+
+	// IPv6CIDRBlocks are the IPv6 CIDR blocks of subnet.
+	// +optional
+	IPv6CIDRBlocks []string `json:"ipv6CIDRBlocks,omitempty"`
+
+	// AvailableIPAddressCount is the count of available IPv4 addresses, defaults to 251.
+	// +optional
+	AvailableIPAddressCount *int64 `json:"availableIPAddressCount,omitempty"`
+
+	// Tags are the tags of subnet, e.g. kubernetes.io/role/elb: "1".
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// SecurityGroup describes a securityGroup within VPC.
+type SecurityGroup struct {
+	// ID is the securityGroup ID.
+	ID string `json:"id"`
+
+	// Name is the securityGroup name.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Tags are the tags of securityGroup.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// Certificate describes an ACM certificate.
+type Certificate struct {
+	// ARN is the certificate ARN.
+	ARN string `json:"arn"`
+
+	// Domains are the domain names of certificate, including wildcard domains like *.example.com.
+	Domains []string `json:"domains"`
+}
+
+// LoadEnvironment loads Environment from YAML or JSON payload.
+func LoadEnvironment(payload []byte) (Environment, error) {
+	env := Environment{}
+	if err := yaml.UnmarshalStrict(payload, &env); err != nil {
+		return Environment{}, errors.Wrap(err, "failed to decode environment")
+	}
+	if err := env.validate(); err != nil {
+		return Environment{}, err
+	}
+	return env, nil
+}
+
+func (env *Environment) validate() error {
+	if env.VPCID == "" {
+		return errors.New("vpcID must be specified")
+	}
+	for _, subnet := range env.Subnets {
+		if subnet.ID == "" || subnet.AvailabilityZone == "" || subnet.AvailabilityZoneID == "" || subnet.CIDRBlock == "" {
+			return errors.Errorf("id, availabilityZone, availabilityZoneID and cidrBlock must be specified for subnet: %v", subnet.ID)
+		}
+	}
+	for _, sg := range env.SecurityGroups {
+		if sg.ID == "" {
+			return errors.New("id must be specified for securityGroup")
+		}
+	}
+	for _, cert := range env.Certificates {
+		if cert.ARN == "" {
+			return errors.New("arn must be specified for certificate")
+		}
+	}
+	return nil
+}
+
+// buildSDKVPC builds the EC2 VPC for environment.
+func (env *Environment) buildSDKVPC() *ec2sdk.Vpc {
+	sdkVPC := &ec2sdk.Vpc{
+		VpcId: awssdk.String(env.VPCID),
+	}
+	for _, cidrBlock := range env.CIDRBlocks {
+		sdkVPC.CidrBlockAssociationSet = append(sdkVPC.CidrBlockAssociationSet, &ec2sdk.VpcCidrBlockAssociation{
+			CidrBlock: awssdk.String(cidrBlock),
+			CidrBlockState: &ec2sdk.VpcCidrBlockState{
+				State: awssdk.String(ec2sdk.VpcCidrBlockStateCodeAssociated),
+			},
+		})
+	}
+	if len(env.CIDRBlocks) != 0 {
+		sdkVPC.CidrBlock = awssdk.String(env.CIDRBlocks[0])
+	}
+	for _, cidrBlock := range env.IPv6CIDRBlocks {
+		sdkVPC.Ipv6CidrBlockAssociationSet = append(sdkVPC.Ipv6CidrBlockAssociationSet, &ec2sdk.VpcIpv6CidrBlockAssociation{
+			Ipv6CidrBlock: awssdk.String(cidrBlock),
+			Ipv6CidrBlockState: &ec2sdk.VpcCidrBlockState{
+				State: awssdk.String(ec2sdk.VpcCidrBlockStateCodeAssociated),
+			},
+		})
+	}
+	return sdkVPC
+}
+
+// buildSDKSubnet builds the EC2 subnet for subnet.
+func (env *Environment) buildSDKSubnet(subnet Subnet) *ec2sdk.Subnet {
+	availableIPAddressCount := int64(defaultSubnetAvailableIPAddressCount)
+	if subnet.AvailableIPAddressCount != nil {
+		availableIPAddressCount = *subnet.AvailableIPAddressCount
+	}
+	sdkSubnet := &ec2sdk.Subnet{
+		SubnetId:                awssdk.String(subnet.ID),
+		VpcId:                   awssdk.String(env.VPCID),
+		AvailabilityZone:        awssdk.String(subnet.AvailabilityZone),
+		AvailabilityZoneId:      awssdk.String(subnet.AvailabilityZoneID),
+		CidrBlock:               awssdk.String(subnet.CIDRBlock),
+		AvailableIpAddressCount: awssdk.Int64(availableIPAddressCount),
+		Tags:                    buildSDKTags(subnet.Tags),
+	}
+	for _, cidrBlock := range subnet.IPv6CIDRBlocks {
+		sdkSubnet.Ipv6CidrBlockAssociationSet = append(sdkSubnet.Ipv6CidrBlockAssociationSet, &ec2sdk.SubnetIpv6CidrBlockAssociation{
+			Ipv6CidrBlock: awssdk.String(cidrBlock),
+			Ipv6CidrBlockState: &ec2sdk.SubnetCidrBlockState{
+				State: awssdk.String(ec2sdk.SubnetCidrBlockStateCodeAssociated),
+			},
+		})
+	}
+	return sdkSubnet
+}
+
+// buildSDKSecurityGroup builds the EC2 securityGroup for sg.
+func (env *Environment) buildSDKSecurityGroup(sg SecurityGroup) *ec2sdk.SecurityGroup {
+	tags := make(map[string]string, len(sg.Tags)+1)
+	for key, value := range sg.Tags {
+		tags[key] = value
+	}
+	if _, ok := tags["Name"]; !ok && sg.Name != "" {
+		tags["Name"] = sg.Name
+	}
+	sdkSG := &ec2sdk.SecurityGroup{
+		GroupId: awssdk.String(sg.ID),
+		VpcId:   awssdk.String(env.VPCID),
+		Tags:    buildSDKTags(tags),
+	}
+	if sg.Name != "" {
+		sdkSG.GroupName = awssdk.String(sg.Name)
+	}
+	return sdkSG
+}
+
+func buildSDKTags(tags map[string]string) []*ec2sdk.Tag {
+	sdkTags := make([]*ec2sdk.Tag, 0, len(tags))
+	for key, value := range tags {
+		sdkTags = append(sdkTags, &ec2sdk.Tag{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(value),
+		})
+	}
+	return sdkTags
+}
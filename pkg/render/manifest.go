@@ -0,0 +1,87 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// the namespace for namespaced objects without namespace, same as kubectl.
+	defaultNamespace = "default"
+)
+
+// kinds of cluster-scoped objects that are relevant to model builders.
+var clusterScopedKinds = sets.NewString("Namespace", "Node", "IngressClass", "IngressClassParams")
+
+// LoadObjects decodes Kubernetes objects from YAML or JSON manifests, which may contain multiple documents.
+func LoadObjects(scheme *runtime.Scheme, payload []byte) ([]client.Object, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := k8syaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(payload)))
+	var objects []client.Object
+	for {
+		document, err := reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, errors.Wrap(err, "failed to read manifest")
+		}
+		if isEmptyDocument(document) {
+			continue
+		}
+		rawObj, gvk, err := decoder.Decode(document, nil, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode manifest")
+		}
+		obj, ok := rawObj.(client.Object)
+		if !ok {
+			return nil, errors.Errorf("unsupported object kind: %v", gvk.Kind)
+		}
+		if obj.GetNamespace() == "" && !clusterScopedKinds.Has(gvk.Kind) {
+			obj.SetNamespace(defaultNamespace)
+		}
+		applyDefaults(obj)
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// applyDefaults applies the defaults that API server would set on objects and are relied on by model builders.
+func applyDefaults(obj client.Object) {
+	svc, ok := obj.(*corev1.Service)
+	if !ok {
+		return
+	}
+	if svc.Spec.Type == "" {
+		svc.Spec.Type = corev1.ServiceTypeClusterIP
+	}
+	for i := range svc.Spec.Ports {
+		port := &svc.Spec.Ports[i]
+		if port.Protocol == "" {
+			port.Protocol = corev1.ProtocolTCP
+		}
+		if port.TargetPort == (intstr.IntOrString{}) {
+			port.TargetPort = intstr.FromInt(int(port.Port))
+		}
+	}
+}
+
+// isEmptyDocument checks whether YAML document contains nothing but comments or whitespaces.
+func isEmptyDocument(document []byte) bool {
+	var content map[string]interface{}
+	if err := yaml.Unmarshal(document, &content); err != nil {
+		return false
+	}
+	return len(content) == 0
+}
@@ -0,0 +1,111 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+func TestLoadObjects(t *testing.T) {
+	scheme := runtime.NewScheme()
+	clientgoscheme.AddToScheme(scheme)
+
+	tests := []struct {
+		name    string
+		payload string
+		want    func(t *testing.T, objects []interface{})
+		wantErr string
+	}{
+		{
+			name: "multiple documents",
+			payload: `
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: alb
+spec:
+  controller: ingress.k8s.aws/alb
+---
+# comments only
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+  namespace: awesome-ns
+spec:
+  ports:
+  - port: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+spec:
+  type: NodePort
+  ports:
+  - port: 443
+    targetPort: https
+    protocol: UDP
+`,
+			want: func(t *testing.T, objects []interface{}) {
+				assert.Len(t, objects, 3)
+				ingClass := objects[0].(*networking.IngressClass)
+				assert.Equal(t, "", ingClass.Namespace)
+				assert.Equal(t, "alb", ingClass.Name)
+
+				svc := objects[1].(*corev1.Service)
+				assert.Equal(t, "awesome-ns", svc.Namespace)
+				assert.Equal(t, corev1.ServiceTypeClusterIP, svc.Spec.Type)
+				assert.Equal(t, []corev1.ServicePort{
+					{
+						Port:       80,
+						Protocol:   corev1.ProtocolTCP,
+						TargetPort: intstr.FromInt(80),
+					},
+				}, svc.Spec.Ports)
+
+				svc = objects[2].(*corev1.Service)
+				assert.Equal(t, "default", svc.Namespace)
+				assert.Equal(t, corev1.ServiceTypeNodePort, svc.Spec.Type)
+				assert.Equal(t, []corev1.ServicePort{
+					{
+						Port:       443,
+						Protocol:   corev1.ProtocolUDP,
+						TargetPort: intstr.FromString("https"),
+					},
+				}, svc.Spec.Ports)
+			},
+		},
+		{
+			name: "unknown kind",
+			payload: `
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: unknown
+`,
+			wantErr: "failed to decode manifest",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadObjects(scheme, []byte(tt.payload))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			objects := make([]interface{}, 0, len(got))
+			for _, obj := range got {
+				objects = append(objects, obj)
+			}
+			tt.want(t, objects)
+		})
+	}
+}
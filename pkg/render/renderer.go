@@ -0,0 +1,250 @@
+package render
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/lbconfig"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	// these must be kept in sync with the ingress and service controllers.
+	ingressTagPrefix        = "ingress.k8s.aws"
+	serviceTagPrefix        = "service.k8s.aws"
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+)
+
+// Renderer renders the resource stacks for Ingresses and Services without a live cluster or AWS account.
+type Renderer interface {
+	// Render renders the resource stacks for Ingresses and Services within objects.
+	// objects must contain all Kubernetes objects referenced by them, e.g. IngressClasses and backend Services.
+	Render(ctx context.Context, objects []client.Object) ([]deploy.StackSchema, error)
+
+	// BuildStacks builds the resource stacks for Ingresses and Services within objects, ordered by stackID.
+	BuildStacks(ctx context.Context, objects []client.Object) ([]StackWithTagPrefix, error)
+}
+
+// StackWithTagPrefix is a resource stack, along with the tag prefix of the controller that deploys it.
+type StackWithTagPrefix struct {
+	Stack     core.Stack
+	TagPrefix string
+}
+
+// NewDefaultRenderer constructs new defaultRenderer.
+func NewDefaultRenderer(scheme *runtime.Scheme, env Environment, controllerConfig config.ControllerConfig, logger logr.Logger) *defaultRenderer {
+	return &defaultRenderer{
+		scheme:           scheme,
+		env:              env,
+		controllerConfig: controllerConfig,
+		logger:           logger,
+	}
+}
+
+var _ Renderer = &defaultRenderer{}
+
+// defaultRenderer runs the same model builders as controller, where AWS lookups are served from environment,
+// and Kubernetes lookups are served from objects.
+type defaultRenderer struct {
+	scheme           *runtime.Scheme
+	env              Environment
+	controllerConfig config.ControllerConfig
+	logger           logr.Logger
+}
+
+func (r *defaultRenderer) Render(ctx context.Context, objects []client.Object) ([]deploy.StackSchema, error) {
+	stacks, err := r.BuildStacks(ctx, objects)
+	if err != nil {
+		return nil, err
+	}
+	stackSchemas := make([]deploy.StackSchema, 0, len(stacks))
+	for _, stack := range stacks {
+		schemaBuilder := deploy.NewStackSchemaBuilder(stack.Stack.StackID())
+		if err := stack.Stack.TopologicalTraversal(schemaBuilder); err != nil {
+			return nil, err
+		}
+		stackSchemas = append(stackSchemas, schemaBuilder.Build())
+	}
+	return stackSchemas, nil
+}
+
+func (r *defaultRenderer) BuildStacks(ctx context.Context, objects []client.Object) ([]StackWithTagPrefix, error) {
+	k8sClient := fake.NewClientBuilder().WithScheme(r.scheme).WithObjects(objects...).Build()
+	ec2Client := newStubEC2(r.env)
+	azInfoProvider := networkingpkg.NewDefaultAZInfoProvider(ec2Client, r.logger)
+	subnetsResolver := networkingpkg.NewDefaultSubnetsResolver(azInfoProvider, ec2Client, r.env.VPCID, r.controllerConfig.ClusterName, r.logger)
+
+	ingStacks, err := r.renderIngressGroups(ctx, k8sClient, ec2Client, subnetsResolver, objects)
+	if err != nil {
+		return nil, err
+	}
+	svcStacks, err := r.renderServices(ctx, k8sClient, ec2Client, subnetsResolver, objects)
+	if err != nil {
+		return nil, err
+	}
+	stacks := make([]StackWithTagPrefix, 0, len(ingStacks)+len(svcStacks))
+	for _, stack := range ingStacks {
+		stacks = append(stacks, StackWithTagPrefix{Stack: stack, TagPrefix: ingressTagPrefix})
+	}
+	for _, stack := range svcStacks {
+		stacks = append(stacks, StackWithTagPrefix{Stack: stack, TagPrefix: serviceTagPrefix})
+	}
+	sort.Slice(stacks, func(i, j int) bool {
+		return stacks[i].Stack.StackID().String() < stacks[j].Stack.StackID().String()
+	})
+	return stacks, nil
+}
+
+// renderIngressGroups renders the stacks for IngressGroups of Ingresses within objects.
+func (r *defaultRenderer) renderIngressGroups(ctx context.Context, k8sClient client.Client, ec2Client *stubEC2,
+	subnetsResolver networkingpkg.SubnetsResolver, objects []client.Object) ([]core.Stack, error) {
+	eventRecorder := &record.FakeRecorder{}
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
+	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder,
+		r.controllerConfig.FeatureGates.Enabled(config.EnableTrafficSplitController))
+	trackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, r.controllerConfig.ClusterName)
+	backendSGProvider := &stubBackendSGProvider{backendSG: r.controllerConfig.BackendSecurityGroup}
+	modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
+		ec2Client, newStubACM(r.env),
+		annotationParser, subnetsResolver,
+		authConfigBuilder, enhancedBackendBuilder, trackingProvider, &stubELBV2TaggingManager{}, r.controllerConfig.FeatureGates,
+		r.env.VPCID, r.controllerConfig.ClusterName, r.controllerConfig.DefaultTags, r.controllerConfig.ExternalManagedTags,
+		r.controllerConfig.DefaultSSLPolicy, r.controllerConfig.DefaultTargetType, backendSGProvider,
+		r.controllerConfig.EnableBackendSecurityGroup, r.controllerConfig.DisableRestrictedSGRules, r.controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType),
+		ingress.NewLoadBalancerQuotas(r.controllerConfig.IngressConfig), config.RuleConflictPolicy(r.controllerConfig.IngressConfig.RuleConflictPolicy), r.logger)
+	classLoader := ingress.NewDefaultClassLoader(k8sClient)
+	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(r.controllerConfig.IngressConfig.IngressClass)
+	manageIngressesWithoutIngressClass := r.controllerConfig.IngressConfig.IngressClass == ""
+	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass,
+		r.controllerConfig.FeatureGates.Enabled(config.IngressGroupPolicy))
+
+	var groupIDs []ingress.GroupID
+	seenGroupIDs := make(map[ingress.GroupID]bool)
+	for _, obj := range objects {
+		ing, ok := obj.(*networking.Ingress)
+		if !ok {
+			continue
+		}
+		groupID, err := groupLoader.LoadGroupIDIfAny(ctx, ing)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Ingress: %v", k8s.NamespacedName(ing))
+		}
+		if groupID == nil || seenGroupIDs[*groupID] {
+			continue
+		}
+		seenGroupIDs[*groupID] = true
+		groupIDs = append(groupIDs, *groupID)
+	}
+
+	stacks := make([]core.Stack, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		ingGroup, err := groupLoader.Load(ctx, groupID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "IngressGroup: %v", groupID)
+		}
+		stack, _, _, _, err := modelBuilder.Build(ctx, ingGroup)
+		if err != nil {
+			return nil, errors.Wrapf(err, "IngressGroup: %v", groupID)
+		}
+		stacks = append(stacks, stack)
+	}
+	return stacks, nil
+}
+
+// renderServices renders the stacks for Services and service groups within objects.
+func (r *defaultRenderer) renderServices(ctx context.Context, k8sClient client.Client, ec2Client *stubEC2,
+	subnetsResolver networkingpkg.SubnetsResolver, objects []client.Object) ([]core.Stack, error) {
+	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
+	trackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, r.controllerConfig.ClusterName)
+	vpcInfoProvider := networkingpkg.NewDefaultVPCInfoProvider(ec2Client, r.logger)
+	serviceUtils := service.NewServiceUtils(annotationParser, k8s.ServiceFinalizer, r.controllerConfig.ServiceConfig.LoadBalancerClass, r.controllerConfig.FeatureGates)
+	groupLoader := service.NewDefaultGroupLoader(k8sClient, annotationParser, serviceUtils)
+	lbConfigResolver := lbconfig.NewDefaultResolver(k8sClient)
+	modelBuilder := service.NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, r.env.VPCID, trackingProvider,
+		&stubELBV2TaggingManager{}, r.controllerConfig.FeatureGates, r.controllerConfig.ClusterName, r.controllerConfig.DefaultTags, r.controllerConfig.ExternalManagedTags,
+		r.controllerConfig.DefaultSSLPolicy, r.controllerConfig.DefaultTargetType, r.controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
+		networkingpkg.NewDefaultSecurityGroupResolver(ec2Client, r.env.VPCID), &stubBackendSGProvider{backendSG: r.controllerConfig.BackendSecurityGroup},
+		r.controllerConfig.EnableBackendSecurityGroup, r.controllerConfig.DisableRestrictedSGRules)
+
+	var stacks []core.Stack
+	seenGroupIDs := make(map[service.GroupID]bool)
+	for _, obj := range objects {
+		svc, ok := obj.(*corev1.Service)
+		if !ok {
+			continue
+		}
+		groupID, err := groupLoader.LoadGroupIDIfAny(ctx, svc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Service: %v", k8s.NamespacedName(svc))
+		}
+		if groupID != nil {
+			if seenGroupIDs[*groupID] {
+				continue
+			}
+			seenGroupIDs[*groupID] = true
+			stack, err := r.renderServiceGroup(ctx, groupLoader, lbConfigResolver, modelBuilder, *groupID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "service group: %v", *groupID)
+			}
+			stacks = append(stacks, stack)
+			continue
+		}
+		if !serviceUtils.IsServiceSupported(svc) {
+			continue
+		}
+		svcAnnotations, err := lbConfigResolver.ResolveServiceAnnotations(ctx, svc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Service: %v", k8s.NamespacedName(svc))
+		}
+		resolvedSvc := svc.DeepCopy()
+		resolvedSvc.Annotations = svcAnnotations
+		stack, _, err := modelBuilder.Build(ctx, resolvedSvc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Service: %v", k8s.NamespacedName(svc))
+		}
+		stacks = append(stacks, stack)
+	}
+	return stacks, nil
+}
+
+// renderServiceGroup renders the stack for service group, in the same way as service controller.
+func (r *defaultRenderer) renderServiceGroup(ctx context.Context, groupLoader service.GroupLoader, lbConfigResolver lbconfig.Resolver,
+	modelBuilder service.ModelBuilder, groupID service.GroupID) (core.Stack, error) {
+	group, err := groupLoader.Load(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	frontend := service.BuildGroupFrontend(group)
+	if len(group.Members) != 0 {
+		frontendAnnotations, err := lbConfigResolver.ResolveServiceAnnotations(ctx, group.Members[0])
+		if err != nil {
+			return nil, err
+		}
+		frontend.Service.Annotations = frontendAnnotations
+	}
+	for _, conflict := range frontend.PortConflicts {
+		r.logger.Info("port not exposed by service group", "serviceGroup", groupID, "conflict", conflict.String())
+	}
+	stack, _, err := modelBuilder.Build(ctx, frontend.Service, service.WithBackendByPort(frontend.BackendByPort))
+	if err != nil {
+		return nil, err
+	}
+	return stack, nil
+}
@@ -0,0 +1,203 @@
+package render
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const testEnvironment = `
+vpcID: vpc-0123
+cidrBlocks:
+- 10.0.0.0/16
+subnets:
+- id: subnet-a
+  availabilityZone: us-west-2a
+  availabilityZoneID: usw2-az1
+  cidrBlock: 10.0.0.0/24
+  tags:
+    kubernetes.io/role/elb: "1"
+- id: subnet-b
+  availabilityZone: us-west-2b
+  availabilityZoneID: usw2-az2
+  cidrBlock: 10.0.1.0/24
+  tags:
+    kubernetes.io/role/elb: "1"
+- id: subnet-c
+  availabilityZone: us-west-2c
+  availabilityZoneID: usw2-az3
+  cidrBlock: 10.0.2.0/24
+  tags:
+    kubernetes.io/role/internal-elb: "1"
+securityGroups:
+- id: sg-1
+  name: frontend
+certificates:
+- arn: arn:aws:acm:us-west-2:123456789012:certificate/cert-1
+  domains:
+  - "*.example.com"
+`
+
+const testManifests = `
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: alb
+spec:
+  controller: ingress.k8s.aws/alb
+---
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: nginx
+spec:
+  controller: k8s.io/ingress-nginx
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  type: NodePort
+  ports:
+  - port: 80
+    targetPort: 8080
+    nodePort: 30080
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: web
+  annotations:
+    alb.ingress.kubernetes.io/scheme: internet-facing
+    alb.ingress.kubernetes.io/listen-ports: '[{"HTTPS":443}]'
+    alb.ingress.kubernetes.io/security-groups: frontend
+spec:
+  ingressClassName: alb
+  rules:
+  - host: www.example.com
+    http:
+      paths:
+      - path: /
+        pathType: Prefix
+        backend:
+          service:
+            name: web
+            port:
+              number: 80
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: other-class
+spec:
+  ingressClassName: nginx
+  defaultBackend:
+    service:
+      name: web
+      port:
+        number: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: nlb
+  annotations:
+    service.beta.kubernetes.io/aws-load-balancer-type: external
+    service.beta.kubernetes.io/aws-load-balancer-nlb-target-type: ip
+spec:
+  type: LoadBalancer
+  ports:
+  - port: 80
+    targetPort: 8080
+`
+
+func Test_defaultRenderer_Render(t *testing.T) {
+	scheme := runtime.NewScheme()
+	clientgoscheme.AddToScheme(scheme)
+	elbv2api.AddToScheme(scheme)
+
+	env, err := LoadEnvironment([]byte(testEnvironment))
+	assert.NoError(t, err)
+	objects, err := LoadObjects(scheme, []byte(testManifests))
+	assert.NoError(t, err)
+
+	controllerConfig := config.ControllerConfig{
+		ClusterName:       "cluster-name",
+		DefaultTargetType: string(elbv2model.TargetTypeInstance),
+		DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+		FeatureGates:      config.NewFeatureGates(),
+		IngressConfig: config.IngressConfig{
+			IngressClass: "alb",
+		},
+	}
+	renderer := NewDefaultRenderer(scheme, env, controllerConfig, logr.New(&log.NullLogSink{}))
+	got, err := renderer.Render(context.Background(), objects)
+	assert.NoError(t, err)
+
+	stackIDs := make([]string, 0, len(got))
+	for _, stackSchema := range got {
+		stackIDs = append(stackIDs, stackSchema.ID)
+	}
+	assert.Equal(t, []string{"default/nlb", "default/web"}, stackIDs)
+
+	nlb := got[0].Resources["AWS::ElasticLoadBalancingV2::LoadBalancer"]["LoadBalancer"].(*elbv2model.LoadBalancer)
+	assert.Equal(t, elbv2model.LoadBalancerTypeNetwork, nlb.Spec.Type)
+	assert.Equal(t, elbv2model.LoadBalancerSchemeInternal, *nlb.Spec.Scheme)
+	assert.Equal(t, []elbv2model.SubnetMapping{{SubnetID: "subnet-c"}}, nlb.Spec.SubnetMappings)
+
+	alb := got[1].Resources["AWS::ElasticLoadBalancingV2::LoadBalancer"]["LoadBalancer"].(*elbv2model.LoadBalancer)
+	assert.Equal(t, elbv2model.LoadBalancerTypeApplication, alb.Spec.Type)
+	assert.Equal(t, []elbv2model.SubnetMapping{{SubnetID: "subnet-a"}, {SubnetID: "subnet-b"}}, alb.Spec.SubnetMappings)
+	albSGIDs := make([]string, 0, len(alb.Spec.SecurityGroups))
+	for _, sg := range alb.Spec.SecurityGroups {
+		sgID, err := sg.Resolve(context.Background())
+		assert.NoError(t, err)
+		albSGIDs = append(albSGIDs, sgID)
+	}
+	assert.Equal(t, []string{"sg-1"}, albSGIDs)
+	ls := got[1].Resources["AWS::ElasticLoadBalancingV2::Listener"]["443"].(*elbv2model.Listener)
+	assert.Equal(t, []elbv2model.Certificate{{CertificateARN: awssdk.String("arn:aws:acm:us-west-2:123456789012:certificate/cert-1")}}, ls.Spec.Certificates)
+}
+
+func Test_defaultRenderer_BuildStacks(t *testing.T) {
+	scheme := runtime.NewScheme()
+	clientgoscheme.AddToScheme(scheme)
+	elbv2api.AddToScheme(scheme)
+
+	env, err := LoadEnvironment([]byte(testEnvironment))
+	assert.NoError(t, err)
+	objects, err := LoadObjects(scheme, []byte(testManifests))
+	assert.NoError(t, err)
+
+	controllerConfig := config.ControllerConfig{
+		ClusterName:       "cluster-name",
+		DefaultTargetType: string(elbv2model.TargetTypeInstance),
+		DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+		FeatureGates:      config.NewFeatureGates(),
+		IngressConfig: config.IngressConfig{
+			IngressClass: "alb",
+		},
+	}
+	renderer := NewDefaultRenderer(scheme, env, controllerConfig, logr.New(&log.NullLogSink{}))
+	got, err := renderer.BuildStacks(context.Background(), objects)
+	assert.NoError(t, err)
+
+	stackIDs := make([]string, 0, len(got))
+	tagPrefixes := make([]string, 0, len(got))
+	for _, stack := range got {
+		stackIDs = append(stackIDs, stack.Stack.StackID().String())
+		tagPrefixes = append(tagPrefixes, stack.TagPrefix)
+	}
+	assert.Equal(t, []string{"default/nlb", "default/web"}, stackIDs)
+	assert.Equal(t, []string{"service.k8s.aws", "ingress.k8s.aws"}, tagPrefixes)
+}
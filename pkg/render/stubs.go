@@ -0,0 +1,60 @@
+package render
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+var _ elbv2deploy.TaggingManager = &stubELBV2TaggingManager{}
+
+// stubELBV2TaggingManager implements elbv2 TaggingManager for an environment without any existing ELBV2 resources,
+// so that stacks are always rendered as newly provisioned.
+type stubELBV2TaggingManager struct{}
+
+func (m *stubELBV2TaggingManager) ReconcileTags(_ context.Context, arn string, _ map[string]string, _ ...elbv2deploy.ReconcileTagsOption) error {
+	return errors.Errorf("cannot reconcile tags offline: %v", arn)
+}
+
+func (m *stubELBV2TaggingManager) ListLoadBalancers(_ context.Context, _ ...tracking.TagFilter) ([]elbv2deploy.LoadBalancerWithTags, error) {
+	return nil, nil
+}
+
+func (m *stubELBV2TaggingManager) ListTargetGroups(_ context.Context, _ ...tracking.TagFilter) ([]elbv2deploy.TargetGroupWithTags, error) {
+	return nil, nil
+}
+
+func (m *stubELBV2TaggingManager) ListTrustStores(_ context.Context, _ ...tracking.TagFilter) ([]elbv2deploy.TrustStoreWithTags, error) {
+	return nil, nil
+}
+
+func (m *stubELBV2TaggingManager) ListListeners(_ context.Context, _ string) ([]elbv2deploy.ListenerWithTags, error) {
+	return nil, nil
+}
+
+func (m *stubELBV2TaggingManager) ListListenerRules(_ context.Context, _ string) ([]elbv2deploy.ListenerRuleWithTags, error) {
+	return nil, nil
+}
+
+var _ networking.BackendSGProvider = &stubBackendSGProvider{}
+
+// stubBackendSGProvider implements BackendSGProvider with the configured backend securityGroup,
+// or a placeholder for the securityGroup that would be auto-generated by controller.
+type stubBackendSGProvider struct {
+	backendSG string
+}
+
+func (p *stubBackendSGProvider) Get(_ context.Context) (string, error) {
+	if p.backendSG != "" {
+		return p.backendSG, nil
+	}
+	return plan.UnknownValue("AWS::EC2::SecurityGroup", "BackendSecurityGroup"), nil
+}
+
+func (p *stubBackendSGProvider) Release(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// FinalizerManager manages finalizer for service groups.
+type FinalizerManager interface {
+	// AddGroupFinalizer add service group finalizer for active member Services.
+	// Services will be in-place updated.
+	AddGroupFinalizer(ctx context.Context, groupID GroupID, members []*corev1.Service) error
+
+	// RemoveGroupFinalizer remove service group finalizer from inactive member Services.
+	// Services will be in-place updated.
+	RemoveGroupFinalizer(ctx context.Context, groupID GroupID, inactiveMembers []*corev1.Service) error
+}
+
+// NewDefaultFinalizerManager constructs new defaultFinalizerManager
+func NewDefaultFinalizerManager(k8sFinalizerManager k8s.FinalizerManager) *defaultFinalizerManager {
+	return &defaultFinalizerManager{
+		k8sFinalizerManager: k8sFinalizerManager,
+	}
+}
+
+var _ FinalizerManager = (*defaultFinalizerManager)(nil)
+
+// default implementation of FinalizerManager
+type defaultFinalizerManager struct {
+	k8sFinalizerManager k8s.FinalizerManager
+}
+
+func (m *defaultFinalizerManager) AddGroupFinalizer(ctx context.Context, groupID GroupID, members []*corev1.Service) error {
+	finalizer := buildGroupFinalizer(groupID)
+	for _, svc := range members {
+		if err := m.k8sFinalizerManager.AddFinalizers(ctx, svc, finalizer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *defaultFinalizerManager) RemoveGroupFinalizer(ctx context.Context, groupID GroupID, inactiveMembers []*corev1.Service) error {
+	finalizer := buildGroupFinalizer(groupID)
+	for _, svc := range inactiveMembers {
+		if err := m.k8sFinalizerManager.RemoveFinalizers(ctx, svc, finalizer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildGroupFinalizer returns a finalizer for specified service group, the format is "group.service.k8s.aws/awesome-group"
+// the namespace of service group is omitted, since it's the same as the namespace of member Services.
+func buildGroupFinalizer(groupID GroupID) string {
+	return fmt.Sprintf("%s%s", k8s.ServiceGroupFinalizerPrefix, groupID.Name)
+}
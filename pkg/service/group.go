@@ -0,0 +1,142 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// GroupID is the unique identifier for a service group within cluster.
+// Service groups are namespaced, only Services within the namespace of service group can join it.
+type GroupID types.NamespacedName
+
+// NewGroupID generates GroupID for a service group within namespace.
+func NewGroupID(namespace string, groupName string) GroupID {
+	return GroupID{
+		Namespace: namespace,
+		Name:      groupName,
+	}
+}
+
+// String returns the string representation of a GroupID.
+func (groupID GroupID) String() string {
+	return fmt.Sprintf("%s/%s", groupID.Namespace, groupID.Name)
+}
+
+// EncodeGroupIDToReconcileRequest encodes a GroupID into a controller-runtime reconcile request.
+// Since Services are always namespaced, reconcile requests for service groups are distinguished by empty namespace,
+// with the namespace of service group encoded into name.
+func EncodeGroupIDToReconcileRequest(gID GroupID) ctrl.Request {
+	return ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "", Name: gID.String()}}
+}
+
+// DecodeGroupIDFromReconcileRequest decodes a GroupID from a controller-runtime reconcile request.
+// It returns false if the reconcile request is for a Service instead of a service group.
+func DecodeGroupIDFromReconcileRequest(request ctrl.Request) (GroupID, bool) {
+	if request.Namespace != "" {
+		return GroupID{}, false
+	}
+	parts := strings.SplitN(request.Name, "/", 2)
+	if len(parts) != 2 {
+		return GroupID{}, false
+	}
+	return NewGroupID(parts[0], parts[1]), true
+}
+
+// A service Group is a group of Services that should be hosted by a single NLB.
+// Services join a group via the `aws-load-balancer-group-name` annotation, and each member Service
+// contributes its ports as listeners of the shared NLB.
+type Group struct {
+	ID GroupID
+
+	// Members are Services that is belong to this group, sorted by group order.
+	Members []*corev1.Service
+
+	// InactiveMembers are Services that no longer belong to this group, but still hold the finalizers.
+	InactiveMembers []*corev1.Service
+}
+
+// PortConflict describes a member Service port that cannot be exposed by the group's NLB,
+// since the listener port is already claimed by a member with lower group order.
+type PortConflict struct {
+	// Service is the member Service that lost the conflict.
+	Service *corev1.Service
+	// Port is the Service port that lost the conflict.
+	Port corev1.ServicePort
+	// ClaimedBy is the member Service that owns the listener port.
+	ClaimedBy *corev1.Service
+}
+
+// String returns the string representation of a PortConflict.
+func (c PortConflict) String() string {
+	return fmt.Sprintf("port %v of %v is already used by %v", c.Port.Port, k8s.NamespacedName(c.Service), k8s.NamespacedName(c.ClaimedBy))
+}
+
+// GroupFrontend is the frontend Service equivalent of a service group, which can be consumed by ModelBuilder.
+type GroupFrontend struct {
+	// Service is the frontend Service of the group.
+	// It's cluster-scoped(with empty namespace), and each port corresponds to a member Service port.
+	Service *corev1.Service
+	// BackendByPort are the member Service ports that traffic received on each frontend Service port will be forwarded to.
+	BackendByPort map[int32]ServiceBackend
+	// PortConflicts are member Service ports that cannot be exposed.
+	PortConflicts []PortConflict
+}
+
+// BuildGroupFrontend builds the frontend Service for service group, which is named after the namespace and name of service group.
+// The load balancer, listener and target group settings are inherited from the first member Service of the group.
+// Each listener port is claimed by the first member Service that exposes it, and later members exposing the same port lose the conflict.
+func BuildGroupFrontend(group Group) GroupFrontend {
+	frontend := &corev1.Service{}
+	frontend.Name = group.ID.String()
+	backendByPort := make(map[int32]ServiceBackend)
+	var portConflicts []PortConflict
+	if len(group.Members) != 0 {
+		leader := group.Members[0]
+		frontend.Annotations = leader.Annotations
+		frontend.Spec = corev1.ServiceSpec{
+			Type:                     leader.Spec.Type,
+			LoadBalancerClass:        leader.Spec.LoadBalancerClass,
+			LoadBalancerSourceRanges: leader.Spec.LoadBalancerSourceRanges,
+		}
+	}
+	for _, member := range group.Members {
+		for _, port := range member.Spec.Ports {
+			if claimed, exists := backendByPort[port.Port]; exists {
+				portConflicts = append(portConflicts, PortConflict{
+					Service:   member,
+					Port:      port,
+					ClaimedBy: claimed.Service,
+				})
+				continue
+			}
+			backendByPort[port.Port] = ServiceBackend{
+				Service: member,
+				Port:    port,
+			}
+			frontend.Spec.Ports = append(frontend.Spec.Ports, corev1.ServicePort{
+				Name:     port.Name,
+				Protocol: port.Protocol,
+				Port:     port.Port,
+			})
+		}
+	}
+	sort.Slice(frontend.Spec.Ports, func(i, j int) bool {
+		return frontend.Spec.Ports[i].Port < frontend.Spec.Ports[j].Port
+	})
+	return GroupFrontend{
+		Service:       frontend,
+		BackendByPort: backendByPort,
+		PortConflicts: portConflicts,
+	}
+}
+
+// isGroupFrontend checks whether the service is the frontend Service of a service group.
+func isGroupFrontend(svc *corev1.Service) bool {
+	return svc.Namespace == ""
+}
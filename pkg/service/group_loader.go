@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultGroupOrder  int64 = 0
+	minGroupOrder      int64 = -1000
+	maxGroupOrder      int64 = 1000
+	maxGroupNameLength int   = 63
+)
+
+var (
+	// groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character.
+	// groupName must be no more than 63 character.
+	groupNameRegex = regexp.MustCompile("^([a-z0-9][-a-z0-9.]*)?[a-z0-9]$")
+
+	// err represents that service group is invalid.
+	errInvalidServiceGroup = errors.New("invalid service group")
+)
+
+// GroupLoader loads service groups.
+type GroupLoader interface {
+	// Load returns a service group given groupID, whose members are Services within the namespace of service group.
+	Load(ctx context.Context, groupID GroupID) (Group, error)
+
+	// LoadGroupIDIfAny loads the groupID for Service if Service belong to any service group.
+	// Services that is not managed by this controller or in deletion state won't have a groupID.
+	LoadGroupIDIfAny(ctx context.Context, svc *corev1.Service) (*GroupID, error)
+
+	// LoadGroupIDsPendingFinalization returns groupIDs that have associated finalizer on Service.
+	LoadGroupIDsPendingFinalization(ctx context.Context, svc *corev1.Service) []GroupID
+}
+
+// NewDefaultGroupLoader constructs new GroupLoader instance.
+func NewDefaultGroupLoader(client client.Client, annotationParser annotations.Parser, serviceUtils ServiceUtils) *defaultGroupLoader {
+	return &defaultGroupLoader{
+		client:           client,
+		annotationParser: annotationParser,
+		serviceUtils:     serviceUtils,
+	}
+}
+
+var _ GroupLoader = (*defaultGroupLoader)(nil)
+
+// default implementation for GroupLoader
+type defaultGroupLoader struct {
+	client           client.Client
+	annotationParser annotations.Parser
+	serviceUtils     ServiceUtils
+}
+
+func (m *defaultGroupLoader) Load(ctx context.Context, groupID GroupID) (Group, error) {
+	svcList := &corev1.ServiceList{}
+	if err := m.client.List(ctx, svcList, client.InNamespace(groupID.Namespace)); err != nil {
+		return Group{}, err
+	}
+	groupFinalizer := buildGroupFinalizer(groupID)
+	var members []*corev1.Service
+	var inactiveMembers []*corev1.Service
+	for index := range svcList.Items {
+		svc := &svcList.Items[index]
+		svcGroupID, err := m.LoadGroupIDIfAny(ctx, svc)
+		if err != nil {
+			// tolerate errInvalidServiceGroup error since a Service with a wrong group name means to leave the service group anyway.
+			if !errors.Is(err, errInvalidServiceGroup) {
+				return Group{}, errors.Wrapf(err, "Service: %v", k8s.NamespacedName(svc))
+			}
+			svcGroupID = nil
+		}
+		if svcGroupID != nil && *svcGroupID == groupID {
+			members = append(members, svc)
+		} else if k8s.HasFinalizer(svc, groupFinalizer) {
+			inactiveMembers = append(inactiveMembers, svc)
+		}
+	}
+
+	sortedMembers, err := m.sortGroupMembers(members)
+	if err != nil {
+		return Group{}, err
+	}
+	return Group{
+		ID:              groupID,
+		Members:         sortedMembers,
+		InactiveMembers: inactiveMembers,
+	}, nil
+}
+
+func (m *defaultGroupLoader) LoadGroupIDIfAny(_ context.Context, svc *corev1.Service) (*GroupID, error) {
+	// Service no longer belong to any service group when it's been deleted or no longer managed by this controller.
+	if !m.serviceUtils.IsServiceSupported(svc) {
+		return nil, nil
+	}
+	groupName := ""
+	if exists := m.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixGroupName, &groupName, svc.Annotations); !exists {
+		return nil, nil
+	}
+	if err := validateGroupName(groupName); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidServiceGroup, err.Error())
+	}
+	groupID := NewGroupID(svc.Namespace, groupName)
+	return &groupID, nil
+}
+
+func (m *defaultGroupLoader) LoadGroupIDsPendingFinalization(_ context.Context, svc *corev1.Service) []GroupID {
+	var groupIDs []GroupID
+	for _, finalizer := range svc.GetFinalizers() {
+		if strings.HasPrefix(finalizer, k8s.ServiceGroupFinalizerPrefix) {
+			groupIDs = append(groupIDs, NewGroupID(svc.Namespace, finalizer[len(k8s.ServiceGroupFinalizerPrefix):]))
+		}
+	}
+	return groupIDs
+}
+
+type groupMemberWithOrder struct {
+	member *corev1.Service
+	order  int64
+}
+
+// sortGroupMembers will sort Services within service group in ascending order.
+// the order for a Service can be set as below:
+// * explicit denote the order via "aws-load-balancer-group-order" annotation.
+// * implicit denote the order of ${defaultGroupOrder}.
+// If two Services are of same order, they are sorted by lexical order of their full-qualified name.
+func (m *defaultGroupLoader) sortGroupMembers(members []*corev1.Service) ([]*corev1.Service, error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	groupMemberWithOrderList := make([]groupMemberWithOrder, 0, len(members))
+	for _, member := range members {
+		var order = defaultGroupOrder
+		exists, err := m.annotationParser.ParseInt64Annotation(annotations.SvcLBSuffixGroupOrder, &order, member.Annotations)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load service group order for service: %v", k8s.NamespacedName(member))
+		}
+		if exists {
+			if order < minGroupOrder || order > maxGroupOrder {
+				return nil, errors.Errorf("explicit service group order must be within [%v:%v], Service: %v, order: %v",
+					minGroupOrder, maxGroupOrder, k8s.NamespacedName(member), order)
+			}
+		}
+		groupMemberWithOrderList = append(groupMemberWithOrderList, groupMemberWithOrder{member: member, order: order})
+	}
+
+	sort.Slice(groupMemberWithOrderList, func(i, j int) bool {
+		orderI := groupMemberWithOrderList[i].order
+		orderJ := groupMemberWithOrderList[j].order
+		if orderI != orderJ {
+			return orderI < orderJ
+		}
+
+		nameI := k8s.NamespacedName(groupMemberWithOrderList[i].member).String()
+		nameJ := k8s.NamespacedName(groupMemberWithOrderList[j].member).String()
+		return nameI < nameJ
+	})
+
+	sortedMembers := make([]*corev1.Service, 0, len(groupMemberWithOrderList))
+	for _, item := range groupMemberWithOrderList {
+		sortedMembers = append(sortedMembers, item.member)
+	}
+	return sortedMembers, nil
+}
+
+// ValidateGroupAnnotations validates the service group annotations on Service.
+func ValidateGroupAnnotations(annotationParser annotations.Parser, svc *corev1.Service) error {
+	groupName := ""
+	if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixGroupName, &groupName, svc.Annotations); exists {
+		if err := validateGroupName(groupName); err != nil {
+			return err
+		}
+	}
+	var order int64
+	exists, err := annotationParser.ParseInt64Annotation(annotations.SvcLBSuffixGroupOrder, &order, svc.Annotations)
+	if err != nil {
+		return err
+	}
+	if exists && (order < minGroupOrder || order > maxGroupOrder) {
+		return errors.Errorf("explicit service group order must be within [%v:%v], order: %v", minGroupOrder, maxGroupOrder, order)
+	}
+	return nil
+}
+
+// validateGroupName validates whether service group name is valid
+func validateGroupName(groupName string) error {
+	if !groupNameRegex.MatchString(groupName) {
+		return errors.New("groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character")
+	}
+	if len(groupName) > maxGroupNameLength {
+		return errors.Errorf("groupName must be no more than %v characters", maxGroupNameLength)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultGroupLoader_Load(t *testing.T) {
+	now := metav1.Date(2021, 03, 28, 11, 11, 11, 0, time.UTC)
+	svcA := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-2",
+			Name:      "svc-a",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+			},
+		},
+	}
+	svcB := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "svc-b",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":        "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name":  "awesome-group",
+				"service.beta.kubernetes.io/aws-load-balancer-group-order": "10",
+			},
+		},
+	}
+	svcC := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "svc-c",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+			},
+		},
+	}
+	svcLeftGroup := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "svc-left-group",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "another-group",
+			},
+			Finalizers: []string{"group.service.k8s.aws/awesome-group"},
+		},
+	}
+	svcDeleting := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "svc-deleting",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+			},
+			Finalizers:        []string{"group.service.k8s.aws/awesome-group"},
+			DeletionTimestamp: &now,
+		},
+	}
+	svcInvalidGroup := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "svc-invalid-group",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "Awesome_Group",
+			},
+			Finalizers: []string{"group.service.k8s.aws/awesome-group"},
+		},
+	}
+	svcUnmanaged := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "svc-unmanaged",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+			},
+		},
+	}
+	svcInvalidOrder := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "svc-invalid-order",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":        "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name":  "awesome-group",
+				"service.beta.kubernetes.io/aws-load-balancer-group-order": "1001",
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		svcList             []*corev1.Service
+		groupID             GroupID
+		wantMembers         []string
+		wantInactiveMembers []string
+		wantErr             error
+	}{
+		{
+			name:                "group with active and inactive members",
+			svcList:             []*corev1.Service{svcA, svcB, svcC, svcLeftGroup, svcDeleting, svcInvalidGroup, svcUnmanaged},
+			groupID:             NewGroupID("ns-1", "awesome-group"),
+			wantMembers:         []string{"ns-1/svc-c", "ns-1/svc-b"},
+			wantInactiveMembers: []string{"ns-1/svc-deleting", "ns-1/svc-invalid-group", "ns-1/svc-left-group"},
+		},
+		{
+			name:        "group with same name in another namespace",
+			svcList:     []*corev1.Service{svcA, svcB, svcC, svcLeftGroup, svcDeleting, svcInvalidGroup, svcUnmanaged},
+			groupID:     NewGroupID("ns-2", "awesome-group"),
+			wantMembers: []string{"ns-2/svc-a"},
+		},
+		{
+			name:    "group without any member",
+			svcList: []*corev1.Service{svcA, svcUnmanaged},
+			groupID: NewGroupID("ns-1", "another-group"),
+		},
+		{
+			name:    "group with invalid order",
+			svcList: []*corev1.Service{svcA, svcInvalidOrder},
+			groupID: NewGroupID("ns-1", "awesome-group"),
+			wantErr: errors.New("explicit service group order must be within [-1000:1000], Service: ns-1/svc-invalid-order, order: 1001"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, svc := range tt.svcList {
+				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
+			}
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", config.NewFeatureGates())
+			m := NewDefaultGroupLoader(k8sClient, annotationParser, serviceUtils)
+
+			got, err := m.Load(ctx, tt.groupID)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.groupID, got.ID)
+			var gotMembers []string
+			for _, member := range got.Members {
+				gotMembers = append(gotMembers, member.Namespace+"/"+member.Name)
+			}
+			assert.Equal(t, tt.wantMembers, gotMembers)
+			var gotInactiveMembers []string
+			for _, member := range got.InactiveMembers {
+				gotInactiveMembers = append(gotInactiveMembers, member.Namespace+"/"+member.Name)
+			}
+			assert.ElementsMatch(t, tt.wantInactiveMembers, gotInactiveMembers)
+		})
+	}
+}
+
+func Test_defaultGroupLoader_LoadGroupIDsPendingFinalization(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  *corev1.Service
+		want []GroupID
+	}{
+		{
+			name: "service without finalizer",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "svc"},
+			},
+			want: nil,
+		},
+		{
+			name: "service with standalone and group finalizers",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "namespace",
+					Name:      "svc",
+					Finalizers: []string{
+						"service.k8s.aws/resources",
+						"group.service.k8s.aws/awesome-group",
+						"group.service.k8s.aws/another-group",
+					},
+				},
+			},
+			want: []GroupID{NewGroupID("namespace", "awesome-group"), NewGroupID("namespace", "another-group")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &defaultGroupLoader{}
+			got := m.LoadGroupIDsPendingFinalization(context.Background(), tt.svc)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_validateGroupName(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupName string
+		wantErr   error
+	}{
+		{
+			name:      "valid group name",
+			groupName: "awesome-group.1",
+		},
+		{
+			name:      "group name with uppercase characters",
+			groupName: "Awesome-Group",
+			wantErr:   errors.New("groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character"),
+		},
+		{
+			name:      "group name too long",
+			groupName: "a123456789a123456789a123456789a123456789a123456789a123456789a123",
+			wantErr:   errors.New("groupName must be no more than 63 characters"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGroupName(tt.groupName)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
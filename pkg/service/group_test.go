@@ -0,0 +1,160 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestEncodeGroupIDToReconcileRequest(t *testing.T) {
+	got := EncodeGroupIDToReconcileRequest(NewGroupID("namespace", "awesome-group"))
+	assert.Equal(t, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "", Name: "namespace/awesome-group"}}, got)
+}
+
+func TestDecodeGroupIDFromReconcileRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     ctrl.Request
+		wantGroupID GroupID
+		wantIsGroup bool
+	}{
+		{
+			name:        "request for service group",
+			request:     ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "", Name: "namespace/awesome-group"}},
+			wantGroupID: NewGroupID("namespace", "awesome-group"),
+			wantIsGroup: true,
+		},
+		{
+			name:        "request for service",
+			request:     ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "namespace", Name: "svc"}},
+			wantGroupID: GroupID{},
+			wantIsGroup: false,
+		},
+		{
+			name:        "request without namespace of service group",
+			request:     ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "", Name: "awesome-group"}},
+			wantGroupID: GroupID{},
+			wantIsGroup: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotGroupID, gotIsGroup := DecodeGroupIDFromReconcileRequest(tt.request)
+			assert.Equal(t, tt.wantGroupID, gotGroupID)
+			assert.Equal(t, tt.wantIsGroup, gotIsGroup)
+		})
+	}
+}
+
+func TestBuildGroupFrontend(t *testing.T) {
+	lbClass := "service.k8s.aws/nlb"
+	svcDNS := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "dns",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":     "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:                     corev1.ServiceTypeLoadBalancer,
+			LoadBalancerClass:        &lbClass,
+			LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+			Ports: []corev1.ServicePort{
+				{Name: "dns-udp", Port: 53, TargetPort: intstr.FromInt(1053), Protocol: corev1.ProtocolUDP},
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+	svcWeb := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-2",
+			Name:      "web",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":     "internal",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeLoadBalancer,
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(80), Protocol: corev1.ProtocolTCP},
+				{Name: "https", Port: 443, TargetPort: intstr.FromInt(443), Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+	tests := []struct {
+		name  string
+		group Group
+		want  GroupFrontend
+	}{
+		{
+			name: "group with port conflict",
+			group: Group{
+				ID:      NewGroupID("ns-1", "awesome-group"),
+				Members: []*corev1.Service{svcDNS, svcWeb},
+			},
+			want: GroupFrontend{
+				Service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "ns-1/awesome-group",
+						Annotations: svcDNS.Annotations,
+					},
+					Spec: corev1.ServiceSpec{
+						Type:                     corev1.ServiceTypeLoadBalancer,
+						LoadBalancerClass:        &lbClass,
+						LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+						Ports: []corev1.ServicePort{
+							{Name: "dns-udp", Port: 53, Protocol: corev1.ProtocolUDP},
+							{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP},
+							{Name: "https", Port: 443, Protocol: corev1.ProtocolTCP},
+						},
+					},
+				},
+				BackendByPort: map[int32]ServiceBackend{
+					53:  {Service: svcDNS, Port: svcDNS.Spec.Ports[0]},
+					80:  {Service: svcDNS, Port: svcDNS.Spec.Ports[1]},
+					443: {Service: svcWeb, Port: svcWeb.Spec.Ports[1]},
+				},
+				PortConflicts: []PortConflict{
+					{Service: svcWeb, Port: svcWeb.Spec.Ports[0], ClaimedBy: svcDNS},
+				},
+			},
+		},
+		{
+			name: "group without members",
+			group: Group{
+				ID: NewGroupID("ns-1", "awesome-group"),
+			},
+			want: GroupFrontend{
+				Service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "ns-1/awesome-group",
+					},
+				},
+				BackendByPort: map[int32]ServiceBackend{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildGroupFrontend(tt.group)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPortConflict_String(t *testing.T) {
+	conflict := PortConflict{
+		Service:   &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-2", Name: "web"}},
+		Port:      corev1.ServicePort{Port: 80},
+		ClaimedBy: &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "dns"}},
+	}
+	assert.Equal(t, "port 80 of ns-2/web is already used by ns-1/dns", conflict.String())
+}
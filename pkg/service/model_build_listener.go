@@ -68,19 +68,42 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port core
 		certificates = cfg.certificates
 	}
 
+	lsAttributes, err := t.buildListenerAttributes(ctx, int64(port.Port), listenerProtocol)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+
 	defaultActions := t.buildListenerDefaultActions(ctx, targetGroup)
 	return elbv2model.ListenerSpec{
-		LoadBalancerARN: t.loadBalancer.LoadBalancerARN(),
-		Port:            int64(port.Port),
-		Protocol:        listenerProtocol,
-		Certificates:    certificates,
-		SSLPolicy:       sslPolicy,
-		ALPNPolicy:      alpnPolicy,
-		DefaultActions:  defaultActions,
-		Tags:            tags,
+		LoadBalancerARN:    t.loadBalancer.LoadBalancerARN(),
+		Port:               int64(port.Port),
+		Protocol:           listenerProtocol,
+		Certificates:       certificates,
+		SSLPolicy:          sslPolicy,
+		ALPNPolicy:         alpnPolicy,
+		DefaultActions:     defaultActions,
+		ListenerAttributes: lsAttributes,
+		Tags:               tags,
 	}, nil
 }
 
+// buildListenerAttributes builds the listener attributes from annotation in the format of `${SvcLBSuffixListenerAttributes}.${Protocol}-${Port}`.
+func (t *defaultModelBuildTask) buildListenerAttributes(_ context.Context, port int64, listenerProtocol elbv2model.Protocol) ([]elbv2model.ListenerAttribute, error) {
+	annotationKey := fmt.Sprintf("%v.%v-%v", annotations.SvcLBSuffixListenerAttributes, listenerProtocol, port)
+	var rawAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotationKey, &rawAttributes, t.service.Annotations); err != nil {
+		return nil, err
+	}
+	attributes := make([]elbv2model.ListenerAttribute, 0, len(rawAttributes))
+	for _, attrKey := range sets.StringKeySet(rawAttributes).List() {
+		attributes = append(attributes, elbv2model.ListenerAttribute{
+			Key:   attrKey,
+			Value: rawAttributes[attrKey],
+		})
+	}
+	return attributes, nil
+}
+
 func (t *defaultModelBuildTask) buildListenerDefaultActions(_ context.Context, targetGroup *elbv2model.TargetGroup) []elbv2model.Action {
 	return []elbv2model.Action{
 		{
@@ -88,3 +88,83 @@ func Test_defaultModelBuilderTask_buildListenerALPNPolicy(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuilderTask_buildListenerAttributes(t *testing.T) {
+	tests := []struct {
+		name             string
+		svc              *corev1.Service
+		port             int64
+		listenerProtocol elbv2model.Protocol
+		want             []elbv2model.ListenerAttribute
+		wantErr          string
+	}{
+		{
+			name:             "Service without annotation",
+			svc:              &corev1.Service{},
+			port:             80,
+			listenerProtocol: elbv2model.ProtocolTCP,
+			want:             []elbv2model.ListenerAttribute{},
+		},
+		{
+			name: "Service with annotation for the listener",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-80":  "tcp.idle_timeout.seconds=400",
+						"service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-443": "tcp.idle_timeout.seconds=60",
+					},
+				},
+			},
+			port:             80,
+			listenerProtocol: elbv2model.ProtocolTCP,
+			want: []elbv2model.ListenerAttribute{
+				{
+					Key:   "tcp.idle_timeout.seconds",
+					Value: "400",
+				},
+			},
+		},
+		{
+			name: "Service with annotation for another protocol",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-80": "tcp.idle_timeout.seconds=400",
+					},
+				},
+			},
+			port:             80,
+			listenerProtocol: elbv2model.ProtocolTLS,
+			want:             []elbv2model.ListenerAttribute{},
+		},
+		{
+			name: "Service with invalid annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-80": "tcp.idle_timeout.seconds",
+					},
+				},
+			},
+			port:             80,
+			listenerProtocol: elbv2model.ProtocolTCP,
+			wantErr:          "failed to parse stringMap annotation, service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-80: tcp.idle_timeout.seconds",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				service:          tt.svc,
+			}
+			got, err := builder.buildListenerAttributes(context.Background(), tt.port, tt.listenerProtocol)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
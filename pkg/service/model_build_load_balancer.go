@@ -62,12 +62,17 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, schem
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
+	securityGroups, err := t.buildLoadBalancerSecurityGroups(ctx, ipAddressType, scheme)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
 	spec := elbv2model.LoadBalancerSpec{
 		Name:                   name,
 		Type:                   elbv2model.LoadBalancerTypeNetwork,
 		Scheme:                 &scheme,
 		IPAddressType:          &ipAddressType,
 		SubnetMappings:         subnetMappings,
+		SecurityGroups:         securityGroups,
 		LoadBalancerAttributes: lbAttributes,
 		Tags:                   tags,
 	}
@@ -400,10 +405,18 @@ func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme
 	}
 	uuidHash := sha256.New()
 	_, _ = uuidHash.Write([]byte(t.clusterName))
-	_, _ = uuidHash.Write([]byte(t.service.UID))
+	if isGroupFrontend(t.service) {
+		_, _ = uuidHash.Write([]byte(t.service.Name))
+	} else {
+		_, _ = uuidHash.Write([]byte(t.service.UID))
+	}
 	_, _ = uuidHash.Write([]byte(scheme))
 	uuid := hex.EncodeToString(uuidHash.Sum(nil))
 
+	if isGroupFrontend(t.service) {
+		payload := invalidLoadBalancerNamePattern.ReplaceAllString(t.service.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid), nil
+	}
 	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.service.Namespace, "")
 	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.service.Name, "")
 	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid), nil
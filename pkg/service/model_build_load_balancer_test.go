@@ -1398,6 +1398,17 @@ func Test_defaultModelBuildTask_buildLoadBalancerName(t *testing.T) {
 			scheme: elbv2.LoadBalancerSchemeInternetFacing,
 			want:   "k8s-foo-bar-e053368fb2",
 		},
+		{
+			name: "frontend service of service group",
+			service: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "awesome-group",
+					Annotations: map[string]string{},
+				},
+			},
+			scheme: elbv2.LoadBalancerSchemeInternetFacing,
+			want:   "k8s-awesomegroup-b8ca6055a3",
+		},
 		{
 			name: "non-empty name annotation",
 			service: &corev1.Service{
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+const (
+	resourceIDManagedSecurityGroup = "ManagedLBSecurityGroup"
+)
+
+// buildLoadBalancerSecurityGroups builds the securityGroups for NLB.
+// NLB can only be associated with securityGroups during creation, so existing NLBs without securityGroups are left as is.
+func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, ipAddressType elbv2model.IPAddressType,
+	scheme elbv2model.LoadBalancerScheme) ([]core.StringToken, error) {
+	if !t.featureGates.Enabled(config.NLBSecurityGroup) {
+		return nil, nil
+	}
+	existingLB, err := t.fetchExistingLoadBalancer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if existingLB != nil && len(existingLB.LoadBalancer.SecurityGroups) == 0 {
+		return nil, nil
+	}
+
+	var sgNameOrIDsViaAnnotation []string
+	t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSecurityGroups, &sgNameOrIDsViaAnnotation, t.service.Annotations)
+	var lbSGTokens []core.StringToken
+	if len(sgNameOrIDsViaAnnotation) == 0 {
+		managedSG, err := t.buildManagedSecurityGroup(ctx, ipAddressType, scheme)
+		if err != nil {
+			return nil, err
+		}
+		lbSGTokens = append(lbSGTokens, managedSG.GroupID())
+		if !t.enableBackendSG {
+			t.backendSGIDToken = managedSG.GroupID()
+		} else {
+			backendSGID, err := t.backendSGProvider.Get(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t.backendSGIDToken = core.LiteralStringToken(backendSGID)
+			lbSGTokens = append(lbSGTokens, t.backendSGIDToken)
+		}
+	} else {
+		manageBackendSGRules, err := t.buildManageSecurityGroupRulesFlag(ctx)
+		if err != nil {
+			return nil, err
+		}
+		frontendSGIDs, err := t.sgResolver.ResolveViaNameOrID(ctx, sgNameOrIDsViaAnnotation)
+		if err != nil {
+			return nil, err
+		}
+		for _, sgID := range frontendSGIDs {
+			lbSGTokens = append(lbSGTokens, core.LiteralStringToken(sgID))
+		}
+
+		if manageBackendSGRules {
+			if !t.enableBackendSG {
+				return nil, errors.New("backendSG feature is required to manage worker node SG rules when frontendSG manually specified")
+			}
+			backendSGID, err := t.backendSGProvider.Get(ctx)
+			if err != nil {
+				return nil, err
+			}
+			t.backendSGIDToken = core.LiteralStringToken(backendSGID)
+			lbSGTokens = append(lbSGTokens, t.backendSGIDToken)
+		}
+	}
+	return lbSGTokens, nil
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroup(ctx context.Context, ipAddressType elbv2model.IPAddressType,
+	scheme elbv2model.LoadBalancerScheme) (*ec2model.SecurityGroup, error) {
+	sgSpec, err := t.buildManagedSecurityGroupSpec(ctx, ipAddressType, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	sg := ec2model.NewSecurityGroup(t.stack, resourceIDManagedSecurityGroup, sgSpec)
+	return sg, nil
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupSpec(ctx context.Context, ipAddressType elbv2model.IPAddressType,
+	scheme elbv2model.LoadBalancerScheme) (ec2model.SecurityGroupSpec, error) {
+	name := t.buildManagedSecurityGroupName(ctx)
+	tags, err := t.buildLoadBalancerTags(ctx)
+	if err != nil {
+		return ec2model.SecurityGroupSpec{}, err
+	}
+	ingressPermissions, err := t.buildManagedSecurityGroupIngressPermissions(ctx, ipAddressType, scheme)
+	if err != nil {
+		return ec2model.SecurityGroupSpec{}, err
+	}
+	return ec2model.SecurityGroupSpec{
+		GroupName:   name,
+		Description: "[k8s] Managed SecurityGroup for LoadBalancer",
+		Tags:        tags,
+		Ingress:     ingressPermissions,
+	}, nil
+}
+
+var invalidSecurityGroupNamePtn, _ = regexp.Compile("[[:^alnum:]]")
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupName(_ context.Context) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.service.Namespace))
+	_, _ = uuidHash.Write([]byte(t.service.Name))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidSecurityGroupNamePtn.ReplaceAllString(t.service.Namespace, "")
+	sanitizedName := invalidSecurityGroupNamePtn.ReplaceAllString(t.service.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(ctx context.Context, ipAddressType elbv2model.IPAddressType,
+	scheme elbv2model.LoadBalancerScheme) ([]ec2model.IPPermission, error) {
+	cidrs, err := t.buildManagedSecurityGroupSourceRanges(ctx, ipAddressType, scheme)
+	if err != nil {
+		return nil, err
+	}
+	var permissions []ec2model.IPPermission
+	for _, port := range t.service.Spec.Ports {
+		listenPort := int64(port.Port)
+		protocol := strings.ToLower(string(port.Protocol))
+		for _, cidr := range cidrs {
+			if !strings.Contains(cidr, ":") {
+				permissions = append(permissions, ec2model.IPPermission{
+					IPProtocol: protocol,
+					FromPort:   awssdk.Int64(listenPort),
+					ToPort:     awssdk.Int64(listenPort),
+					IPRanges: []ec2model.IPRange{
+						{
+							CIDRIP: cidr,
+						},
+					},
+				})
+			} else if ipAddressType == elbv2model.IPAddressTypeDualStack {
+				permissions = append(permissions, ec2model.IPPermission{
+					IPProtocol: protocol,
+					FromPort:   awssdk.Int64(listenPort),
+					ToPort:     awssdk.Int64(listenPort),
+					IPv6Range: []ec2model.IPv6Range{
+						{
+							CIDRIPv6: cidr,
+						},
+					},
+				})
+			}
+		}
+	}
+	return permissions, nil
+}
+
+// buildManagedSecurityGroupSourceRanges builds the client CIDRs allowed by managed securityGroup.
+// by default, internet-facing NLB allows traffic from anywhere, while internal NLB allows traffic from VPC.
+func (t *defaultModelBuildTask) buildManagedSecurityGroupSourceRanges(ctx context.Context, ipAddressType elbv2model.IPAddressType,
+	scheme elbv2model.LoadBalancerScheme) ([]string, error) {
+	var sourceRanges []string
+	sourceRanges = append(sourceRanges, t.service.Spec.LoadBalancerSourceRanges...)
+	if len(sourceRanges) == 0 {
+		t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSourceRanges, &sourceRanges, t.service.Annotations)
+	}
+	if len(sourceRanges) != 0 {
+		return sourceRanges, nil
+	}
+	if scheme == elbv2model.LoadBalancerSchemeInternal {
+		vpcInfo, err := t.vpcInfoProvider.FetchVPCInfo(ctx, t.vpcID, networking.FetchVPCInfoWithoutCache())
+		if err != nil {
+			return nil, err
+		}
+		sourceRanges = append(sourceRanges, vpcInfo.AssociatedIPv4CIDRs()...)
+		if ipAddressType == elbv2model.IPAddressTypeDualStack {
+			sourceRanges = append(sourceRanges, vpcInfo.AssociatedIPv6CIDRs()...)
+		}
+		return sourceRanges, nil
+	}
+	sourceRanges = append(sourceRanges, t.defaultIPv4SourceRanges...)
+	if ipAddressType == elbv2model.IPAddressTypeDualStack {
+		sourceRanges = append(sourceRanges, t.defaultIPv6SourceRanges...)
+	}
+	return sourceRanges, nil
+}
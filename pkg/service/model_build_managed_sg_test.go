@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+func Test_defaultModelBuildTask_buildManagedSecurityGroupSourceRanges(t *testing.T) {
+	type fetchVPCInfoCall struct {
+		wantVPCInfo networking.VPCInfo
+		err         error
+	}
+	associated := ec2.VpcCidrBlockStateCodeAssociated
+	vpcInfo := networking.VPCInfo{
+		CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+			{
+				CidrBlock:      aws.String("192.168.0.0/16"),
+				CidrBlockState: &ec2.VpcCidrBlockState{State: &associated},
+			},
+		},
+		Ipv6CidrBlockAssociationSet: []*ec2.VpcIpv6CidrBlockAssociation{
+			{
+				Ipv6CidrBlock:      aws.String("2600:1f13:837:8500::/56"),
+				Ipv6CidrBlockState: &ec2.VpcCidrBlockState{State: &associated},
+			},
+		},
+	}
+	tests := []struct {
+		name              string
+		svc               *corev1.Service
+		ipAddressType     elbv2model.IPAddressType
+		scheme            elbv2model.LoadBalancerScheme
+		fetchVPCInfoCalls []fetchVPCInfoCall
+		want              []string
+	}{
+		{
+			name:          "internet-facing, ipv4",
+			svc:           &corev1.Service{},
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+			want:          []string{"0.0.0.0/0"},
+		},
+		{
+			name:          "internet-facing, dualstack",
+			svc:           &corev1.Service{},
+			ipAddressType: elbv2model.IPAddressTypeDualStack,
+			scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+			want:          []string{"0.0.0.0/0", "::/0"},
+		},
+		{
+			name:          "internal, dualstack",
+			svc:           &corev1.Service{},
+			ipAddressType: elbv2model.IPAddressTypeDualStack,
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			fetchVPCInfoCalls: []fetchVPCInfoCall{
+				{
+					wantVPCInfo: vpcInfo,
+				},
+			},
+			want: []string{"192.168.0.0/16", "2600:1f13:837:8500::/56"},
+		},
+		{
+			name: "source ranges via spec",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/load-balancer-source-ranges": "10.0.0.0/8",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					LoadBalancerSourceRanges: []string{"10.20.0.0/16", "10.30.0.0/16"},
+				},
+			},
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			want:          []string{"10.20.0.0/16", "10.30.0.0/16"},
+		},
+		{
+			name: "source ranges via annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/load-balancer-source-ranges": "10.0.0.0/8, 2001:db8::/32",
+					},
+				},
+			},
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+			want:          []string{"10.0.0.0/8", "2001:db8::/32"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			vpcInfoProvider := networking.NewMockVPCInfoProvider(ctrl)
+			for _, call := range tt.fetchVPCInfoCalls {
+				vpcInfoProvider.EXPECT().FetchVPCInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(call.wantVPCInfo, call.err)
+			}
+			task := &defaultModelBuildTask{
+				annotationParser:        annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				vpcInfoProvider:         vpcInfoProvider,
+				service:                 tt.svc,
+				defaultIPv4SourceRanges: []string{"0.0.0.0/0"},
+				defaultIPv6SourceRanges: []string{"::/0"},
+			}
+			got, err := task.buildManagedSecurityGroupSourceRanges(context.Background(), tt.ipAddressType, tt.scheme)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -36,10 +36,16 @@ func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, port corev
 	if targetGroup, exists := t.tgByResID[tgResourceID]; exists {
 		return targetGroup, nil
 	}
+	backend := t.buildServiceBackend(ctx, port)
+	port.TargetPort = backend.Port.TargetPort
+	port.NodePort = backend.Port.NodePort
 	targetType, err := t.buildTargetType(ctx, port)
 	if err != nil {
 		return nil, err
 	}
+	if backend.Service != t.service && targetType == elbv2model.TargetTypeInstance && port.NodePort == 0 {
+		return nil, errors.Errorf("unable to support instance target type with backend service %v without NodePort", k8s.NamespacedName(backend.Service))
+	}
 	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, targetType)
 	if err != nil {
 		return nil, err
@@ -57,7 +63,7 @@ func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, port corev
 		return nil, err
 	}
 	targetGroup := elbv2model.NewTargetGroup(t.stack, tgResourceID, tgSpec)
-	_, err = t.buildTargetGroupBinding(ctx, targetGroup, preserveClientIP, port, healthCheckConfig, scheme)
+	_, err = t.buildTargetGroupBinding(ctx, targetGroup, preserveClientIP, backend, port, healthCheckConfig, scheme)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +71,17 @@ func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, port corev
 	return targetGroup, nil
 }
 
+// buildServiceBackend resolves the backend that traffic received on listener for port will be forwarded to.
+func (t *defaultModelBuildTask) buildServiceBackend(_ context.Context, port corev1.ServicePort) ServiceBackend {
+	if backend, ok := t.backendByPort[port.Port]; ok {
+		return backend
+	}
+	return ServiceBackend{
+		Service: t.service,
+		Port:    port,
+	}
+}
+
 func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context, tgProtocol elbv2model.Protocol, targetType elbv2model.TargetType,
 	port corev1.ServicePort, healthCheckConfig *elbv2model.TargetGroupHealthCheckConfig, tgAttrs []elbv2model.TargetGroupAttribute) (elbv2model.TargetGroupSpec, error) {
 	tags, err := t.buildTargetGroupTags(ctx)
@@ -190,7 +207,11 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context, svcPort
 	}
 	uuidHash := sha256.New()
 	_, _ = uuidHash.Write([]byte(t.clusterName))
-	_, _ = uuidHash.Write([]byte(t.service.UID))
+	if isGroupFrontend(t.service) {
+		_, _ = uuidHash.Write([]byte(t.service.Name))
+	} else {
+		_, _ = uuidHash.Write([]byte(t.service.UID))
+	}
 	_, _ = uuidHash.Write([]byte(strconv.Itoa(int(tgPort))))
 	_, _ = uuidHash.Write([]byte(svcPort.String()))
 	_, _ = uuidHash.Write([]byte(targetType))
@@ -199,6 +220,10 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context, svcPort
 	_, _ = uuidHash.Write([]byte(healthCheckInterval))
 	uuid := hex.EncodeToString(uuidHash.Sum(nil))
 
+	if isGroupFrontend(t.service) {
+		payload := invalidTargetGroupNamePattern.ReplaceAllString(t.service.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid)
+	}
 	sanitizedNamespace := invalidTargetGroupNamePattern.ReplaceAllString(t.service.Namespace, "")
 	sanitizedName := invalidTargetGroupNamePattern.ReplaceAllString(t.service.Name, "")
 	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
@@ -389,8 +414,8 @@ func (t *defaultModelBuildTask) buildTargetGroupTags(ctx context.Context) (map[s
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, targetGroup *elbv2model.TargetGroup, preserveClientIP bool,
-	port corev1.ServicePort, hc *elbv2model.TargetGroupHealthCheckConfig, scheme elbv2model.LoadBalancerScheme) (*elbv2model.TargetGroupBindingResource, error) {
-	tgbSpec, err := t.buildTargetGroupBindingSpec(ctx, targetGroup, preserveClientIP, port, hc, scheme)
+	backend ServiceBackend, port corev1.ServicePort, hc *elbv2model.TargetGroupHealthCheckConfig, scheme elbv2model.LoadBalancerScheme) (*elbv2model.TargetGroupBindingResource, error) {
+	tgbSpec, err := t.buildTargetGroupBindingSpec(ctx, targetGroup, preserveClientIP, backend, port, hc, scheme)
 	if err != nil {
 		return nil, err
 	}
@@ -398,7 +423,7 @@ func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, tar
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context, targetGroup *elbv2model.TargetGroup, preserveClientIP bool,
-	port corev1.ServicePort, hc *elbv2model.TargetGroupHealthCheckConfig, scheme elbv2model.LoadBalancerScheme) (elbv2model.TargetGroupBindingResourceSpec, error) {
+	backend ServiceBackend, port corev1.ServicePort, hc *elbv2model.TargetGroupHealthCheckConfig, scheme elbv2model.LoadBalancerScheme) (elbv2model.TargetGroupBindingResourceSpec, error) {
 	nodeSelector, err := t.buildTargetGroupBindingNodeSelector(ctx, targetGroup.Spec.TargetType)
 	if err != nil {
 		return elbv2model.TargetGroupBindingResourceSpec{}, err
@@ -419,15 +444,15 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 	return elbv2model.TargetGroupBindingResourceSpec{
 		Template: elbv2model.TargetGroupBindingTemplate{
 			ObjectMeta: metav1.ObjectMeta{
-				Namespace: t.service.Namespace,
+				Namespace: backend.Service.Namespace,
 				Name:      targetGroup.Spec.Name,
 			},
 			Spec: elbv2model.TargetGroupBindingSpec{
 				TargetGroupARN: targetGroup.TargetGroupARN(),
 				TargetType:     &targetType,
 				ServiceRef: elbv2api.ServiceReference{
-					Name: t.service.Name,
-					Port: intstr.FromInt(int(port.Port)),
+					Name: backend.Service.Name,
+					Port: intstr.FromInt(int(backend.Port.Port)),
 				},
 				Networking:    tgbNetworking,
 				NodeSelector:  nodeSelector,
@@ -470,6 +495,9 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(ctx context.Co
 	if !manageBackendSGRules {
 		return nil, nil
 	}
+	if t.backendSGIDToken != nil {
+		return t.buildTargetGroupBindingNetworkingViaBackendSG(ctx, tgPort, hcPort, port), nil
+	}
 	tgProtocol := port.Protocol
 	loadBalancerSubnetsSourceRanges := t.getLoadBalancerSubnetsSourceRanges(targetGroupIPAddressType)
 	networkingProtocol := elbv2api.NetworkingProtocolTCP
@@ -501,6 +529,71 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(ctx context.Co
 	return tgbNetworking, nil
 }
 
+// buildTargetGroupBindingNetworkingViaBackendSG builds the networking rules that allow traffic from the backend securityGroup of NLB.
+func (t *defaultModelBuildTask) buildTargetGroupBindingNetworkingViaBackendSG(_ context.Context, tgPort intstr.IntOrString,
+	hcPort intstr.IntOrString, port corev1.ServicePort) *elbv2model.TargetGroupBindingNetworking {
+	networkingProtocolTCP := elbv2api.NetworkingProtocolTCP
+	networkingProtocolUDP := elbv2api.NetworkingProtocolUDP
+	backendSGPeers := []elbv2model.NetworkingPeer{
+		{
+			SecurityGroup: &elbv2model.SecurityGroup{
+				GroupID: t.backendSGIDToken,
+			},
+		},
+	}
+	if t.disableRestrictedSGRules {
+		ports := []elbv2api.NetworkingPort{
+			{
+				Protocol: &networkingProtocolTCP,
+				Port:     nil,
+			},
+		}
+		if port.Protocol == corev1.ProtocolUDP {
+			ports = append(ports, elbv2api.NetworkingPort{
+				Protocol: &networkingProtocolUDP,
+				Port:     nil,
+			})
+		}
+		return &elbv2model.TargetGroupBindingNetworking{
+			Ingress: []elbv2model.NetworkingIngressRule{
+				{
+					From:  backendSGPeers,
+					Ports: ports,
+				},
+			},
+		}
+	}
+
+	networkingProtocol := networkingProtocolTCP
+	if port.Protocol == corev1.ProtocolUDP {
+		networkingProtocol = networkingProtocolUDP
+	}
+	ports := []elbv2api.NetworkingPort{
+		{
+			Protocol: &networkingProtocol,
+			Port:     &tgPort,
+		},
+	}
+	networkingHealthCheckPort := hcPort
+	if hcPort.String() == healthCheckPortTrafficPort {
+		networkingHealthCheckPort = tgPort
+	}
+	if networkingProtocol == networkingProtocolUDP || networkingHealthCheckPort.String() != tgPort.String() {
+		ports = append(ports, elbv2api.NetworkingPort{
+			Protocol: &networkingProtocolTCP,
+			Port:     &networkingHealthCheckPort,
+		})
+	}
+	return &elbv2model.TargetGroupBindingNetworking{
+		Ingress: []elbv2model.NetworkingIngressRule{
+			{
+				From:  backendSGPeers,
+				Ports: ports,
+			},
+		},
+	}
+}
+
 func (t *defaultModelBuildTask) getDefaultIPSourceRanges(ctx context.Context, targetGroupIPAddressType elbv2model.TargetGroupIPAddressType,
 	protocol corev1.Protocol, preserveClientIP bool, scheme elbv2model.LoadBalancerScheme) ([]string, error) {
 	defaultSourceRanges := t.defaultIPv4SourceRanges
@@ -27,32 +27,66 @@ const (
 	lbAttrsDeletionProtection      = "deletion_protection.enabled"
 )
 
+// ServiceBackend is the backend service port that traffic received on a listener port will be forwarded to.
+type ServiceBackend struct {
+	Service *corev1.Service
+	Port    corev1.ServicePort
+}
+
+// options for build model stack.
+type BuildOptions struct {
+	// BackendByPort overrides the backend of listener by service port number.
+	// By default, traffic received on listener will be forwarded to the service itself.
+	BackendByPort map[int32]ServiceBackend
+}
+
+// ApplyOptions applies slice of BuildOption.
+func (opts *BuildOptions) ApplyOptions(options []BuildOption) {
+	for _, option := range options {
+		option(opts)
+	}
+}
+
+type BuildOption func(opts *BuildOptions)
+
+// WithBackendByPort generates an option that configures BackendByPort.
+func WithBackendByPort(backendByPort map[int32]ServiceBackend) BuildOption {
+	return func(opts *BuildOptions) {
+		opts.BackendByPort = backendByPort
+	}
+}
+
 // ModelBuilder builds the model stack for the service resource.
 type ModelBuilder interface {
 	// Build model stack for service
-	Build(ctx context.Context, service *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, error)
+	Build(ctx context.Context, service *corev1.Service, opts ...BuildOption) (core.Stack, *elbv2model.LoadBalancer, error)
 }
 
 // NewDefaultModelBuilder construct a new defaultModelBuilder
 func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver networking.SubnetsResolver,
 	vpcInfoProvider networking.VPCInfoProvider, vpcID string, trackingProvider tracking.Provider,
 	elbv2TaggingManager elbv2deploy.TaggingManager, featureGates config.FeatureGates, clusterName string, defaultTags map[string]string,
-	externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string, enableIPTargetType bool, serviceUtils ServiceUtils) *defaultModelBuilder {
+	externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string, enableIPTargetType bool, serviceUtils ServiceUtils,
+	sgResolver networking.SecurityGroupResolver, backendSGProvider networking.BackendSGProvider, enableBackendSG bool, disableRestrictedSGRules bool) *defaultModelBuilder {
 	return &defaultModelBuilder{
-		annotationParser:    annotationParser,
-		subnetsResolver:     subnetsResolver,
-		vpcInfoProvider:     vpcInfoProvider,
-		trackingProvider:    trackingProvider,
-		elbv2TaggingManager: elbv2TaggingManager,
-		featureGates:        featureGates,
-		serviceUtils:        serviceUtils,
-		clusterName:         clusterName,
-		vpcID:               vpcID,
-		defaultTags:         defaultTags,
-		externalManagedTags: sets.NewString(externalManagedTags...),
-		defaultSSLPolicy:    defaultSSLPolicy,
-		defaultTargetType:   elbv2model.TargetType(defaultTargetType),
-		enableIPTargetType:  enableIPTargetType,
+		annotationParser:         annotationParser,
+		subnetsResolver:          subnetsResolver,
+		vpcInfoProvider:          vpcInfoProvider,
+		trackingProvider:         trackingProvider,
+		elbv2TaggingManager:      elbv2TaggingManager,
+		featureGates:             featureGates,
+		serviceUtils:             serviceUtils,
+		sgResolver:               sgResolver,
+		backendSGProvider:        backendSGProvider,
+		clusterName:              clusterName,
+		vpcID:                    vpcID,
+		defaultTags:              defaultTags,
+		externalManagedTags:      sets.NewString(externalManagedTags...),
+		defaultSSLPolicy:         defaultSSLPolicy,
+		defaultTargetType:        elbv2model.TargetType(defaultTargetType),
+		enableIPTargetType:       enableIPTargetType,
+		enableBackendSG:          enableBackendSG,
+		disableRestrictedSGRules: disableRestrictedSGRules,
 	}
 }
 
@@ -66,17 +100,23 @@ type defaultModelBuilder struct {
 	elbv2TaggingManager elbv2deploy.TaggingManager
 	featureGates        config.FeatureGates
 	serviceUtils        ServiceUtils
+	sgResolver          networking.SecurityGroupResolver
+	backendSGProvider   networking.BackendSGProvider
 
-	clusterName         string
-	vpcID               string
-	defaultTags         map[string]string
-	externalManagedTags sets.String
-	defaultSSLPolicy    string
-	defaultTargetType   elbv2model.TargetType
-	enableIPTargetType  bool
+	clusterName              string
+	vpcID                    string
+	defaultTags              map[string]string
+	externalManagedTags      sets.String
+	defaultSSLPolicy         string
+	defaultTargetType        elbv2model.TargetType
+	enableIPTargetType       bool
+	enableBackendSG          bool
+	disableRestrictedSGRules bool
 }
 
-func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, error) {
+func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service, opts ...BuildOption) (core.Stack, *elbv2model.LoadBalancer, error) {
+	buildOpts := BuildOptions{}
+	buildOpts.ApplyOptions(opts)
 	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(service)))
 	task := &defaultModelBuildTask{
 		clusterName:         b.clusterName,
@@ -88,11 +128,17 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		elbv2TaggingManager: b.elbv2TaggingManager,
 		featureGates:        b.featureGates,
 		serviceUtils:        b.serviceUtils,
-		enableIPTargetType:  b.enableIPTargetType,
+		sgResolver:          b.sgResolver,
+		backendSGProvider:   b.backendSGProvider,
+
+		enableIPTargetType:       b.enableIPTargetType,
+		enableBackendSG:          b.enableBackendSG,
+		disableRestrictedSGRules: b.disableRestrictedSGRules,
 
-		service:   service,
-		stack:     stack,
-		tgByResID: make(map[string]*elbv2model.TargetGroup),
+		service:       service,
+		backendByPort: buildOpts.BackendByPort,
+		stack:         stack,
+		tgByResID:     make(map[string]*elbv2model.TargetGroup),
 
 		defaultTags:                          b.defaultTags,
 		externalManagedTags:                  b.externalManagedTags,
@@ -140,14 +186,22 @@ type defaultModelBuildTask struct {
 	elbv2TaggingManager elbv2deploy.TaggingManager
 	featureGates        config.FeatureGates
 	serviceUtils        ServiceUtils
-	enableIPTargetType  bool
+	sgResolver          networking.SecurityGroupResolver
+	backendSGProvider   networking.BackendSGProvider
+
+	enableIPTargetType       bool
+	enableBackendSG          bool
+	disableRestrictedSGRules bool
 
-	service *corev1.Service
+	service       *corev1.Service
+	backendByPort map[int32]ServiceBackend
 
 	stack        core.Stack
 	loadBalancer *elbv2model.LoadBalancer
 	tgByResID    map[string]*elbv2model.TargetGroup
 	ec2Subnets   []*ec2.Subnet
+	// the backend securityGroup that targets allow traffic from, it's nil if NLB doesn't have securityGroups.
+	backendSGIDToken core.StringToken
 
 	fetchExistingLoadBalancerOnce sync.Once
 	existingLoadBalancer          *elbv2deploy.LoadBalancerWithTags
@@ -39,6 +39,11 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 		wantVPCInfo networking.VPCInfo
 		err         error
 	}
+	type resolveSGViaNameOrIDCall struct {
+		args []string
+		want []string
+		err  error
+	}
 	cidrBlockStateAssociated := ec2.VpcCidrBlockStateCodeAssociated
 	resolveViaDiscoveryCallForOneSubnet := resolveViaDiscoveryCall{
 		subnets: []*ec2.Subnet{
@@ -104,10 +109,14 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 		defaultTargetType            string
 		enableIPTargetType           *bool
 		svc                          *corev1.Service
+		buildOptions                 []BuildOption
 		wantError                    bool
 		wantValue                    string
 		wantNumResources             int
 		restrictToTypeLoadBalancer   bool
+		enableNLBSecurityGroup       bool
+		enableBackendSG              bool
+		resolveSGViaNameOrIDCalls    []resolveSGViaNameOrIDCall
 	}{
 		{
 			testName: "Simple service",
@@ -2930,23 +2939,801 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 `,
 			wantNumResources: 4,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.testName, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-
-			subnetsResolver := networking.NewMockSubnetsResolver(ctrl)
-			for _, call := range tt.resolveViaDiscoveryCalls {
-				subnetsResolver.EXPECT().ResolveViaDiscovery(gomock.Any(), gomock.Any()).Return(call.subnets, call.err)
-			}
-			for _, call := range tt.resolveViaNameOrIDSliceCalls {
-				subnetsResolver.EXPECT().ResolveViaNameOrIDSlice(gomock.Any(), gomock.Any(), gomock.Any()).Return(call.subnets, call.err)
-			}
-			featureGates := config.NewFeatureGates()
-			if tt.restrictToTypeLoadBalancer {
-				featureGates.Enable(config.ServiceTypeLoadBalancerOnly)
+		{
+			testName: "with backend overridden by port",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "frontend",
+					Namespace: "default",
+					UID:       "7ab4be33-11c2-4a7b-b655-7add8affab36",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+						"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internet-facing",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(80),
+							Protocol:   corev1.ProtocolTCP,
+						},
+						{
+							Port:       53,
+							TargetPort: intstr.FromInt(53),
+							Protocol:   corev1.ProtocolUDP,
+						},
+					},
+				},
+			},
+			buildOptions: []BuildOption{
+				WithBackendByPort(map[int32]ServiceBackend{
+					80: {
+						Service: &corev1.Service{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "backend-web",
+								Namespace: "default",
+							},
+						},
+						Port: corev1.ServicePort{
+							Port:       8080,
+							TargetPort: intstr.FromInt(9090),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				}),
+			},
+			resolveViaDiscoveryCalls: []resolveViaDiscoveryCall{resolveViaDiscoveryCallForOneSubnet},
+			listLoadBalancerCalls:    []listLoadBalancerCall{listLoadBalancerCallForEmptyLB},
+			wantValue: `
+{
+  "id": "default/frontend",
+  "resources": {
+    "AWS::ElasticLoadBalancingV2::Listener": {
+      "53": {
+        "spec": {
+          "loadBalancerARN": {
+            "$ref": "#/resources/AWS::ElasticLoadBalancingV2::LoadBalancer/LoadBalancer/status/loadBalancerARN"
+          },
+          "port": 53,
+          "protocol": "UDP",
+          "defaultActions": [
+            {
+              "type": "forward",
+              "forwardConfig": {
+                "targetGroups": [
+                  {
+                    "targetGroupARN": {
+                      "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/frontend:53/status/targetGroupARN"
+                    }
+                  }
+                ]
+              }
+            }
+          ]
+        }
+      },
+      "80": {
+        "spec": {
+          "loadBalancerARN": {
+            "$ref": "#/resources/AWS::ElasticLoadBalancingV2::LoadBalancer/LoadBalancer/status/loadBalancerARN"
+          },
+          "port": 80,
+          "protocol": "TCP",
+          "defaultActions": [
+            {
+              "type": "forward",
+              "forwardConfig": {
+                "targetGroups": [
+                  {
+                    "targetGroupARN": {
+                      "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/frontend:80/status/targetGroupARN"
+                    }
+                  }
+                ]
+              }
+            }
+          ]
+        }
+      }
+    },
+    "AWS::ElasticLoadBalancingV2::LoadBalancer": {
+      "LoadBalancer": {
+        "spec": {
+          "name": "k8s-default-frontend-33e41aa671",
+          "type": "network",
+          "scheme": "internet-facing",
+          "ipAddressType": "ipv4",
+          "subnetMapping": [
+            {
+              "subnetID": "subnet-1"
+            }
+          ]
+        }
+      }
+    },
+    "AWS::ElasticLoadBalancingV2::TargetGroup": {
+      "default/frontend:53": {
+        "spec": {
+          "name": "k8s-default-frontend-782b674ba0",
+          "targetType": "ip",
+          "port": 53,
+          "protocol": "UDP",
+          "ipAddressType": "ipv4",
+          "healthCheckConfig": {
+            "port": "traffic-port",
+            "protocol": "TCP",
+            "intervalSeconds": 10,
+            "timeoutSeconds": 10,
+            "healthyThresholdCount": 3,
+            "unhealthyThresholdCount": 3
+          },
+          "targetGroupAttributes": [
+            {
+              "key": "proxy_protocol_v2.enabled",
+              "value": "false"
+            }
+          ]
+        }
+      },
+      "default/frontend:80": {
+        "spec": {
+          "name": "k8s-default-frontend-8e886057ac",
+          "targetType": "ip",
+          "port": 9090,
+          "protocol": "TCP",
+          "ipAddressType": "ipv4",
+          "healthCheckConfig": {
+            "port": "traffic-port",
+            "protocol": "TCP",
+            "intervalSeconds": 10,
+            "timeoutSeconds": 10,
+            "healthyThresholdCount": 3,
+            "unhealthyThresholdCount": 3
+          },
+          "targetGroupAttributes": [
+            {
+              "key": "proxy_protocol_v2.enabled",
+              "value": "false"
+            }
+          ]
+        }
+      }
+    },
+    "K8S::ElasticLoadBalancingV2::TargetGroupBinding": {
+      "default/frontend:53": {
+        "spec": {
+          "template": {
+            "metadata": {
+              "name": "k8s-default-frontend-782b674ba0",
+              "namespace": "default",
+              "creationTimestamp": null
+            },
+            "spec": {
+              "targetGroupARN": {
+                "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/frontend:53/status/targetGroupARN"
+              },
+              "targetType": "ip",
+              "serviceRef": {
+                "name": "frontend",
+                "port": 53
+              },
+              "networking": {
+                "ingress": [
+                  {
+                    "from": [
+                      {
+                        "ipBlock": {
+                          "cidr": "0.0.0.0/0"
+                        }
+                      }
+                    ],
+                    "ports": [
+                      {
+                        "protocol": "UDP",
+                        "port": 53
+                      }
+                    ]
+                  },
+                  {
+                    "from": [
+                      {
+                        "ipBlock": {
+                          "cidr": "192.168.0.0/19"
+                        }
+                      }
+                    ],
+                    "ports": [
+                      {
+                        "protocol": "TCP",
+                        "port": 53
+                      }
+                    ]
+                  }
+                ]
+              },
+              "ipAddressType": "ipv4"
+            }
+          }
+        }
+      },
+      "default/frontend:80": {
+        "spec": {
+          "template": {
+            "metadata": {
+              "name": "k8s-default-frontend-8e886057ac",
+              "namespace": "default",
+              "creationTimestamp": null
+            },
+            "spec": {
+              "targetGroupARN": {
+                "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/frontend:80/status/targetGroupARN"
+              },
+              "targetType": "ip",
+              "serviceRef": {
+                "name": "backend-web",
+                "port": 8080
+              },
+              "networking": {
+                "ingress": [
+                  {
+                    "from": [
+                      {
+                        "ipBlock": {
+                          "cidr": "192.168.0.0/19"
+                        }
+                      }
+                    ],
+                    "ports": [
+                      {
+                        "protocol": "TCP",
+                        "port": 9090
+                      }
+                    ]
+                  }
+                ]
+              },
+              "ipAddressType": "ipv4"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`,
+			wantNumResources: 7,
+		},
+		{
+			testName: "service with NLB securityGroups",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-sg",
+					Namespace: "default",
+					UID:       "7ab4be33-11c2-4a7b-b655-7add8affab36",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+						"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internet-facing",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:                     corev1.ServiceTypeLoadBalancer,
+					Selector:                 map[string]string{"app": "hello"},
+					LoadBalancerSourceRanges: []string{"10.20.0.0/16"},
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(80),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				},
+			},
+			resolveViaDiscoveryCalls: []resolveViaDiscoveryCall{resolveViaDiscoveryCallForOneSubnet},
+			listLoadBalancerCalls:    []listLoadBalancerCall{listLoadBalancerCallForEmptyLB},
+			enableNLBSecurityGroup:   true,
+			wantValue: `
+{
+  "id": "default/nlb-sg",
+  "resources": {
+    "AWS::EC2::SecurityGroup": {
+      "ManagedLBSecurityGroup": {
+        "spec": {
+          "groupName": "k8s-default-nlbsg-4ca0e32a8a",
+          "description": "[k8s] Managed SecurityGroup for LoadBalancer",
+          "ingress": [
+            {
+              "ipProtocol": "tcp",
+              "fromPort": 80,
+              "toPort": 80,
+              "ipRanges": [
+                {
+                  "cidrIP": "10.20.0.0/16"
+                }
+              ]
+            }
+          ]
+        }
+      }
+    },
+    "AWS::ElasticLoadBalancingV2::Listener": {
+      "80": {
+        "spec": {
+          "loadBalancerARN": {
+            "$ref": "#/resources/AWS::ElasticLoadBalancingV2::LoadBalancer/LoadBalancer/status/loadBalancerARN"
+          },
+          "port": 80,
+          "protocol": "TCP",
+          "defaultActions": [
+            {
+              "type": "forward",
+              "forwardConfig": {
+                "targetGroups": [
+                  {
+                    "targetGroupARN": {
+                      "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:80/status/targetGroupARN"
+                    }
+                  }
+                ]
+              }
+            }
+          ]
+        }
+      }
+    },
+    "AWS::ElasticLoadBalancingV2::LoadBalancer": {
+      "LoadBalancer": {
+        "spec": {
+          "name": "k8s-default-nlbsg-33e41aa671",
+          "type": "network",
+          "scheme": "internet-facing",
+          "ipAddressType": "ipv4",
+          "subnetMapping": [
+            {
+              "subnetID": "subnet-1"
+            }
+          ],
+          "securityGroups": [
+            {
+              "$ref": "#/resources/AWS::EC2::SecurityGroup/ManagedLBSecurityGroup/status/groupID"
+            }
+          ]
+        }
+      }
+    },
+    "AWS::ElasticLoadBalancingV2::TargetGroup": {
+      "default/nlb-sg:80": {
+        "spec": {
+          "name": "k8s-default-nlbsg-866b96550a",
+          "targetType": "ip",
+          "port": 80,
+          "protocol": "TCP",
+          "ipAddressType": "ipv4",
+          "healthCheckConfig": {
+            "port": "traffic-port",
+            "protocol": "TCP",
+            "intervalSeconds": 10,
+            "timeoutSeconds": 10,
+            "healthyThresholdCount": 3,
+            "unhealthyThresholdCount": 3
+          },
+          "targetGroupAttributes": [
+            {
+              "key": "proxy_protocol_v2.enabled",
+              "value": "false"
+            }
+          ]
+        }
+      }
+    },
+    "K8S::ElasticLoadBalancingV2::TargetGroupBinding": {
+      "default/nlb-sg:80": {
+        "spec": {
+          "template": {
+            "metadata": {
+              "name": "k8s-default-nlbsg-866b96550a",
+              "namespace": "default",
+              "creationTimestamp": null
+            },
+            "spec": {
+              "targetGroupARN": {
+                "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:80/status/targetGroupARN"
+              },
+              "targetType": "ip",
+              "serviceRef": {
+                "name": "nlb-sg",
+                "port": 80
+              },
+              "networking": {
+                "ingress": [
+                  {
+                    "from": [
+                      {
+                        "securityGroup": {
+                          "groupID": {
+                            "$ref": "#/resources/AWS::EC2::SecurityGroup/ManagedLBSecurityGroup/status/groupID"
+                          }
+                        }
+                      }
+                    ],
+                    "ports": [
+                      {
+                        "protocol": "TCP",
+                        "port": 80
+                      }
+                    ]
+                  }
+                ]
+              },
+              "ipAddressType": "ipv4"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`,
+			wantNumResources: 5,
+		},
+		{
+			testName: "service with NLB securityGroups specified via annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-sg",
+					Namespace: "default",
+					UID:       "7ab4be33-11c2-4a7b-b655-7add8affab36",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+						"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internet-facing",
+						"service.beta.kubernetes.io/aws-load-balancer-security-groups": "sg-frontend, my-sg",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:     corev1.ServiceTypeLoadBalancer,
+					Selector: map[string]string{"app": "hello"},
+					Ports: []corev1.ServicePort{
+						{
+							Port:       53,
+							TargetPort: intstr.FromInt(53),
+							Protocol:   corev1.ProtocolUDP,
+						},
+					},
+				},
+			},
+			resolveViaDiscoveryCalls: []resolveViaDiscoveryCall{resolveViaDiscoveryCallForOneSubnet},
+			listLoadBalancerCalls:    []listLoadBalancerCall{listLoadBalancerCallForEmptyLB},
+			resolveSGViaNameOrIDCalls: []resolveSGViaNameOrIDCall{
+				{
+					args: []string{"sg-frontend", "my-sg"},
+					want: []string{"sg-frontend", "sg-named"},
+				},
+			},
+			enableNLBSecurityGroup: true,
+			enableBackendSG:        true,
+			wantValue: `
+{
+  "id": "default/nlb-sg",
+  "resources": {
+    "AWS::ElasticLoadBalancingV2::Listener": {
+      "53": {
+        "spec": {
+          "loadBalancerARN": {
+            "$ref": "#/resources/AWS::ElasticLoadBalancingV2::LoadBalancer/LoadBalancer/status/loadBalancerARN"
+          },
+          "port": 53,
+          "protocol": "UDP",
+          "defaultActions": [
+            {
+              "type": "forward",
+              "forwardConfig": {
+                "targetGroups": [
+                  {
+                    "targetGroupARN": {
+                      "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:53/status/targetGroupARN"
+                    }
+                  }
+                ]
+              }
+            }
+          ]
+        }
+      }
+    },
+    "AWS::ElasticLoadBalancingV2::LoadBalancer": {
+      "LoadBalancer": {
+        "spec": {
+          "name": "k8s-default-nlbsg-33e41aa671",
+          "type": "network",
+          "scheme": "internet-facing",
+          "ipAddressType": "ipv4",
+          "subnetMapping": [
+            {
+              "subnetID": "subnet-1"
+            }
+          ],
+          "securityGroups": [
+            "sg-frontend",
+            "sg-named",
+            "sg-backend"
+          ]
+        }
+      }
+    },
+    "AWS::ElasticLoadBalancingV2::TargetGroup": {
+      "default/nlb-sg:53": {
+        "spec": {
+          "name": "k8s-default-nlbsg-782b674ba0",
+          "targetType": "ip",
+          "port": 53,
+          "protocol": "UDP",
+          "ipAddressType": "ipv4",
+          "healthCheckConfig": {
+            "port": "traffic-port",
+            "protocol": "TCP",
+            "intervalSeconds": 10,
+            "timeoutSeconds": 10,
+            "healthyThresholdCount": 3,
+            "unhealthyThresholdCount": 3
+          },
+          "targetGroupAttributes": [
+            {
+              "key": "proxy_protocol_v2.enabled",
+              "value": "false"
+            }
+          ]
+        }
+      }
+    },
+    "K8S::ElasticLoadBalancingV2::TargetGroupBinding": {
+      "default/nlb-sg:53": {
+        "spec": {
+          "template": {
+            "metadata": {
+              "name": "k8s-default-nlbsg-782b674ba0",
+              "namespace": "default",
+              "creationTimestamp": null
+            },
+            "spec": {
+              "targetGroupARN": {
+                "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:53/status/targetGroupARN"
+              },
+              "targetType": "ip",
+              "serviceRef": {
+                "name": "nlb-sg",
+                "port": 53
+              },
+              "networking": {
+                "ingress": [
+                  {
+                    "from": [
+                      {
+                        "securityGroup": {
+                          "groupID": "sg-backend"
+                        }
+                      }
+                    ],
+                    "ports": [
+                      {
+                        "protocol": "UDP",
+                        "port": 53
+                      },
+                      {
+                        "protocol": "TCP",
+                        "port": 53
+                      }
+                    ]
+                  }
+                ]
+              },
+              "ipAddressType": "ipv4"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`,
+			wantNumResources: 4,
+		},
+		{
+			testName: "existing NLB without securityGroups",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nlb-sg",
+					Namespace: "default",
+					UID:       "7ab4be33-11c2-4a7b-b655-7add8affab36",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":            "external",
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+						"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internet-facing",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type:     corev1.ServiceTypeLoadBalancer,
+					Selector: map[string]string{"app": "hello"},
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(80),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				},
+			},
+			resolveViaNameOrIDSliceCalls: []resolveViaNameOrIDSliceCall{
+				{
+					subnets: resolveViaDiscoveryCallForOneSubnet.subnets,
+				},
+			},
+			listLoadBalancerCalls: []listLoadBalancerCall{
+				{
+					sdkLBs: []elbv2.LoadBalancerWithTags{
+						{
+							LoadBalancer: &elbv2sdk.LoadBalancer{
+								LoadBalancerArn: aws.String("lb-arn"),
+								Scheme:          aws.String("internet-facing"),
+								AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+									{
+										SubnetId: aws.String("subnet-1"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			enableNLBSecurityGroup: true,
+			wantValue: `
+{
+  "id": "default/nlb-sg",
+  "resources": {
+    "AWS::ElasticLoadBalancingV2::Listener": {
+      "80": {
+        "spec": {
+          "loadBalancerARN": {
+            "$ref": "#/resources/AWS::ElasticLoadBalancingV2::LoadBalancer/LoadBalancer/status/loadBalancerARN"
+          },
+          "port": 80,
+          "protocol": "TCP",
+          "defaultActions": [
+            {
+              "type": "forward",
+              "forwardConfig": {
+                "targetGroups": [
+                  {
+                    "targetGroupARN": {
+                      "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:80/status/targetGroupARN"
+                    }
+                  }
+                ]
+              }
+            }
+          ]
+        }
+      }
+    },
+    "AWS::ElasticLoadBalancingV2::LoadBalancer": {
+      "LoadBalancer": {
+        "spec": {
+          "name": "k8s-default-nlbsg-33e41aa671",
+          "type": "network",
+          "scheme": "internet-facing",
+          "ipAddressType": "ipv4",
+          "subnetMapping": [
+            {
+              "subnetID": "subnet-1"
+            }
+          ]
+        }
+      }
+    },
+    "AWS::ElasticLoadBalancingV2::TargetGroup": {
+      "default/nlb-sg:80": {
+        "spec": {
+          "name": "k8s-default-nlbsg-866b96550a",
+          "targetType": "ip",
+          "port": 80,
+          "protocol": "TCP",
+          "ipAddressType": "ipv4",
+          "healthCheckConfig": {
+            "port": "traffic-port",
+            "protocol": "TCP",
+            "intervalSeconds": 10,
+            "timeoutSeconds": 10,
+            "healthyThresholdCount": 3,
+            "unhealthyThresholdCount": 3
+          },
+          "targetGroupAttributes": [
+            {
+              "key": "proxy_protocol_v2.enabled",
+              "value": "false"
+            }
+          ]
+        }
+      }
+    },
+    "K8S::ElasticLoadBalancingV2::TargetGroupBinding": {
+      "default/nlb-sg:80": {
+        "spec": {
+          "template": {
+            "metadata": {
+              "name": "k8s-default-nlbsg-866b96550a",
+              "namespace": "default",
+              "creationTimestamp": null
+            },
+            "spec": {
+              "targetGroupARN": {
+                "$ref": "#/resources/AWS::ElasticLoadBalancingV2::TargetGroup/default/nlb-sg:80/status/targetGroupARN"
+              },
+              "targetType": "ip",
+              "serviceRef": {
+                "name": "nlb-sg",
+                "port": 80
+              },
+              "networking": {
+                "ingress": [
+                  {
+                    "from": [
+                      {
+                        "ipBlock": {
+                          "cidr": "192.168.0.0/19"
+                        }
+                      }
+                    ],
+                    "ports": [
+                      {
+                        "protocol": "TCP",
+                        "port": 80
+                      }
+                    ]
+                  }
+                ]
+              },
+              "ipAddressType": "ipv4"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`,
+			wantNumResources: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			subnetsResolver := networking.NewMockSubnetsResolver(ctrl)
+			for _, call := range tt.resolveViaDiscoveryCalls {
+				subnetsResolver.EXPECT().ResolveViaDiscovery(gomock.Any(), gomock.Any()).Return(call.subnets, call.err)
+			}
+			for _, call := range tt.resolveViaNameOrIDSliceCalls {
+				subnetsResolver.EXPECT().ResolveViaNameOrIDSlice(gomock.Any(), gomock.Any(), gomock.Any()).Return(call.subnets, call.err)
+			}
+			featureGates := config.NewFeatureGates()
+			if tt.restrictToTypeLoadBalancer {
+				featureGates.Enable(config.ServiceTypeLoadBalancerOnly)
+			}
+			if tt.enableNLBSecurityGroup {
+				featureGates.Enable(config.NLBSecurityGroup)
+			}
+			sgResolver := networking.NewMockSecurityGroupResolver(ctrl)
+			for _, call := range tt.resolveSGViaNameOrIDCalls {
+				sgResolver.EXPECT().ResolveViaNameOrID(gomock.Any(), call.args).Return(call.want, call.err)
+			}
+			backendSGProvider := networking.NewMockBackendSGProvider(ctrl)
+			if tt.enableBackendSG {
+				backendSGProvider.EXPECT().Get(gomock.Any()).Return("sg-backend", nil).AnyTimes()
 			}
 			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
 			trackingProvider := tracking.NewDefaultProvider("service.k8s.aws", "my-cluster")
@@ -2971,9 +3758,10 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 				enableIPTargetType = *tt.enableIPTargetType
 			}
 			builder := NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, "vpc-xxx", trackingProvider, elbv2TaggingManager, featureGates,
-				"my-cluster", nil, nil, "ELBSecurityPolicy-2016-08", defaultTargetType, enableIPTargetType, serviceUtils)
+				"my-cluster", nil, nil, "ELBSecurityPolicy-2016-08", defaultTargetType, enableIPTargetType, serviceUtils,
+				sgResolver, backendSGProvider, tt.enableBackendSG, false)
 			ctx := context.Background()
-			stack, _, err := builder.Build(ctx, tt.svc)
+			stack, _, err := builder.Build(ctx, tt.svc, tt.buildOptions...)
 			if tt.wantError {
 				assert.Error(t, err)
 			} else {
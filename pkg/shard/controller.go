@@ -0,0 +1,31 @@
+package shard
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// NewController constructs new controller registered with mgr, for objects that are sharded by shardManager.
+// the controller is started on every replica when sharding is enabled, and only on the leader replica otherwise.
+// other controllers and singletons like the backend SG provider are left to leader election as usual.
+func NewController(name string, mgr manager.Manager, shardManager Manager, options controller.Options) (controller.Controller, error) {
+	c, err := controller.NewUnmanaged(name, mgr, options)
+	if err != nil {
+		return nil, err
+	}
+	if err := mgr.Add(&shardedController{Controller: c, needLeaderElection: shardManager.NeedLeaderElection()}); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+var _ manager.LeaderElectionRunnable = &shardedController{}
+
+type shardedController struct {
+	controller.Controller
+	needLeaderElection bool
+}
+
+func (c *shardedController) NeedLeaderElection() bool {
+	return c.needLeaderElection
+}
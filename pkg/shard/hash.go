@@ -0,0 +1,40 @@
+package shard
+
+import (
+	"hash/fnv"
+)
+
+// ownerOf computes the member that owns key using rendezvous hashing(highest random weight).
+// Every member scores the key independently, so when a member joins or leaves, only keys owned by that member are moved.
+// It returns "" if there are no members.
+func ownerOf(members []string, key string) string {
+	var owner string
+	var ownerScore uint64
+	for _, member := range members {
+		score := rendezvousScore(member, key)
+		if owner == "" || score > ownerScore || (score == ownerScore && member < owner) {
+			owner = member
+			ownerScore = score
+		}
+	}
+	return owner
+}
+
+// rendezvousScore computes the score of key for member.
+func rendezvousScore(member string, key string) uint64 {
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(member))
+	_, _ = hasher.Write([]byte{0})
+	_, _ = hasher.Write([]byte(key))
+	return mix64(hasher.Sum64())
+}
+
+// mix64 is the finalizer of splitmix64, which spreads the fnv hash of similar inputs(like pod names that only differ in suffix) uniformly.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,70 @@
+package shard
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ownerOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []string
+		key     string
+		want    string
+	}{
+		{
+			name:    "no members",
+			members: nil,
+			key:     "awesome-ns/ing-1",
+			want:    "",
+		},
+		{
+			name:    "single member",
+			members: []string{"controller-a"},
+			key:     "awesome-ns/ing-1",
+			want:    "controller-a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ownerOf(tt.members, tt.key)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_ownerOf_consistency(t *testing.T) {
+	members := []string{"controller-a", "controller-b", "controller-c"}
+	var keys []string
+	for i := 0; i < 3000; i++ {
+		keys = append(keys, fmt.Sprintf("awesome-ns/svc-%d", i))
+	}
+
+	ownerByKey := make(map[string]string, len(keys))
+	keysByOwner := make(map[string]int)
+	for _, key := range keys {
+		owner := ownerOf(members, key)
+		ownerByKey[key] = owner
+		keysByOwner[owner]++
+
+		// the order of members doesn't matter.
+		assert.Equal(t, owner, ownerOf([]string{"controller-c", "controller-a", "controller-b"}, key))
+	}
+	// keys are spread roughly evenly.
+	for _, member := range members {
+		assert.InDelta(t, len(keys)/len(members), keysByOwner[member], float64(len(keys))/10, "member %v", member)
+	}
+
+	// only keys owned by the dead member are moved.
+	survivors := []string{"controller-a", "controller-c"}
+	for _, key := range keys {
+		owner := ownerOf(survivors, key)
+		if ownerByKey[key] != "controller-b" {
+			assert.Equal(t, ownerByKey[key], owner)
+		} else {
+			assert.Contains(t, survivors, owner)
+		}
+	}
+}
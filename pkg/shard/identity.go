@@ -0,0 +1,41 @@
+package shard
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// envPodName is the environment variable that contains the name of controller pod.
+	envPodName = "POD_NAME"
+	// the namespace of controller pod when running in cluster.
+	inClusterNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+// LoadIdentity loads the identity of this replica, which is the name of controller pod.
+// it falls back to hostname when POD_NAME isn't set, which is the pod name unless hostNetwork is used.
+func LoadIdentity() (string, error) {
+	if podName := os.Getenv(envPodName); podName != "" {
+		return podName, nil
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get hostname")
+	}
+	return hostname, nil
+}
+
+// LoadNamespace loads the namespace for shard Leases.
+// it defaults to the namespace of controller pod if namespace is not specified.
+func LoadNamespace(namespace string) (string, error) {
+	if namespace != "" {
+		return namespace, nil
+	}
+	data, err := os.ReadFile(inClusterNamespacePath)
+	if err != nil {
+		return "", errors.Wrap(err, "namespace must be specified when running out of cluster")
+	}
+	return strings.TrimSpace(string(data)), nil
+}
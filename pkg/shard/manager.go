@@ -0,0 +1,405 @@
+package shard
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// LabelShardGroup is the label on Lease objects that identifies the shard group of controller replicas.
+	LabelShardGroup = "elbv2.k8s.aws/shard-group"
+
+	// timeout for releasing our own lease during shutdown.
+	releaseLeaseTimeout = 5 * time.Second
+)
+
+// ChangeHandler is invoked when keys owned by this replica changed.
+type ChangeHandler func(ctx context.Context)
+
+// Manager decides which reconcile keys are owned by this controller replica.
+type Manager interface {
+	// Owns checks whether the object identified by key should be reconciled by this replica.
+	Owns(key types.NamespacedName) bool
+
+	// AddChangeHandler registers a handler that is invoked whenever keys owned by this replica changed,
+	// so that newly owned objects can be reconciled.
+	AddChangeHandler(handler ChangeHandler)
+
+	// NeedLeaderElection checks whether controllers of sharded objects need leader election.
+	// it's false when sharding is enabled, since every replica reconciles the objects it owns.
+	NeedLeaderElection() bool
+}
+
+// NewUnshardedManager constructs new Manager that owns every key, which is used when sharding is disabled.
+func NewUnshardedManager() Manager {
+	return &unshardedManager{}
+}
+
+var _ Manager = &unshardedManager{}
+
+type unshardedManager struct{}
+
+func (m *unshardedManager) Owns(_ types.NamespacedName) bool {
+	return true
+}
+
+func (m *unshardedManager) AddChangeHandler(_ ChangeHandler) {}
+
+func (m *unshardedManager) NeedLeaderElection() bool {
+	return true
+}
+
+// NewDefaultManager constructs new defaultManager.
+//   - groupName identifies the replicas that share keys, each replica maintains a Lease named "<groupName>-<identity>" in namespace.
+//   - identity must be unique among replicas.
+//   - a replica is considered dead if its Lease isn't renewed within leaseDuration, and its keys are handed off to other replicas.
+//   - keys moved from a replica that is still alive are only claimed leaseDuration after the move, by when that replica has either
+//     observed the move or stopped owning any key.
+func NewDefaultManager(k8sClient client.Client, apiReader client.Reader, namespace string, groupName string, identity string,
+	leaseDuration time.Duration, renewInterval time.Duration, logger logr.Logger) *defaultManager {
+	return &defaultManager{
+		k8sClient:      k8sClient,
+		apiReader:      apiReader,
+		namespace:      namespace,
+		groupName:      groupName,
+		identity:       identity,
+		leaseDuration:  leaseDuration,
+		renewInterval:  renewInterval,
+		clock:          clock.RealClock{},
+		logger:         logger,
+		observedLeases: make(map[string]observedLease),
+	}
+}
+
+var _ Manager = &defaultManager{}
+var _ manager.Runnable = &defaultManager{}
+var _ manager.LeaderElectionRunnable = &defaultManager{}
+
+// defaultManager tracks the alive replicas via Lease objects, and assigns keys to them with rendezvous hashing.
+type defaultManager struct {
+	k8sClient     client.Client
+	apiReader     client.Reader
+	namespace     string
+	groupName     string
+	identity      string
+	leaseDuration time.Duration
+	renewInterval time.Duration
+	clock         clock.Clock
+	logger        logr.Logger
+
+	// observedLeases tracks the Leases of other replicas by name.
+	// only accessed from the sync loop.
+	observedLeases map[string]observedLease
+
+	mutex sync.RWMutex
+	// sorted identities of alive replicas.
+	members []string
+	// previous members that keys might still be owned by, until leaseDuration after members changed.
+	handoffs []handoff
+	// the last time our own Lease is renewed, based on local clock.
+	lastRenewTime time.Time
+	// the last time members are refreshed, based on local clock.
+	lastRefreshTime time.Time
+	changeHandlers  []ChangeHandler
+}
+
+// handoff records the members before a membership change.
+// other replicas might reconcile keys based on these members until they observe the change, or their own view goes stale.
+type handoff struct {
+	members []string
+	until   time.Time
+}
+
+// observedLease records when we observed a renewal of other replica's Lease.
+// local clock is used to decide whether a Lease expires, so that clock skew between replicas doesn't matter.
+type observedLease struct {
+	holderIdentity string
+	renewTime      *metav1.MicroTime
+	observedTime   time.Time
+}
+
+func (m *defaultManager) Owns(key types.NamespacedName) bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	if !m.isRenewedLocked() || !m.isRefreshedLocked() {
+		return false
+	}
+	if ownerOf(m.members, key.String()) != m.identity {
+		return false
+	}
+	// the key is not claimed while the previous owner might still reconcile it.
+	// previous owners that are no longer members don't own any key, since their Lease expired or is released.
+	now := m.clock.Now()
+	for _, h := range m.handoffs {
+		if !now.Before(h.until) {
+			continue
+		}
+		previousOwner := ownerOf(h.members, key.String())
+		if previousOwner != m.identity && containsString(m.members, previousOwner) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *defaultManager) AddChangeHandler(handler ChangeHandler) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.changeHandlers = append(m.changeHandlers, handler)
+}
+
+// Start will renew our own Lease and refresh members periodically until ctx is done.
+// Our own Lease is deleted upon stop so that other replicas take over our keys immediately.
+func (m *defaultManager) Start(ctx context.Context) error {
+	m.logger.Info("starting shard manager", "identity", m.identity, "namespace", m.namespace, "group", m.groupName)
+	wait.UntilWithContext(ctx, m.sync, m.renewInterval)
+	m.mutex.Lock()
+	m.members = nil
+	m.mutex.Unlock()
+
+	releaseCtx, cancel := context.WithTimeout(context.Background(), releaseLeaseTimeout)
+	defer cancel()
+	if err := m.releaseLease(releaseCtx); err != nil {
+		m.logger.Error(err, "failed to release shard lease")
+	}
+	return nil
+}
+
+// NeedLeaderElection returns false, all replicas need to participate in sharding.
+func (m *defaultManager) NeedLeaderElection() bool {
+	return false
+}
+
+func (m *defaultManager) sync(ctx context.Context) {
+	if err := m.renewLease(ctx); err != nil {
+		m.logger.Error(err, "failed to renew shard lease")
+	}
+	if err := m.refreshMembers(ctx); err != nil {
+		m.logger.Error(err, "failed to refresh shard members")
+	}
+}
+
+// renewLease creates or renews our own Lease.
+func (m *defaultManager) renewLease(ctx context.Context) error {
+	now := m.clock.Now()
+	leaseKey := types.NamespacedName{Namespace: m.namespace, Name: m.leaseName()}
+	lease := &coordinationv1.Lease{}
+	if err := m.apiReader.Get(ctx, leaseKey, lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: leaseKey.Namespace,
+				Name:      leaseKey.Name,
+				Labels: map[string]string{
+					LabelShardGroup: m.groupName,
+				},
+			},
+		}
+		m.buildLeaseSpec(lease, now)
+		if err := m.k8sClient.Create(ctx, lease); err != nil {
+			return errors.Wrapf(err, "failed to create shard lease: %v", leaseKey)
+		}
+	} else {
+		if lease.Labels[LabelShardGroup] != m.groupName {
+			return errors.Errorf("lease %v doesn't belong to shard group %v", leaseKey, m.groupName)
+		}
+		m.buildLeaseSpec(lease, now)
+		if err := m.k8sClient.Update(ctx, lease); err != nil {
+			return errors.Wrapf(err, "failed to renew shard lease: %v", leaseKey)
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.lastRenewTime = now
+	return nil
+}
+
+// releaseLease deletes our own Lease.
+func (m *defaultManager) releaseLease(ctx context.Context) error {
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: m.namespace,
+			Name:      m.leaseName(),
+		},
+	}
+	if err := m.k8sClient.Delete(ctx, lease); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// refreshMembers refreshes the alive replicas, and notifies changeHandlers if they changed.
+// Leases that have expired are deleted so that they don't accumulate as replicas get replaced.
+func (m *defaultManager) refreshMembers(ctx context.Context) error {
+	leaseList := &coordinationv1.LeaseList{}
+	if err := m.apiReader.List(ctx, leaseList,
+		client.InNamespace(m.namespace),
+		client.MatchingLabels{LabelShardGroup: m.groupName}); err != nil {
+		return err
+	}
+
+	now := m.clock.Now()
+	leaseNames := make(map[string]struct{}, len(leaseList.Items))
+	var members []string
+	for i := range leaseList.Items {
+		lease := &leaseList.Items[i]
+		leaseNames[lease.Name] = struct{}{}
+		holderIdentity := pointer.StringDeref(lease.Spec.HolderIdentity, "")
+		if holderIdentity == "" || holderIdentity == m.identity {
+			continue
+		}
+		observed, exists := m.observedLeases[lease.Name]
+		if !exists || observed.holderIdentity != holderIdentity || !observed.renewTime.Equal(lease.Spec.RenewTime) {
+			observed = observedLease{
+				holderIdentity: holderIdentity,
+				renewTime:      lease.Spec.RenewTime.DeepCopy(),
+				observedTime:   now,
+			}
+			m.observedLeases[lease.Name] = observed
+		}
+		if now.Sub(observed.observedTime) < m.leaseDurationOf(lease) {
+			members = append(members, holderIdentity)
+			continue
+		}
+		if err := m.k8sClient.Delete(ctx, lease, client.Preconditions{ResourceVersion: &lease.ResourceVersion}); err != nil &&
+			!apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+			m.logger.Error(err, "failed to delete expired shard lease", "lease", lease.Name)
+		}
+	}
+	for leaseName := range m.observedLeases {
+		if _, exists := leaseNames[leaseName]; !exists {
+			delete(m.observedLeases, leaseName)
+		}
+	}
+
+	m.mutex.Lock()
+	if m.isRenewedLocked() {
+		members = append(members, m.identity)
+	}
+	sort.Strings(members)
+	m.lastRefreshTime = now
+	handoffsDone := m.pruneHandoffsLocked(now)
+	membersChanged := !reflect.DeepEqual(members, m.members)
+	if membersChanged {
+		m.handoffs = append(m.handoffs, m.buildHandoffsLocked(members, now)...)
+		m.members = members
+	}
+	if !membersChanged && !handoffsDone {
+		m.mutex.Unlock()
+		return nil
+	}
+	changeHandlers := append([]ChangeHandler(nil), m.changeHandlers...)
+	m.mutex.Unlock()
+
+	if membersChanged {
+		m.logger.Info("shard members changed", "members", members)
+	} else {
+		m.logger.Info("shard handoff completed", "members", members)
+	}
+	for _, handler := range changeHandlers {
+		go handler(ctx)
+	}
+	return nil
+}
+
+// buildHandoffsLocked builds the handoffs when members changed to newMembers.
+// if we weren't a member, keys might be owned by any other alive replica according to their view.
+func (m *defaultManager) buildHandoffsLocked(newMembers []string, now time.Time) []handoff {
+	until := now.Add(m.leaseDuration)
+	var handoffs []handoff
+	if len(m.members) != 0 {
+		handoffs = append(handoffs, handoff{members: m.members, until: until})
+	}
+	if !containsString(m.members, m.identity) {
+		var otherMembers []string
+		for _, member := range newMembers {
+			if member != m.identity {
+				otherMembers = append(otherMembers, member)
+			}
+		}
+		if len(otherMembers) != 0 {
+			handoffs = append(handoffs, handoff{members: otherMembers, until: until})
+		}
+	}
+	return handoffs
+}
+
+// pruneHandoffsLocked removes the handoffs that are completed, and returns whether there are any.
+func (m *defaultManager) pruneHandoffsLocked(now time.Time) bool {
+	var pendingHandoffs []handoff
+	for _, h := range m.handoffs {
+		if now.Before(h.until) {
+			pendingHandoffs = append(pendingHandoffs, h)
+		}
+	}
+	handoffsDone := len(pendingHandoffs) != len(m.handoffs)
+	m.handoffs = pendingHandoffs
+	return handoffsDone
+}
+
+// isRefreshedLocked checks whether members are refreshed recently.
+// we own no keys once our view of members is older than leaseDuration, since other replicas might have joined.
+func (m *defaultManager) isRefreshedLocked() bool {
+	if m.lastRefreshTime.IsZero() {
+		return false
+	}
+	return m.clock.Since(m.lastRefreshTime) < m.leaseDuration
+}
+
+// isRenewedLocked checks whether our own Lease is still valid.
+// we own no keys once our Lease expired, since other replicas might have taken over them.
+func (m *defaultManager) isRenewedLocked() bool {
+	if m.lastRenewTime.IsZero() {
+		return false
+	}
+	return m.clock.Since(m.lastRenewTime) < m.leaseDuration
+}
+
+func (m *defaultManager) buildLeaseSpec(lease *coordinationv1.Lease, now time.Time) {
+	renewTime := metav1.NewMicroTime(now)
+	if lease.Spec.AcquireTime == nil || pointer.StringDeref(lease.Spec.HolderIdentity, "") != m.identity {
+		lease.Spec.AcquireTime = &renewTime
+	}
+	lease.Spec.HolderIdentity = pointer.String(m.identity)
+	lease.Spec.LeaseDurationSeconds = pointer.Int32(int32(m.leaseDuration / time.Second))
+	lease.Spec.RenewTime = &renewTime
+}
+
+// leaseDurationOf returns the lease duration announced by other replica, which falls back to ours.
+func (m *defaultManager) leaseDurationOf(lease *coordinationv1.Lease) time.Duration {
+	if lease.Spec.LeaseDurationSeconds == nil || *lease.Spec.LeaseDurationSeconds <= 0 {
+		return m.leaseDuration
+	}
+	return time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+}
+
+func (m *defaultManager) leaseName() string {
+	return m.groupName + "-" + m.identity
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,320 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestManager(k8sClient client.Client, identity string, clock *clocktesting.FakeClock) *defaultManager {
+	m := NewDefaultManager(k8sClient, k8sClient, "kube-system", "lbc", identity, 15*time.Second, 5*time.Second, logr.Discard())
+	m.clock = clock
+	return m
+}
+
+func Test_defaultManager_sync(t *testing.T) {
+	ctx := context.Background()
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	clock := clocktesting.NewFakeClock(time.Now())
+
+	managerA := newTestManager(k8sClient, "controller-a", clock)
+	managerB := newTestManager(k8sClient, "controller-b", clock)
+	var changesMutex sync.Mutex
+	var changesA int
+	changesDone := make(chan struct{}, 10)
+	managerA.AddChangeHandler(func(_ context.Context) {
+		changesMutex.Lock()
+		defer changesMutex.Unlock()
+		changesA++
+		changesDone <- struct{}{}
+	})
+
+	key := types.NamespacedName{Namespace: "awesome-ns", Name: "ing-1"}
+	// no keys are owned before lease is acquired.
+	assert.False(t, managerA.Owns(key))
+
+	managerA.sync(ctx)
+	<-changesDone
+	assert.Equal(t, []string{"controller-a"}, managerA.members)
+	assert.True(t, managerA.Owns(key))
+
+	lease := &coordinationv1.Lease{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: "kube-system", Name: "lbc-controller-a"}, lease))
+	assert.Equal(t, "lbc", lease.Labels[LabelShardGroup])
+	assert.Equal(t, "controller-a", pointer.StringDeref(lease.Spec.HolderIdentity, ""))
+	assert.Equal(t, int32(15), pointer.Int32Deref(lease.Spec.LeaseDurationSeconds, 0))
+
+	// replica joins, keys moved to replica B are claimed after replica A observed the change.
+	managerB.sync(ctx)
+	managerA.sync(ctx)
+	<-changesDone
+	assert.Equal(t, []string{"controller-a", "controller-b"}, managerA.members)
+	assert.Equal(t, []string{"controller-a", "controller-b"}, managerB.members)
+	for i := 0; i < 100; i++ {
+		key := types.NamespacedName{Namespace: "awesome-ns", Name: fmt.Sprintf("ing-%d", i)}
+		assert.False(t, managerA.Owns(key) && managerB.Owns(key), "key %v must be owned by at most one replica", key)
+		if ownerOf(managerA.members, key.String()) == "controller-b" {
+			assert.False(t, managerB.Owns(key), "key %v must not be claimed during handoff", key)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		clock.Step(5 * time.Second)
+		managerB.sync(ctx)
+		managerA.sync(ctx)
+	}
+	// replica A is notified when handoff completes.
+	<-changesDone
+	for i := 0; i < 100; i++ {
+		key := types.NamespacedName{Namespace: "awesome-ns", Name: fmt.Sprintf("ing-%d", i)}
+		assert.NotEqual(t, managerA.Owns(key), managerB.Owns(key), "key %v must be owned by exactly one replica", key)
+	}
+
+	// replica B dies, its lease expires based on the local time of replica A.
+	clock.Step(10 * time.Second)
+	managerA.sync(ctx)
+	assert.Equal(t, []string{"controller-a", "controller-b"}, managerA.members)
+	clock.Step(10 * time.Second)
+	managerA.sync(ctx)
+	<-changesDone
+	assert.Equal(t, []string{"controller-a"}, managerA.members)
+	// keys of dead replica are claimed immediately.
+	for i := 0; i < 100; i++ {
+		assert.True(t, managerA.Owns(types.NamespacedName{Namespace: "awesome-ns", Name: fmt.Sprintf("ing-%d", i)}))
+	}
+	// the expired lease is deleted.
+	leaseList := &coordinationv1.LeaseList{}
+	require.NoError(t, k8sClient.List(ctx, leaseList))
+	assert.Len(t, leaseList.Items, 1)
+
+	// replica B has stopped renewing its lease, thus owns nothing.
+	for i := 0; i < 100; i++ {
+		assert.False(t, managerB.Owns(types.NamespacedName{Namespace: "awesome-ns", Name: fmt.Sprintf("ing-%d", i)}))
+	}
+
+	changesMutex.Lock()
+	defer changesMutex.Unlock()
+	assert.Equal(t, 4, changesA)
+}
+
+// keyOwnedBy finds a key that is owned by owner among members.
+func keyOwnedBy(members []string, owner string) types.NamespacedName {
+	for i := 0; ; i++ {
+		key := types.NamespacedName{Namespace: "awesome-ns", Name: fmt.Sprintf("ing-%d", i)}
+		if ownerOf(members, key.String()) == owner {
+			return key
+		}
+	}
+}
+
+func Test_defaultManager_Owns(t *testing.T) {
+	now := time.Now()
+	membersAB := []string{"controller-a", "controller-b"}
+	tests := []struct {
+		name            string
+		members         []string
+		handoffs        []handoff
+		lastRenewTime   time.Time
+		lastRefreshTime time.Time
+		key             types.NamespacedName
+		want            bool
+	}{
+		{
+			name:            "key owned by us",
+			members:         membersAB,
+			lastRenewTime:   now,
+			lastRefreshTime: now,
+			key:             keyOwnedBy(membersAB, "controller-a"),
+			want:            true,
+		},
+		{
+			name:            "key owned by other replica",
+			members:         membersAB,
+			lastRenewTime:   now,
+			lastRefreshTime: now,
+			key:             keyOwnedBy(membersAB, "controller-b"),
+			want:            false,
+		},
+		{
+			name:    "key moved from replica that is no longer member during handoff",
+			members: []string{"controller-a"},
+			handoffs: []handoff{
+				{members: []string{"controller-b"}, until: now.Add(time.Second)},
+			},
+			lastRenewTime:   now,
+			lastRefreshTime: now,
+			key:             keyOwnedBy([]string{"controller-a"}, "controller-a"),
+			want:            true,
+		},
+		{
+			name:    "key moved from replica that is still member during handoff",
+			members: membersAB,
+			handoffs: []handoff{
+				{members: []string{"controller-b"}, until: now.Add(time.Second)},
+			},
+			lastRenewTime:   now,
+			lastRefreshTime: now,
+			key:             keyOwnedBy(membersAB, "controller-a"),
+			want:            false,
+		},
+		{
+			name:    "key moved from replica that is still member after handoff",
+			members: membersAB,
+			handoffs: []handoff{
+				{members: []string{"controller-b"}, until: now},
+			},
+			lastRenewTime:   now,
+			lastRefreshTime: now,
+			key:             keyOwnedBy(membersAB, "controller-a"),
+			want:            true,
+		},
+		{
+			name:    "key not moved during handoff",
+			members: membersAB,
+			handoffs: []handoff{
+				{members: []string{"controller-a"}, until: now.Add(time.Second)},
+			},
+			lastRenewTime:   now,
+			lastRefreshTime: now,
+			key:             keyOwnedBy(membersAB, "controller-a"),
+			want:            true,
+		},
+		{
+			name:            "members are stale",
+			members:         membersAB,
+			lastRenewTime:   now,
+			lastRefreshTime: now.Add(-20 * time.Second),
+			key:             keyOwnedBy(membersAB, "controller-a"),
+			want:            false,
+		},
+		{
+			name:            "our own lease is stale",
+			members:         membersAB,
+			lastRenewTime:   now.Add(-20 * time.Second),
+			lastRefreshTime: now,
+			key:             keyOwnedBy(membersAB, "controller-a"),
+			want:            false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestManager(nil, "controller-a", clocktesting.NewFakeClock(now))
+			m.members = tt.members
+			m.handoffs = tt.handoffs
+			m.lastRenewTime = tt.lastRenewTime
+			m.lastRefreshTime = tt.lastRefreshTime
+			assert.Equal(t, tt.want, m.Owns(tt.key))
+		})
+	}
+}
+
+func Test_defaultManager_releaseLease(t *testing.T) {
+	ctx := context.Background()
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	clock := clocktesting.NewFakeClock(time.Now())
+
+	managerA := newTestManager(k8sClient, "controller-a", clock)
+	managerB := newTestManager(k8sClient, "controller-b", clock)
+	managerA.sync(ctx)
+	managerB.sync(ctx)
+	managerA.sync(ctx)
+	assert.Equal(t, []string{"controller-a", "controller-b"}, managerA.members)
+
+	// keys of replica B are handed off as soon as it released its lease.
+	require.NoError(t, managerB.releaseLease(ctx))
+	managerA.sync(ctx)
+	assert.Equal(t, []string{"controller-a"}, managerA.members)
+}
+
+func Test_defaultManager_refreshMembers(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name          string
+		leases        []*coordinationv1.Lease
+		lastRenewTime time.Time
+		want          []string
+	}{
+		{
+			name: "leases of other shard groups are ignored",
+			leases: []*coordinationv1.Lease{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kube-system",
+						Name:      "other-controller-b",
+						Labels:    map[string]string{LabelShardGroup: "other"},
+					},
+					Spec: coordinationv1.LeaseSpec{
+						HolderIdentity: pointer.String("controller-b"),
+					},
+				},
+			},
+			lastRenewTime: now,
+			want:          []string{"controller-a"},
+		},
+		{
+			name: "leases without holder are ignored",
+			leases: []*coordinationv1.Lease{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kube-system",
+						Name:      "lbc-controller-b",
+						Labels:    map[string]string{LabelShardGroup: "lbc"},
+					},
+				},
+			},
+			lastRenewTime: now,
+			want:          []string{"controller-a"},
+		},
+		{
+			name: "our own lease is stale",
+			leases: []*coordinationv1.Lease{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "kube-system",
+						Name:      "lbc-controller-b",
+						Labels:    map[string]string{LabelShardGroup: "lbc"},
+					},
+					Spec: coordinationv1.LeaseSpec{
+						HolderIdentity: pointer.String("controller-b"),
+					},
+				},
+			},
+			lastRenewTime: now.Add(-20 * time.Second),
+			want:          []string{"controller-b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, lease := range tt.leases {
+				require.NoError(t, k8sClient.Create(ctx, lease.DeepCopy()))
+			}
+			m := newTestManager(k8sClient, "controller-a", clocktesting.NewFakeClock(now))
+			m.lastRenewTime = tt.lastRenewTime
+
+			require.NoError(t, m.refreshMembers(ctx))
+			assert.Equal(t, tt.want, m.members)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package shard
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewShardedReconciler constructs new reconciler that only invokes reconciler for requests owned by this replica.
+// requests that are not owned are dropped, the owning replica will reconcile them.
+func NewShardedReconciler(reconciler reconcile.Reconciler, shardManager Manager, logger logr.Logger) reconcile.Reconciler {
+	return &shardedReconciler{
+		reconciler:   reconciler,
+		shardManager: shardManager,
+		logger:       logger,
+	}
+}
+
+var _ reconcile.Reconciler = &shardedReconciler{}
+
+type shardedReconciler struct {
+	reconciler   reconcile.Reconciler
+	shardManager Manager
+	logger       logr.Logger
+}
+
+func (r *shardedReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	if !r.shardManager.Owns(req.NamespacedName) {
+		r.logger.V(1).Info("skipping reconcile request owned by other replica", "request", req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	return r.reconciler.Reconcile(ctx, req)
+}
+
+// NewResyncHandler constructs new ChangeHandler that sends every object of listed kind into eventChan,
+// so that objects newly owned by this replica are reconciled after shard members changed.
+// objects that are not owned will be dropped by the shardedReconciler.
+func NewResyncHandler(k8sClient client.Client, newObjectList func() client.ObjectList, eventChan chan<- event.GenericEvent, logger logr.Logger) ChangeHandler {
+	return func(ctx context.Context) {
+		objList := newObjectList()
+		if err := k8sClient.List(ctx, objList); err != nil {
+			logger.Error(err, "failed to list objects for shard resync")
+			return
+		}
+		objs, err := meta.ExtractList(objList)
+		if err != nil {
+			logger.Error(err, "failed to extract objects for shard resync")
+			return
+		}
+		for _, obj := range objs {
+			clientObj, ok := obj.(client.Object)
+			if !ok {
+				continue
+			}
+			select {
+			case eventChan <- event.GenericEvent{Object: clientObj}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package shard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type staticManager struct {
+	ownedKeys map[types.NamespacedName]bool
+}
+
+func (m *staticManager) Owns(key types.NamespacedName) bool {
+	return m.ownedKeys[key]
+}
+
+func (m *staticManager) AddChangeHandler(_ ChangeHandler) {}
+
+func (m *staticManager) NeedLeaderElection() bool {
+	return false
+}
+
+func Test_shardedReconciler_Reconcile(t *testing.T) {
+	ownedKey := types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"}
+	otherKey := types.NamespacedName{Namespace: "awesome-ns", Name: "svc-2"}
+	tests := []struct {
+		name           string
+		req            reconcile.Request
+		wantReconciled bool
+	}{
+		{
+			name:           "request owned by this replica",
+			req:            reconcile.Request{NamespacedName: ownedKey},
+			wantReconciled: true,
+		},
+		{
+			name:           "request owned by other replica",
+			req:            reconcile.Request{NamespacedName: otherKey},
+			wantReconciled: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reconciled := false
+			reconciler := reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				reconciled = true
+				return reconcile.Result{Requeue: true}, nil
+			})
+			shardManager := &staticManager{ownedKeys: map[types.NamespacedName]bool{ownedKey: true}}
+			r := NewShardedReconciler(reconciler, shardManager, logr.Discard())
+
+			got, err := r.Reconcile(context.Background(), tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReconciled, reconciled)
+			assert.Equal(t, reconcile.Result{Requeue: tt.wantReconciled}, got)
+		})
+	}
+}
+
+func Test_NewResyncHandler(t *testing.T) {
+	ctx := context.Background()
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+	for _, name := range []string{"svc-1", "svc-2"} {
+		require.NoError(t, k8sClient.Create(ctx, &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: name},
+		}))
+	}
+
+	eventChan := make(chan event.GenericEvent, 10)
+	handler := NewResyncHandler(k8sClient, func() client.ObjectList { return &corev1.ServiceList{} }, eventChan, logr.Discard())
+	handler(ctx)
+	close(eventChan)
+
+	var got []string
+	for e := range eventChan {
+		got = append(got, e.Object.(*corev1.Service).Name)
+	}
+	assert.ElementsMatch(t, []string{"svc-1", "svc-2"}, got)
+}
@@ -0,0 +1,69 @@
+package targetgroupbinding
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+// NewNetworkingReconcileError constructs new NetworkingReconcileError.
+func NewNetworkingReconcileError(err error) *NetworkingReconcileError {
+	return &NetworkingReconcileError{
+		err: err,
+	}
+}
+
+var _ error = &NetworkingReconcileError{}
+
+// NetworkingReconcileError indicates the networking rules for TargetGroupBinding failed to reconcile.
+type NetworkingReconcileError struct {
+	err error
+}
+
+func (e *NetworkingReconcileError) Error() string {
+	return e.err.Error()
+}
+
+func (e *NetworkingReconcileError) Unwrap() error {
+	return e.err
+}
+
+// NewServiceRefSwapInProgressError constructs new ServiceRefSwapInProgressError.
+func NewServiceRefSwapInProgressError(pendingTargets int32, requeueAfter time.Duration) *ServiceRefSwapInProgressError {
+	return &ServiceRefSwapInProgressError{
+		PendingTargets: pendingTargets,
+		err:            runtime.NewRequeueNeededAfter(fmt.Sprintf("swap serviceRef with %v pending targets", pendingTargets), requeueAfter),
+	}
+}
+
+var _ error = &ServiceRefSwapInProgressError{}
+
+// ServiceRefSwapInProgressError indicates a BlueGreen swap of serviceRef is in progress for TargetGroupBinding.
+// It wraps a RequeueNeededAfter, so that the swap is monitored until the targets of new serviceRef are healthy.
+type ServiceRefSwapInProgressError struct {
+	// PendingTargets is the number of endpoints of new serviceRef that are not yet registered as healthy targets.
+	PendingTargets int32
+
+	err error
+}
+
+func (e *ServiceRefSwapInProgressError) Error() string {
+	return e.err.Error()
+}
+
+func (e *ServiceRefSwapInProgressError) Unwrap() error {
+	return e.err
+}
+
+// IsTargetGroupNotFoundError checks whether the error indicates the TargetGroup doesn't exist.
+func IsTargetGroupNotFoundError(err error) bool {
+	return isELBV2TargetGroupNotFoundError(err)
+}
+
+// IsNetworkingReconcileError checks whether the error indicates the networking rules failed to reconcile.
+func IsNetworkingReconcileError(err error) bool {
+	var networkingErr *NetworkingReconcileError
+	return errors.As(err, &networkingErr)
+}
@@ -0,0 +1,184 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// the name prefix of ConfigMaps that record the targets owned by multi-cluster TargetGroupBindings.
+	trackedTargetsConfigMapNamePrefix = "aws-lbc-targets-"
+	// the key in ConfigMap data that holds the targets owned by multi-cluster TargetGroupBindings.
+	trackedTargetsConfigMapDataKey = "targets"
+	trackedTargetsDelimiter        = ","
+	// the length of hash suffix appended to truncated ConfigMap names.
+	trackedTargetsConfigMapNameHashLength = 10
+)
+
+// MultiClusterManager tracks the targets registered by TargetGroupBindings with multiClusterTargetGroup enabled,
+// so that TargetGroupBindings from multiple clusters can safely share the same TargetGroup.
+// For TargetGroupBindings without multiClusterTargetGroup, every target in the TargetGroup is considered as owned.
+type MultiClusterManager interface {
+	// UpdateTrackedTargets records the targets currently owned by TargetGroupBinding.
+	UpdateTrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targetUIDs sets.String) error
+
+	// FilterTargetsForDeregistration returns the targets that are owned by TargetGroupBinding and can be deregistered.
+	FilterTargetsForDeregistration(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targets []TargetInfo) ([]TargetInfo, error)
+
+	// Cleanup removes the tracked targets of TargetGroupBinding.
+	Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
+}
+
+// NewDefaultMultiClusterManager constructs new defaultMultiClusterManager.
+// the records are read via apiReader, so that ConfigMaps don't need to be cached by the controller.
+func NewDefaultMultiClusterManager(k8sClient client.Client, apiReader client.Reader, logger logr.Logger) *defaultMultiClusterManager {
+	return &defaultMultiClusterManager{
+		k8sClient: k8sClient,
+		apiReader: apiReader,
+		logger:    logger,
+	}
+}
+
+var _ MultiClusterManager = &defaultMultiClusterManager{}
+
+// default implementation for MultiClusterManager, which records the owned targets in a ConfigMap per TargetGroupBinding.
+type defaultMultiClusterManager struct {
+	k8sClient client.Client
+	apiReader client.Reader
+	logger    logr.Logger
+}
+
+func (m *defaultMultiClusterManager) UpdateTrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targetUIDs sets.String) error {
+	if !tgb.Spec.MultiClusterTargetGroup {
+		return nil
+	}
+	cm, exists, err := m.getTrackedTargetsConfigMap(ctx, tgb)
+	if err != nil {
+		return err
+	}
+	rawTargets := strings.Join(targetUIDs.List(), trackedTargetsDelimiter)
+	if !exists {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: tgb.Namespace,
+				Name:      buildTrackedTargetsConfigMapName(tgb),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: elbv2api.GroupVersion.String(),
+						Kind:       "TargetGroupBinding",
+						Name:       tgb.Name,
+						UID:        tgb.UID,
+					},
+				},
+			},
+			Data: map[string]string{
+				trackedTargetsConfigMapDataKey: rawTargets,
+			},
+		}
+		if err := m.k8sClient.Create(ctx, cm); err != nil {
+			return errors.Wrapf(err, "failed to create tracked targets for targetGroupBinding: %v", k8s.NamespacedName(tgb))
+		}
+		return nil
+	}
+	if cm.Data[trackedTargetsConfigMapDataKey] == rawTargets {
+		return nil
+	}
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[trackedTargetsConfigMapDataKey] = rawTargets
+	if err := m.k8sClient.Update(ctx, cm); err != nil {
+		return errors.Wrapf(err, "failed to update tracked targets for targetGroupBinding: %v", k8s.NamespacedName(tgb))
+	}
+	return nil
+}
+
+func (m *defaultMultiClusterManager) FilterTargetsForDeregistration(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targets []TargetInfo) ([]TargetInfo, error) {
+	if !tgb.Spec.MultiClusterTargetGroup {
+		return targets, nil
+	}
+	trackedTargetUIDs, err := m.listTrackedTargets(ctx, tgb)
+	if err != nil {
+		return nil, err
+	}
+	var ownedTargets []TargetInfo
+	for _, target := range targets {
+		if trackedTargetUIDs.Has(UniqueIDForTargetDescription(target.Target)) {
+			ownedTargets = append(ownedTargets, target)
+		}
+	}
+	return ownedTargets, nil
+}
+
+func (m *defaultMultiClusterManager) Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	if !tgb.Spec.MultiClusterTargetGroup {
+		return nil
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: tgb.Namespace,
+			Name:      buildTrackedTargetsConfigMapName(tgb),
+		},
+	}
+	if err := m.k8sClient.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete tracked targets for targetGroupBinding: %v", k8s.NamespacedName(tgb))
+	}
+	return nil
+}
+
+func (m *defaultMultiClusterManager) listTrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (sets.String, error) {
+	cm, exists, err := m.getTrackedTargetsConfigMap(ctx, tgb)
+	if err != nil {
+		return nil, err
+	}
+	trackedTargetUIDs := sets.NewString()
+	if !exists {
+		return trackedTargetUIDs, nil
+	}
+	for _, targetUID := range strings.Split(cm.Data[trackedTargetsConfigMapDataKey], trackedTargetsDelimiter) {
+		if targetUID != "" {
+			trackedTargetUIDs.Insert(targetUID)
+		}
+	}
+	return trackedTargetUIDs, nil
+}
+
+func (m *defaultMultiClusterManager) getTrackedTargetsConfigMap(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (*corev1.ConfigMap, bool, error) {
+	cm := &corev1.ConfigMap{}
+	cmKey := types.NamespacedName{Namespace: tgb.Namespace, Name: buildTrackedTargetsConfigMapName(tgb)}
+	if err := m.apiReader.Get(ctx, cmKey, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, errors.Wrapf(err, "failed to get tracked targets for targetGroupBinding: %v", k8s.NamespacedName(tgb))
+	}
+	return cm, true, nil
+}
+
+// buildTrackedTargetsConfigMapName builds the name of ConfigMap that records the targets owned by TargetGroupBinding.
+// names that exceed the limit of Kubernetes object names are truncated, with a hash of the TargetGroupBinding name appended to keep them unique.
+func buildTrackedTargetsConfigMapName(tgb *elbv2api.TargetGroupBinding) string {
+	cmName := trackedTargetsConfigMapNamePrefix + tgb.Name
+	if len(cmName) <= validation.DNS1123SubdomainMaxLength {
+		return cmName
+	}
+	nameHash := sha256.Sum256([]byte(tgb.Name))
+	truncatedNameLength := validation.DNS1123SubdomainMaxLength - len(trackedTargetsConfigMapNamePrefix) - trackedTargetsConfigMapNameHashLength - 1
+	truncatedName := strings.TrimRight(tgb.Name[:truncatedNameLength], ".-")
+	return fmt.Sprintf("%s%s-%.*s", trackedTargetsConfigMapNamePrefix, truncatedName, trackedTargetsConfigMapNameHashLength, hex.EncodeToString(nameHash[:]))
+}
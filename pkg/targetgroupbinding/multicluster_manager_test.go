@@ -0,0 +1,230 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultMultiClusterManager_FilterTargetsForDeregistration(t *testing.T) {
+	targets := []TargetInfo{
+		{Target: elbv2sdk.TargetDescription{Id: awssdk.String("192.168.1.1"), Port: awssdk.Int64(8080)}},
+		{Target: elbv2sdk.TargetDescription{Id: awssdk.String("192.168.1.2"), Port: awssdk.Int64(8080)}},
+		{Target: elbv2sdk.TargetDescription{Id: awssdk.String("10.0.1.1"), Port: awssdk.Int64(8080)}},
+	}
+	tests := []struct {
+		name       string
+		configMaps []*corev1.ConfigMap
+		tgb        *elbv2api.TargetGroupBinding
+		want       []TargetInfo
+	}{
+		{
+			name: "all targets are owned by single cluster TargetGroupBinding",
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tgb"},
+			},
+			want: targets,
+		},
+		{
+			name: "only tracked targets are owned by multi-cluster TargetGroupBinding",
+			configMaps: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "aws-lbc-targets-tgb"},
+					Data: map[string]string{
+						"targets": "192.168.1.1:8080,192.168.1.2:8080,192.168.1.3:8080",
+					},
+				},
+			},
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					MultiClusterTargetGroup: true,
+				},
+			},
+			want: []TargetInfo{targets[0], targets[1]},
+		},
+		{
+			name: "no targets are owned by multi-cluster TargetGroupBinding without tracked targets",
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tgb"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					MultiClusterTargetGroup: true,
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sClient := newMultiClusterTestClient()
+			for _, cm := range tt.configMaps {
+				assert.NoError(t, k8sClient.Create(ctx, cm.DeepCopy()))
+			}
+			m := NewDefaultMultiClusterManager(k8sClient, k8sClient, logr.New(&log.NullLogSink{}))
+			got, err := m.FilterTargetsForDeregistration(ctx, tt.tgb, targets)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultMultiClusterManager_UpdateTrackedTargets(t *testing.T) {
+	tests := []struct {
+		name       string
+		configMaps []*corev1.ConfigMap
+		tgb        *elbv2api.TargetGroupBinding
+		targetUIDs sets.String
+		wantData   map[string]string
+	}{
+		{
+			name: "single cluster TargetGroupBinding don't track targets",
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tgb"},
+			},
+			targetUIDs: sets.NewString("192.168.1.1:8080"),
+		},
+		{
+			name: "multi-cluster TargetGroupBinding creates tracked targets",
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tgb", UID: "tgb-uid"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					MultiClusterTargetGroup: true,
+				},
+			},
+			targetUIDs: sets.NewString("192.168.1.2:8080", "192.168.1.1:8080"),
+			wantData: map[string]string{
+				"targets": "192.168.1.1:8080,192.168.1.2:8080",
+			},
+		},
+		{
+			name: "multi-cluster TargetGroupBinding updates tracked targets",
+			configMaps: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "aws-lbc-targets-tgb"},
+					Data: map[string]string{
+						"targets": "192.168.1.1:8080,192.168.1.2:8080",
+					},
+				},
+			},
+			tgb: &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tgb", UID: "tgb-uid"},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					MultiClusterTargetGroup: true,
+				},
+			},
+			targetUIDs: sets.NewString("192.168.1.3:8080"),
+			wantData: map[string]string{
+				"targets": "192.168.1.3:8080",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sClient := newMultiClusterTestClient()
+			for _, cm := range tt.configMaps {
+				assert.NoError(t, k8sClient.Create(ctx, cm.DeepCopy()))
+			}
+			m := NewDefaultMultiClusterManager(k8sClient, k8sClient, logr.New(&log.NullLogSink{}))
+			err := m.UpdateTrackedTargets(ctx, tt.tgb, tt.targetUIDs)
+			assert.NoError(t, err)
+
+			cm := &corev1.ConfigMap{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Namespace: "awesome-ns", Name: "aws-lbc-targets-tgb"}, cm)
+			if tt.wantData == nil {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantData, cm.Data)
+			}
+		})
+	}
+}
+
+func Test_defaultMultiClusterManager_Cleanup(t *testing.T) {
+	ctx := context.Background()
+	k8sClient := newMultiClusterTestClient()
+	assert.NoError(t, k8sClient.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "aws-lbc-targets-tgb"},
+		Data: map[string]string{
+			"targets": "192.168.1.1:8080",
+		},
+	}))
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "tgb"},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			MultiClusterTargetGroup: true,
+		},
+	}
+	m := NewDefaultMultiClusterManager(k8sClient, k8sClient, logr.New(&log.NullLogSink{}))
+	assert.NoError(t, m.Cleanup(ctx, tgb))
+	assert.NoError(t, m.Cleanup(ctx, tgb))
+
+	err := k8sClient.Get(ctx, types.NamespacedName{Namespace: "awesome-ns", Name: "aws-lbc-targets-tgb"}, &corev1.ConfigMap{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func Test_buildTrackedTargetsConfigMapName(t *testing.T) {
+	longName := strings.Repeat("a", 240)
+	tests := []struct {
+		name    string
+		tgbName string
+		want    string
+	}{
+		{
+			name:    "short name",
+			tgbName: "tgb",
+			want:    "aws-lbc-targets-tgb",
+		},
+		{
+			name:    "name at length limit",
+			tgbName: strings.Repeat("a", 237),
+			want:    "aws-lbc-targets-" + strings.Repeat("a", 237),
+		},
+		{
+			name:    "long name",
+			tgbName: longName,
+			want:    "aws-lbc-targets-" + strings.Repeat("a", 226) + "-9b3043905c",
+		},
+		{
+			name:    "long name truncated at delimiter",
+			tgbName: strings.Repeat("a", 225) + ".bbbbbbbbbbbbbbb",
+			want:    "aws-lbc-targets-" + strings.Repeat("a", 225) + "-c41cc36065",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tgb := &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: tt.tgbName},
+			}
+			got := buildTrackedTargetsConfigMapName(tgb)
+			assert.Equal(t, tt.want, got)
+			assert.Empty(t, validation.IsDNS1123Subdomain(got))
+		})
+	}
+}
+
+func newMultiClusterTestClient() client.Client {
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	elbv2api.AddToScheme(k8sSchema)
+	return testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -33,14 +34,16 @@ const defaultTargetHealthRequeueDuration = 15 * time.Second
 
 // ResourceManager manages the TargetGroupBinding resource.
 type ResourceManager interface {
-	Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
+	// Reconcile reconciles the targets and networking rules of TargetGroupBinding.
+	// returns the summary of targets observed in TargetGroup, which is nil if targets are not observed.
+	Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (*elbv2api.TargetGroupBindingTargetsStatus, error)
 	Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
 }
 
 // NewDefaultResourceManager constructs new defaultResourceManager.
 func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELBV2, ec2Client services.EC2,
 	podInfoRepo k8s.PodInfoRepo, sgManager networking.SecurityGroupManager, sgReconciler networking.SecurityGroupReconciler,
-	vpcInfoProvider networking.VPCInfoProvider,
+	vpcInfoProvider networking.VPCInfoProvider, multiClusterManager MultiClusterManager,
 	vpcID string, clusterName string, failOpenEnabled bool, endpointSliceEnabled bool, disabledRestrictedSGRulesFlag bool,
 	eventRecorder record.EventRecorder, logger logr.Logger) *defaultResourceManager {
 	targetsManager := NewCachedTargetsManager(elbv2Client, logger)
@@ -52,15 +55,16 @@ func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELB
 
 	networkingManager := NewDefaultNetworkingManager(k8sClient, podENIResolver, nodeENIResolver, sgManager, sgReconciler, vpcID, clusterName, logger, disabledRestrictedSGRulesFlag)
 	return &defaultResourceManager{
-		k8sClient:         k8sClient,
-		targetsManager:    targetsManager,
-		endpointResolver:  endpointResolver,
-		networkingManager: networkingManager,
-		eventRecorder:     eventRecorder,
-		logger:            logger,
-		vpcID:             vpcID,
-		vpcInfoProvider:   vpcInfoProvider,
-		podInfoRepo:       podInfoRepo,
+		k8sClient:           k8sClient,
+		targetsManager:      targetsManager,
+		endpointResolver:    endpointResolver,
+		networkingManager:   networkingManager,
+		multiClusterManager: multiClusterManager,
+		eventRecorder:       eventRecorder,
+		logger:              logger,
+		vpcID:               vpcID,
+		vpcInfoProvider:     vpcInfoProvider,
+		podInfoRepo:         podInfoRepo,
 
 		targetHealthRequeueDuration: defaultTargetHealthRequeueDuration,
 	}
@@ -70,22 +74,23 @@ var _ ResourceManager = &defaultResourceManager{}
 
 // default implementation for ResourceManager.
 type defaultResourceManager struct {
-	k8sClient         client.Client
-	targetsManager    TargetsManager
-	endpointResolver  backend.EndpointResolver
-	networkingManager NetworkingManager
-	eventRecorder     record.EventRecorder
-	logger            logr.Logger
-	vpcInfoProvider   networking.VPCInfoProvider
-	podInfoRepo       k8s.PodInfoRepo
-	vpcID             string
+	k8sClient           client.Client
+	targetsManager      TargetsManager
+	endpointResolver    backend.EndpointResolver
+	networkingManager   NetworkingManager
+	multiClusterManager MultiClusterManager
+	eventRecorder       record.EventRecorder
+	logger              logr.Logger
+	vpcInfoProvider     networking.VPCInfoProvider
+	podInfoRepo         k8s.PodInfoRepo
+	vpcID               string
 
 	targetHealthRequeueDuration time.Duration
 }
 
-func (m *defaultResourceManager) Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+func (m *defaultResourceManager) Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (*elbv2api.TargetGroupBindingTargetsStatus, error) {
 	if tgb.Spec.TargetType == nil {
-		return errors.Errorf("targetType is not specified: %v", k8s.NamespacedName(tgb).String())
+		return nil, errors.Errorf("targetType is not specified: %v", k8s.NamespacedName(tgb).String())
 	}
 	if *tgb.Spec.TargetType == elbv2api.TargetTypeIP {
 		return m.reconcileWithIPTargetType(ctx, tgb)
@@ -97,6 +102,9 @@ func (m *defaultResourceManager) Cleanup(ctx context.Context, tgb *elbv2api.Targ
 	if err := m.cleanupTargets(ctx, tgb); err != nil {
 		return err
 	}
+	if err := m.multiClusterManager.Cleanup(ctx, tgb); err != nil {
+		return err
+	}
 	if err := m.networkingManager.Cleanup(ctx, tgb); err != nil {
 		return err
 	}
@@ -106,7 +114,7 @@ func (m *defaultResourceManager) Cleanup(ctx context.Context, tgb *elbv2api.Targ
 	return nil
 }
 
-func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (*elbv2api.TargetGroupBindingTargetsStatus, error) {
 	svcKey := buildServiceReferenceKey(tgb, tgb.Spec.ServiceRef)
 
 	targetHealthCondType := BuildTargetHealthPodConditionType(tgb)
@@ -123,58 +131,66 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 	if err != nil {
 		if errors.Is(err, backend.ErrNotFound) {
 			m.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonBackendNotFound, err.Error())
-			return m.Cleanup(ctx, tgb)
+			return nil, m.cleanupForBackendNotFound(ctx, tgb)
 		}
-		return err
+		return nil, err
 	}
 
 	tgARN := tgb.Spec.TargetGroupARN
 	targets, err := m.targetsManager.ListTargets(ctx, tgARN)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	targetsStatus := BuildTargetsStatus(targets)
 	notDrainingTargets, drainingTargets := partitionTargetsByDrainingStatus(targets)
 	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchPodEndpointWithTargets(endpoints, notDrainingTargets)
+	pendingSwapTargets, swapInProgress := checkServiceRefSwap(tgb, len(endpoints), countHealthyPodEndpointTargets(matchedEndpointAndTargets))
 
 	if err := m.networkingManager.ReconcileForPodEndpoints(ctx, tgb, endpoints); err != nil {
-		return err
+		return targetsStatus, NewNetworkingReconcileError(err)
 	}
-	if len(unmatchedTargets) > 0 {
-		if err := m.deregisterTargets(ctx, tgARN, unmatchedTargets); err != nil {
-			return err
-		}
+	retainedTargets, err := m.deregisterUnmatchedTargetsUnlessSwapping(ctx, tgb, unmatchedTargets, swapInProgress)
+	if err != nil {
+		return targetsStatus, err
+	}
+	if err := m.updateTrackedTargets(ctx, tgb, buildPodEndpointUIDs(endpoints), retainedTargets); err != nil {
+		return targetsStatus, err
 	}
 	if len(unmatchedEndpoints) > 0 {
 		if err := m.registerPodEndpoints(ctx, tgARN, unmatchedEndpoints); err != nil {
-			return err
+			return targetsStatus, err
 		}
 	}
 
 	anyPodNeedFurtherProbe, err := m.updateTargetHealthPodCondition(ctx, targetHealthCondType, matchedEndpointAndTargets, unmatchedEndpoints)
 	if err != nil {
-		return err
+		return targetsStatus, err
+	}
+
+	if swapInProgress {
+		return targetsStatus, NewServiceRefSwapInProgressError(pendingSwapTargets, m.targetHealthRequeueDuration)
 	}
 
 	if anyPodNeedFurtherProbe {
 		if containsTargetsInInitialState(matchedEndpointAndTargets) || len(unmatchedEndpoints) != 0 {
-			return runtime.NewRequeueNeededAfter("monitor targetHealth", m.targetHealthRequeueDuration)
+			return targetsStatus, runtime.NewRequeueNeededAfter("monitor targetHealth", m.targetHealthRequeueDuration)
 		}
-		return runtime.NewRequeueNeeded("monitor targetHealth")
+		return targetsStatus, runtime.NewRequeueNeeded("monitor targetHealth")
 	}
 
 	if containsPotentialReadyEndpoints {
-		return runtime.NewRequeueNeeded("monitor potential ready endpoints")
+		return targetsStatus, runtime.NewRequeueNeeded("monitor potential ready endpoints")
 	}
 
 	_ = drainingTargets
-	return nil
+	return targetsStatus, nil
 }
 
-func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (*elbv2api.TargetGroupBindingTargetsStatus, error) {
 	svcKey := buildServiceReferenceKey(tgb, tgb.Spec.ServiceRef)
 	nodeSelector, err := backend.GetTrafficProxyNodeSelector(tgb)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	resolveOpts := []backend.EndpointResolveOption{backend.WithNodeSelector(nodeSelector)}
@@ -182,33 +198,81 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 	if err != nil {
 		if errors.Is(err, backend.ErrNotFound) {
 			m.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonBackendNotFound, err.Error())
-			return m.Cleanup(ctx, tgb)
+			return nil, m.cleanupForBackendNotFound(ctx, tgb)
 		}
-		return err
+		return nil, err
 	}
 	tgARN := tgb.Spec.TargetGroupARN
 	targets, err := m.targetsManager.ListTargets(ctx, tgARN)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	targetsStatus := BuildTargetsStatus(targets)
 	notDrainingTargets, drainingTargets := partitionTargetsByDrainingStatus(targets)
-	_, unmatchedEndpoints, unmatchedTargets := matchNodePortEndpointWithTargets(endpoints, notDrainingTargets)
+	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchNodePortEndpointWithTargets(endpoints, notDrainingTargets)
+	pendingSwapTargets, swapInProgress := checkServiceRefSwap(tgb, len(endpoints), countHealthyNodePortEndpointTargets(matchedEndpointAndTargets))
 
 	if err := m.networkingManager.ReconcileForNodePortEndpoints(ctx, tgb, endpoints); err != nil {
-		return err
+		return targetsStatus, NewNetworkingReconcileError(err)
 	}
-	if len(unmatchedTargets) > 0 {
-		if err := m.deregisterTargets(ctx, tgARN, unmatchedTargets); err != nil {
-			return err
-		}
+	retainedTargets, err := m.deregisterUnmatchedTargetsUnlessSwapping(ctx, tgb, unmatchedTargets, swapInProgress)
+	if err != nil {
+		return targetsStatus, err
+	}
+	if err := m.updateTrackedTargets(ctx, tgb, buildNodePortEndpointUIDs(endpoints), retainedTargets); err != nil {
+		return targetsStatus, err
 	}
 	if len(unmatchedEndpoints) > 0 {
 		if err := m.registerNodePortEndpoints(ctx, tgARN, unmatchedEndpoints); err != nil {
-			return err
+			return targetsStatus, err
 		}
 	}
+	if swapInProgress {
+		return targetsStatus, NewServiceRefSwapInProgressError(pendingSwapTargets, m.targetHealthRequeueDuration)
+	}
 	_ = drainingTargets
-	return nil
+	return targetsStatus, nil
+}
+
+// cleanupForBackendNotFound cleans up TargetGroupBinding when its backend Service doesn't exist.
+// while a BlueGreen swap is in progress, the targets of previous serviceRef are kept registered until the new Service is created.
+func (m *defaultResourceManager) cleanupForBackendNotFound(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	if _, swapInProgress := checkServiceRefSwap(tgb, 0, 0); swapInProgress {
+		return NewServiceRefSwapInProgressError(0, m.targetHealthRequeueDuration)
+	}
+	return m.Cleanup(ctx, tgb)
+}
+
+// deregisterUnmatchedTargetsUnlessSwapping deregisters the unmatched targets, unless a BlueGreen swap of serviceRef is in progress.
+// returns the unmatched targets that are kept registered.
+func (m *defaultResourceManager) deregisterUnmatchedTargetsUnlessSwapping(ctx context.Context, tgb *elbv2api.TargetGroupBinding,
+	unmatchedTargets []TargetInfo, swapInProgress bool) ([]TargetInfo, error) {
+	if swapInProgress {
+		// the targets of previous serviceRef keep serving traffic until the targets of new serviceRef are healthy.
+		return unmatchedTargets, nil
+	}
+	if err := m.deregisterUnmatchedTargets(ctx, tgb, unmatchedTargets); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// updateTrackedTargets records the endpoints as well as the owned targets that are kept registered as tracked targets,
+// so that the retained targets can still be deregistered once a BlueGreen swap completes.
+func (m *defaultResourceManager) updateTrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding,
+	endpointUIDs sets.String, retainedTargets []TargetInfo) error {
+	targetUIDs := endpointUIDs
+	if len(retainedTargets) != 0 {
+		ownedTargets, err := m.multiClusterManager.FilterTargetsForDeregistration(ctx, tgb, retainedTargets)
+		if err != nil {
+			return err
+		}
+		targetUIDs = sets.NewString(endpointUIDs.UnsortedList()...)
+		for _, target := range ownedTargets {
+			targetUIDs.Insert(UniqueIDForTargetDescription(target.Target))
+		}
+	}
+	return m.multiClusterManager.UpdateTrackedTargets(ctx, tgb, targetUIDs)
 }
 
 func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
@@ -221,6 +285,10 @@ func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2a
 		}
 		return err
 	}
+	targets, err = m.multiClusterManager.FilterTargetsForDeregistration(ctx, tgb, targets)
+	if err != nil {
+		return err
+	}
 	if err := m.deregisterTargets(ctx, tgb.Spec.TargetGroupARN, targets); err != nil {
 		if isELBV2TargetGroupNotFoundError(err) {
 			return nil
@@ -362,6 +430,18 @@ func (m *defaultResourceManager) updatePodAsHealthyForDeletedTGB(ctx context.Con
 	return nil
 }
 
+// deregisterUnmatchedTargets deregisters the unmatched targets that are owned by TargetGroupBinding.
+func (m *defaultResourceManager) deregisterUnmatchedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, unmatchedTargets []TargetInfo) error {
+	targetsToDeregister, err := m.multiClusterManager.FilterTargetsForDeregistration(ctx, tgb, unmatchedTargets)
+	if err != nil {
+		return err
+	}
+	if len(targetsToDeregister) == 0 {
+		return nil
+	}
+	return m.deregisterTargets(ctx, tgb.Spec.TargetGroupARN, targetsToDeregister)
+}
+
 func (m *defaultResourceManager) deregisterTargets(ctx context.Context, tgARN string, targets []TargetInfo) error {
 	sdkTargets := make([]elbv2sdk.TargetDescription, 0, len(targets))
 	for _, target := range targets {
@@ -412,6 +492,78 @@ func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context,
 	return m.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
 }
 
+func buildPodEndpointUIDs(endpoints []backend.PodEndpoint) sets.String {
+	endpointUIDs := sets.NewString()
+	for _, endpoint := range endpoints {
+		endpointUIDs.Insert(fmt.Sprintf("%v:%v", endpoint.IP, endpoint.Port))
+	}
+	return endpointUIDs
+}
+
+func buildNodePortEndpointUIDs(endpoints []backend.NodePortEndpoint) sets.String {
+	endpointUIDs := sets.NewString()
+	for _, endpoint := range endpoints {
+		endpointUIDs.Insert(fmt.Sprintf("%v:%v", endpoint.InstanceID, endpoint.Port))
+	}
+	return endpointUIDs
+}
+
+// BuildTargetsStatus summarizes the targets in TargetGroup by their health state.
+func BuildTargetsStatus(targets []TargetInfo) *elbv2api.TargetGroupBindingTargetsStatus {
+	targetsStatus := &elbv2api.TargetGroupBindingTargetsStatus{
+		Registered: int32(len(targets)),
+	}
+	for _, target := range targets {
+		switch {
+		case target.IsHealthy():
+			targetsStatus.Healthy++
+		case target.IsDraining():
+			targetsStatus.Draining++
+		case target.IsUnhealthy():
+			targetsStatus.Unhealthy++
+		}
+	}
+	return targetsStatus
+}
+
+// checkServiceRefSwap checks whether a BlueGreen swap of serviceRef is in progress for TargetGroupBinding,
+// and returns the number of endpoints of new serviceRef that are not yet registered as healthy targets.
+// A swap starts when spec.serviceRef differs from the serviceRef in status,
+// and completes once the new serviceRef has endpoints and all of them are registered as healthy targets.
+func checkServiceRefSwap(tgb *elbv2api.TargetGroupBinding, endpointsCount int, healthyTargetsCount int) (int32, bool) {
+	if tgb.Spec.ServiceRefUpdateStrategy == nil || *tgb.Spec.ServiceRefUpdateStrategy != elbv2api.ServiceRefUpdateStrategyBlueGreen {
+		return 0, false
+	}
+	if tgb.Status.ServiceRef == nil || equality.Semantic.DeepEqual(*tgb.Status.ServiceRef, tgb.Spec.ServiceRef) {
+		return 0, false
+	}
+	pendingTargets := endpointsCount - healthyTargetsCount
+	if endpointsCount == 0 || pendingTargets > 0 {
+		return int32(pendingTargets), true
+	}
+	return 0, false
+}
+
+func countHealthyPodEndpointTargets(matchedEndpointAndTargets []podEndpointAndTargetPair) int {
+	count := 0
+	for _, endpointAndTarget := range matchedEndpointAndTargets {
+		if endpointAndTarget.target.IsHealthy() {
+			count++
+		}
+	}
+	return count
+}
+
+func countHealthyNodePortEndpointTargets(matchedEndpointAndTargets []nodePortEndpointAndTargetPair) int {
+	count := 0
+	for _, endpointAndTarget := range matchedEndpointAndTargets {
+		if endpointAndTarget.target.IsHealthy() {
+			count++
+		}
+	}
+	return count
+}
+
 type podEndpointAndTargetPair struct {
 	endpoint backend.PodEndpoint
 	target   TargetInfo
@@ -14,7 +14,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -460,6 +462,184 @@ func Test_containsTargetsInInitialState(t *testing.T) {
 	}
 }
 
+func Test_checkServiceRefSwap(t *testing.T) {
+	blueGreen := elbv2api.ServiceRefUpdateStrategyBlueGreen
+	replace := elbv2api.ServiceRefUpdateStrategyReplace
+	blueServiceRef := elbv2api.ServiceReference{Name: "svc-blue", Port: intstr.FromInt(80)}
+	greenServiceRef := elbv2api.ServiceReference{Name: "svc-green", Port: intstr.FromInt(80)}
+	type args struct {
+		strategy            *elbv2api.ServiceRefUpdateStrategy
+		specServiceRef      elbv2api.ServiceReference
+		statusServiceRef    *elbv2api.ServiceReference
+		endpointsCount      int
+		healthyTargetsCount int
+	}
+	tests := []struct {
+		name               string
+		args               args
+		wantPendingTargets int32
+		wantInProgress     bool
+	}{
+		{
+			name: "strategy unspecified",
+			args: args{
+				specServiceRef:   greenServiceRef,
+				statusServiceRef: &blueServiceRef,
+				endpointsCount:   2,
+			},
+			wantPendingTargets: 0,
+			wantInProgress:     false,
+		},
+		{
+			name: "strategy is Replace",
+			args: args{
+				strategy:         &replace,
+				specServiceRef:   greenServiceRef,
+				statusServiceRef: &blueServiceRef,
+				endpointsCount:   2,
+			},
+			wantPendingTargets: 0,
+			wantInProgress:     false,
+		},
+		{
+			name: "serviceRef never reconciled",
+			args: args{
+				strategy:       &blueGreen,
+				specServiceRef: greenServiceRef,
+				endpointsCount: 2,
+			},
+			wantPendingTargets: 0,
+			wantInProgress:     false,
+		},
+		{
+			name: "serviceRef unchanged",
+			args: args{
+				strategy:         &blueGreen,
+				specServiceRef:   blueServiceRef,
+				statusServiceRef: &blueServiceRef,
+				endpointsCount:   2,
+			},
+			wantPendingTargets: 0,
+			wantInProgress:     false,
+		},
+		{
+			name: "serviceRef changed without endpoints",
+			args: args{
+				strategy:         &blueGreen,
+				specServiceRef:   greenServiceRef,
+				statusServiceRef: &blueServiceRef,
+			},
+			wantPendingTargets: 0,
+			wantInProgress:     true,
+		},
+		{
+			name: "serviceRef changed with pending targets",
+			args: args{
+				strategy:            &blueGreen,
+				specServiceRef:      greenServiceRef,
+				statusServiceRef:    &blueServiceRef,
+				endpointsCount:      3,
+				healthyTargetsCount: 1,
+			},
+			wantPendingTargets: 2,
+			wantInProgress:     true,
+		},
+		{
+			name: "serviceRef changed with all targets healthy",
+			args: args{
+				strategy:            &blueGreen,
+				specServiceRef:      greenServiceRef,
+				statusServiceRef:    &blueServiceRef,
+				endpointsCount:      3,
+				healthyTargetsCount: 3,
+			},
+			wantPendingTargets: 0,
+			wantInProgress:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tgb := &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					ServiceRef:               tt.args.specServiceRef,
+					ServiceRefUpdateStrategy: tt.args.strategy,
+				},
+				Status: elbv2api.TargetGroupBindingStatus{
+					ServiceRef: tt.args.statusServiceRef,
+				},
+			}
+			gotPendingTargets, gotInProgress := checkServiceRefSwap(tgb, tt.args.endpointsCount, tt.args.healthyTargetsCount)
+			assert.Equal(t, tt.wantPendingTargets, gotPendingTargets)
+			assert.Equal(t, tt.wantInProgress, gotInProgress)
+		})
+	}
+}
+
+func TestBuildTargetsStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		targets []TargetInfo
+		want    *elbv2api.TargetGroupBindingTargetsStatus
+	}{
+		{
+			name:    "no targets",
+			targets: nil,
+			want:    &elbv2api.TargetGroupBindingTargetsStatus{},
+		},
+		{
+			name: "targets in various states",
+			targets: []TargetInfo{
+				{
+					TargetHealth: &elbv2sdk.TargetHealth{
+						State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+					},
+				},
+				{
+					TargetHealth: &elbv2sdk.TargetHealth{
+						State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+					},
+				},
+				{
+					TargetHealth: &elbv2sdk.TargetHealth{
+						State:  awssdk.String(elbv2sdk.TargetHealthStateEnumUnhealthy),
+						Reason: awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetTimeout),
+					},
+				},
+				{
+					TargetHealth: &elbv2sdk.TargetHealth{
+						State: awssdk.String(elbv2sdk.TargetHealthStateEnumUnavailable),
+					},
+				},
+				{
+					TargetHealth: &elbv2sdk.TargetHealth{
+						State: awssdk.String(elbv2sdk.TargetHealthStateEnumDraining),
+					},
+				},
+				{
+					TargetHealth: &elbv2sdk.TargetHealth{
+						State: awssdk.String(elbv2sdk.TargetHealthStateEnumInitial),
+					},
+				},
+				{
+					TargetHealth: nil,
+				},
+			},
+			want: &elbv2api.TargetGroupBindingTargetsStatus{
+				Registered: 7,
+				Healthy:    2,
+				Unhealthy:  2,
+				Draining:   1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildTargetsStatus(tt.targets)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_buildPodConditionPatch(t *testing.T) {
 	type args struct {
 		pod       k8s.PodInfo
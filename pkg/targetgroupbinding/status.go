@@ -0,0 +1,150 @@
+package targetgroupbinding
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+const (
+	// lastSyncTime is only refreshed after this interval if nothing else in status changed,
+	// so that status updates won't trigger reconcile of TargetGroupBinding continuously.
+	lastSyncTimeRefreshInterval = 1 * time.Minute
+
+	conditionReasonReconciled                = "Reconciled"
+	conditionReasonReconcileFailed           = "ReconcileFailed"
+	conditionReasonTargetGroupFound          = "TargetGroupFound"
+	conditionReasonNetworkingReconciled      = "NetworkingReconciled"
+	conditionReasonTargetGroupNotFound       = "TargetGroupNotFound"
+	conditionReasonNetworkingReconcileFailed = "NetworkingReconcileFailed"
+)
+
+// BuildTargetGroupBindingStatus computes the status for TargetGroupBinding based on reconcile results.
+// targetsStatus is the summary of targets observed during reconcile, and reconcileErr is the error returned by reconcile.
+func BuildTargetGroupBindingStatus(tgb *elbv2api.TargetGroupBinding, targetsStatus *elbv2api.TargetGroupBindingTargetsStatus,
+	reconcileErr error, now metav1.Time) elbv2api.TargetGroupBindingStatus {
+	status := *tgb.Status.DeepCopy()
+	succeeded := isReconcileSucceeded(reconcileErr)
+	tgNotFound := !succeeded && IsTargetGroupNotFoundError(reconcileErr)
+	networkingFailed := !succeeded && IsNetworkingReconcileError(reconcileErr)
+
+	readyCondition := metav1.Condition{
+		Type:               elbv2api.TargetGroupBindingConditionReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             conditionReasonReconciled,
+		Message:            "targetGroupBinding reconciled",
+		ObservedGeneration: tgb.Generation,
+	}
+	switch {
+	case succeeded:
+	case tgNotFound:
+		readyCondition.Status = metav1.ConditionFalse
+		readyCondition.Reason = conditionReasonTargetGroupNotFound
+		readyCondition.Message = reconcileErr.Error()
+	case networkingFailed:
+		readyCondition.Status = metav1.ConditionFalse
+		readyCondition.Reason = conditionReasonNetworkingReconcileFailed
+		readyCondition.Message = reconcileErr.Error()
+	default:
+		readyCondition.Status = metav1.ConditionFalse
+		readyCondition.Reason = conditionReasonReconcileFailed
+		readyCondition.Message = reconcileErr.Error()
+	}
+	meta.SetStatusCondition(&status.Conditions, readyCondition)
+
+	// TargetGroup exists if we observed its targets, and its existence is unknown if reconcile failed before that.
+	if tgNotFound {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               elbv2api.TargetGroupBindingConditionTargetGroupNotFound,
+			Status:             metav1.ConditionTrue,
+			Reason:             conditionReasonTargetGroupNotFound,
+			Message:            reconcileErr.Error(),
+			ObservedGeneration: tgb.Generation,
+		})
+	} else if targetsStatus != nil {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               elbv2api.TargetGroupBindingConditionTargetGroupNotFound,
+			Status:             metav1.ConditionFalse,
+			Reason:             conditionReasonTargetGroupFound,
+			Message:            "targetGroup found",
+			ObservedGeneration: tgb.Generation,
+		})
+	}
+
+	// networking rules are reconciled if reconcile succeeded, and its result is unknown if reconcile failed otherwise.
+	if networkingFailed {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               elbv2api.TargetGroupBindingConditionNetworkingReconcileFailed,
+			Status:             metav1.ConditionTrue,
+			Reason:             conditionReasonNetworkingReconcileFailed,
+			Message:            reconcileErr.Error(),
+			ObservedGeneration: tgb.Generation,
+		})
+	} else if succeeded {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               elbv2api.TargetGroupBindingConditionNetworkingReconcileFailed,
+			Status:             metav1.ConditionFalse,
+			Reason:             conditionReasonNetworkingReconciled,
+			Message:            "networking rules reconciled",
+			ObservedGeneration: tgb.Generation,
+		})
+	}
+
+	if tgNotFound {
+		status.Targets = nil
+	} else if targetsStatus != nil {
+		status.Targets = targetsStatus.DeepCopy()
+	}
+
+	// the serviceRef in status is only moved to spec.serviceRef once its targets took over, see ServiceRefSwapInProgressError.
+	var swapErr *ServiceRefSwapInProgressError
+	if errors.As(reconcileErr, &swapErr) {
+		if status.Swap == nil {
+			status.Swap = &elbv2api.TargetGroupBindingSwapStatus{
+				StartTime: *now.DeepCopy(),
+			}
+		}
+		status.Swap.PendingTargets = swapErr.PendingTargets
+	} else if succeeded {
+		status.ServiceRef = tgb.Spec.ServiceRef.DeepCopy()
+		status.Swap = nil
+	}
+
+	if succeeded {
+		generation := tgb.Generation
+		status.ObservedGeneration = &generation
+		if shouldRefreshLastSyncTime(tgb.Status, status, now) {
+			status.LastSyncTime = now.DeepCopy()
+		}
+	}
+	return status
+}
+
+// isReconcileSucceeded checks whether reconcile succeeded, requeue for monitoring target health is considered as succeeded.
+func isReconcileSucceeded(reconcileErr error) bool {
+	if reconcileErr == nil {
+		return true
+	}
+	var requeueNeededAfter *runtime.RequeueNeededAfter
+	if errors.As(reconcileErr, &requeueNeededAfter) {
+		return true
+	}
+	var requeueNeeded *runtime.RequeueNeeded
+	return errors.As(reconcileErr, &requeueNeeded)
+}
+
+// shouldRefreshLastSyncTime checks whether lastSyncTime should be refreshed for a successful reconcile.
+func shouldRefreshLastSyncTime(oldStatus elbv2api.TargetGroupBindingStatus, newStatus elbv2api.TargetGroupBindingStatus, now metav1.Time) bool {
+	if newStatus.LastSyncTime == nil {
+		return true
+	}
+	if now.Sub(newStatus.LastSyncTime.Time) >= lastSyncTimeRefreshInterval {
+		return true
+	}
+	return !equality.Semantic.DeepEqual(oldStatus, newStatus)
+}
@@ -0,0 +1,336 @@
+package targetgroupbinding
+
+import (
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+func TestBuildTargetGroupBindingStatus(t *testing.T) {
+	now := metav1.NewTime(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC))
+	recentSyncTime := metav1.NewTime(now.Add(-10 * time.Second))
+	staleSyncTime := metav1.NewTime(now.Add(-10 * time.Minute))
+	targetsStatus := &elbv2api.TargetGroupBindingTargetsStatus{
+		Registered: 3,
+		Healthy:    2,
+		Unhealthy:  1,
+	}
+	reconciledConditions := []metav1.Condition{
+		{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			Reason:             "Reconciled",
+			Message:            "targetGroupBinding reconciled",
+			ObservedGeneration: 2,
+		},
+		{
+			Type:               "TargetGroupNotFound",
+			Status:             metav1.ConditionFalse,
+			Reason:             "TargetGroupFound",
+			Message:            "targetGroup found",
+			ObservedGeneration: 2,
+		},
+		{
+			Type:               "NetworkingReconcileFailed",
+			Status:             metav1.ConditionFalse,
+			Reason:             "NetworkingReconciled",
+			Message:            "networking rules reconciled",
+			ObservedGeneration: 2,
+		},
+	}
+	blueServiceRef := elbv2api.ServiceReference{Name: "svc-blue", Port: intstr.FromInt(80)}
+	greenServiceRef := elbv2api.ServiceReference{Name: "svc-green", Port: intstr.FromInt(80)}
+	swapStartTime := metav1.NewTime(now.Add(-1 * time.Minute))
+	tgNotFoundErr := errors.Wrap(awserr.New("TargetGroupNotFound", "target group not found", nil), "failed to list targets")
+	networkingErr := NewNetworkingReconcileError(errors.New("failed to authorize ingress"))
+	tests := []struct {
+		name          string
+		serviceRef    *elbv2api.ServiceReference
+		status        elbv2api.TargetGroupBindingStatus
+		targetsStatus *elbv2api.TargetGroupBindingTargetsStatus
+		reconcileErr  error
+		want          elbv2api.TargetGroupBindingStatus
+	}{
+		{
+			name:          "reconcile succeeded",
+			targetsStatus: targetsStatus,
+			want: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &now,
+				ServiceRef:         &blueServiceRef,
+			},
+		},
+		{
+			name:          "reconcile requeued to monitor target health",
+			targetsStatus: targetsStatus,
+			reconcileErr:  runtime.NewRequeueNeededAfter("monitor targetHealth", 15*time.Second),
+			want: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &now,
+				ServiceRef:         &blueServiceRef,
+			},
+		},
+		{
+			name: "reconcile succeeded without changes shortly after last sync",
+			status: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &recentSyncTime,
+				ServiceRef:         &blueServiceRef,
+			},
+			targetsStatus: targetsStatus,
+			want: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &recentSyncTime,
+				ServiceRef:         &blueServiceRef,
+			},
+		},
+		{
+			name: "reconcile succeeded without changes long after last sync",
+			status: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &staleSyncTime,
+				ServiceRef:         &blueServiceRef,
+			},
+			targetsStatus: targetsStatus,
+			want: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &now,
+				ServiceRef:         &blueServiceRef,
+			},
+		},
+		{
+			name: "targetGroup not found",
+			status: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &staleSyncTime,
+			},
+			reconcileErr: tgNotFoundErr,
+			want: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Conditions: []metav1.Condition{
+					{
+						Type:               "Ready",
+						Status:             metav1.ConditionFalse,
+						Reason:             "TargetGroupNotFound",
+						Message:            tgNotFoundErr.Error(),
+						ObservedGeneration: 2,
+					},
+					{
+						Type:               "TargetGroupNotFound",
+						Status:             metav1.ConditionTrue,
+						Reason:             "TargetGroupNotFound",
+						Message:            tgNotFoundErr.Error(),
+						ObservedGeneration: 2,
+					},
+					reconciledConditions[2],
+				},
+				LastSyncTime: &staleSyncTime,
+			},
+		},
+		{
+			name: "networking reconcile failed",
+			status: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				LastSyncTime:       &staleSyncTime,
+			},
+			targetsStatus: targetsStatus,
+			reconcileErr:  networkingErr,
+			want: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions: []metav1.Condition{
+					{
+						Type:               "Ready",
+						Status:             metav1.ConditionFalse,
+						Reason:             "NetworkingReconcileFailed",
+						Message:            "failed to authorize ingress",
+						ObservedGeneration: 2,
+					},
+					reconciledConditions[1],
+					{
+						Type:               "NetworkingReconcileFailed",
+						Status:             metav1.ConditionTrue,
+						Reason:             "NetworkingReconcileFailed",
+						Message:            "failed to authorize ingress",
+						ObservedGeneration: 2,
+					},
+				},
+				Targets:      targetsStatus,
+				LastSyncTime: &staleSyncTime,
+			},
+		},
+		{
+			name:         "reconcile failed before targets are observed",
+			reconcileErr: errors.New("targetType is not specified"),
+			want: elbv2api.TargetGroupBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               "Ready",
+						Status:             metav1.ConditionFalse,
+						Reason:             "ReconcileFailed",
+						Message:            "targetType is not specified",
+						ObservedGeneration: 2,
+					},
+				},
+			},
+		},
+		{
+			name:       "serviceRef swap started",
+			serviceRef: &greenServiceRef,
+			status: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &staleSyncTime,
+				ServiceRef:         &blueServiceRef,
+			},
+			targetsStatus: targetsStatus,
+			reconcileErr:  NewServiceRefSwapInProgressError(3, 15*time.Second),
+			want: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &now,
+				ServiceRef:         &blueServiceRef,
+				Swap: &elbv2api.TargetGroupBindingSwapStatus{
+					StartTime:      now,
+					PendingTargets: 3,
+				},
+			},
+		},
+		{
+			name:       "serviceRef swap in progress",
+			serviceRef: &greenServiceRef,
+			status: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &recentSyncTime,
+				ServiceRef:         &blueServiceRef,
+				Swap: &elbv2api.TargetGroupBindingSwapStatus{
+					StartTime:      swapStartTime,
+					PendingTargets: 3,
+				},
+			},
+			targetsStatus: targetsStatus,
+			reconcileErr:  NewServiceRefSwapInProgressError(1, 15*time.Second),
+			want: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &now,
+				ServiceRef:         &blueServiceRef,
+				Swap: &elbv2api.TargetGroupBindingSwapStatus{
+					StartTime:      swapStartTime,
+					PendingTargets: 1,
+				},
+			},
+		},
+		{
+			name:       "serviceRef swap completed",
+			serviceRef: &greenServiceRef,
+			status: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &recentSyncTime,
+				ServiceRef:         &blueServiceRef,
+				Swap: &elbv2api.TargetGroupBindingSwapStatus{
+					StartTime:      swapStartTime,
+					PendingTargets: 1,
+				},
+			},
+			targetsStatus: targetsStatus,
+			want: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &now,
+				ServiceRef:         &greenServiceRef,
+			},
+		},
+		{
+			name:       "serviceRef swap is kept when reconcile failed",
+			serviceRef: &greenServiceRef,
+			status: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions:         reconciledConditions,
+				Targets:            targetsStatus,
+				LastSyncTime:       &recentSyncTime,
+				ServiceRef:         &blueServiceRef,
+				Swap: &elbv2api.TargetGroupBindingSwapStatus{
+					StartTime:      swapStartTime,
+					PendingTargets: 1,
+				},
+			},
+			targetsStatus: targetsStatus,
+			reconcileErr:  errors.New("failed to register targets"),
+			want: elbv2api.TargetGroupBindingStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Conditions: []metav1.Condition{
+					{
+						Type:               "Ready",
+						Status:             metav1.ConditionFalse,
+						Reason:             "ReconcileFailed",
+						Message:            "failed to register targets",
+						ObservedGeneration: 2,
+					},
+					reconciledConditions[1],
+					reconciledConditions[2],
+				},
+				Targets:      targetsStatus,
+				LastSyncTime: &recentSyncTime,
+				ServiceRef:   &blueServiceRef,
+				Swap: &elbv2api.TargetGroupBindingSwapStatus{
+					StartTime:      swapStartTime,
+					PendingTargets: 1,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tgb := &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "tgb",
+					Generation: 2,
+				},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					ServiceRef: blueServiceRef,
+				},
+				Status: tt.status,
+			}
+			if tt.serviceRef != nil {
+				tgb.Spec.ServiceRef = *tt.serviceRef
+			}
+			got := BuildTargetGroupBindingStatus(tgb, tt.targetsStatus, tt.reconcileErr, now)
+			opts := cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")
+			assert.True(t, cmp.Equal(tt.want, got, opts), "diff", cmp.Diff(tt.want, got, opts))
+		})
+	}
+}
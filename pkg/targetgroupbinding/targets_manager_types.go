@@ -24,6 +24,15 @@ func (t *TargetInfo) IsHealthy() bool {
 	return awssdk.StringValue(t.TargetHealth.State) == elbv2sdk.TargetHealthStateEnumHealthy
 }
 
+// IsUnhealthy returns whether target is unhealthy or unavailable.
+func (t *TargetInfo) IsUnhealthy() bool {
+	if t.TargetHealth == nil {
+		return false
+	}
+	state := awssdk.StringValue(t.TargetHealth.State)
+	return state == elbv2sdk.TargetHealthStateEnumUnhealthy || state == elbv2sdk.TargetHealthStateEnumUnavailable
+}
+
 // IsNotRegistered returns whether target is not registered.
 func (t *TargetInfo) IsNotRegistered() bool {
 	if t.TargetHealth == nil {
@@ -74,6 +74,72 @@ func TestTargetInfo_IsHealthy(t *testing.T) {
 	}
 }
 
+func TestTargetInfo_IsUnhealthy(t *testing.T) {
+	tests := []struct {
+		name   string
+		target TargetInfo
+		want   bool
+	}{
+		{
+			name: "target with unknown TargetHealth",
+			target: TargetInfo{
+				Target: elbv2sdk.TargetDescription{
+					Id:   awssdk.String("192.168.1.1"),
+					Port: awssdk.Int64(8080),
+				},
+				TargetHealth: nil,
+			},
+			want: false,
+		},
+		{
+			name: "target with healthy state",
+			target: TargetInfo{
+				Target: elbv2sdk.TargetDescription{
+					Id:   awssdk.String("192.168.1.1"),
+					Port: awssdk.Int64(8080),
+				},
+				TargetHealth: &elbv2sdk.TargetHealth{
+					State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "target with unhealthy state and targetTimeout reason",
+			target: TargetInfo{
+				Target: elbv2sdk.TargetDescription{
+					Id:   awssdk.String("192.168.1.1"),
+					Port: awssdk.Int64(8080),
+				},
+				TargetHealth: &elbv2sdk.TargetHealth{
+					Reason: awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetTimeout),
+					State:  awssdk.String(elbv2sdk.TargetHealthStateEnumUnhealthy),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "target with unavailable state",
+			target: TargetInfo{
+				Target: elbv2sdk.TargetDescription{
+					Id:   awssdk.String("192.168.1.1"),
+					Port: awssdk.Int64(8080),
+				},
+				TargetHealth: &elbv2sdk.TargetHealth{
+					State: awssdk.String(elbv2sdk.TargetHealthStateEnumUnavailable),
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.target.IsUnhealthy()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestTargetInfo_IsNotRegistered(t *testing.T) {
 	tests := []struct {
 		name   string
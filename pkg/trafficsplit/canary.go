@@ -0,0 +1,44 @@
+package trafficsplit
+
+import (
+	"context"
+
+	networking "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FindCanaryTargetGroupARN finds the target group of canary Service that's created for the TrafficSplit's Ingress.
+// an empty ARN is returned if the target group isn't deployed yet.
+func FindCanaryTargetGroupARN(ctx context.Context, k8sClient client.Client, ts *elbv2api.TrafficSplit) (string, error) {
+	ing := &networking.Ingress{}
+	ingKey := types.NamespacedName{Namespace: ts.Namespace, Name: ts.Spec.Backend.IngressName}
+	if err := k8sClient.Get(ctx, ingKey, ing); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	reconcileStatus, exists := ingress.ParseReconcileStatus(ing)
+	if !exists || len(reconcileStatus.TargetGroupARNs) == 0 {
+		return "", nil
+	}
+	ingTGARNs := make(map[string]bool, len(reconcileStatus.TargetGroupARNs))
+	for _, tgARN := range reconcileStatus.TargetGroupARNs {
+		ingTGARNs[tgARN] = true
+	}
+
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := k8sClient.List(ctx, tgbList, client.InNamespace(ts.Namespace)); err != nil {
+		return "", err
+	}
+	for _, tgb := range tgbList.Items {
+		if tgb.Spec.ServiceRef.Name == ts.Spec.CanaryServiceName && ingTGARNs[tgb.Spec.TargetGroupARN] {
+			return tgb.Spec.TargetGroupARN, nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,122 @@
+package trafficsplit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_FindCanaryTargetGroupARN(t *testing.T) {
+	ts := &elbv2api.TrafficSplit{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-split",
+		},
+		Spec: elbv2api.TrafficSplitSpec{
+			Backend: elbv2api.TrafficSplitIngressBackend{
+				IngressName: "awesome-ing",
+				ServiceName: "awesome-svc",
+			},
+			StableServiceName: "awesome-svc-stable",
+			CanaryServiceName: "awesome-svc-canary",
+		},
+	}
+	newIngress := func(rawStatus string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "awesome-ing",
+			},
+		}
+		if rawStatus != "" {
+			ing.Annotations = map[string]string{
+				"alb.ingress.kubernetes.io/status": rawStatus,
+			}
+		}
+		return ing
+	}
+	newTGB := func(namespace string, name string, svcName string, tgARN string) *elbv2api.TargetGroupBinding {
+		return &elbv2api.TargetGroupBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Spec: elbv2api.TargetGroupBindingSpec{
+				TargetGroupARN: tgARN,
+				ServiceRef:     elbv2api.ServiceReference{Name: svcName},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		ing     *networking.Ingress
+		tgbs    []*elbv2api.TargetGroupBinding
+		want    string
+		wantErr error
+	}{
+		{
+			name: "ingress not found",
+			tgbs: []*elbv2api.TargetGroupBinding{
+				newTGB("awesome-ns", "tgb-canary", "awesome-svc-canary", "tg-arn-canary"),
+			},
+			want: "",
+		},
+		{
+			name: "ingress not reconciled yet",
+			ing:  newIngress(""),
+			tgbs: []*elbv2api.TargetGroupBinding{
+				newTGB("awesome-ns", "tgb-canary", "awesome-svc-canary", "tg-arn-canary"),
+			},
+			want: "",
+		},
+		{
+			name: "canary target group found",
+			ing:  newIngress(`{"groupName":"awesome-ns/awesome-ing","observedGeneration":1,"rulesAccepted":true,"targetGroupARNs":["tg-arn-canary","tg-arn-stable"]}`),
+			tgbs: []*elbv2api.TargetGroupBinding{
+				newTGB("awesome-ns", "tgb-stable", "awesome-svc-stable", "tg-arn-stable"),
+				newTGB("awesome-ns", "tgb-canary", "awesome-svc-canary", "tg-arn-canary"),
+			},
+			want: "tg-arn-canary",
+		},
+		{
+			name: "target groups of canary Service not created for the ingress are ignored",
+			ing:  newIngress(`{"groupName":"awesome-ns/awesome-ing","observedGeneration":1,"rulesAccepted":true,"targetGroupARNs":["tg-arn-stable"]}`),
+			tgbs: []*elbv2api.TargetGroupBinding{
+				newTGB("awesome-ns", "tgb-stable", "awesome-svc-stable", "tg-arn-stable"),
+				newTGB("awesome-ns", "tgb-canary", "awesome-svc-canary", "tg-arn-other"),
+				newTGB("other-ns", "tgb-canary", "awesome-svc-canary", "tg-arn-stable"),
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			ctx := context.Background()
+			if tt.ing != nil {
+				assert.NoError(t, k8sClient.Create(ctx, tt.ing.DeepCopy()))
+			}
+			for _, tgb := range tt.tgbs {
+				assert.NoError(t, k8sClient.Create(ctx, tgb.DeepCopy()))
+			}
+			got, err := FindCanaryTargetGroupARN(ctx, k8sClient, ts)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
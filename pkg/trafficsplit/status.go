@@ -0,0 +1,140 @@
+package trafficsplit
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+const (
+	// by default, the canary is degraded once any of its targets is unhealthy.
+	defaultMaxUnhealthyTargetsPercent = 0
+	// by default, traffic is shifted back to stable Service when the canary is degraded.
+	defaultFailurePolicy = elbv2api.TrafficSplitFailurePolicyRollback
+	// the interval to analyse the health of canary while traffic is being shifted.
+	defaultAnalysisInterval = 30 * time.Second
+)
+
+// BuildTrafficSplitStatus computes the next status of TrafficSplit, along with the duration after which it should be computed again.
+// canaryTGARN and canaryTargets are the target group of canary Service and the summary of its targets,
+// which are empty if the target group isn't deployed yet. A zero duration means no recompute is needed until TrafficSplit changes.
+//
+// Traffic is shifted by executing the steps in order, and each step is held for its duration.
+// The canary is analysed throughout, and the failurePolicy is applied once it's degraded.
+// A TrafficSplit that succeeded or rolled back starts over from the first step when its spec changes.
+func BuildTrafficSplitStatus(ts *elbv2api.TrafficSplit, canaryTGARN string, canaryTargets *elbv2api.TargetGroupBindingTargetsStatus,
+	now metav1.Time) (elbv2api.TrafficSplitStatus, time.Duration) {
+	status := *ts.Status.DeepCopy()
+	specChanged := status.ObservedGeneration == nil || *status.ObservedGeneration != ts.Generation
+	status.ObservedGeneration = &ts.Generation
+	status.CanaryTargetGroupARN = canaryTGARN
+	status.CanaryTargets = canaryTargets
+	if len(ts.Spec.Steps) == 0 {
+		return status, 0
+	}
+
+	switch {
+	case status.Phase == "":
+		startTrafficSplitStep(ts, &status, 0, now)
+	case status.Phase == elbv2api.TrafficSplitPhaseSucceeded || status.Phase == elbv2api.TrafficSplitPhaseRolledBack:
+		if !specChanged {
+			return status, 0
+		}
+		startTrafficSplitStep(ts, &status, 0, now)
+	case int(status.CurrentStep) >= len(ts.Spec.Steps):
+		// steps are removed while progressing.
+		startTrafficSplitStep(ts, &status, int32(len(ts.Spec.Steps)-1), now)
+	}
+
+	if ts.Spec.Paused {
+		status.Phase = elbv2api.TrafficSplitPhasePaused
+		status.Message = "Traffic shifting is paused"
+		return status, 0
+	}
+	if canaryTGARN == "" {
+		status.Message = "Waiting for the target group of canary Service"
+		return status, defaultAnalysisInterval
+	}
+	if degraded, reason := isCanaryDegraded(ts, canaryTargets); degraded {
+		if buildFailurePolicy(ts) == elbv2api.TrafficSplitFailurePolicyRollback {
+			status.Phase = elbv2api.TrafficSplitPhaseRolledBack
+			status.Weight = 0
+			status.Message = fmt.Sprintf("Rolled back since canary is degraded: %v", reason)
+			return status, 0
+		}
+		status.Phase = elbv2api.TrafficSplitPhasePaused
+		status.Message = fmt.Sprintf("Paused since canary is degraded: %v", reason)
+		return status, defaultAnalysisInterval
+	}
+	if status.Phase == elbv2api.TrafficSplitPhasePaused {
+		// the current step is restarted once traffic shifting is resumed.
+		startTrafficSplitStep(ts, &status, status.CurrentStep, now)
+	}
+
+	lastStep := int32(len(ts.Spec.Steps) - 1)
+	if status.CurrentStep == lastStep {
+		status.Phase = elbv2api.TrafficSplitPhaseSucceeded
+		status.Message = ""
+		return status, 0
+	}
+	remaining := buildStepDuration(ts.Spec.Steps[status.CurrentStep])
+	if status.StepStartTime != nil {
+		remaining -= now.Sub(status.StepStartTime.Time)
+	}
+	if remaining > 0 {
+		return status, minDuration(remaining, defaultAnalysisInterval)
+	}
+	startTrafficSplitStep(ts, &status, status.CurrentStep+1, now)
+	if status.CurrentStep == lastStep {
+		status.Phase = elbv2api.TrafficSplitPhaseSucceeded
+		return status, 0
+	}
+	return status, minDuration(buildStepDuration(ts.Spec.Steps[status.CurrentStep]), defaultAnalysisInterval)
+}
+
+// startTrafficSplitStep moves TrafficSplit to the step.
+func startTrafficSplitStep(ts *elbv2api.TrafficSplit, status *elbv2api.TrafficSplitStatus, step int32, now metav1.Time) {
+	status.Phase = elbv2api.TrafficSplitPhaseProgressing
+	status.CurrentStep = step
+	status.Weight = ts.Spec.Steps[step].Weight
+	status.StepStartTime = &now
+	status.Message = ""
+}
+
+// isCanaryDegraded checks whether the canary is degraded based on the health of its targets.
+func isCanaryDegraded(ts *elbv2api.TrafficSplit, canaryTargets *elbv2api.TargetGroupBindingTargetsStatus) (bool, string) {
+	if canaryTargets == nil || canaryTargets.Registered == 0 {
+		return false, ""
+	}
+	maxUnhealthyTargetsPercent := int32(defaultMaxUnhealthyTargetsPercent)
+	if ts.Spec.Analysis != nil && ts.Spec.Analysis.MaxUnhealthyTargetsPercent != nil {
+		maxUnhealthyTargetsPercent = *ts.Spec.Analysis.MaxUnhealthyTargetsPercent
+	}
+	if canaryTargets.Unhealthy*100 <= maxUnhealthyTargetsPercent*canaryTargets.Registered {
+		return false, ""
+	}
+	return true, fmt.Sprintf("%v of %v targets are unhealthy", canaryTargets.Unhealthy, canaryTargets.Registered)
+}
+
+func buildFailurePolicy(ts *elbv2api.TrafficSplit) elbv2api.TrafficSplitFailurePolicy {
+	if ts.Spec.Analysis != nil && ts.Spec.Analysis.FailurePolicy != nil {
+		return *ts.Spec.Analysis.FailurePolicy
+	}
+	return defaultFailurePolicy
+}
+
+func buildStepDuration(step elbv2api.TrafficSplitStep) time.Duration {
+	if step.Duration == nil {
+		return 0
+	}
+	return step.Duration.Duration
+}
+
+func minDuration(a time.Duration, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,386 @@
+package trafficsplit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+func Test_BuildTrafficSplitStatus(t *testing.T) {
+	startTime := metav1.NewTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	now := metav1.NewTime(startTime.Add(5 * time.Minute))
+	steps := []elbv2api.TrafficSplitStep{
+		{Weight: 10, Duration: &metav1.Duration{Duration: 5 * time.Minute}},
+		{Weight: 50, Duration: &metav1.Duration{Duration: 10 * time.Minute}},
+		{Weight: 100},
+	}
+	pausePolicy := elbv2api.TrafficSplitFailurePolicyPause
+	healthyTargets := &elbv2api.TargetGroupBindingTargetsStatus{Registered: 4, Healthy: 4}
+	degradedTargets := &elbv2api.TargetGroupBindingTargetsStatus{Registered: 4, Healthy: 3, Unhealthy: 1}
+
+	type args struct {
+		spec          elbv2api.TrafficSplitSpec
+		generation    int64
+		status        elbv2api.TrafficSplitStatus
+		canaryTGARN   string
+		canaryTargets *elbv2api.TargetGroupBindingTargetsStatus
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantStatus  elbv2api.TrafficSplitStatus
+		wantRequeue time.Duration
+	}{
+		{
+			name: "new TrafficSplit starts from first step",
+			args: args{
+				spec:          elbv2api.TrafficSplitSpec{Steps: steps},
+				generation:    1,
+				canaryTGARN:   "tg-arn",
+				canaryTargets: healthyTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(1),
+				Phase:                elbv2api.TrafficSplitPhaseProgressing,
+				CurrentStep:          0,
+				Weight:               10,
+				StepStartTime:        &now,
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        healthyTargets,
+			},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "waiting for canary target group",
+			args: args{
+				spec:       elbv2api.TrafficSplitSpec{Steps: steps},
+				generation: 1,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration: aws.Int64(1),
+				Phase:              elbv2api.TrafficSplitPhaseProgressing,
+				CurrentStep:        0,
+				Weight:             10,
+				StepStartTime:      &now,
+				Message:            "Waiting for the target group of canary Service",
+			},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "step is held until its duration elapses",
+			args: args{
+				spec:       elbv2api.TrafficSplitSpec{Steps: steps},
+				generation: 1,
+				status: elbv2api.TrafficSplitStatus{
+					ObservedGeneration: aws.Int64(1),
+					Phase:              elbv2api.TrafficSplitPhaseProgressing,
+					CurrentStep:        0,
+					Weight:             10,
+					StepStartTime:      &metav1.Time{Time: now.Add(-4*time.Minute - 50*time.Second)},
+				},
+				canaryTGARN:   "tg-arn",
+				canaryTargets: healthyTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(1),
+				Phase:                elbv2api.TrafficSplitPhaseProgressing,
+				CurrentStep:          0,
+				Weight:               10,
+				StepStartTime:        &metav1.Time{Time: now.Add(-4*time.Minute - 50*time.Second)},
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        healthyTargets,
+			},
+			wantRequeue: 10 * time.Second,
+		},
+		{
+			name: "advance to next step once duration elapses",
+			args: args{
+				spec:       elbv2api.TrafficSplitSpec{Steps: steps},
+				generation: 1,
+				status: elbv2api.TrafficSplitStatus{
+					ObservedGeneration: aws.Int64(1),
+					Phase:              elbv2api.TrafficSplitPhaseProgressing,
+					CurrentStep:        0,
+					Weight:             10,
+					StepStartTime:      &startTime,
+				},
+				canaryTGARN:   "tg-arn",
+				canaryTargets: healthyTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(1),
+				Phase:                elbv2api.TrafficSplitPhaseProgressing,
+				CurrentStep:          1,
+				Weight:               50,
+				StepStartTime:        &now,
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        healthyTargets,
+			},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "succeeded once last step is reached",
+			args: args{
+				spec:       elbv2api.TrafficSplitSpec{Steps: steps},
+				generation: 1,
+				status: elbv2api.TrafficSplitStatus{
+					ObservedGeneration: aws.Int64(1),
+					Phase:              elbv2api.TrafficSplitPhaseProgressing,
+					CurrentStep:        1,
+					Weight:             50,
+					StepStartTime:      &metav1.Time{Time: now.Add(-10 * time.Minute)},
+				},
+				canaryTGARN:   "tg-arn",
+				canaryTargets: healthyTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(1),
+				Phase:                elbv2api.TrafficSplitPhaseSucceeded,
+				CurrentStep:          2,
+				Weight:               100,
+				StepStartTime:        &now,
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        healthyTargets,
+			},
+			wantRequeue: 0,
+		},
+		{
+			name: "paused by spec",
+			args: args{
+				spec:       elbv2api.TrafficSplitSpec{Steps: steps, Paused: true},
+				generation: 2,
+				status: elbv2api.TrafficSplitStatus{
+					ObservedGeneration: aws.Int64(1),
+					Phase:              elbv2api.TrafficSplitPhaseProgressing,
+					CurrentStep:        1,
+					Weight:             50,
+					StepStartTime:      &startTime,
+				},
+				canaryTGARN:   "tg-arn",
+				canaryTargets: healthyTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(2),
+				Phase:                elbv2api.TrafficSplitPhasePaused,
+				CurrentStep:          1,
+				Weight:               50,
+				StepStartTime:        &startTime,
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        healthyTargets,
+				Message:              "Traffic shifting is paused",
+			},
+			wantRequeue: 0,
+		},
+		{
+			name: "rolled back when canary is degraded",
+			args: args{
+				spec:       elbv2api.TrafficSplitSpec{Steps: steps},
+				generation: 1,
+				status: elbv2api.TrafficSplitStatus{
+					ObservedGeneration: aws.Int64(1),
+					Phase:              elbv2api.TrafficSplitPhaseProgressing,
+					CurrentStep:        1,
+					Weight:             50,
+					StepStartTime:      &startTime,
+				},
+				canaryTGARN:   "tg-arn",
+				canaryTargets: degradedTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(1),
+				Phase:                elbv2api.TrafficSplitPhaseRolledBack,
+				CurrentStep:          1,
+				Weight:               0,
+				StepStartTime:        &startTime,
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        degradedTargets,
+				Message:              "Rolled back since canary is degraded: 1 of 4 targets are unhealthy",
+			},
+			wantRequeue: 0,
+		},
+		{
+			name: "unhealthy targets within maxUnhealthyTargetsPercent",
+			args: args{
+				spec: elbv2api.TrafficSplitSpec{
+					Steps:    steps,
+					Analysis: &elbv2api.TrafficSplitAnalysis{MaxUnhealthyTargetsPercent: aws.Int32(25)},
+				},
+				generation: 1,
+				status: elbv2api.TrafficSplitStatus{
+					ObservedGeneration: aws.Int64(1),
+					Phase:              elbv2api.TrafficSplitPhaseProgressing,
+					CurrentStep:        1,
+					Weight:             50,
+					StepStartTime:      &metav1.Time{Time: now.Add(-time.Minute)},
+				},
+				canaryTGARN:   "tg-arn",
+				canaryTargets: degradedTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(1),
+				Phase:                elbv2api.TrafficSplitPhaseProgressing,
+				CurrentStep:          1,
+				Weight:               50,
+				StepStartTime:        &metav1.Time{Time: now.Add(-time.Minute)},
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        degradedTargets,
+			},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "paused when canary is degraded with Pause policy",
+			args: args{
+				spec: elbv2api.TrafficSplitSpec{
+					Steps:    steps,
+					Analysis: &elbv2api.TrafficSplitAnalysis{FailurePolicy: &pausePolicy},
+				},
+				generation: 1,
+				status: elbv2api.TrafficSplitStatus{
+					ObservedGeneration: aws.Int64(1),
+					Phase:              elbv2api.TrafficSplitPhaseProgressing,
+					CurrentStep:        1,
+					Weight:             50,
+					StepStartTime:      &startTime,
+				},
+				canaryTGARN:   "tg-arn",
+				canaryTargets: degradedTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(1),
+				Phase:                elbv2api.TrafficSplitPhasePaused,
+				CurrentStep:          1,
+				Weight:               50,
+				StepStartTime:        &startTime,
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        degradedTargets,
+				Message:              "Paused since canary is degraded: 1 of 4 targets are unhealthy",
+			},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "resumed from current step once canary recovers",
+			args: args{
+				spec: elbv2api.TrafficSplitSpec{
+					Steps:    steps,
+					Analysis: &elbv2api.TrafficSplitAnalysis{FailurePolicy: &pausePolicy},
+				},
+				generation: 1,
+				status: elbv2api.TrafficSplitStatus{
+					ObservedGeneration: aws.Int64(1),
+					Phase:              elbv2api.TrafficSplitPhasePaused,
+					CurrentStep:        1,
+					Weight:             50,
+					StepStartTime:      &startTime,
+					Message:            "Paused since canary is degraded: 1 of 4 targets are unhealthy",
+				},
+				canaryTGARN:   "tg-arn",
+				canaryTargets: healthyTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(1),
+				Phase:                elbv2api.TrafficSplitPhaseProgressing,
+				CurrentStep:          1,
+				Weight:               50,
+				StepStartTime:        &now,
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        healthyTargets,
+			},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "rolled back TrafficSplit stays rolled back",
+			args: args{
+				spec:       elbv2api.TrafficSplitSpec{Steps: steps},
+				generation: 1,
+				status: elbv2api.TrafficSplitStatus{
+					ObservedGeneration: aws.Int64(1),
+					Phase:              elbv2api.TrafficSplitPhaseRolledBack,
+					CurrentStep:        1,
+					StepStartTime:      &startTime,
+				},
+				canaryTGARN:   "tg-arn",
+				canaryTargets: healthyTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(1),
+				Phase:                elbv2api.TrafficSplitPhaseRolledBack,
+				CurrentStep:          1,
+				StepStartTime:        &startTime,
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        healthyTargets,
+			},
+			wantRequeue: 0,
+		},
+		{
+			name: "rolled back TrafficSplit starts over when spec changes",
+			args: args{
+				spec:       elbv2api.TrafficSplitSpec{Steps: steps},
+				generation: 2,
+				status: elbv2api.TrafficSplitStatus{
+					ObservedGeneration: aws.Int64(1),
+					Phase:              elbv2api.TrafficSplitPhaseRolledBack,
+					CurrentStep:        1,
+					StepStartTime:      &startTime,
+				},
+				canaryTGARN:   "tg-arn",
+				canaryTargets: healthyTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(2),
+				Phase:                elbv2api.TrafficSplitPhaseProgressing,
+				CurrentStep:          0,
+				Weight:               10,
+				StepStartTime:        &now,
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        healthyTargets,
+			},
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name: "current step is clamped when steps are removed",
+			args: args{
+				spec:       elbv2api.TrafficSplitSpec{Steps: steps[:1]},
+				generation: 2,
+				status: elbv2api.TrafficSplitStatus{
+					ObservedGeneration: aws.Int64(1),
+					Phase:              elbv2api.TrafficSplitPhaseProgressing,
+					CurrentStep:        1,
+					Weight:             50,
+					StepStartTime:      &startTime,
+				},
+				canaryTGARN:   "tg-arn",
+				canaryTargets: healthyTargets,
+			},
+			wantStatus: elbv2api.TrafficSplitStatus{
+				ObservedGeneration:   aws.Int64(2),
+				Phase:                elbv2api.TrafficSplitPhaseSucceeded,
+				CurrentStep:          0,
+				Weight:               10,
+				StepStartTime:        &now,
+				CanaryTargetGroupARN: "tg-arn",
+				CanaryTargets:        healthyTargets,
+			},
+			wantRequeue: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := &elbv2api.TrafficSplit{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "awesome-split",
+					Generation: tt.args.generation,
+				},
+				Spec:   tt.args.spec,
+				Status: tt.args.status,
+			}
+			gotStatus, gotRequeue := BuildTrafficSplitStatus(ts, tt.args.canaryTGARN, tt.args.canaryTargets, now)
+			assert.Equal(t, tt.wantStatus, gotStatus)
+			assert.Equal(t, tt.wantRequeue, gotRequeue)
+		})
+	}
+}
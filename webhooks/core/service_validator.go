@@ -0,0 +1,81 @@
+package core
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	apiPathValidateService  = "/validate-v1-service"
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+)
+
+// NewServiceValidator returns a validator for Service.
+func NewServiceValidator() *serviceValidator {
+	return &serviceValidator{
+		annotationParser: annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix),
+	}
+}
+
+var _ webhook.Validator = &serviceValidator{}
+
+type serviceValidator struct {
+	annotationParser annotations.Parser
+}
+
+func (v *serviceValidator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &corev1.Service{}, nil
+}
+
+func (v *serviceValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	svc := obj.(*corev1.Service)
+	return v.checkGroupAnnotations(svc, nil)
+}
+
+func (v *serviceValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, oldObj runtime.Object) error {
+	svc := obj.(*corev1.Service)
+	oldSvc := oldObj.(*corev1.Service)
+	return v.checkGroupAnnotations(svc, oldSvc)
+}
+
+func (v *serviceValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// checkGroupAnnotations checks the validity of "aws-load-balancer-group-name" and "aws-load-balancer-group-order" annotations.
+// Services are only checked when these annotations are changed, so that existing Services can still be updated otherwise.
+func (v *serviceValidator) checkGroupAnnotations(svc *corev1.Service, oldSvc *corev1.Service) error {
+	if oldSvc != nil && !v.isGroupAnnotationsChanged(svc, oldSvc) {
+		return nil
+	}
+	if err := service.ValidateGroupAnnotations(v.annotationParser, svc); err != nil {
+		return errors.Wrap(err, "invalid service group annotations")
+	}
+	return nil
+}
+
+func (v *serviceValidator) isGroupAnnotationsChanged(svc *corev1.Service, oldSvc *corev1.Service) bool {
+	for _, suffix := range []string{annotations.SvcLBSuffixGroupName, annotations.SvcLBSuffixGroupOrder} {
+		var value, oldValue string
+		exists := v.annotationParser.ParseStringAnnotation(suffix, &value, svc.Annotations)
+		oldExists := v.annotationParser.ParseStringAnnotation(suffix, &oldValue, oldSvc.Annotations)
+		if exists != oldExists || value != oldValue {
+			return true
+		}
+	}
+	return false
+}
+
+// +kubebuilder:webhook:path=/validate-v1-service,mutating=false,failurePolicy=ignore,groups="",resources=services,verbs=create;update,versions=v1,name=vservice.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
+
+func (v *serviceValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathValidateService, webhook.ValidatingWebhookForValidator(v))
+}
@@ -0,0 +1,202 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_serviceValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		wantErr string
+	}{
+		{
+			name: "service without group annotations",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+				},
+			},
+		},
+		{
+			name: "service with valid group annotations",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name":  "my-team.awesome-group",
+						"service.beta.kubernetes.io/aws-load-balancer-group-order": "-10",
+					},
+				},
+			},
+		},
+		{
+			name: "service with invalid group name",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name": "Awesome_Group",
+					},
+				},
+			},
+			wantErr: "invalid service group annotations: groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character",
+		},
+		{
+			name: "service with group order out of range",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name":  "awesome-group",
+						"service.beta.kubernetes.io/aws-load-balancer-group-order": "1001",
+					},
+				},
+			},
+			wantErr: "invalid service group annotations: explicit service group order must be within [-1000:1000], order: 1001",
+		},
+		{
+			name: "service with malformed group order",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name":  "awesome-group",
+						"service.beta.kubernetes.io/aws-load-balancer-group-order": "first",
+					},
+				},
+			},
+			wantErr: "invalid service group annotations: failed to parse int64 annotation, service.beta.kubernetes.io/aws-load-balancer-group-order: first: strconv.ParseInt: parsing \"first\": invalid syntax",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewServiceValidator()
+			err := v.ValidateCreate(context.Background(), tt.svc)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_serviceValidator_ValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		oldSvc  *corev1.Service
+		wantErr string
+	}{
+		{
+			name: "group name changed to valid value",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name": "another-group",
+					},
+				},
+			},
+			oldSvc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+					},
+				},
+			},
+		},
+		{
+			name: "group name changed to invalid value",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name": "Awesome_Group",
+					},
+				},
+			},
+			oldSvc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+					},
+				},
+			},
+			wantErr: "invalid service group annotations: groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character",
+		},
+		{
+			name: "group order added with invalid value",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name":  "awesome-group",
+						"service.beta.kubernetes.io/aws-load-balancer-group-order": "-1001",
+					},
+				},
+			},
+			oldSvc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+					},
+				},
+			},
+			wantErr: "invalid service group annotations: explicit service group order must be within [-1000:1000], order: -1001",
+		},
+		{
+			name: "invalid group annotations unchanged",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name": "Awesome_Group",
+						"service.beta.kubernetes.io/aws-load-balancer-scheme":     "internet-facing",
+					},
+				},
+			},
+			oldSvc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-group-name": "Awesome_Group",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewServiceValidator()
+			err := v.ValidateUpdate(context.Background(), tt.svc, tt.oldSvc)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package elbv2
 
 import (
 	"context"
+	"net"
+	"regexp"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
@@ -13,6 +16,9 @@ import (
 
 const apiPathValidateELBv2IngressClassParams = "/validate-elbv2-k8s-aws-v1beta1-ingressclassparams"
 
+// the name of the loadbalancer can only have up to 32 characters, and must only contain alphanumeric characters or hyphens.
+var loadBalancerNamePattern = regexp.MustCompile("^[a-zA-Z0-9]([a-zA-Z0-9-]{0,30}[a-zA-Z0-9])?$")
+
 // NewIngressClassParamsValidator returns a validator for the IngressClassParams CRD.
 func NewIngressClassParamsValidator() *ingressClassParamsValidator {
 	return &ingressClassParamsValidator{}
@@ -31,6 +37,11 @@ func (v *ingressClassParamsValidator) ValidateCreate(ctx context.Context, obj ru
 	icp := obj.(*elbv2api.IngressClassParams)
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, v.checkSubnetSelectors(icp)...)
+	allErrs = append(allErrs, v.checkLoadBalancerName(icp)...)
+	allErrs = append(allErrs, v.checkListeners(icp)...)
+	allErrs = append(allErrs, v.checkCertificateARNs(icp)...)
+	allErrs = append(allErrs, v.checkInboundCIDRs(icp)...)
+	allErrs = append(allErrs, v.checkWAFv2ACLARN(icp)...)
 
 	return allErrs.ToAggregate()
 }
@@ -39,6 +50,11 @@ func (v *ingressClassParamsValidator) ValidateUpdate(ctx context.Context, obj ru
 	icp := obj.(*elbv2api.IngressClassParams)
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, v.checkSubnetSelectors(icp)...)
+	allErrs = append(allErrs, v.checkLoadBalancerName(icp)...)
+	allErrs = append(allErrs, v.checkListeners(icp)...)
+	allErrs = append(allErrs, v.checkCertificateARNs(icp)...)
+	allErrs = append(allErrs, v.checkInboundCIDRs(icp)...)
+	allErrs = append(allErrs, v.checkWAFv2ACLARN(icp)...)
 
 	return allErrs.ToAggregate()
 }
@@ -90,6 +106,84 @@ func (v *ingressClassParamsValidator) checkSubnetSelectors(icp *elbv2api.Ingress
 	return allErrs
 }
 
+// checkLoadBalancerName will check for valid LoadBalancerName
+func (v *ingressClassParamsValidator) checkLoadBalancerName(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	if icp.Spec.LoadBalancerName == "" {
+		return allErrs
+	}
+	fieldPath := field.NewPath("spec", "loadBalancerName")
+	if !loadBalancerNamePattern.MatchString(icp.Spec.LoadBalancerName) {
+		allErrs = append(allErrs, field.Invalid(fieldPath, icp.Spec.LoadBalancerName, "must have up to 32 alphanumeric characters or hyphens, and must not begin or end with a hyphen"))
+	}
+	return allErrs
+}
+
+// checkListeners will check for valid Listeners
+func (v *ingressClassParamsValidator) checkListeners(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	fieldPath := field.NewPath("spec", "listeners")
+	seen := map[int32]bool{}
+	for i, listener := range icp.Spec.Listeners {
+		fieldPath := fieldPath.Index(i)
+		if listener.Port < 1 || listener.Port > 65535 {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("port"), listener.Port, "must be within [1, 65535]"))
+		}
+		if listener.Protocol != elbv2api.ListenerProtocolHTTP && listener.Protocol != elbv2api.ListenerProtocolHTTPS {
+			allErrs = append(allErrs, field.NotSupported(fieldPath.Child("protocol"), listener.Protocol,
+				[]string{string(elbv2api.ListenerProtocolHTTP), string(elbv2api.ListenerProtocolHTTPS)}))
+		}
+		if seen[listener.Port] {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Child("port"), listener.Port))
+		}
+		seen[listener.Port] = true
+	}
+	return allErrs
+}
+
+// checkCertificateARNs will check for valid CertificateARNs
+func (v *ingressClassParamsValidator) checkCertificateARNs(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	fieldPath := field.NewPath("spec", "certificateARNs")
+	seen := map[string]bool{}
+	for i, certARN := range icp.Spec.CertificateARNs {
+		if !arn.IsARN(certARN) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Index(i), certARN, "must be a valid ARN"))
+		}
+		if seen[certARN] {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Index(i), certARN))
+		}
+		seen[certARN] = true
+	}
+	return allErrs
+}
+
+// checkInboundCIDRs will check for valid InboundCIDRs
+func (v *ingressClassParamsValidator) checkInboundCIDRs(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	fieldPath := field.NewPath("spec", "inboundCIDRs")
+	seen := map[string]bool{}
+	for i, cidr := range icp.Spec.InboundCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Index(i), cidr, "must be a valid CIDR"))
+		}
+		if seen[cidr] {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Index(i), cidr))
+		}
+		seen[cidr] = true
+	}
+	return allErrs
+}
+
+// checkWAFv2ACLARN will check for valid WAFv2ACLARN
+func (v *ingressClassParamsValidator) checkWAFv2ACLARN(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	if icp.Spec.WAFv2ACLARN == "" {
+		return allErrs
+	}
+	fieldPath := field.NewPath("spec", "wafv2ACLARN")
+	parsedARN, err := arn.Parse(icp.Spec.WAFv2ACLARN)
+	if err != nil || parsedARN.Service != "wafv2" {
+		allErrs = append(allErrs, field.Invalid(fieldPath, icp.Spec.WAFv2ACLARN, "must be a valid WAFv2 WebACL ARN"))
+	}
+	return allErrs
+}
+
 // +kubebuilder:webhook:path=/validate-elbv2-k8s-aws-v1beta1-ingressclassparams,mutating=false,failurePolicy=fail,groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=create;update,versions=v1beta1,name=vingressclassparams.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
 
 func (v *ingressClassParamsValidator) SetupWithManager(mgr ctrl.Manager) {
@@ -99,6 +99,110 @@ func Test_ingressClassParamsValidator_ValidateCreate(t *testing.T) {
 			},
 			wantErr: "spec.subnets.tags: Required value: must have at least one tag key",
 		},
+		{
+			name: "valid load balancer settings",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					LoadBalancerName: "my-alb",
+					Listeners: []elbv2api.Listener{
+						{Port: 80, Protocol: elbv2api.ListenerProtocolHTTP},
+						{Port: 443, Protocol: elbv2api.ListenerProtocolHTTPS},
+					},
+					CertificateARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/cert-1"},
+					InboundCIDRs:    []string{"10.0.0.0/16", "2001:db8::/32"},
+					WAFv2ACLARN:     "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/my-acl/3ab78708-85b0-49d3-b4e1-7a9615a6613b",
+				},
+			},
+		},
+		{
+			name: "load balancer name with invalid characters",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					LoadBalancerName: "my_alb-",
+				},
+			},
+			wantErr: "spec.loadBalancerName: Invalid value: \"my_alb-\": must have up to 32 alphanumeric characters or hyphens, and must not begin or end with a hyphen",
+		},
+		{
+			name: "load balancer name too long",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					LoadBalancerName: "a-very-long-load-balancer-name-xyz",
+				},
+			},
+			wantErr: "spec.loadBalancerName: Invalid value: \"a-very-long-load-balancer-name-xyz\": must have up to 32 alphanumeric characters or hyphens, and must not begin or end with a hyphen",
+		},
+		{
+			name: "listener with TLS protocol",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Listeners: []elbv2api.Listener{
+						{Port: 443, Protocol: elbv2api.ListenerProtocolTLS},
+					},
+				},
+			},
+			wantErr: "spec.listeners[0].protocol: Unsupported value: \"TLS\": supported values: \"HTTP\", \"HTTPS\"",
+		},
+		{
+			name: "listener duplicate port",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Listeners: []elbv2api.Listener{
+						{Port: 443, Protocol: elbv2api.ListenerProtocolHTTPS},
+						{Port: 443, Protocol: elbv2api.ListenerProtocolHTTP},
+					},
+				},
+			},
+			wantErr: "spec.listeners[1].port: Duplicate value: 443",
+		},
+		{
+			name: "certificate ARN invalid",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					CertificateARNs: []string{"cert-1"},
+				},
+			},
+			wantErr: "spec.certificateARNs[0]: Invalid value: \"cert-1\": must be a valid ARN",
+		},
+		{
+			name: "certificate ARN duplicate",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					CertificateARNs: []string{
+						"arn:aws:acm:us-west-2:123456789012:certificate/cert-1",
+						"arn:aws:acm:us-west-2:123456789012:certificate/cert-1",
+					},
+				},
+			},
+			wantErr: "spec.certificateARNs[1]: Duplicate value: \"arn:aws:acm:us-west-2:123456789012:certificate/cert-1\"",
+		},
+		{
+			name: "inbound CIDR invalid",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					InboundCIDRs: []string{"10.0.0.0/16", "10.0.0.1"},
+				},
+			},
+			wantErr: "spec.inboundCIDRs[1]: Invalid value: \"10.0.0.1\": must be a valid CIDR",
+		},
+		{
+			name: "inbound CIDR duplicate",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					InboundCIDRs: []string{"10.0.0.0/16", "10.0.0.0/16"},
+				},
+			},
+			wantErr: "spec.inboundCIDRs[1]: Duplicate value: \"10.0.0.0/16\"",
+		},
+		{
+			name: "WAFv2 ACL ARN of another service",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFv2ACLARN: "arn:aws:waf-regional:us-west-2:123456789012:webacl/my-acl",
+				},
+			},
+			wantErr: "spec.wafv2ACLARN: Invalid value: \"arn:aws:waf-regional:us-west-2:123456789012:webacl/my-acl\": must be a valid WAFv2 WebACL ARN",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
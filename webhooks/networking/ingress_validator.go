@@ -171,10 +171,16 @@ func (v *ingressValidator) checkIngressClassUsage(ctx context.Context, ing *netw
 	return nil
 }
 
-// checkGroupNameAnnotationUsage checks the validity of "conditions.${conditions-name}" annotation.
+// checkIngressAnnotationConditions checks the validity of "conditions.${conditions-name}" annotation.
 func (v *ingressValidator) checkIngressAnnotationConditions(ing *networking.Ingress) error {
 	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
 		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service == nil {
+				continue
+			}
 			var conditions []ingress.RuleCondition
 			annotationKey := fmt.Sprintf("conditions.%v", path.Backend.Service.Name)
 			_, err := v.annotationParser.ParseJSONAnnotation(annotationKey, &conditions, ing.Annotations)
@@ -182,15 +188,13 @@ func (v *ingressValidator) checkIngressAnnotationConditions(ing *networking.Ingr
 				return err
 			}
 
-			for _, condition := range conditions {
-				if err := condition.Validate(); err != nil {
-					return fmt.Errorf("ignoring Ingress %s/%s since invalid alb.ingress.kubernetes.io/conditions.%s annotation: %w",
-						ing.Namespace,
-						ing.Name,
-						path.Backend.Service.Name,
-						err,
-					)
-				}
+			if err := ingress.ValidateRuleConditions(conditions); err != nil {
+				return fmt.Errorf("ignoring Ingress %s/%s since invalid alb.ingress.kubernetes.io/conditions.%s annotation: %w",
+					ing.Namespace,
+					ing.Name,
+					path.Backend.Service.Name,
+					err,
+				)
 			}
 		}
 	}